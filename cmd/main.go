@@ -1,7 +1,21 @@
 package main
 
-import "github.com/tmaffia/dungeon-time-api/internal/api"
+import (
+	"os"
+
+	"github.com/tmaffia/dungeon-time-api/internal/api"
+)
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "worker":
+			api.StartWorker()
+			return
+		case "import-characters":
+			api.ImportCharacters(os.Args[2:])
+			return
+		}
+	}
 	api.StartApi()
 }
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS catalog_versions;
+
+DROP TRIGGER IF EXISTS update_dungeons_updated_at ON dungeons;
+
+DROP TABLE dungeons;
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS dungeons (
+    id SERIAL PRIMARY KEY,
+    code TEXT NOT NULL UNIQUE,
+    name TEXT NOT NULL,
+    expansion TEXT NOT NULL,
+    season TEXT NOT NULL,
+    par_seconds INTEGER NOT NULL CHECK (par_seconds > 0),
+    boss_count INTEGER NOT NULL CHECK (boss_count > 0),
+    difficulties TEXT[] NOT NULL DEFAULT '{}',
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER update_dungeons_updated_at
+BEFORE UPDATE ON dungeons
+FOR EACH ROW
+EXECUTE PROCEDURE update_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS catalog_versions (
+    catalog TEXT PRIMARY KEY,
+    version INTEGER NOT NULL,
+    applied_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_runs_updated_at ON runs;
+
+DROP TABLE runs;
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS runs (
+    id SERIAL PRIMARY KEY,
+    dungeon_id INTEGER NOT NULL REFERENCES dungeons (id),
+    difficulty TEXT NOT NULL,
+    key_level INTEGER CHECK (key_level IS NULL OR key_level >= 2),
+    organizer_id INTEGER NOT NULL REFERENCES users (id),
+    starts_at TIMESTAMPTZ NOT NULL,
+    timezone TEXT NOT NULL DEFAULT 'UTC',
+    duration_minutes INTEGER NOT NULL CHECK (duration_minutes > 0),
+    notes TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'scheduled',
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS runs_starts_at_idx ON runs (starts_at);
+
+CREATE TRIGGER update_runs_updated_at
+BEFORE UPDATE ON runs
+FOR EACH ROW
+EXECUTE PROCEDURE update_updated_at_column();
@@ -0,0 +1,8 @@
+DROP TRIGGER IF EXISTS update_run_signups_updated_at ON run_signups;
+
+DROP TABLE run_signups;
+
+ALTER TABLE runs
+    DROP COLUMN tank_slots,
+    DROP COLUMN healer_slots,
+    DROP COLUMN dps_slots;
@@ -0,0 +1,25 @@
+ALTER TABLE runs
+    ADD COLUMN tank_slots INTEGER NOT NULL DEFAULT 1 CHECK (tank_slots >= 0),
+    ADD COLUMN healer_slots INTEGER NOT NULL DEFAULT 1 CHECK (healer_slots >= 0),
+    ADD COLUMN dps_slots INTEGER NOT NULL DEFAULT 3 CHECK (dps_slots >= 0);
+
+CREATE TABLE IF NOT EXISTS run_signups (
+    id SERIAL PRIMARY KEY,
+    run_id INTEGER NOT NULL REFERENCES runs (id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    role TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'confirmed',
+    withdrawn_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- A user can only hold one active signup per run, withdrawn signups are kept as history.
+CREATE UNIQUE INDEX IF NOT EXISTS run_signups_active_idx
+ON run_signups (run_id, user_id)
+WHERE status <> 'withdrawn';
+
+CREATE TRIGGER update_run_signups_updated_at
+BEFORE UPDATE ON run_signups
+FOR EACH ROW
+EXECUTE PROCEDURE update_updated_at_column();
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS run_events;
+
+ALTER TABLE run_signups DROP COLUMN waitlist_position;
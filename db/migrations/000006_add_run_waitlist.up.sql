@@ -0,0 +1,12 @@
+ALTER TABLE run_signups ADD COLUMN waitlist_position INTEGER;
+
+CREATE TABLE IF NOT EXISTS run_events (
+    id BIGSERIAL PRIMARY KEY,
+    run_id INTEGER NOT NULL REFERENCES runs (id) ON DELETE CASCADE,
+    type TEXT NOT NULL,
+    user_id INTEGER REFERENCES users (id) ON DELETE SET NULL,
+    payload JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS run_events_run_id_idx ON run_events (run_id, id);
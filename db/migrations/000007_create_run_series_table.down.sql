@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS runs_series_occurrence_idx;
+
+ALTER TABLE runs
+    DROP COLUMN series_id,
+    DROP COLUMN occurrence_at;
+
+DROP TABLE IF EXISTS run_series_exceptions;
+
+DROP TRIGGER IF EXISTS update_run_series_updated_at ON run_series;
+
+DROP TABLE run_series;
@@ -0,0 +1,39 @@
+CREATE TABLE IF NOT EXISTS run_series (
+    id SERIAL PRIMARY KEY,
+    organizer_id INTEGER NOT NULL REFERENCES users (id),
+    dungeon_id INTEGER NOT NULL REFERENCES dungeons (id),
+    difficulty TEXT NOT NULL,
+    key_level INTEGER CHECK (key_level IS NULL OR key_level >= 2),
+    rrule TEXT NOT NULL,
+    timezone TEXT NOT NULL DEFAULT 'UTC',
+    starts_at TIMESTAMPTZ NOT NULL,
+    until_at TIMESTAMPTZ,
+    duration_minutes INTEGER NOT NULL CHECK (duration_minutes > 0),
+    notes TEXT NOT NULL DEFAULT '',
+    tank_slots INTEGER NOT NULL DEFAULT 1 CHECK (tank_slots >= 0),
+    healer_slots INTEGER NOT NULL DEFAULT 1 CHECK (healer_slots >= 0),
+    dps_slots INTEGER NOT NULL DEFAULT 3 CHECK (dps_slots >= 0),
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER update_run_series_updated_at
+BEFORE UPDATE ON run_series
+FOR EACH ROW
+EXECUTE PROCEDURE update_updated_at_column();
+
+-- Occurrences that were skipped or edited individually, keyed by the
+-- start time the recurrence rule produced for them.
+CREATE TABLE IF NOT EXISTS run_series_exceptions (
+    series_id INTEGER NOT NULL REFERENCES run_series (id) ON DELETE CASCADE,
+    occurrence_at TIMESTAMPTZ NOT NULL,
+    kind TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (series_id, occurrence_at)
+);
+
+ALTER TABLE runs
+    ADD COLUMN series_id INTEGER REFERENCES run_series (id) ON DELETE SET NULL,
+    ADD COLUMN occurrence_at TIMESTAMPTZ;
+
+CREATE UNIQUE INDEX IF NOT EXISTS runs_series_occurrence_idx ON runs (series_id, occurrence_at);
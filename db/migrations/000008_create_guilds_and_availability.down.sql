@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS availability_exceptions;
+
+DROP TABLE IF EXISTS availability_windows;
+
+DROP TABLE IF EXISTS guild_members;
+
+DROP TRIGGER IF EXISTS update_guilds_updated_at ON guilds;
+
+DROP TABLE guilds;
@@ -0,0 +1,49 @@
+CREATE TABLE IF NOT EXISTS guilds (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER update_guilds_updated_at
+BEFORE UPDATE ON guilds
+FOR EACH ROW
+EXECUTE PROCEDURE update_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS guild_members (
+    guild_id INTEGER NOT NULL REFERENCES guilds (id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    role TEXT NOT NULL DEFAULT 'member',
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (guild_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS guild_members_user_id_idx ON guild_members (user_id);
+
+-- Weekly windows are wall clock times in the user's timezone. weekday follows
+-- Go's time.Weekday, 0 is Sunday, and minutes count from local midnight.
+CREATE TABLE IF NOT EXISTS availability_windows (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    weekday INTEGER NOT NULL CHECK (weekday BETWEEN 0 AND 6),
+    start_minute INTEGER NOT NULL CHECK (start_minute >= 0),
+    end_minute INTEGER NOT NULL CHECK (end_minute <= 1440),
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CHECK (start_minute < end_minute)
+);
+
+CREATE INDEX IF NOT EXISTS availability_windows_user_id_idx ON availability_windows (user_id);
+
+-- One-off changes to a user's availability. available is false for time the
+-- user is busy and true for extra time outside their weekly windows.
+CREATE TABLE IF NOT EXISTS availability_exceptions (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    available BOOLEAN NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CHECK (starts_at < ends_at)
+);
+
+CREATE INDEX IF NOT EXISTS availability_exceptions_user_id_idx ON availability_exceptions (user_id, starts_at);
@@ -0,0 +1,3 @@
+ALTER TABLE runs DROP COLUMN sequence;
+
+ALTER TABLE users DROP COLUMN calendar_token;
@@ -0,0 +1,4 @@
+ALTER TABLE users ADD COLUMN calendar_token TEXT UNIQUE;
+
+-- Incremented on every change to a run so calendar clients pick up updates.
+ALTER TABLE runs ADD COLUMN sequence INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS inbox_notifications;
+
+DROP TABLE IF EXISTS notification_preferences;
+
+DROP TRIGGER IF EXISTS update_notification_settings_updated_at ON notification_settings;
+
+DROP TABLE IF EXISTS notification_settings;
+
+DROP TRIGGER IF EXISTS update_notifications_updated_at ON notifications;
+
+DROP TABLE notifications;
@@ -0,0 +1,67 @@
+-- Outbox of notifications. Rows are written in the same transaction as the
+-- change they are about and delivered later by the notification worker.
+-- delivered_channels records the channels that already succeeded so retries
+-- only go to the ones that failed.
+CREATE TABLE IF NOT EXISTS notifications (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    type TEXT NOT NULL,
+    run_id INTEGER REFERENCES runs (id) ON DELETE CASCADE,
+    payload JSONB NOT NULL DEFAULT '{}',
+    status TEXT NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    delivered_channels TEXT[] NOT NULL DEFAULT '{}',
+    last_error TEXT NOT NULL DEFAULT '',
+    deliver_after TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    delivered_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS notifications_pending_idx ON notifications (deliver_after)
+WHERE status = 'pending';
+
+CREATE TRIGGER update_notifications_updated_at
+BEFORE UPDATE ON notifications
+FOR EACH ROW
+EXECUTE PROCEDURE update_updated_at_column();
+
+-- Quiet hours are wall clock minutes from local midnight in the user's timezone
+-- and may wrap past midnight, e.g. 1320 to 480 for 22:00 to 08:00.
+CREATE TABLE IF NOT EXISTS notification_settings (
+    user_id INTEGER PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    quiet_start_minute INTEGER CHECK (quiet_start_minute BETWEEN 0 AND 1439),
+    quiet_end_minute INTEGER CHECK (quiet_end_minute BETWEEN 0 AND 1439),
+    webhook_url TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((quiet_start_minute IS NULL) = (quiet_end_minute IS NULL))
+);
+
+CREATE TRIGGER update_notification_settings_updated_at
+BEFORE UPDATE ON notification_settings
+FOR EACH ROW
+EXECUTE PROCEDURE update_updated_at_column();
+
+-- The channels a user wants a type of notification on. Types without a row
+-- use the default channels.
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    type TEXT NOT NULL,
+    channels TEXT[] NOT NULL DEFAULT '{}',
+    PRIMARY KEY (user_id, type)
+);
+
+-- Notifications delivered by the in-app channel.
+CREATE TABLE IF NOT EXISTS inbox_notifications (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    notification_id BIGINT NOT NULL UNIQUE REFERENCES notifications (id) ON DELETE CASCADE,
+    type TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    body TEXT NOT NULL,
+    read_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS inbox_notifications_user_id_idx ON inbox_notifications (user_id, id);
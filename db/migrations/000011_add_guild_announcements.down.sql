@@ -0,0 +1,9 @@
+DROP TRIGGER IF EXISTS update_guild_announcements_updated_at ON guild_announcements;
+
+DROP TABLE guild_announcements;
+
+ALTER TABLE run_series DROP COLUMN guild_id;
+
+ALTER TABLE runs DROP COLUMN guild_id;
+
+ALTER TABLE guilds DROP COLUMN discord_webhook_url;
@@ -0,0 +1,29 @@
+ALTER TABLE guilds ADD COLUMN discord_webhook_url TEXT NOT NULL DEFAULT '';
+
+-- Runs and series can be organized for a guild, which announces them.
+ALTER TABLE runs ADD COLUMN guild_id INTEGER REFERENCES guilds (id) ON DELETE SET NULL;
+ALTER TABLE run_series ADD COLUMN guild_id INTEGER REFERENCES guilds (id) ON DELETE SET NULL;
+
+-- Outbox of announcements of runs to a guild's Discord channel, written in the
+-- same transaction as the change to the run.
+CREATE TABLE IF NOT EXISTS guild_announcements (
+    id BIGSERIAL PRIMARY KEY,
+    guild_id INTEGER NOT NULL REFERENCES guilds (id) ON DELETE CASCADE,
+    run_id INTEGER NOT NULL REFERENCES runs (id) ON DELETE CASCADE,
+    kind TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    deliver_after TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    delivered_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS guild_announcements_pending_idx ON guild_announcements (deliver_after)
+WHERE status = 'pending';
+
+CREATE TRIGGER update_guild_announcements_updated_at
+BEFORE UPDATE ON guild_announcements
+FOR EACH ROW
+EXECUTE PROCEDURE update_updated_at_column();
@@ -0,0 +1,3 @@
+ALTER TABLE notifications DROP COLUMN expires_at;
+
+DROP TABLE run_reminders;
@@ -0,0 +1,12 @@
+-- Reminders sent for a run, one row per offset before the start time, so
+-- each reminder is only sent once however many workers are running.
+CREATE TABLE IF NOT EXISTS run_reminders (
+    run_id INTEGER NOT NULL REFERENCES runs (id) ON DELETE CASCADE,
+    offset_minutes INTEGER NOT NULL,
+    sent_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (run_id, offset_minutes)
+);
+
+-- Notifications that are no use after a point, like a reminder for a run that
+-- has started, are dropped instead of delivered late.
+ALTER TABLE notifications ADD COLUMN expires_at TIMESTAMPTZ;
@@ -0,0 +1,7 @@
+DROP TRIGGER IF EXISTS notify_jobs_ready ON jobs;
+
+DROP FUNCTION IF EXISTS notify_job_ready;
+
+DROP TRIGGER IF EXISTS update_jobs_updated_at ON jobs;
+
+DROP TABLE jobs;
@@ -0,0 +1,46 @@
+-- Background jobs. Workers claim pending jobs with FOR UPDATE SKIP LOCKED and
+-- hold the row lock while the job runs, so a job whose worker dies becomes
+-- available again. Jobs that succeed are deleted, jobs that run out of
+-- attempts are kept as dead until an admin retries them.
+CREATE TABLE IF NOT EXISTS jobs (
+    id BIGSERIAL PRIMARY KEY,
+    queue TEXT NOT NULL DEFAULT 'default',
+    kind TEXT NOT NULL,
+    args JSONB NOT NULL DEFAULT '{}',
+    unique_key TEXT,
+    status TEXT NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    max_attempts INTEGER NOT NULL DEFAULT 10,
+    last_error TEXT NOT NULL DEFAULT '',
+    run_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS jobs_pending_idx ON jobs (queue, run_at)
+WHERE status = 'pending';
+
+-- Only one pending job of a kind may have a given unique key.
+CREATE UNIQUE INDEX IF NOT EXISTS jobs_unique_key_idx ON jobs (kind, unique_key)
+WHERE unique_key IS NOT NULL AND status = 'pending';
+
+CREATE TRIGGER update_jobs_updated_at
+BEFORE UPDATE ON jobs
+FOR EACH ROW
+EXECUTE PROCEDURE update_updated_at_column();
+
+-- Wake workers listening on the jobs channel when a job is ready to run.
+CREATE OR REPLACE FUNCTION notify_job_ready()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.status = 'pending' AND NEW.run_at <= CURRENT_TIMESTAMP THEN
+        PERFORM pg_notify('jobs', NEW.queue);
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER notify_jobs_ready
+AFTER INSERT OR UPDATE OF status, run_at ON jobs
+FOR EACH ROW
+EXECUTE PROCEDURE notify_job_ready();
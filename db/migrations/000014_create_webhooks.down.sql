@@ -0,0 +1,7 @@
+DROP TRIGGER IF EXISTS update_webhook_deliveries_updated_at ON webhook_deliveries;
+
+DROP TABLE webhook_deliveries;
+
+DROP TRIGGER IF EXISTS update_webhook_endpoints_updated_at ON webhook_endpoints;
+
+DROP TABLE webhook_endpoints;
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS notify_run_events ON run_events;
+
+DROP FUNCTION IF EXISTS notify_run_event;
@@ -0,0 +1,21 @@
+-- Publish every run event on the run_events channel once its transaction
+-- commits, so each API replica can push it to the streams it serves.
+CREATE OR REPLACE FUNCTION notify_run_event()
+RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('run_events', json_build_object(
+        'id', NEW.id,
+        'run_id', NEW.run_id,
+        'type', NEW.type,
+        'user_id', NEW.user_id,
+        'payload', NEW.payload,
+        'created_at', NEW.created_at
+    )::text);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER notify_run_events
+AFTER INSERT ON run_events
+FOR EACH ROW
+EXECUTE PROCEDURE notify_run_event();
@@ -0,0 +1,5 @@
+DROP TABLE ready_check_responses;
+
+DROP TRIGGER IF EXISTS update_ready_checks_updated_at ON ready_checks;
+
+DROP TABLE ready_checks;
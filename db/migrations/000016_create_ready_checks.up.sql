@@ -0,0 +1,32 @@
+-- Ready checks a run's organizer started before a key. A check is pending
+-- until everyone taking part has answered or it times out, then it is
+-- passed or failed.
+CREATE TABLE IF NOT EXISTS ready_checks (
+    id SERIAL PRIMARY KEY,
+    run_id INTEGER NOT NULL REFERENCES runs (id) ON DELETE CASCADE,
+    started_by INTEGER REFERENCES users (id) ON DELETE SET NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'passed', 'failed')),
+    expires_at TIMESTAMPTZ NOT NULL,
+    finished_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS ready_checks_run_id_idx ON ready_checks (run_id, id);
+
+-- A run has at most one ready check going at a time.
+CREATE UNIQUE INDEX IF NOT EXISTS ready_checks_pending_idx ON ready_checks (run_id)
+WHERE status = 'pending';
+
+CREATE TRIGGER update_ready_checks_updated_at
+BEFORE UPDATE ON ready_checks
+FOR EACH ROW
+EXECUTE PROCEDURE update_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS ready_check_responses (
+    ready_check_id INTEGER NOT NULL REFERENCES ready_checks (id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    ready BOOLEAN NOT NULL,
+    responded_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (ready_check_id, user_id)
+);
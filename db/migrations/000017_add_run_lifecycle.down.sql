@@ -0,0 +1,7 @@
+ALTER TABLE runs DROP CONSTRAINT IF EXISTS runs_status_check;
+
+ALTER TABLE runs DROP COLUMN upgrade_level;
+
+ALTER TABLE runs DROP COLUMN finished_at;
+
+ALTER TABLE runs DROP COLUMN started_at;
@@ -0,0 +1,7 @@
+ALTER TABLE runs ADD COLUMN started_at TIMESTAMPTZ;
+ALTER TABLE runs ADD COLUMN finished_at TIMESTAMPTZ;
+ALTER TABLE runs ADD COLUMN upgrade_level INTEGER CHECK (upgrade_level IS NULL OR upgrade_level BETWEEN 0 AND 3);
+
+ALTER TABLE runs ADD CONSTRAINT runs_status_check CHECK (
+    status IN ('scheduled', 'forming', 'in_progress', 'completed', 'depleted', 'abandoned', 'cancelled')
+);
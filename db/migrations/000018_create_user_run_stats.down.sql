@@ -0,0 +1,7 @@
+DROP MATERIALIZED VIEW IF EXISTS user_run_stats_summary;
+
+DROP VIEW IF EXISTS user_run_stats;
+
+DROP INDEX IF EXISTS run_signups_user_id_idx;
+
+ALTER TABLE run_signups DROP COLUMN attendance;
@@ -0,0 +1,47 @@
+-- Marked by the leader once a run is over. Counted by user stats as no-shows.
+ALTER TABLE run_signups ADD COLUMN attendance TEXT CHECK (attendance IN ('attended', 'late', 'no_show'));
+
+CREATE INDEX IF NOT EXISTS run_signups_user_id_idx ON run_signups (user_id);
+
+-- Finished runs per player, dungeon and role. Organizers take part in their
+-- runs without a signup, their role is ''. best_timed_level is 0 when no run
+-- was timed. Elapsed and par seconds are totals so averages can be taken over
+-- any grouping of the rows.
+CREATE VIEW user_run_stats AS
+WITH participants AS (
+    SELECT run_signups.user_id, run_signups.run_id, run_signups.role
+    FROM run_signups
+    WHERE run_signups.status = 'confirmed'
+    UNION
+    SELECT runs.organizer_id, runs.id, ''
+    FROM runs
+    WHERE NOT EXISTS (
+        SELECT 1 FROM run_signups
+        WHERE run_signups.run_id = runs.id AND run_signups.user_id = runs.organizer_id
+            AND run_signups.status = 'confirmed'
+    )
+)
+SELECT
+    participants.user_id,
+    runs.dungeon_id,
+    participants.role,
+    count(*)::int AS completed,
+    count(*) FILTER (WHERE runs.status = 'completed' AND runs.upgrade_level > 0)::int AS timed,
+    COALESCE(max(runs.key_level) FILTER (WHERE runs.status = 'completed' AND runs.upgrade_level > 0), 0)::int
+        AS best_timed_level,
+    COALESCE(sum(EXTRACT(EPOCH FROM runs.finished_at - runs.started_at)), 0)::bigint AS elapsed_seconds,
+    sum(dungeons.par_seconds)::bigint AS par_seconds
+FROM participants
+JOIN runs ON runs.id = participants.run_id
+JOIN dungeons ON dungeons.id = runs.dungeon_id
+WHERE runs.status IN ('completed', 'depleted')
+GROUP BY participants.user_id, runs.dungeon_id, participants.role;
+
+-- A copy of user_run_stats refreshed by the stats.refresh job, for servers
+-- with too many runs to aggregate on every request.
+CREATE MATERIALIZED VIEW user_run_stats_summary AS
+SELECT * FROM user_run_stats;
+
+-- Needed to refresh the summary concurrently.
+CREATE UNIQUE INDEX IF NOT EXISTS user_run_stats_summary_idx
+ON user_run_stats_summary (user_id, dungeon_id, role);
@@ -0,0 +1,40 @@
+-- Back to the definition from 000018, without run_participants.
+CREATE OR REPLACE VIEW user_run_stats AS
+WITH participants AS (
+    SELECT run_signups.user_id, run_signups.run_id, run_signups.role
+    FROM run_signups
+    WHERE run_signups.status = 'confirmed'
+    UNION
+    SELECT runs.organizer_id, runs.id, ''
+    FROM runs
+    WHERE NOT EXISTS (
+        SELECT 1 FROM run_signups
+        WHERE run_signups.run_id = runs.id AND run_signups.user_id = runs.organizer_id
+            AND run_signups.status = 'confirmed'
+    )
+)
+SELECT
+    participants.user_id,
+    runs.dungeon_id,
+    participants.role,
+    count(*)::int AS completed,
+    count(*) FILTER (WHERE runs.status = 'completed' AND runs.upgrade_level > 0)::int AS timed,
+    COALESCE(max(runs.key_level) FILTER (WHERE runs.status = 'completed' AND runs.upgrade_level > 0), 0)::int
+        AS best_timed_level,
+    COALESCE(sum(EXTRACT(EPOCH FROM runs.finished_at - runs.started_at)), 0)::bigint AS elapsed_seconds,
+    sum(dungeons.par_seconds)::bigint AS par_seconds
+FROM participants
+JOIN runs ON runs.id = participants.run_id
+JOIN dungeons ON dungeons.id = runs.dungeon_id
+WHERE runs.status IN ('completed', 'depleted')
+GROUP BY participants.user_id, runs.dungeon_id, participants.role;
+
+DROP VIEW IF EXISTS run_participants;
+
+DROP TABLE IF EXISTS leaderboard_snapshots;
+
+ALTER TABLE guilds DROP COLUMN leaderboard_scoring;
+
+DROP TRIGGER IF EXISTS update_seasons_updated_at ON seasons;
+
+DROP TABLE IF EXISTS seasons;
@@ -0,0 +1,67 @@
+CREATE TABLE IF NOT EXISTS seasons (
+    id SERIAL PRIMARY KEY,
+    code TEXT NOT NULL UNIQUE,
+    name TEXT NOT NULL,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ CHECK (ends_at IS NULL OR ends_at > starts_at),
+    closed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Only one season is open at a time.
+CREATE UNIQUE INDEX IF NOT EXISTS seasons_open_idx ON seasons ((closed_at IS NULL)) WHERE closed_at IS NULL;
+
+CREATE TRIGGER update_seasons_updated_at
+BEFORE UPDATE ON seasons
+FOR EACH ROW
+EXECUTE PROCEDURE update_updated_at_column();
+
+ALTER TABLE guilds ADD COLUMN leaderboard_scoring TEXT NOT NULL DEFAULT 'mythic_plus';
+
+-- Guild leaderboards as they stood when their season closed.
+CREATE TABLE IF NOT EXISTS leaderboard_snapshots (
+    season_id INTEGER NOT NULL REFERENCES seasons (id) ON DELETE CASCADE,
+    guild_id INTEGER NOT NULL REFERENCES guilds (id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    rank INTEGER NOT NULL,
+    username TEXT NOT NULL,
+    rating DOUBLE PRECISION NOT NULL,
+    elapsed_seconds INTEGER NOT NULL,
+    best_runs JSONB NOT NULL DEFAULT '[]',
+    scoring TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (season_id, guild_id, user_id)
+);
+
+-- The players that took part in a run: its confirmed signups and the
+-- organizer, who plays without a signup and has the role ''.
+CREATE VIEW run_participants AS
+SELECT run_signups.user_id, run_signups.run_id, run_signups.role
+FROM run_signups
+WHERE run_signups.status = 'confirmed'
+UNION
+SELECT runs.organizer_id, runs.id, ''
+FROM runs
+WHERE NOT EXISTS (
+    SELECT 1 FROM run_signups
+    WHERE run_signups.run_id = runs.id AND run_signups.user_id = runs.organizer_id
+        AND run_signups.status = 'confirmed'
+);
+
+CREATE OR REPLACE VIEW user_run_stats AS
+SELECT
+    run_participants.user_id,
+    runs.dungeon_id,
+    run_participants.role,
+    count(*)::int AS completed,
+    count(*) FILTER (WHERE runs.status = 'completed' AND runs.upgrade_level > 0)::int AS timed,
+    COALESCE(max(runs.key_level) FILTER (WHERE runs.status = 'completed' AND runs.upgrade_level > 0), 0)::int
+        AS best_timed_level,
+    COALESCE(sum(EXTRACT(EPOCH FROM runs.finished_at - runs.started_at)), 0)::bigint AS elapsed_seconds,
+    sum(dungeons.par_seconds)::bigint AS par_seconds
+FROM run_participants
+JOIN runs ON runs.id = run_participants.run_id
+JOIN dungeons ON dungeons.id = runs.dungeon_id
+WHERE runs.status IN ('completed', 'depleted')
+GROUP BY run_participants.user_id, runs.dungeon_id, run_participants.role;
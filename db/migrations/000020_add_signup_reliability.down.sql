@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS run_signups_attendance_idx;
+
+ALTER TABLE guilds DROP COLUMN IF EXISTS waitlist_below_reliability;
@@ -0,0 +1,7 @@
+-- Guilds can hold back members below a reliability score, 0 to 100, by putting
+-- their signups on the waitlist even while slots are open.
+ALTER TABLE guilds ADD COLUMN waitlist_below_reliability INTEGER
+    CHECK (waitlist_below_reliability BETWEEN 0 AND 100);
+
+CREATE INDEX IF NOT EXISTS run_signups_attendance_idx ON run_signups (user_id)
+WHERE attendance IS NOT NULL OR status = 'withdrawn';
@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS lfg_proposal_members;
+
+ALTER TABLE lfg_entries DROP CONSTRAINT IF EXISTS lfg_entries_proposal_id_fkey;
+
+DROP TRIGGER IF EXISTS update_lfg_proposals_updated_at ON lfg_proposals;
+
+DROP TABLE IF EXISTS lfg_proposals;
+
+DROP TRIGGER IF EXISTS update_lfg_entries_updated_at ON lfg_entries;
+
+DROP TABLE IF EXISTS lfg_entries;
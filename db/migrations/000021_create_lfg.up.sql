@@ -0,0 +1,71 @@
+-- Looking for group queue. Entries are queued until the matcher proposes a
+-- group, matched once the group accepted and left when the user leaves the
+-- queue or lets a proposal lapse.
+CREATE TABLE IF NOT EXISTS lfg_entries (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    dungeon_id INTEGER NOT NULL REFERENCES dungeons (id),
+    min_key_level INTEGER NOT NULL,
+    max_key_level INTEGER NOT NULL,
+    roles TEXT[] NOT NULL,
+    available_from TIMESTAMPTZ NOT NULL,
+    available_until TIMESTAMPTZ NOT NULL,
+    status TEXT NOT NULL DEFAULT 'queued'
+        CONSTRAINT lfg_entries_status_check CHECK (status IN ('queued', 'proposed', 'matched', 'left')),
+    proposal_id INTEGER,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CHECK (min_key_level <= max_key_level),
+    CHECK (available_until > available_from)
+);
+
+-- A user is in the queue at most once.
+CREATE UNIQUE INDEX IF NOT EXISTS lfg_entries_active_idx
+ON lfg_entries (user_id)
+WHERE status IN ('queued', 'proposed');
+
+CREATE INDEX IF NOT EXISTS lfg_entries_queued_idx ON lfg_entries (dungeon_id, created_at)
+WHERE status = 'queued';
+
+CREATE TRIGGER update_lfg_entries_updated_at
+BEFORE UPDATE ON lfg_entries
+FOR EACH ROW
+EXECUTE PROCEDURE update_updated_at_column();
+
+-- Groups the matcher put together, waiting on every member to accept. The run
+-- is created once they all have.
+CREATE TABLE IF NOT EXISTS lfg_proposals (
+    id SERIAL PRIMARY KEY,
+    dungeon_id INTEGER NOT NULL REFERENCES dungeons (id),
+    key_level INTEGER NOT NULL,
+    starts_at TIMESTAMPTZ NOT NULL,
+    score DOUBLE PRECISION NOT NULL,
+    seed BIGINT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending'
+        CONSTRAINT lfg_proposals_status_check CHECK (status IN ('pending', 'accepted', 'declined', 'expired')),
+    expires_at TIMESTAMPTZ NOT NULL,
+    run_id INTEGER REFERENCES runs (id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS lfg_proposals_pending_idx ON lfg_proposals (expires_at)
+WHERE status = 'pending';
+
+CREATE TRIGGER update_lfg_proposals_updated_at
+BEFORE UPDATE ON lfg_proposals
+FOR EACH ROW
+EXECUTE PROCEDURE update_updated_at_column();
+
+ALTER TABLE lfg_entries ADD CONSTRAINT lfg_entries_proposal_id_fkey
+FOREIGN KEY (proposal_id) REFERENCES lfg_proposals (id) ON DELETE SET NULL;
+
+CREATE TABLE IF NOT EXISTS lfg_proposal_members (
+    proposal_id INTEGER NOT NULL REFERENCES lfg_proposals (id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    entry_id INTEGER NOT NULL REFERENCES lfg_entries (id) ON DELETE CASCADE,
+    role TEXT NOT NULL,
+    response TEXT NOT NULL DEFAULT 'pending'
+        CONSTRAINT lfg_proposal_members_response_check CHECK (response IN ('pending', 'accepted', 'declined')),
+    PRIMARY KEY (proposal_id, user_id)
+);
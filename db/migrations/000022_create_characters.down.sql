@@ -0,0 +1,7 @@
+ALTER TABLE lfg_entries DROP COLUMN IF EXISTS character_id;
+
+ALTER TABLE run_signups DROP COLUMN IF EXISTS character_id;
+
+DROP TRIGGER IF EXISTS update_characters_updated_at ON characters;
+
+DROP TABLE IF EXISTS characters;
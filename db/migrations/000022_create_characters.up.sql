@@ -0,0 +1,30 @@
+-- In-game characters of a user. A name is unique within its realm.
+CREATE TABLE IF NOT EXISTS characters (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    realm TEXT NOT NULL,
+    class TEXT NOT NULL,
+    spec TEXT NOT NULL DEFAULT '',
+    item_level INTEGER NOT NULL DEFAULT 0 CHECK (item_level >= 0),
+    roles TEXT[] NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS characters_name_realm_idx ON characters (lower(realm), lower(name));
+
+CREATE INDEX IF NOT EXISTS characters_user_id_idx ON characters (user_id);
+
+CREATE TRIGGER update_characters_updated_at
+BEFORE UPDATE ON characters
+FOR EACH ROW
+EXECUTE PROCEDURE update_updated_at_column();
+
+-- Signups and queue entries made before characters, or whose character was
+-- deleted since, have no character and fall back to the roles of the user.
+ALTER TABLE run_signups ADD COLUMN IF NOT EXISTS character_id INTEGER
+REFERENCES characters (id) ON DELETE SET NULL;
+
+ALTER TABLE lfg_entries ADD COLUMN IF NOT EXISTS character_id INTEGER
+REFERENCES characters (id) ON DELETE SET NULL;
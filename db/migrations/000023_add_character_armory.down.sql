@@ -0,0 +1,6 @@
+ALTER TABLE characters DROP CONSTRAINT IF EXISTS characters_key_check;
+
+ALTER TABLE characters
+    DROP COLUMN IF EXISTS imported_at,
+    DROP COLUMN IF EXISTS key_level,
+    DROP COLUMN IF EXISTS key_dungeon_id;
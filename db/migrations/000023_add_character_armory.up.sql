@@ -0,0 +1,9 @@
+-- The key a character holds and when its profile was last imported from the
+-- armory.
+ALTER TABLE characters
+    ADD COLUMN IF NOT EXISTS key_dungeon_id INTEGER REFERENCES dungeons (id),
+    ADD COLUMN IF NOT EXISTS key_level INTEGER,
+    ADD COLUMN IF NOT EXISTS imported_at TIMESTAMPTZ;
+
+ALTER TABLE characters ADD CONSTRAINT characters_key_check
+CHECK ((key_dungeon_id IS NULL) = (key_level IS NULL));
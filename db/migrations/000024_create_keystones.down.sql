@@ -0,0 +1,14 @@
+ALTER TABLE characters
+    ADD COLUMN IF NOT EXISTS key_dungeon_id INTEGER REFERENCES dungeons (id),
+    ADD COLUMN IF NOT EXISTS key_level INTEGER;
+
+UPDATE characters SET key_dungeon_id = keystones.dungeon_id, key_level = keystones.level
+FROM keystones
+WHERE keystones.character_id = characters.id;
+
+ALTER TABLE characters ADD CONSTRAINT characters_key_check
+CHECK ((key_dungeon_id IS NULL) = (key_level IS NULL));
+
+DROP TRIGGER IF EXISTS update_keystones_updated_at ON keystones;
+
+DROP TABLE IF EXISTS keystones;
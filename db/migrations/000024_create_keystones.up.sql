@@ -0,0 +1,32 @@
+-- The Mythic+ keys players hold, each by a user or one of their characters.
+-- A key counts for the week it was recorded in, older keys were reset.
+CREATE TABLE IF NOT EXISTS keystones (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    character_id INTEGER REFERENCES characters (id) ON DELETE CASCADE,
+    dungeon_id INTEGER NOT NULL REFERENCES dungeons (id),
+    level INTEGER NOT NULL,
+    recorded_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS keystones_user_idx ON keystones (user_id) WHERE character_id IS NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS keystones_character_idx ON keystones (character_id) WHERE character_id IS NOT NULL;
+
+CREATE TRIGGER update_keystones_updated_at
+BEFORE UPDATE ON keystones
+FOR EACH ROW
+EXECUTE PROCEDURE update_updated_at_column();
+
+-- Keys imported from the armory move from the characters to the inventory.
+INSERT INTO keystones (user_id, character_id, dungeon_id, level, recorded_at)
+SELECT user_id, id, key_dungeon_id, key_level, COALESCE(imported_at, CURRENT_TIMESTAMP) FROM characters
+WHERE key_dungeon_id IS NOT NULL;
+
+ALTER TABLE characters DROP CONSTRAINT IF EXISTS characters_key_check;
+
+ALTER TABLE characters
+    DROP COLUMN IF EXISTS key_level,
+    DROP COLUMN IF EXISTS key_dungeon_id;
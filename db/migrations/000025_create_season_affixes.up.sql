@@ -0,0 +1,8 @@
+-- The affix rotation of a season, the affixes of each week of the rotation by
+-- its position. The rotation starts over once every week of it was played.
+CREATE TABLE IF NOT EXISTS season_affixes (
+    season_id INTEGER NOT NULL REFERENCES seasons (id) ON DELETE CASCADE,
+    week INTEGER NOT NULL CHECK (week >= 1),
+    affixes TEXT[] NOT NULL,
+    PRIMARY KEY (season_id, week)
+);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS runs_affixes_idx;
+
+ALTER TABLE runs DROP COLUMN IF EXISTS affixes;
+
+DROP TRIGGER IF EXISTS update_affixes_updated_at ON affixes;
+
+DROP TABLE IF EXISTS affixes;
@@ -0,0 +1,21 @@
+-- The affix catalog, seeded from the binary like the dungeon catalog. Season
+-- rotations list affixes by their code.
+CREATE TABLE IF NOT EXISTS affixes (
+    id SERIAL PRIMARY KEY,
+    code TEXT NOT NULL UNIQUE,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER update_affixes_updated_at
+BEFORE UPDATE ON affixes
+FOR EACH ROW
+EXECUTE PROCEDURE update_updated_at_column();
+
+-- The codes of the affixes that were active when the run started.
+ALTER TABLE runs ADD COLUMN IF NOT EXISTS affixes TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS runs_affixes_idx ON runs USING GIN (affixes);
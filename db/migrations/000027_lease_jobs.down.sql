@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS jobs_unique_key_idx;
+
+UPDATE jobs SET status = 'pending' WHERE status = 'running';
+
+CREATE UNIQUE INDEX IF NOT EXISTS jobs_unique_key_idx ON jobs (kind, unique_key)
+WHERE unique_key IS NOT NULL AND status = 'pending';
+
+DROP INDEX IF EXISTS jobs_running_idx;
+
+ALTER TABLE jobs DROP COLUMN IF EXISTS locked_until;
@@ -0,0 +1,13 @@
+-- Workers lease the jobs they run instead of holding the row lock, so a running
+-- job does not keep a connection busy. A running job whose lease ran out was
+-- left by a worker that died and can be claimed again.
+ALTER TABLE jobs ADD COLUMN locked_until TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS jobs_running_idx ON jobs (locked_until)
+WHERE status = 'running';
+
+-- A job keeps its unique key while it runs, as it did while it was locked.
+DROP INDEX IF EXISTS jobs_unique_key_idx;
+
+CREATE UNIQUE INDEX IF NOT EXISTS jobs_unique_key_idx ON jobs (kind, unique_key)
+WHERE unique_key IS NOT NULL AND status IN ('pending', 'running');
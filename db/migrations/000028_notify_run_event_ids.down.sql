@@ -0,0 +1,14 @@
+CREATE OR REPLACE FUNCTION notify_run_event()
+RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('run_events', json_build_object(
+        'id', NEW.id,
+        'run_id', NEW.run_id,
+        'type', NEW.type,
+        'user_id', NEW.user_id,
+        'payload', NEW.payload,
+        'created_at', NEW.created_at
+    )::text);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
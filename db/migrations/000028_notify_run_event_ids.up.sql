@@ -0,0 +1,12 @@
+-- Notifications are limited to 8000 bytes and run events embed the run, notes
+-- and all, so only the ids are published. Listeners load the event itself.
+CREATE OR REPLACE FUNCTION notify_run_event()
+RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('run_events', json_build_object(
+        'id', NEW.id,
+        'run_id', NEW.run_id
+    )::text);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
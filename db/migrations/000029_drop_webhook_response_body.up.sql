@@ -0,0 +1,3 @@
+-- Response bodies of endpoints are not kept. An endpoint URL can lead into a
+-- network the server can reach, and guild admins can read the delivery log.
+ALTER TABLE webhook_deliveries DROP COLUMN IF EXISTS response_body;
@@ -0,0 +1,15 @@
+-- Back to the definition from 000019.
+CREATE OR REPLACE VIEW run_participants AS
+SELECT run_signups.user_id, run_signups.run_id, run_signups.role
+FROM run_signups
+WHERE run_signups.status = 'confirmed'
+UNION
+SELECT runs.organizer_id, runs.id, ''
+FROM runs
+WHERE NOT EXISTS (
+    SELECT 1 FROM run_signups
+    WHERE run_signups.run_id = runs.id AND run_signups.user_id = runs.organizer_id
+        AND run_signups.status = 'confirmed'
+);
+
+REFRESH MATERIALIZED VIEW user_run_stats_summary;
@@ -0,0 +1,16 @@
+-- Players marked as no-shows did not take part in the run, so their runs are
+-- left out of stats, leaderboards and weekly vault progress.
+CREATE OR REPLACE VIEW run_participants AS
+SELECT run_signups.user_id, run_signups.run_id, run_signups.role
+FROM run_signups
+WHERE run_signups.status = 'confirmed' AND run_signups.attendance IS DISTINCT FROM 'no_show'
+UNION
+SELECT runs.organizer_id, runs.id, ''
+FROM runs
+WHERE NOT EXISTS (
+    SELECT 1 FROM run_signups
+    WHERE run_signups.run_id = runs.id AND run_signups.user_id = runs.organizer_id
+        AND run_signups.status = 'confirmed'
+);
+
+REFRESH MATERIALIZED VIEW user_run_stats_summary;
@@ -21,3 +21,953 @@ WHERE email = $1 LIMIT 1;
 INSERT INTO users (username, email, password_hash, roles, timezone)
 VALUES ($1, $2, $3, $4, $5)
 RETURNING *;
+
+-- name: GetDungeons :many
+SELECT * FROM dungeons
+WHERE active
+ORDER BY name;
+
+-- name: GetDungeonByID :one
+SELECT * FROM dungeons
+WHERE id = $1 LIMIT 1;
+
+-- name: GetDungeonByCode :one
+SELECT * FROM dungeons
+WHERE code = $1 LIMIT 1;
+
+-- name: UpsertDungeon :one
+INSERT INTO dungeons (code, name, expansion, season, par_seconds, boss_count, difficulties, active)
+VALUES ($1, $2, $3, $4, $5, $6, $7, TRUE)
+ON CONFLICT (code) DO UPDATE SET
+    name = EXCLUDED.name,
+    expansion = EXCLUDED.expansion,
+    season = EXCLUDED.season,
+    par_seconds = EXCLUDED.par_seconds,
+    boss_count = EXCLUDED.boss_count,
+    difficulties = EXCLUDED.difficulties,
+    active = TRUE
+RETURNING *;
+
+-- name: DeactivateDungeons :exec
+UPDATE dungeons SET active = FALSE
+WHERE active;
+
+-- name: GetAffixes :many
+SELECT * FROM affixes
+WHERE active
+ORDER BY name;
+
+-- name: GetAffixByCode :one
+SELECT * FROM affixes
+WHERE code = $1 LIMIT 1;
+
+-- name: UpsertAffix :one
+INSERT INTO affixes (code, name, description, active)
+VALUES ($1, $2, $3, TRUE)
+ON CONFLICT (code) DO UPDATE SET
+    name = EXCLUDED.name,
+    description = EXCLUDED.description,
+    active = TRUE
+RETURNING *;
+
+-- name: DeactivateAffixes :exec
+UPDATE affixes SET active = FALSE
+WHERE active;
+
+-- name: LockCatalog :exec
+SELECT pg_advisory_xact_lock(hashtext(@catalog::text));
+
+-- name: GetCatalogVersion :one
+SELECT version FROM catalog_versions
+WHERE catalog = $1;
+
+-- name: SetCatalogVersion :exec
+INSERT INTO catalog_versions (catalog, version)
+VALUES ($1, $2)
+ON CONFLICT (catalog) DO UPDATE SET
+    version = EXCLUDED.version,
+    applied_at = CURRENT_TIMESTAMP;
+
+-- name: GetRuns :many
+SELECT sqlc.embed(runs), sqlc.embed(dungeons) FROM runs
+JOIN dungeons ON dungeons.id = runs.dungeon_id
+WHERE runs.starts_at >= @starts_after AND runs.starts_at < @starts_before
+    AND runs.status <> 'cancelled'
+ORDER BY runs.starts_at, runs.id;
+
+-- name: GetRunByID :one
+SELECT sqlc.embed(runs), sqlc.embed(dungeons) FROM runs
+JOIN dungeons ON dungeons.id = runs.dungeon_id
+WHERE runs.id = $1 LIMIT 1;
+
+-- name: CreateRun :one
+INSERT INTO runs (dungeon_id, difficulty, key_level, organizer_id, starts_at, timezone, duration_minutes, notes,
+    tank_slots, healer_slots, dps_slots, guild_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+RETURNING *;
+
+-- name: UpdateRun :one
+UPDATE runs SET
+    dungeon_id = $2,
+    difficulty = $3,
+    key_level = $4,
+    starts_at = $5,
+    timezone = $6,
+    duration_minutes = $7,
+    notes = $8,
+    tank_slots = $9,
+    healer_slots = $10,
+    dps_slots = $11,
+    guild_id = $12,
+    sequence = sequence + 1
+WHERE id = $1
+RETURNING *;
+
+-- name: SetRunStatus :one
+UPDATE runs SET status = $2, sequence = sequence + 1
+WHERE id = $1
+RETURNING *;
+
+-- name: TransitionRun :one
+UPDATE runs SET status = $2, started_at = $3, finished_at = $4, upgrade_level = $5,
+    affixes = COALESCE(sqlc.narg(affixes)::text[], affixes), sequence = sequence + 1
+WHERE id = $1
+RETURNING *;
+
+-- name: LockRun :one
+SELECT * FROM runs
+WHERE id = $1
+FOR UPDATE;
+
+-- name: GetRunSignups :many
+SELECT run_signups.*, users.username, characters.name AS character_name FROM run_signups
+JOIN users ON users.id = run_signups.user_id
+LEFT JOIN characters ON characters.id = run_signups.character_id
+WHERE run_signups.run_id = $1 AND run_signups.status <> 'withdrawn'
+ORDER BY run_signups.waitlist_position NULLS FIRST, run_signups.created_at, run_signups.id;
+
+-- name: GetActiveSignup :one
+SELECT * FROM run_signups
+WHERE run_id = $1 AND user_id = $2 AND status <> 'withdrawn'
+LIMIT 1;
+
+-- name: CountConfirmedSignups :one
+SELECT count(*) FROM run_signups
+WHERE run_id = $1 AND role = $2 AND status = 'confirmed';
+
+-- name: CreateSignup :one
+INSERT INTO run_signups (run_id, user_id, role, status, waitlist_position, character_id)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: WithdrawSignup :one
+UPDATE run_signups SET status = 'withdrawn', withdrawn_at = CURRENT_TIMESTAMP, waitlist_position = NULL
+WHERE id = $1
+RETURNING *;
+
+-- name: NextWaitlistPosition :one
+SELECT (COALESCE(MAX(waitlist_position), 0) + 1)::int FROM run_signups
+WHERE run_id = $1 AND role = $2 AND status = 'waitlisted';
+
+-- name: GetWaitlist :many
+-- user_roles are the roles of the character signed up, or of the user for
+-- signups without one.
+SELECT run_signups.*, COALESCE(characters.roles, users.roles)::text[] AS user_roles FROM run_signups
+JOIN users ON users.id = run_signups.user_id
+LEFT JOIN characters ON characters.id = run_signups.character_id
+WHERE run_signups.run_id = $1 AND run_signups.role = $2 AND run_signups.status = 'waitlisted'
+ORDER BY run_signups.waitlist_position, run_signups.created_at, run_signups.id;
+
+-- name: PromoteSignup :one
+UPDATE run_signups SET status = 'confirmed', waitlist_position = NULL
+WHERE id = $1
+RETURNING *;
+
+-- name: SetWaitlistPosition :exec
+UPDATE run_signups SET waitlist_position = $2
+WHERE id = $1;
+
+-- name: CreateRunEvent :one
+INSERT INTO run_events (run_id, type, user_id, payload)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetRunEvent :one
+SELECT * FROM run_events
+WHERE id = $1;
+
+-- name: GetRunEventsAfter :many
+SELECT * FROM run_events
+WHERE run_id = $1 AND id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg(max_events);
+
+-- name: CreateSeries :one
+INSERT INTO run_series (organizer_id, dungeon_id, difficulty, key_level, rrule, timezone, starts_at,
+    duration_minutes, notes, tank_slots, healer_slots, dps_slots, guild_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+RETURNING *;
+
+-- name: GetSeriesByID :one
+SELECT sqlc.embed(run_series), sqlc.embed(dungeons) FROM run_series
+JOIN dungeons ON dungeons.id = run_series.dungeon_id
+WHERE run_series.id = $1 LIMIT 1;
+
+-- name: EndSeries :exec
+UPDATE run_series SET until_at = $2
+WHERE id = $1;
+
+-- name: UpsertSeriesException :exec
+INSERT INTO run_series_exceptions (series_id, occurrence_at, kind)
+VALUES ($1, $2, $3)
+ON CONFLICT (series_id, occurrence_at) DO UPDATE SET kind = EXCLUDED.kind;
+
+-- name: MaterializeSeriesRun :one
+INSERT INTO runs (dungeon_id, difficulty, key_level, organizer_id, starts_at, timezone, duration_minutes, notes,
+    tank_slots, healer_slots, dps_slots, series_id, occurrence_at, guild_id)
+SELECT dungeon_id, difficulty, key_level, organizer_id, @occurrence_at::timestamptz, timezone, duration_minutes, notes,
+    tank_slots, healer_slots, dps_slots, id, @occurrence_at::timestamptz, guild_id
+FROM run_series
+WHERE run_series.id = @series_id
+    AND (run_series.until_at IS NULL OR @occurrence_at::timestamptz < run_series.until_at)
+    AND NOT EXISTS (
+        SELECT 1 FROM run_series_exceptions
+        WHERE run_series_exceptions.series_id = @series_id
+            AND run_series_exceptions.occurrence_at = @occurrence_at::timestamptz
+            AND run_series_exceptions.kind = 'skip'
+    )
+ON CONFLICT (series_id, occurrence_at) DO NOTHING
+RETURNING *;
+
+-- name: GetSeriesRun :one
+SELECT * FROM runs
+WHERE series_id = $1 AND occurrence_at = $2
+LIMIT 1;
+
+-- name: GetSeriesRuns :many
+SELECT sqlc.embed(runs), sqlc.embed(dungeons) FROM runs
+JOIN dungeons ON dungeons.id = runs.dungeon_id
+WHERE runs.series_id = $1 AND runs.occurrence_at >= @starts_after AND runs.occurrence_at < @starts_before
+    AND runs.status <> 'cancelled'
+ORDER BY runs.starts_at, runs.id;
+
+-- name: LockSeriesRun :one
+SELECT * FROM runs
+WHERE series_id = $1 AND occurrence_at = $2
+FOR UPDATE;
+
+-- name: GetSeriesRunsFrom :many
+SELECT sqlc.embed(runs), sqlc.embed(dungeons), EXISTS (
+        SELECT 1 FROM run_series_exceptions
+        WHERE run_series_exceptions.series_id = runs.series_id
+            AND run_series_exceptions.occurrence_at = runs.occurrence_at
+            AND run_series_exceptions.kind = 'override'
+    ) AS overridden
+FROM runs
+JOIN dungeons ON dungeons.id = runs.dungeon_id
+WHERE runs.series_id = $1 AND runs.occurrence_at >= $2
+ORDER BY runs.occurrence_at
+FOR UPDATE OF runs;
+
+-- name: GetSeriesSkipsFrom :many
+SELECT occurrence_at FROM run_series_exceptions
+WHERE series_id = $1 AND occurrence_at >= $2 AND kind = 'skip'
+ORDER BY occurrence_at;
+
+-- name: MoveSeriesRun :exec
+UPDATE runs SET series_id = @to_series_id, occurrence_at = @occurrence_at
+WHERE id = @id;
+
+-- name: CreateGuild :one
+INSERT INTO guilds (name)
+VALUES ($1)
+RETURNING *;
+
+-- name: GetGuildByID :one
+SELECT * FROM guilds
+WHERE id = $1 LIMIT 1;
+
+-- name: GetGuildByName :one
+SELECT * FROM guilds
+WHERE name = $1 LIMIT 1;
+
+-- name: GetGuildMembers :many
+SELECT guild_members.*, users.username, users.roles AS user_roles, users.timezone FROM guild_members
+JOIN users ON users.id = guild_members.user_id
+WHERE guild_members.guild_id = $1
+ORDER BY guild_members.created_at, guild_members.user_id;
+
+-- name: GetGuildMember :one
+SELECT * FROM guild_members
+WHERE guild_id = $1 AND user_id = $2 LIMIT 1;
+
+-- name: AddGuildMember :one
+INSERT INTO guild_members (guild_id, user_id, role)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: RemoveGuildMember :exec
+DELETE FROM guild_members
+WHERE guild_id = $1 AND user_id = $2;
+
+-- name: GetUsersByIDs :many
+SELECT id, username, roles, timezone FROM users
+WHERE id = ANY(@ids::int[])
+ORDER BY id;
+
+-- name: GetAvailabilityWindows :many
+SELECT * FROM availability_windows
+WHERE user_id = ANY(@user_ids::int[])
+ORDER BY user_id, weekday, start_minute;
+
+-- name: DeleteAvailabilityWindows :exec
+DELETE FROM availability_windows
+WHERE user_id = $1;
+
+-- name: CreateAvailabilityWindow :one
+INSERT INTO availability_windows (user_id, weekday, start_minute, end_minute)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetAvailabilityExceptions :many
+SELECT * FROM availability_exceptions
+WHERE user_id = ANY(@user_ids::int[]) AND ends_at > @starts_after AND starts_at < @starts_before
+ORDER BY user_id, starts_at;
+
+-- name: CreateAvailabilityException :one
+INSERT INTO availability_exceptions (user_id, starts_at, ends_at, available)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: DeleteAvailabilityException :execrows
+DELETE FROM availability_exceptions
+WHERE id = $1 AND user_id = $2;
+
+-- name: GetUserCalendarToken :one
+SELECT calendar_token FROM users
+WHERE id = $1 LIMIT 1;
+
+-- name: SetUserCalendarToken :exec
+UPDATE users SET calendar_token = $2
+WHERE id = $1;
+
+-- name: GetUserCalendarRuns :many
+SELECT sqlc.embed(runs), sqlc.embed(dungeons) FROM runs
+JOIN dungeons ON dungeons.id = runs.dungeon_id
+WHERE runs.starts_at >= @starts_after
+    AND (runs.organizer_id = @user_id OR EXISTS (
+        SELECT 1 FROM run_signups
+        WHERE run_signups.run_id = runs.id AND run_signups.user_id = @user_id
+            AND run_signups.status <> 'withdrawn'
+    ))
+ORDER BY runs.starts_at, runs.id;
+
+-- name: CreateNotification :exec
+INSERT INTO notifications (user_id, type, run_id, payload, expires_at)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: ClaimNotifications :many
+UPDATE notifications SET deliver_after = @leased_until
+WHERE id IN (
+    SELECT due.id FROM notifications AS due
+    WHERE due.status = 'pending' AND due.deliver_after <= @now
+    ORDER BY due.deliver_after, due.id
+    LIMIT @batch_size
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: DeferNotification :execrows
+UPDATE notifications SET deliver_after = @deliver_after
+WHERE id = @id AND status = 'pending' AND deliver_after = @leased_until;
+
+-- name: UpdateNotificationDelivery :execrows
+UPDATE notifications SET status = @status, attempts = @attempts, delivered_channels = @delivered_channels,
+    last_error = @last_error, deliver_after = @deliver_after, delivered_at = @delivered_at
+WHERE id = @id AND status = 'pending' AND deliver_after = @leased_until;
+
+-- name: GetNotificationRecipient :one
+SELECT users.id, users.email, users.timezone, notification_settings.quiet_start_minute,
+    notification_settings.quiet_end_minute, COALESCE(notification_settings.webhook_url, '')::text AS webhook_url
+FROM users
+LEFT JOIN notification_settings ON notification_settings.user_id = users.id
+WHERE users.id = $1 LIMIT 1;
+
+-- name: GetNotificationSettings :one
+SELECT * FROM notification_settings
+WHERE user_id = $1 LIMIT 1;
+
+-- name: UpsertNotificationSettings :exec
+INSERT INTO notification_settings (user_id, quiet_start_minute, quiet_end_minute, webhook_url)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id) DO UPDATE
+SET quiet_start_minute = EXCLUDED.quiet_start_minute, quiet_end_minute = EXCLUDED.quiet_end_minute,
+    webhook_url = EXCLUDED.webhook_url;
+
+-- name: GetNotificationPreferences :many
+SELECT * FROM notification_preferences
+WHERE user_id = $1
+ORDER BY type;
+
+-- name: GetNotificationPreference :one
+SELECT channels FROM notification_preferences
+WHERE user_id = $1 AND type = $2 LIMIT 1;
+
+-- name: DeleteNotificationPreferences :exec
+DELETE FROM notification_preferences
+WHERE user_id = $1;
+
+-- name: CreateNotificationPreference :exec
+INSERT INTO notification_preferences (user_id, type, channels)
+VALUES ($1, $2, $3);
+
+-- name: CreateInboxNotification :exec
+INSERT INTO inbox_notifications (user_id, notification_id, type, subject, body)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (notification_id) DO NOTHING;
+
+-- name: GetInboxNotifications :many
+SELECT * FROM inbox_notifications
+WHERE user_id = $1
+ORDER BY id DESC
+LIMIT $2;
+
+-- name: MarkInboxNotificationRead :execrows
+UPDATE inbox_notifications SET read_at = COALESCE(read_at, @read_at)
+WHERE id = @id AND user_id = @user_id;
+
+-- name: SetGuildDiscordWebhook :exec
+UPDATE guilds SET discord_webhook_url = $2
+WHERE id = $1;
+
+-- name: CreateGuildAnnouncement :exec
+INSERT INTO guild_announcements (guild_id, run_id, kind)
+SELECT guilds.id, @run_id, @kind FROM guilds
+WHERE guilds.id = @guild_id AND guilds.discord_webhook_url <> '';
+
+-- name: ClaimGuildAnnouncements :many
+UPDATE guild_announcements SET deliver_after = @leased_until
+WHERE id IN (
+    SELECT due.id FROM guild_announcements AS due
+    WHERE due.status = 'pending' AND due.deliver_after <= @now
+    ORDER BY due.deliver_after, due.id
+    LIMIT @batch_size
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: UpdateGuildAnnouncementDelivery :execrows
+UPDATE guild_announcements SET status = @status, attempts = @attempts, last_error = @last_error,
+    deliver_after = @deliver_after, delivered_at = @delivered_at
+WHERE id = @id AND status = 'pending' AND deliver_after = @leased_until;
+
+-- name: DeferGuildAnnouncements :exec
+UPDATE guild_announcements SET deliver_after = @deliver_after
+WHERE guild_id = @guild_id AND status = 'pending'
+    AND (deliver_after <= @now OR (id = @id AND deliver_after = @leased_until));
+
+-- name: ClaimRunsDueReminders :many
+SELECT * FROM runs
+WHERE runs.status IN ('scheduled', 'forming') AND runs.starts_at > @now
+    AND EXISTS (
+        SELECT 1 FROM unnest(@offset_minutes::int[]) AS offsets(minutes)
+        WHERE runs.starts_at - make_interval(mins => offsets.minutes) <= @now
+            AND NOT EXISTS (
+                SELECT 1 FROM run_reminders
+                WHERE run_reminders.run_id = runs.id AND run_reminders.offset_minutes = offsets.minutes
+            )
+    )
+ORDER BY runs.starts_at, runs.id
+LIMIT @batch_size
+FOR UPDATE SKIP LOCKED;
+
+-- name: GetRunReminderOffsets :many
+SELECT offset_minutes FROM run_reminders
+WHERE run_id = $1
+ORDER BY offset_minutes;
+
+-- name: CreateRunReminder :exec
+INSERT INTO run_reminders (run_id, offset_minutes)
+VALUES ($1, $2)
+ON CONFLICT (run_id, offset_minutes) DO NOTHING;
+
+-- name: DeleteRunReminders :exec
+DELETE FROM run_reminders
+WHERE run_id = $1;
+
+-- name: CreateJob :execrows
+INSERT INTO jobs (queue, kind, args, unique_key, max_attempts, run_at)
+VALUES ($1, $2, $3, $4, $5, COALESCE(sqlc.narg(run_at)::timestamptz, CURRENT_TIMESTAMP))
+ON CONFLICT (kind, unique_key) WHERE unique_key IS NOT NULL AND status IN ('pending', 'running') DO NOTHING;
+
+-- name: ExpireJobLeases :exec
+UPDATE jobs SET status = CASE WHEN attempts + 1 >= max_attempts THEN 'dead' ELSE 'pending' END,
+    attempts = attempts + 1, last_error = 'lease expired', locked_until = NULL
+WHERE status = 'running' AND locked_until <= @now;
+
+-- name: ClaimJobs :many
+UPDATE jobs SET status = 'running', locked_until = @locked_until
+WHERE id IN (
+    SELECT ready.id FROM jobs AS ready
+    WHERE ready.queue = @queue AND ready.kind = ANY(@kinds::text[])
+        AND ready.status = 'pending' AND ready.run_at <= @now
+    ORDER BY ready.run_at, ready.id
+    LIMIT @batch_size
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: ReleaseJob :exec
+UPDATE jobs SET status = 'pending', locked_until = NULL
+WHERE id = $1 AND status = 'running' AND locked_until = $2;
+
+-- name: DeleteJob :execrows
+DELETE FROM jobs
+WHERE id = $1 AND locked_until = $2;
+
+-- name: UpdateJobFailure :execrows
+UPDATE jobs SET status = $2, attempts = $3, last_error = $4, run_at = $5, locked_until = NULL
+WHERE id = $1 AND locked_until = $6;
+
+-- name: GetJobs :many
+SELECT * FROM jobs
+WHERE (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status))
+    AND (sqlc.narg(queue)::text IS NULL OR queue = sqlc.narg(queue))
+ORDER BY id DESC
+LIMIT @max_jobs;
+
+-- name: GetJobByID :one
+SELECT * FROM jobs
+WHERE id = $1;
+
+-- name: RetryJob :one
+UPDATE jobs SET status = 'pending', attempts = 0, last_error = '', run_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND status = 'dead'
+RETURNING *;
+
+-- name: CreateWebhookEndpoint :one
+INSERT INTO webhook_endpoints (guild_id, url, secret, events)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetWebhookEndpoints :many
+SELECT * FROM webhook_endpoints
+WHERE guild_id IS NOT DISTINCT FROM sqlc.narg(guild_id)
+ORDER BY id;
+
+-- name: GetWebhookEndpoint :one
+SELECT * FROM webhook_endpoints
+WHERE id = $1;
+
+-- name: UpdateWebhookEndpoint :one
+UPDATE webhook_endpoints SET url = $2, events = $3, active = $4, consecutive_failures = $5, disabled_at = $6
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteWebhookEndpoint :exec
+DELETE FROM webhook_endpoints
+WHERE id = $1;
+
+-- name: GetSubscribedWebhookEndpoints :many
+SELECT * FROM webhook_endpoints
+WHERE active AND @event::text = ANY(events)
+    AND (guild_id IS NULL OR guild_id = sqlc.narg(guild_id))
+ORDER BY id;
+
+-- name: ResetWebhookFailures :exec
+UPDATE webhook_endpoints SET consecutive_failures = 0
+WHERE id = $1 AND consecutive_failures > 0;
+
+-- name: RecordWebhookFailure :one
+UPDATE webhook_endpoints SET
+    consecutive_failures = consecutive_failures + 1,
+    active = active AND consecutive_failures + 1 < @max_failures,
+    disabled_at = CASE
+        WHEN active AND consecutive_failures + 1 >= @max_failures THEN CURRENT_TIMESTAMP
+        ELSE disabled_at
+    END
+WHERE id = @id
+RETURNING *;
+
+-- name: CreateWebhookDelivery :one
+INSERT INTO webhook_deliveries (endpoint_id, event_id, event, payload)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetWebhookDelivery :one
+SELECT * FROM webhook_deliveries
+WHERE id = $1;
+
+-- name: GetWebhookDeliveries :many
+SELECT * FROM webhook_deliveries
+WHERE endpoint_id = $1
+ORDER BY id DESC
+LIMIT @max_deliveries;
+
+-- name: UpdateWebhookDelivery :exec
+UPDATE webhook_deliveries SET status = $2, attempts = $3, response_status = $4, last_error = $5,
+    delivered_at = $6
+WHERE id = $1;
+
+-- name: CreateReadyCheck :one
+INSERT INTO ready_checks (run_id, started_by, expires_at)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetLatestReadyCheck :one
+SELECT * FROM ready_checks
+WHERE run_id = $1
+ORDER BY id DESC
+LIMIT 1;
+
+-- name: GetReadyCheck :one
+SELECT * FROM ready_checks
+WHERE id = $1;
+
+-- name: LockPendingReadyCheck :one
+SELECT * FROM ready_checks
+WHERE run_id = $1 AND status = 'pending'
+FOR UPDATE;
+
+-- name: LockReadyCheck :one
+SELECT * FROM ready_checks
+WHERE id = $1
+FOR UPDATE;
+
+-- name: FinishReadyCheck :one
+UPDATE ready_checks SET status = $2, finished_at = $3
+WHERE id = $1
+RETURNING *;
+
+-- name: UpsertReadyCheckResponse :exec
+INSERT INTO ready_check_responses (ready_check_id, user_id, ready, responded_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (ready_check_id, user_id) DO UPDATE SET ready = EXCLUDED.ready, responded_at = EXCLUDED.responded_at;
+
+-- name: GetReadyCheckResponses :many
+SELECT * FROM ready_check_responses
+WHERE ready_check_id = $1
+ORDER BY responded_at, user_id;
+
+-- name: GetUserRunStats :many
+SELECT sqlc.embed(user_run_stats), sqlc.embed(dungeons) FROM user_run_stats
+JOIN dungeons ON dungeons.id = user_run_stats.dungeon_id
+WHERE user_run_stats.user_id = $1
+ORDER BY dungeons.name, user_run_stats.role;
+
+-- name: GetUserAffixRunStats :many
+-- user_run_stats of a user, counting only the runs started while the affix was active.
+SELECT
+    run_participants.user_id,
+    runs.dungeon_id,
+    run_participants.role,
+    count(*)::int AS completed,
+    count(*) FILTER (WHERE runs.status = 'completed' AND runs.upgrade_level > 0)::int AS timed,
+    COALESCE(max(runs.key_level) FILTER (WHERE runs.status = 'completed' AND runs.upgrade_level > 0), 0)::int
+        AS best_timed_level,
+    COALESCE(sum(EXTRACT(EPOCH FROM runs.finished_at - runs.started_at)), 0)::bigint AS elapsed_seconds,
+    sum(dungeons.par_seconds)::bigint AS par_seconds,
+    sqlc.embed(dungeons)
+FROM run_participants
+JOIN runs ON runs.id = run_participants.run_id
+JOIN dungeons ON dungeons.id = runs.dungeon_id
+WHERE run_participants.user_id = @user_id AND runs.status IN ('completed', 'depleted')
+    AND @affix::text = ANY(runs.affixes)
+GROUP BY run_participants.user_id, runs.dungeon_id, run_participants.role, dungeons.id
+ORDER BY dungeons.name, run_participants.role;
+
+-- name: DeleteSeasonAffixes :exec
+DELETE FROM season_affixes
+WHERE season_id = $1;
+
+-- name: GetUserRunStatsSummary :many
+SELECT sqlc.embed(user_run_stats_summary), sqlc.embed(dungeons) FROM user_run_stats_summary
+JOIN dungeons ON dungeons.id = user_run_stats_summary.dungeon_id
+WHERE user_run_stats_summary.user_id = $1
+ORDER BY dungeons.name, user_run_stats_summary.role;
+
+-- name: RefreshUserRunStatsSummary :exec
+REFRESH MATERIALIZED VIEW CONCURRENTLY user_run_stats_summary;
+
+-- name: GetUserSignupStats :one
+SELECT
+    count(*) FILTER (WHERE run_signups.attendance = 'no_show')::int AS no_shows,
+    count(*) FILTER (
+        WHERE run_signups.status = 'withdrawn' AND runs.status <> 'cancelled'
+            AND run_signups.withdrawn_at > runs.starts_at - make_interval(secs => @late_withdrawal_seconds::int)
+    )::int AS late_withdrawals
+FROM run_signups
+JOIN runs ON runs.id = run_signups.run_id
+WHERE run_signups.user_id = @user_id;
+
+-- name: CreateSeason :one
+INSERT INTO seasons (code, name, starts_at, ends_at)
+VALUES (@code, @name, @starts_at, sqlc.narg(ends_at))
+RETURNING *;
+
+-- name: CreateSeasonAffixes :exec
+INSERT INTO season_affixes (season_id, week, affixes)
+VALUES ($1, $2, $3);
+
+-- name: GetSeasonAffixes :many
+SELECT * FROM season_affixes
+WHERE season_id = $1
+ORDER BY week;
+
+-- name: GetSeasons :many
+SELECT * FROM seasons
+ORDER BY starts_at DESC;
+
+-- name: GetSeasonByCode :one
+SELECT * FROM seasons
+WHERE code = $1 LIMIT 1;
+
+-- name: GetOpenSeason :one
+SELECT * FROM seasons
+WHERE closed_at IS NULL LIMIT 1;
+
+-- name: CloseSeason :one
+UPDATE seasons SET ends_at = @closed_at, closed_at = @closed_at
+WHERE id = @id AND closed_at IS NULL
+RETURNING *;
+
+-- name: GetGuilds :many
+SELECT * FROM guilds
+ORDER BY id;
+
+-- name: SetGuildLeaderboardScoring :exec
+UPDATE guilds SET leaderboard_scoring = $2
+WHERE id = $1;
+
+-- name: GetSeasonTimedRuns :many
+SELECT
+    run_participants.user_id,
+    users.username,
+    runs.id AS run_id,
+    dungeons.code AS dungeon_code,
+    COALESCE(runs.key_level, 0)::int AS key_level,
+    COALESCE(runs.upgrade_level, 0)::int AS upgrade_level,
+    EXTRACT(EPOCH FROM runs.finished_at - runs.started_at)::int AS elapsed_seconds,
+    dungeons.par_seconds
+FROM guild_members
+JOIN run_participants ON run_participants.user_id = guild_members.user_id
+JOIN runs ON runs.id = run_participants.run_id
+JOIN dungeons ON dungeons.id = runs.dungeon_id
+JOIN users ON users.id = guild_members.user_id
+WHERE guild_members.guild_id = @guild_id
+    AND runs.status = 'completed' AND runs.upgrade_level > 0
+    AND runs.finished_at >= @starts_at
+    AND (sqlc.narg(ends_at)::timestamptz IS NULL OR runs.finished_at < sqlc.narg(ends_at))
+    AND (@affix::text = '' OR @affix = ANY(runs.affixes))
+ORDER BY run_participants.user_id, runs.id;
+
+-- name: CreateLeaderboardSnapshot :exec
+INSERT INTO leaderboard_snapshots (season_id, guild_id, user_id, rank, username, rating, elapsed_seconds,
+    best_runs, scoring)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: GetLeaderboardSnapshot :many
+SELECT * FROM leaderboard_snapshots
+WHERE season_id = $1 AND guild_id = $2
+ORDER BY rank;
+
+-- name: MarkAttendance :execrows
+UPDATE run_signups SET attendance = $3
+WHERE run_id = $1 AND user_id = $2 AND status = 'confirmed';
+
+-- name: GetRunAttendance :many
+-- The confirmed signups of a run and those withdrawn by users that did not sign
+-- up again, with how long before the start they withdrew.
+SELECT
+    run_signups.user_id,
+    users.username,
+    run_signups.role,
+    run_signups.status,
+    run_signups.attendance,
+    run_signups.withdrawn_at,
+    runs.starts_at
+FROM run_signups
+JOIN users ON users.id = run_signups.user_id
+JOIN runs ON runs.id = run_signups.run_id
+WHERE run_signups.run_id = $1 AND (
+    run_signups.status = 'confirmed'
+    OR (run_signups.status = 'withdrawn' AND NOT EXISTS (
+        SELECT 1 FROM run_signups AS active
+        WHERE active.run_id = run_signups.run_id AND active.user_id = run_signups.user_id
+            AND active.status <> 'withdrawn'
+    ))
+)
+ORDER BY run_signups.status, users.username, run_signups.withdrawn_at DESC;
+
+-- name: GetUserAttendance :many
+-- The marked signups and withdrawals of a user in runs that were not cancelled,
+-- starting after since.
+SELECT run_signups.status, run_signups.attendance, run_signups.withdrawn_at, runs.starts_at
+FROM run_signups
+JOIN runs ON runs.id = run_signups.run_id
+WHERE run_signups.user_id = @user_id AND runs.status <> 'cancelled' AND runs.starts_at > @since
+    AND (run_signups.attendance IS NOT NULL OR run_signups.status = 'withdrawn')
+ORDER BY runs.starts_at;
+
+-- name: SetGuildSignupPolicy :exec
+UPDATE guilds SET waitlist_below_reliability = $2
+WHERE id = $1;
+
+-- name: CreateLFGEntry :one
+INSERT INTO lfg_entries (user_id, dungeon_id, min_key_level, max_key_level, roles, available_from, available_until,
+    character_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: GetActiveLFGEntry :one
+SELECT sqlc.embed(lfg_entries), sqlc.embed(dungeons) FROM lfg_entries
+JOIN dungeons ON dungeons.id = lfg_entries.dungeon_id
+WHERE lfg_entries.user_id = $1 AND lfg_entries.status IN ('queued', 'proposed');
+
+-- name: LockActiveLFGEntry :one
+SELECT * FROM lfg_entries
+WHERE user_id = $1 AND status IN ('queued', 'proposed')
+FOR UPDATE;
+
+-- name: LockQueuedLFGEntries :many
+-- Entries of users that are still available, oldest first. Entries another
+-- matcher holds are skipped.
+SELECT sqlc.embed(lfg_entries), sqlc.embed(dungeons) FROM lfg_entries
+JOIN dungeons ON dungeons.id = lfg_entries.dungeon_id
+WHERE lfg_entries.status = 'queued' AND lfg_entries.available_until > @now
+ORDER BY lfg_entries.created_at, lfg_entries.id
+FOR UPDATE OF lfg_entries SKIP LOCKED;
+
+-- name: SetLFGEntryStatus :exec
+UPDATE lfg_entries SET status = @status, proposal_id = sqlc.narg(proposal_id)
+WHERE id = @id;
+
+-- name: CreateLFGProposal :one
+INSERT INTO lfg_proposals (dungeon_id, key_level, starts_at, score, seed, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: AddLFGProposalMember :exec
+INSERT INTO lfg_proposal_members (proposal_id, user_id, entry_id, role)
+VALUES ($1, $2, $3, $4);
+
+-- name: GetLFGProposal :one
+SELECT sqlc.embed(lfg_proposals), sqlc.embed(dungeons) FROM lfg_proposals
+JOIN dungeons ON dungeons.id = lfg_proposals.dungeon_id
+WHERE lfg_proposals.id = $1;
+
+-- name: LockLFGProposal :one
+SELECT * FROM lfg_proposals
+WHERE id = $1
+FOR UPDATE;
+
+-- name: LockExpiredLFGProposals :many
+SELECT * FROM lfg_proposals
+WHERE status = 'pending' AND expires_at <= @now
+ORDER BY id
+FOR UPDATE SKIP LOCKED;
+
+-- name: GetLFGProposalMembers :many
+SELECT lfg_proposal_members.*, users.username, users.timezone, lfg_entries.character_id,
+    characters.name AS character_name
+FROM lfg_proposal_members
+JOIN users ON users.id = lfg_proposal_members.user_id
+JOIN lfg_entries ON lfg_entries.id = lfg_proposal_members.entry_id
+LEFT JOIN characters ON characters.id = lfg_entries.character_id
+WHERE lfg_proposal_members.proposal_id = $1
+ORDER BY lfg_proposal_members.user_id;
+
+-- name: SetLFGProposalResponse :execrows
+UPDATE lfg_proposal_members SET response = $3
+WHERE proposal_id = $1 AND user_id = $2 AND response = 'pending';
+
+-- name: SetLFGProposalStatus :one
+UPDATE lfg_proposals SET status = @status, run_id = sqlc.narg(run_id)
+WHERE id = @id
+RETURNING *;
+
+-- name: CreateCharacter :one
+INSERT INTO characters (user_id, name, realm, class, spec, item_level, roles)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: GetCharacterByID :one
+SELECT sqlc.embed(characters), dungeons.code AS key_dungeon, keystones.level AS key_level,
+    keystones.recorded_at AS key_recorded_at
+FROM characters
+LEFT JOIN keystones ON keystones.character_id = characters.id
+LEFT JOIN dungeons ON dungeons.id = keystones.dungeon_id
+WHERE characters.id = $1;
+
+-- name: GetUserCharacters :many
+SELECT sqlc.embed(characters), dungeons.code AS key_dungeon, keystones.level AS key_level,
+    keystones.recorded_at AS key_recorded_at
+FROM characters
+LEFT JOIN keystones ON keystones.character_id = characters.id
+LEFT JOIN dungeons ON dungeons.id = keystones.dungeon_id
+WHERE characters.user_id = $1
+ORDER BY lower(characters.realm), lower(characters.name);
+
+-- name: GetCharacterByName :one
+SELECT sqlc.embed(characters), dungeons.code AS key_dungeon, keystones.level AS key_level,
+    keystones.recorded_at AS key_recorded_at
+FROM characters
+LEFT JOIN keystones ON keystones.character_id = characters.id
+LEFT JOIN dungeons ON dungeons.id = keystones.dungeon_id
+WHERE lower(characters.realm) = lower(@realm) AND lower(characters.name) = lower(@name);
+
+-- name: UpdateCharacter :one
+UPDATE characters SET name = $2, realm = $3, class = $4, spec = $5, item_level = $6, roles = $7
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteCharacter :execrows
+DELETE FROM characters
+WHERE id = $1 AND user_id = $2;
+
+-- name: SetCharacterArmory :exec
+UPDATE characters
+SET class = @class, spec = @spec, item_level = @item_level, roles = @roles, imported_at = @imported_at
+WHERE id = @id;
+
+-- name: DeleteKeystone :execrows
+DELETE FROM keystones
+WHERE user_id = @user_id AND character_id IS NOT DISTINCT FROM sqlc.narg(character_id);
+
+-- name: CreateKeystone :one
+INSERT INTO keystones (user_id, character_id, dungeon_id, level, recorded_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetUserKeystones :many
+SELECT sqlc.embed(keystones), sqlc.embed(dungeons), users.username, characters.name AS character_name,
+    COALESCE(characters.roles, users.roles)::text[] AS holder_roles
+FROM keystones
+JOIN dungeons ON dungeons.id = keystones.dungeon_id
+JOIN users ON users.id = keystones.user_id
+LEFT JOIN characters ON characters.id = keystones.character_id
+WHERE keystones.user_id = @user_id AND keystones.recorded_at >= @recorded_after
+ORDER BY keystones.level DESC, keystones.id;
+
+-- name: GetGuildKeystones :many
+SELECT sqlc.embed(keystones), sqlc.embed(dungeons), users.username, characters.name AS character_name,
+    COALESCE(characters.roles, users.roles)::text[] AS holder_roles
+FROM keystones
+JOIN guild_members ON guild_members.user_id = keystones.user_id
+JOIN dungeons ON dungeons.id = keystones.dungeon_id
+JOIN users ON users.id = keystones.user_id
+LEFT JOIN characters ON characters.id = keystones.character_id
+WHERE guild_members.guild_id = @guild_id AND keystones.recorded_at >= @recorded_after
+ORDER BY keystones.level DESC, keystones.id;
+
+-- name: GetWeeklyTimedRuns :many
+-- The key levels of the Mythic+ runs each of the users timed in a week, at
+-- min_level or above, highest first.
+SELECT run_participants.user_id, COALESCE(runs.key_level, 0)::int AS key_level
+FROM run_participants
+JOIN runs ON runs.id = run_participants.run_id
+WHERE run_participants.user_id = ANY(@user_ids::int[])
+    AND runs.difficulty = 'Mythic+' AND runs.status = 'completed' AND runs.upgrade_level > 0
+    AND runs.finished_at >= @starts_at AND runs.finished_at < @ends_at
+    AND COALESCE(runs.key_level, 0) >= @min_level::int
+ORDER BY run_participants.user_id, key_level DESC, runs.id;
@@ -3,8 +3,11 @@ module github.com/tmaffia/dungeon-time-api
 go 1.23.4
 
 require (
+	github.com/coder/websocket v1.8.12
 	github.com/jackc/pgx/v5 v5.7.2
 	github.com/stretchr/testify v1.10.0
+	github.com/teambition/rrule-go v1.8.2
+	golang.org/x/crypto v0.32.0
 )
 
 require (
@@ -16,7 +19,6 @@ require (
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/rogpeppe/go-internal v1.13.1 // indirect
 	github.com/stretchr/objx v0.5.2 // indirect
-	golang.org/x/crypto v0.32.0 // indirect
 	golang.org/x/sync v0.10.0 // indirect
 	golang.org/x/text v0.21.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
@@ -0,0 +1,15 @@
+package api
+
+import (
+	"net/http"
+)
+
+func (as appState) getAffixesHandler(w http.ResponseWriter, r *http.Request) {
+	affixes, err := as.affixService.GetAffixes(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, affixes)
+}
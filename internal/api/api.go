@@ -3,25 +3,103 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
 	"strconv"
+	"syscall"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/tmaffia/dungeon-time-api/internal/service"
+	"github.com/tmaffia/dungeon-time-api/internal/webhook"
 )
 
+// shutdownTimeout is how long in-flight requests get to finish on shutdown.
+const shutdownTimeout = 10 * time.Second
+
+// StartApi serves the API along with the background workers until the process
+// is interrupted or terminated, then stops taking requests and waits for
+// in-flight requests and jobs to finish.
 func StartApi() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	conf := newConfig()
-	dbpool, err := pgxpool.New(context.Background(), conf.databaseUrl)
+	dbpool, err := pgxpool.New(ctx, conf.databaseUrl)
 	if err != nil {
 		panic(err)
 	}
+	defer dbpool.Close()
 
 	userService := service.NewUserService(dbpool)
+	dungeonService := service.NewDungeonService(dbpool)
+	weeklyReset, err := parseWeeklyReset(conf.weeklyReset)
+	if err != nil {
+		panic(err)
+	}
+	runService := service.NewRunService(dbpool, weeklyReset)
+	signupService := service.NewSignupService(dbpool)
+	seriesService := service.NewSeriesService(dbpool)
+	guildService := service.NewGuildService(dbpool)
+	availabilityService := service.NewAvailabilityService(dbpool)
+	calendarService := service.NewCalendarService(dbpool)
+	notificationService := service.NewNotificationService(dbpool)
+	jobService := service.NewJobService(dbpool)
+	webhookService := service.NewWebhookService(dbpool, webhook.NewClient(webhook.NewHTTPClient(webhookTimeout)))
+	runStreamService := service.NewRunStreamService(dbpool)
+	readyCheckService := service.NewReadyCheckService(dbpool)
+	statsRefresh, err := parseStatsRefreshInterval(conf.statsRefresh)
+	if err != nil {
+		panic(err)
+	}
+	statsService := service.NewStatsService(dbpool, statsRefresh > 0)
+	seasonService := service.NewSeasonService(dbpool, weeklyReset)
+	leaderboardService := service.NewLeaderboardService(dbpool)
+	lfgService := service.NewLFGService(dbpool)
+	characterService := service.NewCharacterService(dbpool, weeklyReset)
+	keystoneService := service.NewKeystoneService(dbpool, weeklyReset)
+	affixService := service.NewAffixService(dbpool)
+	vaultService := service.NewVaultService(dbpool, weeklyReset)
+
+	if err := dungeonService.SeedCatalog(ctx); err != nil {
+		panic(err)
+	}
+	if err := affixService.SeedCatalog(ctx); err != nil {
+		panic(err)
+	}
+
+	workers := runWorkers(ctx, dbpool, conf)
+	// Run event streams and ready check sockets end once this stops listening,
+	// so they do not hold up the shutdown.
+	go runStreamService.Listen(ctx)
 
 	as := appState{
-		userService: userService,
+		userService:         userService,
+		dungeonService:      dungeonService,
+		runService:          runService,
+		signupService:       signupService,
+		seriesService:       seriesService,
+		guildService:        guildService,
+		availabilityService: availabilityService,
+		calendarService:     calendarService,
+		notificationService: notificationService,
+		jobService:          jobService,
+		webhookService:      webhookService,
+		runStreamService:    runStreamService,
+		readyCheckService:   readyCheckService,
+		readyCheckConns:     newConnLimiter(maxReadyCheckConns),
+		statsService:        statsService,
+		seasonService:       seasonService,
+		leaderboardService:  leaderboardService,
+		lfgService:          lfgService,
+		characterService:    characterService,
+		keystoneService:     keystoneService,
+		affixService:        affixService,
+		vaultService:        vaultService,
+		adminToken:          conf.adminToken,
 	}
 
 	mux := http.NewServeMux()
@@ -29,9 +107,122 @@ func StartApi() {
 	mux.HandleFunc("GET /api/v1/health", healthHandler)
 	mux.HandleFunc("GET /api/v1/users", as.getUsersHandler)
 	mux.HandleFunc("GET /api/v1/users/{id}", as.getUserHandler)
+	mux.HandleFunc("GET /api/v1/users/{id}/stats", as.getUserStatsHandler)
+	mux.HandleFunc("GET /api/v1/users/{id}/characters", as.getCharactersHandler)
+	mux.HandleFunc("POST /api/v1/users/{id}/characters", as.createCharacterHandler)
+	mux.HandleFunc("GET /api/v1/users/{id}/characters/{characterID}", as.getCharacterHandler)
+	mux.HandleFunc("PUT /api/v1/users/{id}/characters/{characterID}", as.updateCharacterHandler)
+	mux.HandleFunc("DELETE /api/v1/users/{id}/characters/{characterID}", as.deleteCharacterHandler)
+	mux.HandleFunc("POST /api/v1/users/{id}/characters/import", as.importUserCharactersHandler)
+	mux.HandleFunc("POST /api/v1/guilds/{id}/characters/import", as.importGuildCharactersHandler)
+	mux.HandleFunc("POST /api/v1/admin/characters/import", as.requireAdmin(as.adminImportCharactersHandler))
+	mux.HandleFunc("GET /api/v1/users/{id}/keystones", as.getKeystonesHandler)
+	mux.HandleFunc("PUT /api/v1/users/{id}/keystone", as.setKeystoneHandler)
+	mux.HandleFunc("DELETE /api/v1/users/{id}/keystone", as.deleteKeystoneHandler)
+	mux.HandleFunc("PUT /api/v1/users/{id}/characters/{characterID}/keystone", as.setKeystoneHandler)
+	mux.HandleFunc("DELETE /api/v1/users/{id}/characters/{characterID}/keystone", as.deleteKeystoneHandler)
+	mux.HandleFunc("GET /api/v1/users/{id}/vault", as.getVaultProgressHandler)
+	mux.HandleFunc("GET /api/v1/dungeons", as.getDungeonsHandler)
+	mux.HandleFunc("GET /api/v1/dungeons/{code}", as.getDungeonHandler)
+	mux.HandleFunc("POST /api/v1/admin/dungeons/import", as.requireAdmin(as.importDungeonsHandler))
+	mux.HandleFunc("GET /api/v1/affixes", as.getAffixesHandler)
+	mux.HandleFunc("GET /api/v1/seasons", as.getSeasonsHandler)
+	mux.HandleFunc("GET /api/v1/seasons/{code}/calendar", as.getSeasonCalendarHandler)
+	mux.HandleFunc("POST /api/v1/admin/seasons", as.requireAdmin(as.createSeasonHandler))
+	mux.HandleFunc("POST /api/v1/admin/seasons/{code}/close", as.requireAdmin(as.closeSeasonHandler))
+	mux.HandleFunc("PUT /api/v1/admin/seasons/{code}/affixes", as.requireAdmin(as.setSeasonAffixesHandler))
+	mux.HandleFunc("POST /api/v1/admin/lfg/match", as.requireAdmin(as.matchHandler))
+	mux.HandleFunc("GET /api/v1/admin/jobs", as.requireAdmin(as.getJobsHandler))
+	mux.HandleFunc("POST /api/v1/admin/jobs/{id}/retry", as.requireAdmin(as.retryJobHandler))
+	mux.HandleFunc("GET /api/v1/admin/webhooks", as.requireAdmin(as.getWebhooksHandler))
+	mux.HandleFunc("POST /api/v1/admin/webhooks", as.requireAdmin(as.createWebhookHandler))
+	mux.HandleFunc("PUT /api/v1/admin/webhooks/{webhookID}", as.requireAdmin(as.updateWebhookHandler))
+	mux.HandleFunc("DELETE /api/v1/admin/webhooks/{webhookID}", as.requireAdmin(as.deleteWebhookHandler))
+	mux.HandleFunc("GET /api/v1/admin/webhooks/{webhookID}/deliveries", as.requireAdmin(as.getWebhookDeliveriesHandler))
+	mux.HandleFunc("POST /api/v1/admin/webhooks/{webhookID}/deliveries/{deliveryID}/redeliver",
+		as.requireAdmin(as.redeliverWebhookHandler))
+	mux.HandleFunc("POST /api/v1/lfg", as.joinQueueHandler)
+	mux.HandleFunc("GET /api/v1/lfg", as.getQueueEntryHandler)
+	mux.HandleFunc("DELETE /api/v1/lfg", as.leaveQueueHandler)
+	mux.HandleFunc("GET /api/v1/lfg/proposals/{id}", as.getProposalHandler)
+	mux.HandleFunc("POST /api/v1/lfg/proposals/{id}/accept", as.acceptProposalHandler)
+	mux.HandleFunc("POST /api/v1/lfg/proposals/{id}/decline", as.declineProposalHandler)
+	mux.HandleFunc("GET /api/v1/runs", as.getRunsHandler)
+	mux.HandleFunc("POST /api/v1/runs", as.createRunHandler)
+	mux.HandleFunc("GET /api/v1/runs/{id}", as.getRunHandler)
+	mux.HandleFunc("PUT /api/v1/runs/{id}", as.updateRunHandler)
+	mux.HandleFunc("DELETE /api/v1/runs/{id}", as.cancelRunHandler)
+	mux.HandleFunc("POST /api/v1/runs/{id}/status", as.transitionRunHandler)
+	mux.HandleFunc("GET /api/v1/runs/{id}/attendance", as.getAttendanceHandler)
+	mux.HandleFunc("PUT /api/v1/runs/{id}/attendance", as.markAttendanceHandler)
+	mux.HandleFunc("GET /api/v1/runs/{id}/signups", as.getRosterHandler)
+	mux.HandleFunc("POST /api/v1/runs/{id}/signups", as.signUpHandler)
+	mux.HandleFunc("DELETE /api/v1/runs/{id}/signups", as.withdrawHandler)
+	mux.HandleFunc("PUT /api/v1/runs/{id}/waitlist", as.reorderWaitlistHandler)
+	mux.HandleFunc("GET /api/v1/runs/{id}/events", as.runEventsHandler)
+	mux.HandleFunc("GET /api/v1/runs/{id}/ready-check", as.getReadyCheckHandler)
+	mux.HandleFunc("GET /api/v1/runs/{id}/ready-check/ws", as.readyCheckSocketHandler)
+	mux.HandleFunc("POST /api/v1/series", as.createSeriesHandler)
+	mux.HandleFunc("GET /api/v1/series/{id}", as.getSeriesHandler)
+	mux.HandleFunc("GET /api/v1/series/{id}/occurrences", as.getOccurrencesHandler)
+	mux.HandleFunc("PUT /api/v1/series/{id}/occurrences/{occurrence}", as.updateOccurrenceHandler)
+	mux.HandleFunc("DELETE /api/v1/series/{id}/occurrences/{occurrence}", as.skipOccurrenceHandler)
+	mux.HandleFunc("POST /api/v1/guilds", as.createGuildHandler)
+	mux.HandleFunc("GET /api/v1/guilds/{id}", as.getGuildHandler)
+	mux.HandleFunc("POST /api/v1/guilds/{id}/members", as.addGuildMemberHandler)
+	mux.HandleFunc("DELETE /api/v1/guilds/{id}/members/{userID}", as.removeGuildMemberHandler)
+	mux.HandleFunc("PUT /api/v1/guilds/{id}/discord-webhook", as.setDiscordWebhookHandler)
+	mux.HandleFunc("DELETE /api/v1/guilds/{id}/discord-webhook", as.deleteDiscordWebhookHandler)
+	mux.HandleFunc("GET /api/v1/guilds/{id}/leaderboard", as.getLeaderboardHandler)
+	mux.HandleFunc("GET /api/v1/guilds/{id}/keystones", as.getGuildKeystonesHandler)
+	mux.HandleFunc("GET /api/v1/guilds/{id}/keystones/plan", as.planKeystonesHandler)
+	mux.HandleFunc("GET /api/v1/guilds/{id}/vault", as.getGuildVaultHandler)
+	mux.HandleFunc("POST /api/v1/guilds/{id}/vault/push", as.createVaultPushHandler)
+	mux.HandleFunc("PUT /api/v1/guilds/{id}/leaderboard-scoring", as.setLeaderboardScoringHandler)
+	mux.HandleFunc("PUT /api/v1/guilds/{id}/signup-policy", as.setSignupPolicyHandler)
+	mux.HandleFunc("GET /api/v1/guilds/{id}/webhooks", as.getWebhooksHandler)
+	mux.HandleFunc("POST /api/v1/guilds/{id}/webhooks", as.createWebhookHandler)
+	mux.HandleFunc("PUT /api/v1/guilds/{id}/webhooks/{webhookID}", as.updateWebhookHandler)
+	mux.HandleFunc("DELETE /api/v1/guilds/{id}/webhooks/{webhookID}", as.deleteWebhookHandler)
+	mux.HandleFunc("GET /api/v1/guilds/{id}/webhooks/{webhookID}/deliveries", as.getWebhookDeliveriesHandler)
+	mux.HandleFunc("POST /api/v1/guilds/{id}/webhooks/{webhookID}/deliveries/{deliveryID}/redeliver",
+		as.redeliverWebhookHandler)
+	mux.HandleFunc("GET /api/v1/users/{id}/availability", as.getAvailabilityHandler)
+	mux.HandleFunc("PUT /api/v1/users/{id}/availability/windows", as.setWeeklyWindowsHandler)
+	mux.HandleFunc("POST /api/v1/users/{id}/availability/exceptions", as.addAvailabilityExceptionHandler)
+	mux.HandleFunc("DELETE /api/v1/users/{id}/availability/exceptions/{exceptionID}", as.deleteAvailabilityExceptionHandler)
+	mux.HandleFunc("GET /api/v1/availability/best-times", as.getBestTimesHandler)
+	mux.HandleFunc("GET /api/v1/users/{id}/calendar.ics", as.getCalendarFeedHandler)
+	mux.HandleFunc("GET /api/v1/users/{id}/calendar-token", as.getCalendarTokenHandler)
+	mux.HandleFunc("POST /api/v1/users/{id}/calendar-token", as.rotateCalendarTokenHandler)
+	mux.HandleFunc("GET /api/v1/runs/{id}/calendar.ics", as.getRunCalendarHandler)
+	mux.HandleFunc("GET /api/v1/users/{id}/notification-preferences", as.getNotificationPreferencesHandler)
+	mux.HandleFunc("PUT /api/v1/users/{id}/notification-preferences", as.setNotificationPreferencesHandler)
+	mux.HandleFunc("GET /api/v1/users/{id}/notifications", as.getInboxHandler)
+	mux.HandleFunc("POST /api/v1/users/{id}/notifications/{notificationID}/read", as.markNotificationReadHandler)
+
+	server := &http.Server{Addr: ":8080", Handler: mux}
+	shutdown := make(chan struct{})
+	go func() {
+		defer close(shutdown)
+		<-ctx.Done()
+		log.Println("Shutting down")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.Printf("shutting down: %v", err)
+		}
+	}()
 
 	log.Println("Starting Dungeon Time API on :8080")
-	log.Fatal(http.ListenAndServe(":8080", mux))
+	if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		log.Fatal(err)
+	}
+	// ListenAndServe returns as soon as the shutdown starts, in-flight requests
+	// still need the pool until it is done.
+	<-shutdown
+	workers.Wait()
+	log.Println("Server stopped")
 }
 
 func healthHandler(w http.ResponseWriter, r *http.Request) {
@@ -74,6 +265,12 @@ func (as appState) getUserHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	user.Reliability, err = as.userService.GetReliability(r.Context(), user.ID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
 	userJson, err := json.Marshal(user)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
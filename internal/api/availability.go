@@ -0,0 +1,197 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tmaffia/dungeon-time-api/internal/service"
+)
+
+// Defaults for best time searches that leave out the window or run length.
+const (
+	defaultBestTimeWindow   = 7 * 24 * time.Hour
+	defaultBestTimeDuration = 60
+)
+
+func (as appState) getAvailabilityHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	availability, err := as.availabilityService.GetAvailability(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, availability)
+}
+
+// setWeeklyWindowsHandler replaces the user's weekly windows with the list in the body.
+func (as appState) setWeeklyWindowsHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	var windows []service.WeeklyWindow
+	if err := json.NewDecoder(r.Body).Decode(&windows); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	availability, err := as.availabilityService.SetWeeklyWindows(r.Context(), actorID, id, windows)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, availability)
+}
+
+func (as appState) addAvailabilityExceptionHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	var input service.AvailabilityExceptionInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	exception, err := as.availabilityService.AddException(r.Context(), actorID, id, &input)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, exception)
+}
+
+func (as appState) deleteAvailabilityExceptionHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	exceptionID, err := pathID(r, "exceptionID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	if err := as.availabilityService.DeleteException(r.Context(), actorID, id, exceptionID); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// getBestTimesHandler finds start times for a group, see bestTimeQuery for the parameters.
+func (as appState) getBestTimesHandler(w http.ResponseWriter, r *http.Request) {
+	query, err := bestTimeQuery(r, time.Now())
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	candidates, err := as.availabilityService.FindBestTimes(r.Context(), query)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, candidates)
+}
+
+// bestTimeQuery parses the query parameters of a best time search:
+// guild, a guild id, or users, a comma separated list of user ids;
+// tanks, healers and dps, the composition, defaulting to a standard group;
+// duration_minutes, defaulting to an hour; and from and to, defaulting to the next week.
+func bestTimeQuery(r *http.Request, now time.Time) (*service.BestTimeQuery, error) {
+	values := r.URL.Query()
+
+	from, to, err := timeRange(r, now, defaultBestTimeWindow)
+	if err != nil {
+		return nil, err
+	}
+	query := &service.BestTimeQuery{
+		Composition:     service.DefaultComposition,
+		From:            from,
+		To:              to,
+		DurationMinutes: defaultBestTimeDuration,
+	}
+
+	if v := values.Get("guild"); v != "" {
+		id, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid guild: %w", err)
+		}
+		guildID := int32(id)
+		query.GuildID = &guildID
+	}
+
+	if v := values.Get("users"); v != "" {
+		for _, s := range strings.Split(v, ",") {
+			id, err := strconv.ParseInt(strings.TrimSpace(s), 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid users: %w", err)
+			}
+			query.UserIDs = append(query.UserIDs, int32(id))
+		}
+	}
+
+	for name, field := range map[string]*int32{
+		"tanks":            &query.Composition.Tanks,
+		"healers":          &query.Composition.Healers,
+		"dps":              &query.Composition.DPS,
+		"duration_minutes": &query.DurationMinutes,
+	} {
+		if v := values.Get(name); v != "" {
+			n, err := strconv.ParseInt(v, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", name, err)
+			}
+			*field = int32(n)
+		}
+	}
+	return query, nil
+}
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tmaffia/dungeon-time-api/internal/service"
+)
+
+func Test_bestTimeQuery(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	guildID := int32(4)
+
+	tests := []struct {
+		name    string
+		target  string
+		want    *service.BestTimeQuery
+		wantErr bool
+	}{
+		{"Guild With Defaults", "/api/v1/availability/best-times?guild=4",
+			&service.BestTimeQuery{GuildID: &guildID, Composition: service.DefaultComposition,
+				From: now, To: now.Add(defaultBestTimeWindow), DurationMinutes: defaultBestTimeDuration}, false},
+		{"Users And Composition", "/api/v1/availability/best-times?users=1,%202,3&tanks=1&healers=1&dps=1&duration_minutes=30",
+			&service.BestTimeQuery{UserIDs: []int32{1, 2, 3}, Composition: service.Composition{Tanks: 1, Healers: 1, DPS: 1},
+				From: now, To: now.Add(defaultBestTimeWindow), DurationMinutes: 30}, false},
+		{"Invalid Users", "/api/v1/availability/best-times?users=1,bob", nil, true},
+		{"Invalid Composition", "/api/v1/availability/best-times?guild=4&dps=many", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := bestTimeQuery(httptest.NewRequest("GET", tt.target, nil), now)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
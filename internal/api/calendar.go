@@ -0,0 +1,155 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/tmaffia/dungeon-time-api/internal/ical"
+	"github.com/tmaffia/dungeon-time-api/internal/service"
+)
+
+// calendarUIDDomain makes run UIDs globally unique as RFC 5545 asks.
+// It must never change, calendar apps use UIDs to match events across updates.
+const calendarUIDDomain = "dungeon-time-api"
+
+type calendarTokenResponse struct {
+	Token string `json:"token"`
+	URL   string `json:"url"`
+}
+
+// getCalendarFeedHandler serves a user's runs as an iCalendar feed. The token
+// query parameter must be the user's calendar token.
+func (as appState) getCalendarFeedHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	runs, err := as.calendarService.GetCalendarRuns(r.Context(), id, r.URL.Query().Get("token"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeCalendar(w, "Dungeon Time", runs)
+}
+
+// getRunCalendarHandler serves a single run as an iCalendar file.
+func (as appState) getRunCalendarHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	run, err := as.runService.GetRunByID(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="run-%d.ics"`, run.ID))
+	writeCalendar(w, "", []*service.Run{run})
+}
+
+func (as appState) getCalendarTokenHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	token, err := as.calendarService.GetCalendarToken(r.Context(), actorID, id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newCalendarTokenResponse(id, token))
+}
+
+// rotateCalendarTokenHandler replaces the user's calendar token, for when a feed URL leaked.
+func (as appState) rotateCalendarTokenHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	token, err := as.calendarService.RotateCalendarToken(r.Context(), actorID, id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newCalendarTokenResponse(id, token))
+}
+
+func newCalendarTokenResponse(userID int32, token string) calendarTokenResponse {
+	return calendarTokenResponse{
+		Token: token,
+		URL:   fmt.Sprintf("/api/v1/users/%d/calendar.ics?token=%s", userID, token),
+	}
+}
+
+// writeCalendar writes runs as an iCalendar document.
+func writeCalendar(w http.ResponseWriter, name string, runs []*service.Run) {
+	c := &ical.Calendar{Name: name, Events: make([]ical.Event, 0, len(runs))}
+	for _, run := range runs {
+		c.Events = append(c.Events, runEvent(run))
+	}
+
+	var b bytes.Buffer
+	if err := ical.Encode(&b, c, time.Now()); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(b.Bytes())
+}
+
+// runEvent converts a run to a calendar event in the run's own timezone.
+func runEvent(run *service.Run) ical.Event {
+	summary := fmt.Sprintf("%s (%s)", run.Dungeon.Name, run.Difficulty)
+	if run.KeyLevel != nil {
+		summary = fmt.Sprintf("%s +%d", run.Dungeon.Name, *run.KeyLevel)
+	}
+
+	status := ical.StatusConfirmed
+	if run.Status == service.RunStatusCancelled {
+		status = ical.StatusCancelled
+	}
+
+	return ical.Event{
+		UID:          fmt.Sprintf("run-%d@%s", run.ID, calendarUIDDomain),
+		Sequence:     run.Sequence,
+		Start:        run.StartsAt,
+		End:          run.EndsAt(),
+		Location:     run.Location(),
+		Summary:      summary,
+		Description:  run.Notes,
+		Status:       status,
+		LastModified: run.UpdatedAt,
+	}
+}
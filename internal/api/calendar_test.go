@@ -0,0 +1,43 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tmaffia/dungeon-time-api/internal/ical"
+	"github.com/tmaffia/dungeon-time-api/internal/service"
+)
+
+func Test_runEvent(t *testing.T) {
+	keyLevel := int32(10)
+	startsAt := time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		run         *service.Run
+		wantSummary string
+		wantStatus  string
+	}{
+		{"Mythic Plus", &service.Run{ID: 4, Dungeon: &service.Dungeon{Name: "The Stonevault"},
+			Difficulty: service.DifficultyMythicPlus, KeyLevel: &keyLevel, Status: service.RunStatusScheduled},
+			"The Stonevault +10", ical.StatusConfirmed},
+		{"Heroic Cancelled", &service.Run{ID: 4, Dungeon: &service.Dungeon{Name: "The Stonevault"},
+			Difficulty: service.DifficultyHeroic, Status: service.RunStatusCancelled},
+			"The Stonevault (Heroic)", ical.StatusCancelled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.run.StartsAt = startsAt
+			tt.run.DurationMinutes = 45
+			tt.run.Timezone = "Europe/Berlin"
+
+			got := runEvent(tt.run)
+			assert.Equal(t, "run-4@dungeon-time-api", got.UID)
+			assert.Equal(t, tt.wantSummary, got.Summary)
+			assert.Equal(t, tt.wantStatus, got.Status)
+			assert.Equal(t, startsAt.Add(45*time.Minute), got.End)
+			assert.Equal(t, "Europe/Berlin", got.Location.String())
+		})
+	}
+}
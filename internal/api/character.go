@@ -0,0 +1,145 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/tmaffia/dungeon-time-api/internal/service"
+)
+
+func (as appState) getCharactersHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	characters, err := as.characterService.GetCharacters(r.Context(), userID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, characters)
+}
+
+func (as appState) getCharacterHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	id, err := pathID(r, "characterID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	character, err := as.characterService.GetCharacter(r.Context(), userID, id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, character)
+}
+
+func (as appState) createCharacterHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	userID, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	var input service.CharacterInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	character, err := as.characterService.CreateCharacter(r.Context(), actorID, userID, &input)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, character)
+}
+
+func (as appState) updateCharacterHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	userID, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	id, err := pathID(r, "characterID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	var input service.CharacterInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	character, err := as.characterService.UpdateCharacter(r.Context(), actorID, userID, id, &input)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, character)
+}
+
+func (as appState) deleteCharacterHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	userID, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	id, err := pathID(r, "characterID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	if err := as.characterService.DeleteCharacter(r.Context(), actorID, userID, id); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
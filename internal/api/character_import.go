@@ -0,0 +1,176 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/tmaffia/dungeon-time-api/internal/armory"
+	"github.com/tmaffia/dungeon-time-api/internal/service"
+)
+
+// maxImportSize is the largest armory export that can be uploaded.
+const maxImportSize = 5 << 20
+
+// importUserCharactersHandler imports the characters of the user in the path,
+// who must be the acting user.
+func (as appState) importUserCharactersHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	userID, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	as.importCharacters(w, r, service.ImportTarget{UserID: &userID, ActorID: actorID})
+}
+
+// importGuildCharactersHandler imports the characters of the members of the
+// guild in the path. The acting user must be an admin of the guild.
+func (as appState) importGuildCharactersHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	guildID, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	as.importCharacters(w, r, service.ImportTarget{GuildID: &guildID, ActorID: actorID})
+}
+
+// adminImportCharactersHandler imports characters for the user or guild in the
+// user or guild query parameter, behind requireAdmin.
+func (as appState) adminImportCharactersHandler(w http.ResponseWriter, r *http.Request) {
+	target := service.ImportTarget{Admin: true}
+	for name, id := range map[string]**int32{"user": &target.UserID, "guild": &target.GuildID} {
+		v := r.URL.Query().Get(name)
+		if v == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf("invalid %s: %s", name, err)))
+			return
+		}
+		value := int32(n)
+		*id = &value
+	}
+
+	as.importCharacters(w, r, target)
+}
+
+// importCharacters imports the armory export in the request body for target.
+// The format is the format query parameter, or told by the Content-Type, and
+// dry_run=true reports the changes without making them.
+func (as appState) importCharacters(w http.ResponseWriter, r *http.Request, target service.ImportTarget) {
+	dryRun := false
+	if v := r.URL.Query().Get("dry_run"); v != "" {
+		var err error
+		if dryRun, err = strconv.ParseBool(v); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf("invalid dry_run: %s", err)))
+			return
+		}
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = armory.FormatJSON
+		if strings.HasPrefix(r.Header.Get("Content-Type"), "text/csv") {
+			format = armory.FormatCSV
+		}
+	}
+
+	profiles, err := armory.Parse(http.MaxBytesReader(w, r.Body, maxImportSize), format)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+		} else {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	report, err := as.characterService.ImportCharacters(r.Context(), target, profiles, dryRun)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, report)
+}
+
+// ImportCharacters runs the import-characters command with args, like
+//
+//	import-characters -guild 3 -dry-run roster.json
+//
+// It imports the armory export at the path for the -user or -guild given and
+// writes the report to stdout as JSON.
+func ImportCharacters(args []string) {
+	flags := flag.NewFlagSet("import-characters", flag.ExitOnError)
+	userID := flags.Int("user", 0, "import the characters of the user with this id")
+	guildID := flags.Int("guild", 0, "import the characters of the members of the guild with this id")
+	dryRun := flags.Bool("dry-run", false, "report the changes without making them")
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: import-characters (-user id | -guild id) [-dry-run] file")
+		os.Exit(2)
+	}
+
+	target := service.ImportTarget{Admin: true}
+	if *userID != 0 {
+		id := int32(*userID)
+		target.UserID = &id
+	}
+	if *guildID != 0 {
+		id := int32(*guildID)
+		target.GuildID = &id
+	}
+
+	ctx := context.Background()
+	conf := newConfig()
+	weeklyReset, err := parseWeeklyReset(conf.weeklyReset)
+	if err != nil {
+		log.Fatal(err)
+	}
+	dbpool, err := pgxpool.New(ctx, conf.databaseUrl)
+	if err != nil {
+		panic(err)
+	}
+	defer dbpool.Close()
+
+	report, err := service.NewCharacterService(dbpool, weeklyReset).ImportCharacters(ctx, target,
+		armory.NewFileSource(flags.Arg(0)), *dryRun)
+	if err != nil {
+		log.Fatal(err)
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		log.Fatal(err)
+	}
+}
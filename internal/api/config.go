@@ -7,11 +7,48 @@ import (
 )
 
 type appState struct {
-	userService service.UserService
+	userService         service.UserService
+	dungeonService      service.DungeonService
+	runService          service.RunService
+	signupService       service.SignupService
+	seriesService       service.SeriesService
+	guildService        service.GuildService
+	availabilityService service.AvailabilityService
+	calendarService     service.CalendarService
+	notificationService service.NotificationService
+	jobService          service.JobService
+	webhookService      service.WebhookService
+	runStreamService    service.RunStreamService
+	readyCheckService   service.ReadyCheckService
+	readyCheckConns     *connLimiter
+	statsService        service.StatsService
+	seasonService       service.SeasonService
+	leaderboardService  service.LeaderboardService
+	lfgService          service.LFGService
+	characterService    service.CharacterService
+	keystoneService     service.KeystoneService
+	affixService        service.AffixService
+	vaultService        service.VaultService
+	adminToken          string
 }
 
+// config holds settings read from the environment. Email notifications are
+// sent through smtpAddr when it is set, otherwise they are written to
+// notifyLogPath, or to stdout if that is empty too. reminderOffsets is a comma
+// separated list of durations, see parseReminderOffsets. statsRefresh turns
+// on the stats summary, see parseStatsRefreshInterval. weeklyReset is when
+// the game week rolls over, see parseWeeklyReset.
 type config struct {
-	databaseUrl string
+	databaseUrl     string
+	adminToken      string
+	smtpAddr        string
+	smtpUsername    string
+	smtpPassword    string
+	smtpFrom        string
+	notifyLogPath   string
+	reminderOffsets string
+	statsRefresh    string
+	weeklyReset     string
 }
 
 func newConfig() *config {
@@ -20,6 +57,15 @@ func newConfig() *config {
 		panic("DUNGEON_TIME_API_DATABASE_URL is required")
 	}
 	return &config{
-		databaseUrl: dbUrl,
+		databaseUrl:     dbUrl,
+		adminToken:      os.Getenv("DUNGEON_TIME_API_ADMIN_TOKEN"),
+		smtpAddr:        os.Getenv("DUNGEON_TIME_API_SMTP_ADDR"),
+		smtpUsername:    os.Getenv("DUNGEON_TIME_API_SMTP_USERNAME"),
+		smtpPassword:    os.Getenv("DUNGEON_TIME_API_SMTP_PASSWORD"),
+		smtpFrom:        os.Getenv("DUNGEON_TIME_API_SMTP_FROM"),
+		notifyLogPath:   os.Getenv("DUNGEON_TIME_API_NOTIFY_LOG"),
+		reminderOffsets: os.Getenv("DUNGEON_TIME_API_REMINDER_OFFSETS"),
+		statsRefresh:    os.Getenv("DUNGEON_TIME_API_STATS_REFRESH_INTERVAL"),
+		weeklyReset:     os.Getenv("DUNGEON_TIME_API_WEEKLY_RESET"),
 	}
 }
@@ -12,7 +12,16 @@ func Test_newConfig(t *testing.T) {
 		want *config
 	}{
 		{name: "Config Envs", want: &config{
-			databaseUrl: os.Getenv("DUNGEON_TIME_API_DATABASE_URL"),
+			databaseUrl:     os.Getenv("DUNGEON_TIME_API_DATABASE_URL"),
+			adminToken:      os.Getenv("DUNGEON_TIME_API_ADMIN_TOKEN"),
+			smtpAddr:        os.Getenv("DUNGEON_TIME_API_SMTP_ADDR"),
+			smtpUsername:    os.Getenv("DUNGEON_TIME_API_SMTP_USERNAME"),
+			smtpPassword:    os.Getenv("DUNGEON_TIME_API_SMTP_PASSWORD"),
+			smtpFrom:        os.Getenv("DUNGEON_TIME_API_SMTP_FROM"),
+			notifyLogPath:   os.Getenv("DUNGEON_TIME_API_NOTIFY_LOG"),
+			reminderOffsets: os.Getenv("DUNGEON_TIME_API_REMINDER_OFFSETS"),
+			statsRefresh:    os.Getenv("DUNGEON_TIME_API_STATS_REFRESH_INTERVAL"),
+			weeklyReset:     os.Getenv("DUNGEON_TIME_API_WEEKLY_RESET"),
 		}},
 	}
 	for _, tt := range tests {
@@ -0,0 +1,46 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/tmaffia/dungeon-time-api/internal/service"
+)
+
+func (as appState) getDungeonsHandler(w http.ResponseWriter, r *http.Request) {
+	dungeons, err := as.dungeonService.GetDungeons(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dungeons)
+}
+
+func (as appState) getDungeonHandler(w http.ResponseWriter, r *http.Request) {
+	dungeon, err := as.dungeonService.GetDungeonByCode(r.Context(), r.PathValue("code"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dungeon)
+}
+
+// importDungeonsHandler replaces the dungeon catalog, letting seasons rotate
+// without shipping a new binary. The body uses the same format as the embedded seed file.
+func (as appState) importDungeonsHandler(w http.ResponseWriter, r *http.Request) {
+	var catalog service.DungeonCatalog
+	if err := json.NewDecoder(r.Body).Decode(&catalog); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	if err := as.dungeonService.ImportCatalog(r.Context(), &catalog); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
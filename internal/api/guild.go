@@ -0,0 +1,240 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/tmaffia/dungeon-time-api/internal/service"
+)
+
+type createGuildRequest struct {
+	Name string `json:"name"`
+}
+
+type addMemberRequest struct {
+	UserID int32 `json:"user_id"`
+}
+
+type discordWebhookRequest struct {
+	URL string `json:"url"`
+}
+
+type leaderboardScoringRequest struct {
+	Scoring service.LeaderboardScoring `json:"scoring"`
+}
+
+func (as appState) createGuildHandler(w http.ResponseWriter, r *http.Request) {
+	ownerID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var req createGuildRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	guild, err := as.guildService.CreateGuild(r.Context(), ownerID, req.Name)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, guild)
+}
+
+func (as appState) getGuildHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	guild, err := as.guildService.GetGuildByID(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, guild)
+}
+
+func (as appState) addGuildMemberHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	var req addMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	guild, err := as.guildService.AddMember(r.Context(), actorID, id, req.UserID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, guild)
+}
+
+func (as appState) removeGuildMemberHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	userID, err := pathID(r, "userID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	guild, err := as.guildService.RemoveMember(r.Context(), actorID, id, userID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, guild)
+}
+
+// setDiscordWebhookHandler sets the Discord webhook runs for the guild are announced to.
+func (as appState) setDiscordWebhookHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	var req discordWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	guild, err := as.guildService.SetDiscordWebhook(r.Context(), actorID, id, req.URL)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, guild)
+}
+
+func (as appState) setLeaderboardScoringHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	var req leaderboardScoringRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	guild, err := as.guildService.SetLeaderboardScoring(r.Context(), actorID, id, req.Scoring)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, guild)
+}
+
+// setSignupPolicyHandler replaces the signup policy of a guild, see
+// service.SignupPolicy.
+func (as appState) setSignupPolicyHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	var policy service.SignupPolicy
+	if err := json.NewDecoder(r.Body).Decode(&policy); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	guild, err := as.guildService.SetSignupPolicy(r.Context(), actorID, id, &policy)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, guild)
+}
+
+func (as appState) deleteDiscordWebhookHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	guild, err := as.guildService.SetDiscordWebhook(r.Context(), actorID, id, "")
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, guild)
+}
@@ -0,0 +1,249 @@
+package api
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tmaffia/dungeon-time-api/internal/service"
+)
+
+// userIDHeader identifies the user making a request. The API does not
+// authenticate users yet so the header is trusted as is, actingUserID is the
+// single place to swap in real authentication once it exists.
+const userIDHeader = "X-User-ID"
+
+var errNoActingUser = errors.New(userIDHeader + " header is required")
+
+// writeJSON marshals v and writes it with the provided status code.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+// writeJSONWithETag writes v like writeJSON with a 200 status and an ETag of
+// its body. Requests that already have the body, going by If-None-Match, get a
+// 304 without it instead.
+func writeJSONWithETag(w http.ResponseWriter, r *http.Request, v any) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "private, no-cache")
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// etagMatches reports whether an If-None-Match header value matches etag.
+// Weak validators match their strong counterpart.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// problemResponse is the body written for errors that carry a problem code,
+// such as *service.TransitionError.
+type problemResponse struct {
+	Code  string `json:"code"`
+	Error string `json:"error"`
+}
+
+// writeError writes err as a plain text response, or as a JSON problemResponse
+// if it has a problem code. The status code is chosen from the service error
+// that err wraps, see errorStatus.
+func writeError(w http.ResponseWriter, err error) {
+	var p interface{ ProblemCode() string }
+	if errors.As(err, &p) {
+		writeJSON(w, errorStatus(err), problemResponse{Code: p.ProblemCode(), Error: err.Error()})
+		return
+	}
+
+	w.WriteHeader(errorStatus(err))
+	w.Write([]byte(err.Error()))
+}
+
+// errorStatus maps service errors to HTTP status codes.
+// Unknown errors are treated as internal server errors.
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, errNoActingUser):
+		return http.StatusUnauthorized
+	case errors.Is(err, service.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, service.ErrUserNotFound),
+		errors.Is(err, service.ErrDungeonNotFound),
+		errors.Is(err, service.ErrRunNotFound),
+		errors.Is(err, service.ErrSignupNotFound),
+		errors.Is(err, service.ErrSeriesNotFound),
+		errors.Is(err, service.ErrOccurrenceNotFound),
+		errors.Is(err, service.ErrGuildNotFound),
+		errors.Is(err, service.ErrGuildMemberNotFound),
+		errors.Is(err, service.ErrAvailabilityNotFound),
+		errors.Is(err, service.ErrNotificationNotFound),
+		errors.Is(err, service.ErrJobNotFound),
+		errors.Is(err, service.ErrWebhookNotFound),
+		errors.Is(err, service.ErrWebhookDeliveryNotFound),
+		errors.Is(err, service.ErrReadyCheckNotFound),
+		errors.Is(err, service.ErrSeasonNotFound),
+		errors.Is(err, service.ErrAffixNotFound),
+		errors.Is(err, service.ErrNotQueued),
+		errors.Is(err, service.ErrProposalNotFound),
+		errors.Is(err, service.ErrCharacterNotFound),
+		errors.Is(err, service.ErrKeystoneNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrInvalidUser),
+		errors.Is(err, service.ErrInvalidRole),
+		errors.Is(err, service.ErrInvalidTimezone),
+		errors.Is(err, service.ErrInvalidPassword),
+		errors.Is(err, service.ErrInvalidEmail),
+		errors.Is(err, service.ErrInvalidUsername),
+		errors.Is(err, service.ErrInvalidDungeon),
+		errors.Is(err, service.ErrInvalidDifficulty),
+		errors.Is(err, service.ErrInvalidCatalog),
+		errors.Is(err, service.ErrRunInPast),
+		errors.Is(err, service.ErrInvalidStartTime),
+		errors.Is(err, service.ErrInvalidRunStatus),
+		errors.Is(err, service.ErrInvalidDuration),
+		errors.Is(err, service.ErrInvalidNotes),
+		errors.Is(err, service.ErrInvalidKeyLevel),
+		errors.Is(err, service.ErrInvalidTimeRange),
+		errors.Is(err, service.ErrInvalidComposition),
+		errors.Is(err, service.ErrRoleNotPlayable),
+		errors.Is(err, service.ErrInvalidWaitlistOrder),
+		errors.Is(err, service.ErrInvalidRRule),
+		errors.Is(err, service.ErrInvalidGuild),
+		errors.Is(err, service.ErrInvalidAvailability),
+		errors.Is(err, service.ErrInvalidNotificationPreferences),
+		errors.Is(err, service.ErrInvalidDiscordWebhook),
+		errors.Is(err, service.ErrInvalidJobStatus),
+		errors.Is(err, service.ErrInvalidWebhook),
+		errors.Is(err, service.ErrInvalidReadyCheck),
+		errors.Is(err, service.ErrInvalidSeason),
+		errors.Is(err, service.ErrInvalidScoring),
+		errors.Is(err, service.ErrInvalidPage),
+		errors.Is(err, service.ErrInvalidAttendance),
+		errors.Is(err, service.ErrInvalidSignupPolicy),
+		errors.Is(err, service.ErrInvalidLFGEntry),
+		errors.Is(err, service.ErrInvalidCharacter),
+		errors.Is(err, service.ErrInvalidImport),
+		errors.Is(err, service.ErrInvalidKeystone),
+		errors.Is(err, service.ErrInvalidRegion),
+		errors.Is(err, service.ErrInvalidAffixRotation),
+		errors.Is(err, service.ErrInvalidVaultQuery):
+		return http.StatusBadRequest
+	case errors.Is(err, service.ErrUserExists),
+		errors.Is(err, service.ErrStaleCatalog),
+		errors.Is(err, service.ErrRunCancelled),
+		errors.Is(err, service.ErrRunStarted),
+		errors.Is(err, service.ErrInvalidTransition),
+		errors.Is(err, service.ErrAlreadySignedUp),
+		errors.Is(err, service.ErrGuildExists),
+		errors.Is(err, service.ErrAlreadyGuildMember),
+		errors.Is(err, service.ErrJobNotDead),
+		errors.Is(err, service.ErrJobPending),
+		errors.Is(err, service.ErrWebhookDisabled),
+		errors.Is(err, service.ErrReadyCheckInProgress),
+		errors.Is(err, service.ErrReadyCheckNotPending),
+		errors.Is(err, service.ErrSeasonExists),
+		errors.Is(err, service.ErrNoVaultPush),
+		errors.Is(err, service.ErrSeasonOpen),
+		errors.Is(err, service.ErrSeasonClosed),
+		errors.Is(err, service.ErrRunNotFinished),
+		errors.Is(err, service.ErrAlreadyQueued),
+		errors.Is(err, service.ErrProposalClosed),
+		errors.Is(err, service.ErrCharacterExists):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// requireAdmin wraps a handler that is only available to administrators.
+// Requests must send the configured admin token as a bearer token.
+// Admin endpoints are disabled entirely when no admin token is configured.
+func (as appState) requireAdmin(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if as.adminToken == "" || !ok ||
+			subtle.ConstantTimeCompare([]byte(token), []byte(as.adminToken)) != 1 {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte("admin token required"))
+			return
+		}
+		next(w, r)
+	}
+}
+
+// actingUserID returns the id of the user making the request.
+func actingUserID(r *http.Request) (int32, error) {
+	value := r.Header.Get(userIDHeader)
+	if value == "" {
+		return 0, errNoActingUser
+	}
+
+	id, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", errNoActingUser, err)
+	}
+	return int32(id), nil
+}
+
+// viewerLocation returns the timezone times should be rendered in for the
+// requesting user. Anonymous requests are rendered in UTC.
+func (as appState) viewerLocation(r *http.Request) (*time.Location, error) {
+	if r.Header.Get(userIDHeader) == "" {
+		return time.UTC, nil
+	}
+
+	id, err := actingUserID(r)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := as.userService.GetUserByID(r.Context(), id)
+	if err != nil {
+		return nil, err
+	}
+	return &user.Timezone, nil
+}
+
+// pathID parses a numeric id from the named path value.
+func pathID(r *http.Request, name string) (int32, error) {
+	id, err := strconv.ParseInt(r.PathValue(name), 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return int32(id), nil
+}
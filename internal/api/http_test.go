@@ -0,0 +1,119 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tmaffia/dungeon-time-api/internal/service"
+)
+
+func Test_errorStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"Not Found", service.ErrDungeonNotFound, http.StatusNotFound},
+		{"Wrapped Validation Error", fmt.Errorf("%w: ARAK", service.ErrInvalidDungeon), http.StatusBadRequest},
+		{"Conflict", service.ErrStaleCatalog, http.StatusConflict},
+		{"Invalid Transition", &service.TransitionError{From: "scheduled", To: "completed"}, http.StatusConflict},
+		{"Unknown Error", errors.New("boom"), http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, errorStatus(tt.err))
+		})
+	}
+}
+
+func Test_writeError(t *testing.T) {
+	tests := []struct {
+		name            string
+		err             error
+		wantStatus      int
+		wantContentType string
+		wantBody        string
+	}{
+		{"Plain Text", service.ErrRunNotFound, http.StatusNotFound, "", "run not found"},
+		{"Problem", &service.TransitionError{From: "cancelled", To: "forming"}, http.StatusConflict,
+			"application/json",
+			`{"code":"run_cancelled","error":"invalid run status transition: cancelled run can not become forming"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			writeError(w, tt.err)
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.wantContentType, w.Header().Get("Content-Type"))
+			assert.Equal(t, tt.wantBody, w.Body.String())
+		})
+	}
+}
+
+func Test_writeJSONWithETag(t *testing.T) {
+	body := map[string]int{"completed": 3}
+	w := httptest.NewRecorder()
+	writeJSONWithETag(w, httptest.NewRequest("GET", "/", nil), body)
+	etag := w.Header().Get("ETag")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `{"completed":3}`, w.Body.String())
+	assert.NotEmpty(t, etag)
+
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		want        int
+	}{
+		{"Same", etag, http.StatusNotModified},
+		{"Weak In List", `"abc", W/` + etag, http.StatusNotModified},
+		{"Any", "*", http.StatusNotModified},
+		{"Changed", `"abc"`, http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/", nil)
+			r.Header.Set("If-None-Match", tt.ifNoneMatch)
+			w := httptest.NewRecorder()
+			writeJSONWithETag(w, r, body)
+			assert.Equal(t, tt.want, w.Code)
+			assert.Equal(t, etag, w.Header().Get("ETag"))
+			if tt.want == http.StatusNotModified {
+				assert.Empty(t, w.Body.String())
+			}
+		})
+	}
+}
+
+func Test_appState_requireAdmin(t *testing.T) {
+	tests := []struct {
+		name          string
+		adminToken    string
+		authorization string
+		want          int
+	}{
+		{"Valid Token", "secret", "Bearer secret", http.StatusNoContent},
+		{"Wrong Token", "secret", "Bearer nope", http.StatusForbidden},
+		{"Missing Token", "secret", "", http.StatusForbidden},
+		{"Admin Disabled", "", "Bearer ", http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			as := appState{adminToken: tt.adminToken}
+			handler := as.requireAdmin(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("POST", "/api/v1/admin/dungeons/import", nil)
+			if tt.authorization != "" {
+				r.Header.Set("Authorization", tt.authorization)
+			}
+			handler(w, r)
+			assert.Equal(t, tt.want, w.Code)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+)
+
+func (as appState) getJobsHandler(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	jobs, err := as.jobService.GetJobs(r.Context(), query.Get("status"), query.Get("queue"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, jobs)
+}
+
+func (as appState) retryJobHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	job, err := as.jobService.RetryJob(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, job)
+}
@@ -0,0 +1,169 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/tmaffia/dungeon-time-api/internal/service"
+)
+
+// defaultKeyDuration is how long a planned key is expected to take, in minutes.
+const defaultKeyDuration = 45
+
+func (as appState) getKeystonesHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	inventory, err := as.keystoneService.GetKeystones(r.Context(), userID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, inventory)
+}
+
+// setKeystoneHandler records the key held by the user in the path, or by their
+// character if the path names one.
+func (as appState) setKeystoneHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	userID, characterID, err := keystoneHolder(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	var input service.KeystoneInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	keystone, err := as.keystoneService.SetKeystone(r.Context(), actorID, userID, characterID, &input)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, keystone)
+}
+
+// deleteKeystoneHandler clears the key held by the user in the path, or by
+// their character if the path names one.
+func (as appState) deleteKeystoneHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	userID, characterID, err := keystoneHolder(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	if err := as.keystoneService.DeleteKeystone(r.Context(), actorID, userID, characterID); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (as appState) getGuildKeystonesHandler(w http.ResponseWriter, r *http.Request) {
+	guildID, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	inventory, err := as.keystoneService.GetGuildKeystones(r.Context(), guildID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, inventory)
+}
+
+// planKeystonesHandler plans the keys held in the guild in the path. The
+// tanks, healers and dps query parameters set the composition, defaulting to
+// a standard group, and duration_minutes how long each key takes.
+func (as appState) planKeystonesHandler(w http.ResponseWriter, r *http.Request) {
+	guildID, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	query := &service.KeyPlanQuery{Composition: service.DefaultComposition, DurationMinutes: defaultKeyDuration}
+	err = parseInt32Params(r, map[string]*int32{
+		"tanks":            &query.Composition.Tanks,
+		"healers":          &query.Composition.Healers,
+		"dps":              &query.Composition.DPS,
+		"duration_minutes": &query.DurationMinutes,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	plan, err := as.keystoneService.PlanWeek(r.Context(), guildID, query)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, plan)
+}
+
+// keystoneHolder returns the user in the path and, if the path names one, their
+// character.
+func keystoneHolder(r *http.Request) (int32, *int32, error) {
+	userID, err := pathID(r, "id")
+	if err != nil {
+		return 0, nil, err
+	}
+	if r.PathValue("characterID") == "" {
+		return userID, nil, nil
+	}
+
+	characterID, err := pathID(r, "characterID")
+	if err != nil {
+		return 0, nil, err
+	}
+	return userID, &characterID, nil
+}
+
+// parseInt32Params sets each field to the query parameter of its name, leaving
+// those that are not set as they are.
+func parseInt32Params(r *http.Request, fields map[string]*int32) error {
+	for name, field := range fields {
+		if v := r.URL.Query().Get(name); v != "" {
+			n, err := strconv.ParseInt(v, 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid %s: %w", name, err)
+			}
+			*field = int32(n)
+		}
+	}
+	return nil
+}
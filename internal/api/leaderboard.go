@@ -0,0 +1,54 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// getLeaderboardHandler returns a page of a guild's leaderboard. The season
+// query parameter picks a season by code, the open season by default, affix
+// rates only the runs started with that affix active, and limit and offset
+// page through the members.
+func (as appState) getLeaderboardHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	limit, offset, err := pageParams(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	board, err := as.leaderboardService.GetLeaderboard(r.Context(), id, r.URL.Query().Get("season"),
+		r.URL.Query().Get("affix"), limit, offset)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSONWithETag(w, r, board)
+}
+
+// pageParams parses the limit and offset query parameters, which are 0 when
+// they are not given.
+func pageParams(r *http.Request) (int, int, error) {
+	var page [2]int
+	for i, name := range []string{"limit", "offset"} {
+		v := r.URL.Query().Get(name)
+		if v == "" {
+			continue
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid %s: %w", name, err)
+		}
+		page[i] = n
+	}
+	return page[0], page[1], nil
+}
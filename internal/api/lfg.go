@@ -0,0 +1,137 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/tmaffia/dungeon-time-api/internal/service"
+)
+
+// matchRequest is the body of a manual matching pass. Passes with the same
+// seed over the same queue propose the same groups.
+type matchRequest struct {
+	Seed int64 `json:"seed"`
+}
+
+func (as appState) joinQueueHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var input service.LFGInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	entry, err := as.lfgService.JoinQueue(r.Context(), userID, &input)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, entry)
+}
+
+func (as appState) getQueueEntryHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	entry, err := as.lfgService.GetQueueEntry(r.Context(), userID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, entry)
+}
+
+func (as appState) leaveQueueHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if err := as.lfgService.LeaveQueue(r.Context(), userID); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (as appState) getProposalHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	proposal, err := as.lfgService.GetProposal(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, proposal)
+}
+
+func (as appState) acceptProposalHandler(w http.ResponseWriter, r *http.Request) {
+	as.respondToProposal(w, r, true)
+}
+
+func (as appState) declineProposalHandler(w http.ResponseWriter, r *http.Request) {
+	as.respondToProposal(w, r, false)
+}
+
+// respondToProposal answers a proposed group for the acting user. The response
+// carries the run once the last member accepts.
+func (as appState) respondToProposal(w http.ResponseWriter, r *http.Request, accept bool) {
+	userID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	proposal, err := as.lfgService.RespondToProposal(r.Context(), userID, id, accept)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, proposal)
+}
+
+// matchHandler runs a matching pass straight away with the given seed, to
+// replay how a queue was matched. The worker matches on its own otherwise.
+func (as appState) matchHandler(w http.ResponseWriter, r *http.Request) {
+	var req matchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	proposals, err := as.lfgService.Match(r.Context(), req.Seed)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, proposals)
+}
@@ -0,0 +1,161 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/smtp"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/tmaffia/dungeon-time-api/internal/notify"
+	"github.com/tmaffia/dungeon-time-api/internal/service"
+	"github.com/tmaffia/dungeon-time-api/internal/webhook"
+)
+
+// How often queued notifications and announcements are delivered, and how long
+// a webhook gets to respond.
+const (
+	notificationPollInterval = 15 * time.Second
+	webhookTimeout           = 10 * time.Second
+)
+
+// notificationChannels builds the delivery channels from the config. Without an
+// SMTP server, email is written to the notification log instead.
+func notificationChannels(conf *config) []notify.Channel {
+	channels := []notify.Channel{
+		notify.NewWebhookChannel(webhook.NewHTTPClient(webhookTimeout)),
+	}
+
+	if conf.smtpAddr == "" {
+		var w io.Writer = os.Stdout
+		if conf.notifyLogPath != "" {
+			f, err := os.OpenFile(conf.notifyLogPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+			if err != nil {
+				panic(err)
+			}
+			w = f
+		}
+		log.Println("No SMTP server configured, email notifications are logged")
+		return append(channels, notify.NewLogChannel(notify.ChannelEmail, w))
+	}
+
+	var auth smtp.Auth
+	if conf.smtpUsername != "" {
+		host, _, err := net.SplitHostPort(conf.smtpAddr)
+		if err != nil {
+			panic(err)
+		}
+		auth = smtp.PlainAuth("", conf.smtpUsername, conf.smtpPassword, host)
+	}
+	return append(channels, notify.NewEmailChannel(conf.smtpAddr, conf.smtpFrom, auth))
+}
+
+func (as appState) getNotificationPreferencesHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	prefs, err := as.notificationService.GetPreferences(r.Context(), actorID, id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, prefs)
+}
+
+// setNotificationPreferencesHandler replaces the user's notification preferences with the body.
+func (as appState) setNotificationPreferencesHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	var prefs service.NotificationPreferences
+	if err := json.NewDecoder(r.Body).Decode(&prefs); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	updated, err := as.notificationService.SetPreferences(r.Context(), actorID, id, &prefs)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, updated)
+}
+
+func (as appState) getInboxHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	inbox, err := as.notificationService.GetInbox(r.Context(), actorID, id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, inbox)
+}
+
+func (as appState) markNotificationReadHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	notificationID, err := strconv.ParseInt(r.PathValue("notificationID"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	if err := as.notificationService.MarkRead(r.Context(), actorID, id, notificationID); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,34 @@
+package api
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tmaffia/dungeon-time-api/internal/notify"
+)
+
+func Test_notificationChannels(t *testing.T) {
+	logPath := filepath.Join(t.TempDir(), "notifications.log")
+
+	tests := []struct {
+		name      string
+		conf      *config
+		wantEmail any
+	}{
+		{"Logs Email Without SMTP", &config{notifyLogPath: logPath}, &notify.LogChannel{}},
+		{"Sends Email With SMTP", &config{smtpAddr: "smtp.example.com:587", smtpFrom: "runs@example.com",
+			smtpUsername: "runs", smtpPassword: "secret"}, &notify.EmailChannel{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			channels := notificationChannels(tt.conf)
+			names := make([]string, 0, len(channels))
+			for _, c := range channels {
+				names = append(names, c.Name())
+			}
+			assert.Equal(t, []string{notify.ChannelWebhook, notify.ChannelEmail}, names)
+			assert.IsType(t, tt.wantEmail, channels[1])
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/tmaffia/dungeon-time-api/internal/service"
+)
+
+const (
+	// readyCheckPingInterval is how often ready check connections are pinged,
+	// and readyCheckWriteTimeout how long a ping or a message may take before
+	// the connection is given up on.
+	readyCheckPingInterval = 30 * time.Second
+	readyCheckWriteTimeout = 10 * time.Second
+	// maxReadyCheckMessage is the largest message a client may send.
+	maxReadyCheckMessage = 1024
+	// maxReadyCheckConns is how many ready check connections one user may have
+	// open to a process at once.
+	maxReadyCheckConns = 5
+)
+
+// readyCheckRequest is a message from a client: "start" a ready check, as the
+// organizer, or "respond" to the one in progress.
+type readyCheckRequest struct {
+	Type           string `json:"type"`
+	TimeoutSeconds int    `json:"timeout_seconds"`
+	Ready          *bool  `json:"ready"`
+}
+
+// readyCheckMessage is a message to a client. "state" is sent on connect with
+// the latest ready check, then every ready_check.* run event is sent as it
+// happens. Requests that fail get an "error".
+type readyCheckMessage struct {
+	Type       string `json:"type"`
+	ReadyCheck any    `json:"ready_check,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// connLimiter counts open connections per user.
+type connLimiter struct {
+	mu    sync.Mutex
+	max   int
+	conns map[int32]int
+}
+
+func newConnLimiter(max int) *connLimiter {
+	return &connLimiter{max: max, conns: make(map[int32]int)}
+}
+
+// acquire takes a connection for userID, reporting false if they have the
+// most already.
+func (l *connLimiter) acquire(userID int32) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.conns[userID] >= l.max {
+		return false
+	}
+	l.conns[userID]++
+	return true
+}
+
+// release gives back a connection of userID.
+func (l *connLimiter) release(userID int32) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.conns[userID]--
+	if l.conns[userID] <= 0 {
+		delete(l.conns, userID)
+	}
+}
+
+// getReadyCheckHandler returns the latest ready check of a run and its outcome.
+func (as appState) getReadyCheckHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	check, err := as.readyCheckService.GetReadyCheck(r.Context(), actorID, id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, check)
+}
+
+// readyCheckSocketHandler runs the ready checks of a run over a WebSocket.
+// Only players taking part in the run can connect, with the same acting user
+// header as every other request.
+func (as appState) readyCheckSocketHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	// Subscribe before loading the state so no change falls in between.
+	sub, err := as.runStreamService.Subscribe(r.Context(), id, 0)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	defer sub.Close()
+
+	check, err := as.readyCheckService.GetReadyCheck(r.Context(), actorID, id)
+	if err != nil && !errors.Is(err, service.ErrReadyCheckNotFound) {
+		writeError(w, err)
+		return
+	}
+
+	if !as.readyCheckConns.acquire(actorID) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte("too many ready check connections"))
+		return
+	}
+	defer as.readyCheckConns.release(actorID)
+
+	conn, err := websocket.Accept(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.CloseNow()
+	conn.SetReadLimit(maxReadyCheckMessage)
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	// Requests are read and handled one at a time, so a client sending faster
+	// than they are handled is held back. Their errors are sent by the loop
+	// below, which is the only writer.
+	errs := make(chan string)
+	go func() {
+		defer cancel()
+		as.readReadyCheckRequests(ctx, conn, actorID, id, errs)
+	}()
+
+	if err := writeReadyCheckMessage(ctx, conn, readyCheckMessage{Type: "state", ReadyCheck: check}); err != nil {
+		return
+	}
+
+	ping := time.NewTicker(readyCheckPingInterval)
+	defer ping.Stop()
+	for {
+		var err error
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-sub.Events:
+			if !ok {
+				// Too far behind, or shutting down. Clients reconnect and get the
+				// current state.
+				conn.Close(websocket.StatusTryAgainLater, "reconnect")
+				return
+			}
+			if !strings.HasPrefix(string(event.Type), "ready_check.") {
+				continue
+			}
+			err = writeReadyCheckMessage(ctx, conn, readyCheckMessage{
+				Type:       string(event.Type),
+				ReadyCheck: event.Payload,
+			})
+		case msg := <-errs:
+			err = writeReadyCheckMessage(ctx, conn, readyCheckMessage{Type: "error", Error: msg})
+		case <-ping.C:
+			pingCtx, cancelPing := context.WithTimeout(ctx, readyCheckWriteTimeout)
+			err = conn.Ping(pingCtx)
+			cancelPing()
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// readReadyCheckRequests handles the requests of a client until the
+// connection fails or ctx is done. Changes reach every client, this one too,
+// as run events.
+func (as appState) readReadyCheckRequests(ctx context.Context, conn *websocket.Conn, actorID, runID int32,
+	errs chan<- string) {
+	for {
+		var req readyCheckRequest
+		if err := wsjson.Read(ctx, conn, &req); err != nil {
+			// Closed, or not JSON, which wsjson closes the connection for.
+			return
+		}
+
+		var err error
+		switch req.Type {
+		case "start":
+			timeout := time.Duration(req.TimeoutSeconds) * time.Second
+			_, err = as.readyCheckService.StartReadyCheck(ctx, actorID, runID, timeout)
+		case "respond":
+			if req.Ready == nil {
+				err = errors.New("ready is required")
+				break
+			}
+			_, err = as.readyCheckService.RespondReadyCheck(ctx, actorID, runID, *req.Ready)
+		default:
+			err = fmt.Errorf("unknown message type %q", req.Type)
+		}
+		if err != nil {
+			select {
+			case errs <- err.Error():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// writeReadyCheckMessage sends msg, giving up after readyCheckWriteTimeout so
+// a client that does not read can not hold the connection open.
+func writeReadyCheckMessage(ctx context.Context, conn *websocket.Conn, msg readyCheckMessage) error {
+	ctx, cancel := context.WithTimeout(ctx, readyCheckWriteTimeout)
+	defer cancel()
+	return wsjson.Write(ctx, conn, msg)
+}
@@ -0,0 +1,123 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/stretchr/testify/assert"
+	"github.com/tmaffia/dungeon-time-api/internal/service"
+)
+
+// fakeReadyChecks starts ready checks by sending their event to a stream,
+// like the database does.
+type fakeReadyChecks struct {
+	current *service.ReadyCheck
+	events  chan *service.RunEvent
+}
+
+func (f *fakeReadyChecks) GetReadyCheck(_ context.Context, actorID, _ int32) (*service.ReadyCheck, error) {
+	if actorID != 2 {
+		return nil, service.ErrForbidden
+	}
+	if f.current == nil {
+		return nil, service.ErrReadyCheckNotFound
+	}
+	return f.current, nil
+}
+
+func (f *fakeReadyChecks) StartReadyCheck(_ context.Context, actorID, runID int32,
+	timeout time.Duration) (*service.ReadyCheck, error) {
+	check := &service.ReadyCheck{ID: 7, RunID: runID, StartedBy: &actorID, Status: service.ReadyCheckPending}
+	payload, _ := json.Marshal(check)
+	f.events <- &service.RunEvent{ID: 1, RunID: runID, Type: service.EventSignupConfirmed, Payload: []byte(`{}`)}
+	f.events <- &service.RunEvent{ID: 2, RunID: runID, Type: service.EventReadyCheckStarted, Payload: payload}
+	return check, nil
+}
+
+func (f *fakeReadyChecks) RespondReadyCheck(context.Context, int32, int32, bool) (*service.ReadyCheck, error) {
+	return nil, service.ErrReadyCheckNotPending
+}
+
+func Test_readyCheckSocketHandler(t *testing.T) {
+	events := make(chan *service.RunEvent, 4)
+	as := appState{
+		runStreamService:  &fakeRunStream{sub: &service.RunSubscription{}, events: events},
+		readyCheckService: &fakeReadyChecks{events: events},
+		readyCheckConns:   newConnLimiter(1),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/runs/{id}/ready-check/ws", as.readyCheckSocketHandler)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/api/v1/runs/1/ready-check/ws"
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	dial := func(userID string) (*websocket.Conn, *http.Response, error) {
+		return websocket.Dial(ctx, url, &websocket.DialOptions{HTTPHeader: http.Header{userIDHeader: {userID}}})
+	}
+
+	_, resp, err := dial("3")
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode, "only players taking part can connect")
+	}
+
+	conn, _, err := dial("2")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer conn.CloseNow()
+
+	_, resp, err = dial("2")
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	}
+
+	var msg map[string]any
+	assert.NoError(t, wsjson.Read(ctx, conn, &msg))
+	assert.Equal(t, map[string]any{"type": "state", "ready_check": nil}, msg)
+
+	assert.NoError(t, wsjson.Write(ctx, conn, map[string]any{"type": "start", "timeout_seconds": 20}))
+	msg = nil
+	assert.NoError(t, wsjson.Read(ctx, conn, &msg))
+	assert.Equal(t, "ready_check.started", msg["type"], "other run events are not sent")
+	if check, ok := msg["ready_check"].(map[string]any); assert.True(t, ok) {
+		assert.Equal(t, "pending", check["status"])
+	}
+
+	assert.NoError(t, wsjson.Write(ctx, conn, map[string]any{"type": "respond", "ready": true}))
+	msg = nil
+	assert.NoError(t, wsjson.Read(ctx, conn, &msg))
+	assert.Equal(t, map[string]any{"type": "error", "error": service.ErrReadyCheckNotPending.Error()}, msg)
+
+	assert.NoError(t, wsjson.Write(ctx, conn, map[string]any{"type": "dance"}))
+	msg = nil
+	assert.NoError(t, wsjson.Read(ctx, conn, &msg))
+	assert.Equal(t, `unknown message type "dance"`, msg["error"])
+
+	close(events)
+	_, _, err = conn.Read(ctx)
+	assert.Equal(t, websocket.StatusTryAgainLater, websocket.CloseStatus(err), "closed when the stream ends")
+}
+
+func Test_connLimiter(t *testing.T) {
+	l := newConnLimiter(2)
+	assert.True(t, l.acquire(1))
+	assert.True(t, l.acquire(1))
+	assert.False(t, l.acquire(1))
+	assert.True(t, l.acquire(2), "limits are per user")
+
+	l.release(1)
+	assert.True(t, l.acquire(1))
+	l.release(1)
+	l.release(1)
+	l.release(2)
+	assert.Empty(t, l.conns)
+}
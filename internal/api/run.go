@@ -0,0 +1,260 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/tmaffia/dungeon-time-api/internal/service"
+)
+
+// defaultRunWindow is how far ahead run listings look when no end time is given.
+const defaultRunWindow = 14 * 24 * time.Hour
+
+func (as appState) createRunHandler(w http.ResponseWriter, r *http.Request) {
+	organizerID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var input service.RunInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	run, err := as.runService.CreateRun(r.Context(), organizerID, &input)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	as.writeRun(w, r, http.StatusCreated, run)
+}
+
+// getRunsHandler lists runs starting between the from and to query parameters,
+// both RFC 3339 timestamps. Defaults to the next two weeks.
+func (as appState) getRunsHandler(w http.ResponseWriter, r *http.Request) {
+	from, to, err := timeRange(r, time.Now(), defaultRunWindow)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	viewer, err := as.viewerLocation(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	runs, err := as.runService.GetRuns(r.Context(), from, to)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	views := make([]*service.RunView, 0, len(runs))
+	for _, run := range runs {
+		views = append(views, service.NewRunView(run, viewer))
+	}
+	writeJSON(w, http.StatusOK, views)
+}
+
+func (as appState) getRunHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	run, err := as.runService.GetRunByID(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	as.writeRun(w, r, http.StatusOK, run)
+}
+
+func (as appState) updateRunHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	var input service.RunInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	run, err := as.runService.UpdateRun(r.Context(), actorID, id, &input)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	as.writeRun(w, r, http.StatusOK, run)
+}
+
+// runStatusRequest is the body of a request to move a run to another status.
+// Attendance can be marked as the run is completed or abandoned.
+type runStatusRequest struct {
+	Status     service.RunStatus        `json:"status"`
+	Attendance []service.AttendanceMark `json:"attendance"`
+}
+
+// transitionRunHandler moves a run through its lifecycle, from forming to in
+// progress and on to finished. Transitions that are not allowed from the run's
+// current status are a conflict with a problem code, see writeError.
+func (as appState) transitionRunHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	var req runStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	run, err := as.runService.TransitionRun(r.Context(), actorID, id, req.Status, req.Attendance)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	as.writeRun(w, r, http.StatusOK, run)
+}
+
+// cancelRunHandler cancels a run. Runs are never deleted so cancellations
+// can still be shown to the people that signed up.
+func (as appState) cancelRunHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	run, err := as.runService.CancelRun(r.Context(), actorID, id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	as.writeRun(w, r, http.StatusOK, run)
+}
+
+// writeRun writes a run rendered in the requesting user's timezone.
+func (as appState) writeRun(w http.ResponseWriter, r *http.Request, status int, run *service.Run) {
+	viewer, err := as.viewerLocation(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, status, service.NewRunView(run, viewer))
+}
+
+// timeRange parses the from and to query parameters as RFC 3339 timestamps.
+// from defaults to now and to defaults to window after from.
+func timeRange(r *http.Request, now time.Time, window time.Duration) (time.Time, time.Time, error) {
+	from := now
+	if v := r.URL.Query().Get("from"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		from = t
+	}
+
+	to := from.Add(window)
+	if v := r.URL.Query().Get("to"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		to = t
+	}
+	return from, to, nil
+}
+
+// getAttendanceHandler lists who showed up for a run and who withdrew from it.
+func (as appState) getAttendanceHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	records, err := as.runService.GetAttendance(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, records)
+}
+
+// markAttendanceHandler lets the leader correct the attendance of a finished
+// run. The body lists the marks to change.
+func (as appState) markAttendanceHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	var marks []service.AttendanceMark
+	if err := json.NewDecoder(r.Body).Decode(&marks); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	records, err := as.runService.MarkAttendance(r.Context(), actorID, id, marks)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, records)
+}
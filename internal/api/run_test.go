@@ -0,0 +1,67 @@
+package api
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_timeRange(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		target   string
+		wantFrom time.Time
+		wantTo   time.Time
+		wantErr  bool
+	}{
+		{"Defaults", "/api/v1/runs", now, now.Add(defaultRunWindow), false},
+		{"From Only", "/api/v1/runs?from=2025-04-01T00:00:00Z",
+			time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC).Add(defaultRunWindow), false},
+		{"From And To", "/api/v1/runs?from=2025-04-01T00:00:00Z&to=2025-04-02T00:00:00Z",
+			time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2025, 4, 2, 0, 0, 0, 0, time.UTC), false},
+		{"Invalid To", "/api/v1/runs?to=tomorrow", time.Time{}, time.Time{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to, err := timeRange(httptest.NewRequest("GET", tt.target, nil), now, defaultRunWindow)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, tt.wantFrom.Equal(from))
+			assert.True(t, tt.wantTo.Equal(to))
+		})
+	}
+}
+
+func Test_actingUserID(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  string
+		want    int32
+		wantErr error
+	}{
+		{"Valid Header", "42", 42, nil},
+		{"Missing Header", "", 0, errNoActingUser},
+		{"Invalid Header", "bob", 0, errNoActingUser},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/api/v1/runs", nil)
+			if tt.header != "" {
+				r.Header.Set(userIDHeader, tt.header)
+			}
+
+			got, err := actingUserID(r)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/tmaffia/dungeon-time-api/internal/service"
+)
+
+func (as appState) getSeasonsHandler(w http.ResponseWriter, r *http.Request) {
+	seasons, err := as.seasonService.GetSeasons(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, seasons)
+}
+
+// createSeasonHandler opens a season. Only one season can be open at a time.
+func (as appState) createSeasonHandler(w http.ResponseWriter, r *http.Request) {
+	var input service.SeasonInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	season, err := as.seasonService.CreateSeason(r.Context(), &input)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, season)
+}
+
+// closeSeasonHandler ends a season and freezes its guild leaderboards.
+func (as appState) closeSeasonHandler(w http.ResponseWriter, r *http.Request) {
+	season, err := as.seasonService.CloseSeason(r.Context(), r.PathValue("code"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, season)
+}
+
+// setSeasonAffixesHandler replaces the affix rotation of a season, overriding
+// the one from the embedded seed. The body lists the affix codes of each week.
+func (as appState) setSeasonAffixesHandler(w http.ResponseWriter, r *http.Request) {
+	var rotation [][]string
+	if err := json.NewDecoder(r.Body).Decode(&rotation); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	if err := as.seasonService.SetAffixRotation(r.Context(), r.PathValue("code"), rotation); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// getSeasonCalendarHandler returns the weeks of a season and their affixes.
+// The code "current" picks the open season, and the region query parameter
+// lays the weeks out by the reset of a region instead of the configured one.
+func (as appState) getSeasonCalendarHandler(w http.ResponseWriter, r *http.Request) {
+	code := r.PathValue("code")
+	if code == "current" {
+		code = ""
+	}
+
+	calendar, err := as.seasonService.GetCalendar(r.Context(), code, service.Region(r.URL.Query().Get("region")))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, calendar)
+}
@@ -0,0 +1,185 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/tmaffia/dungeon-time-api/internal/service"
+)
+
+// defaultOccurrenceWindow is how far ahead occurrence listings look when no end time is given.
+const defaultOccurrenceWindow = 28 * 24 * time.Hour
+
+// Scopes for changing an occurrence of a series.
+const (
+	scopeThis      = "this"
+	scopeFollowing = "following"
+)
+
+func (as appState) createSeriesHandler(w http.ResponseWriter, r *http.Request) {
+	organizerID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var input service.SeriesInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	series, err := as.seriesService.CreateSeries(r.Context(), organizerID, &input)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, series)
+}
+
+func (as appState) getSeriesHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	series, err := as.seriesService.GetSeriesByID(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, series)
+}
+
+// getOccurrencesHandler lists the runs of a series starting between the from
+// and to query parameters, both RFC 3339 timestamps. Defaults to the next four weeks.
+func (as appState) getOccurrencesHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	from, to, err := timeRange(r, time.Now(), defaultOccurrenceWindow)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	viewer, err := as.viewerLocation(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	runs, err := as.seriesService.GetOccurrences(r.Context(), id, from, to)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	views := make([]*service.RunView, 0, len(runs))
+	for _, run := range runs {
+		views = append(views, service.NewRunView(run, viewer))
+	}
+	writeJSON(w, http.StatusOK, views)
+}
+
+// skipOccurrenceHandler cancels a single occurrence of a series.
+func (as appState) skipOccurrenceHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	id, occurrence, err := occurrencePath(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	if err := as.seriesService.SkipOccurrence(r.Context(), actorID, id, occurrence); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// updateOccurrenceHandler changes an occurrence of a series. With scope=this,
+// the default, only that occurrence changes and the body is a run. With
+// scope=following the series is split and the body is the new series.
+func (as appState) updateOccurrenceHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	id, occurrence, err := occurrencePath(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	switch scope := r.URL.Query().Get("scope"); scope {
+	case "", scopeThis:
+		var input service.RunInput
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		run, err := as.seriesService.OverrideOccurrence(r.Context(), actorID, id, occurrence, &input)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		as.writeRun(w, r, http.StatusOK, run)
+	case scopeFollowing:
+		var input service.SeriesInput
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		series, err := as.seriesService.SplitSeries(r.Context(), actorID, id, occurrence, &input)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusCreated, series)
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf("scope must be %q or %q", scopeThis, scopeFollowing)))
+	}
+}
+
+// occurrencePath parses the series id and the occurrence start time, an
+// RFC 3339 timestamp, from the request path.
+func occurrencePath(r *http.Request) (int32, time.Time, error) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	occurrence, err := time.Parse(time.RFC3339, r.PathValue("occurrence"))
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	return id, occurrence.UTC(), nil
+}
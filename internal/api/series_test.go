@@ -0,0 +1,41 @@
+package api
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_occurrencePath(t *testing.T) {
+	tests := []struct {
+		name           string
+		id             string
+		occurrence     string
+		wantID         int32
+		wantOccurrence time.Time
+		wantErr        bool
+	}{
+		{"UTC", "3", "2025-03-12T00:00:00Z", 3, time.Date(2025, 3, 12, 0, 0, 0, 0, time.UTC), false},
+		{"Offset", "3", "2025-03-11T20:00:00-04:00", 3, time.Date(2025, 3, 12, 0, 0, 0, 0, time.UTC), false},
+		{"Wall Clock", "3", "2025-03-11T20:00", 0, time.Time{}, true},
+		{"Invalid ID", "abc", "2025-03-12T00:00:00Z", 0, time.Time{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("DELETE", "/api/v1/series/x/occurrences/y", nil)
+			r.SetPathValue("id", tt.id)
+			r.SetPathValue("occurrence", tt.occurrence)
+
+			id, occurrence, err := occurrencePath(r)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantID, id)
+			assert.Equal(t, tt.wantOccurrence, occurrence)
+		})
+	}
+}
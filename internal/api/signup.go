@@ -0,0 +1,121 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/tmaffia/dungeon-time-api/internal/service"
+)
+
+// signupRequest is the body of a signup, the role slot the user wants to claim
+// and optionally the character they will play it on.
+type signupRequest struct {
+	Role        service.UserRole `json:"role"`
+	CharacterID *int32           `json:"character_id"`
+}
+
+func (as appState) getRosterHandler(w http.ResponseWriter, r *http.Request) {
+	runID, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	roster, err := as.signupService.GetRoster(r.Context(), runID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, roster)
+}
+
+func (as appState) signUpHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	runID, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	var req signupRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	roster, err := as.signupService.SignUp(r.Context(), runID, userID, req.Role, req.CharacterID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, roster)
+}
+
+func (as appState) withdrawHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	runID, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	roster, err := as.signupService.Withdraw(r.Context(), runID, userID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, roster)
+}
+
+// reorderWaitlistRequest is the new order of a role's waitlist, first to be promoted first.
+type reorderWaitlistRequest struct {
+	Role    service.UserRole `json:"role"`
+	UserIDs []int32          `json:"user_ids"`
+}
+
+func (as appState) reorderWaitlistHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	runID, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	var req reorderWaitlistRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	roster, err := as.signupService.ReorderWaitlist(r.Context(), actorID, runID, req.Role, req.UserIDs)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, roster)
+}
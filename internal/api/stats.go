@@ -0,0 +1,25 @@
+package api
+
+import (
+	"net/http"
+)
+
+// getUserStatsHandler returns the run history and statistics of a user, of the
+// runs started with the affix query parameter active if it is given.
+// Responses carry an ETag so clients polling for changes can revalidate cheaply.
+func (as appState) getUserStatsHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	stats, err := as.statsService.GetUserStats(r.Context(), id, r.URL.Query().Get("affix"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSONWithETag(w, r, stats)
+}
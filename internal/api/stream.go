@@ -0,0 +1,103 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/tmaffia/dungeon-time-api/internal/service"
+)
+
+// sseHeartbeatInterval is how often a comment is sent on an idle event stream
+// so that proxies and clients do not give up on it.
+const sseHeartbeatInterval = 15 * time.Second
+
+// runEventsHandler streams the events of a run as Server-Sent Events. Clients
+// that reconnect with a Last-Event-ID header get the events they missed
+// first, or a reset event when they missed too many and have to reload the run.
+func (as appState) runEventsHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	var lastEventID int64
+	if value := r.Header.Get("Last-Event-ID"); value != "" {
+		lastEventID, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+	}
+
+	sub, err := as.runStreamService.Subscribe(r.Context(), id, lastEventID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	defer sub.Close()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	rc := http.NewResponseController(w)
+
+	if sub.Reset {
+		fmt.Fprint(w, "event: reset\ndata: {}\n\n")
+	}
+	lastID := lastEventID
+	for _, event := range sub.Replay {
+		if err := writeRunEvent(w, event); err != nil {
+			return
+		}
+		lastID = event.ID
+	}
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(sseHeartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case event, ok := <-sub.Events:
+			if !ok {
+				// Dropped or shutting down, the client resumes from lastID.
+				return
+			}
+			if event.ID <= lastID {
+				continue
+			}
+			if err := writeRunEvent(w, event); err != nil {
+				return
+			}
+			lastID = event.ID
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// writeRunEvent writes event as a Server-Sent Event named after its type.
+func writeRunEvent(w io.Writer, event *service.RunEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+	return err
+}
@@ -0,0 +1,99 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tmaffia/dungeon-time-api/internal/service"
+)
+
+// fakeRunStream hands out a subscription with the given events already sent.
+// With events set, that channel is used instead and left open.
+type fakeRunStream struct {
+	sub         *service.RunSubscription
+	live        []*service.RunEvent
+	events      chan *service.RunEvent
+	lastEventID int64
+}
+
+func (f *fakeRunStream) Subscribe(_ context.Context, _ int32, lastEventID int64) (*service.RunSubscription, error) {
+	if f.events != nil {
+		sub := *f.sub
+		sub.Events = f.events
+		return &sub, nil
+	}
+	f.lastEventID = lastEventID
+	events := make(chan *service.RunEvent, len(f.live))
+	for _, event := range f.live {
+		events <- event
+	}
+	close(events)
+	f.sub.Events = events
+	return f.sub, nil
+}
+
+func Test_runEventsHandler(t *testing.T) {
+	event := func(id int64, eventType service.RunEventType) *service.RunEvent {
+		return &service.RunEvent{ID: id, RunID: 1, Type: eventType, Payload: json.RawMessage(`{}`)}
+	}
+
+	tests := []struct {
+		name        string
+		lastEventID string
+		sub         service.RunSubscription
+		live        []*service.RunEvent
+		wantStatus  int
+		wantReset   bool
+		wantEvents  []string
+	}{
+		{
+			"Live", "", service.RunSubscription{},
+			[]*service.RunEvent{event(4, service.EventSignupConfirmed)},
+			http.StatusOK, false,
+			[]string{"id: 4\nevent: signup.confirmed\ndata: {\"id\":4,\"run_id\":1,\"type\":\"signup.confirmed\"," +
+				"\"payload\":{},\"created_at\":\"0001-01-01T00:00:00Z\"}\n\n"},
+		},
+		{
+			"Resume Skips Replayed", "3",
+			service.RunSubscription{Replay: []*service.RunEvent{event(4, service.EventSignupWithdrawn)}},
+			[]*service.RunEvent{event(4, service.EventSignupWithdrawn), event(5, service.EventSignupPromoted)},
+			http.StatusOK, false,
+			[]string{"id: 4\nevent: signup.withdrawn\n", "id: 5\nevent: signup.promoted\n"},
+		},
+		{"Reset", "3", service.RunSubscription{Reset: true}, nil, http.StatusOK, true, nil},
+		{"Bad Last Event ID", "abc", service.RunSubscription{}, nil, http.StatusBadRequest, false, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sub := tt.sub
+			stream := &fakeRunStream{sub: &sub, live: tt.live}
+			as := appState{runStreamService: stream}
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /api/v1/runs/{id}/events", as.runEventsHandler)
+
+			r := httptest.NewRequest("GET", "/api/v1/runs/1/events", nil)
+			if tt.lastEventID != "" {
+				r.Header.Set("Last-Event-ID", tt.lastEventID)
+			}
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, r)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+			body := w.Body.String()
+			assert.Equal(t, tt.wantReset, strings.HasPrefix(body, "event: reset\ndata: {}\n\n"))
+			assert.Equal(t, len(tt.wantEvents), strings.Count(body, "id: "), "replayed events are not sent twice")
+			for _, want := range tt.wantEvents {
+				assert.Contains(t, body, want)
+			}
+		})
+	}
+}
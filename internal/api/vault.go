@@ -0,0 +1,120 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/tmaffia/dungeon-time-api/internal/service"
+)
+
+// vaultQuery reads a service.VaultQuery from the min_level and slots query
+// parameters. With push set, or always for a vault push, the tanks, healers,
+// dps and duration_minutes query parameters plan it as they do keys.
+func vaultQuery(r *http.Request, push bool) (*service.VaultQuery, error) {
+	query := &service.VaultQuery{}
+	fields := map[string]*int32{
+		"min_level": &query.MinLevel,
+		"slots":     &query.Slots,
+	}
+
+	if v := r.URL.Query().Get("push"); v != "" && !push {
+		var err error
+		if push, err = strconv.ParseBool(v); err != nil {
+			return nil, err
+		}
+	}
+	if push {
+		query.Push = &service.KeyPlanQuery{Composition: service.DefaultComposition,
+			DurationMinutes: defaultKeyDuration}
+		fields["tanks"] = &query.Push.Composition.Tanks
+		fields["healers"] = &query.Push.Composition.Healers
+		fields["dps"] = &query.Push.Composition.DPS
+		fields["duration_minutes"] = &query.Push.DurationMinutes
+	}
+
+	if err := parseInt32Params(r, fields); err != nil {
+		return nil, err
+	}
+	return query, nil
+}
+
+func (as appState) getVaultProgressHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	query, err := vaultQuery(r, false)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	progress, err := as.vaultService.GetVaultProgress(r.Context(), userID, query)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, progress)
+}
+
+// getGuildVaultHandler lists the members of the guild in the path that are
+// short of their vault, with a suggested vault push if push=true.
+func (as appState) getGuildVaultHandler(w http.ResponseWriter, r *http.Request) {
+	guildID, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	query, err := vaultQuery(r, false)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	vault, err := as.vaultService.GetGuildVault(r.Context(), guildID, query)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, vault)
+}
+
+// createVaultPushHandler schedules the vault push of the guild in the path.
+func (as appState) createVaultPushHandler(w http.ResponseWriter, r *http.Request) {
+	actorID, err := actingUserID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	guildID, err := pathID(r, "id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	query, err := vaultQuery(r, true)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	run, err := as.vaultService.CreateVaultPush(r.Context(), actorID, guildID, query)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, run)
+}
@@ -0,0 +1,186 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/tmaffia/dungeon-time-api/internal/service"
+)
+
+// The same handlers serve the webhooks of a guild, under /guilds/{id}/webhooks,
+// and the server's, under /admin/webhooks behind requireAdmin.
+
+// webhookOwner returns the owner of the webhooks a request is about: the
+// guild in the path with the acting user, or the server on admin routes,
+// which have no guild id.
+func webhookOwner(r *http.Request) (service.WebhookOwner, error) {
+	if r.PathValue("id") == "" {
+		return service.WebhookOwner{}, nil
+	}
+
+	actorID, err := actingUserID(r)
+	if err != nil {
+		return service.WebhookOwner{}, err
+	}
+	guildID, err := pathID(r, "id")
+	if err != nil {
+		return service.WebhookOwner{}, err
+	}
+	return service.WebhookOwner{GuildID: &guildID, ActorID: actorID}, nil
+}
+
+func (as appState) createWebhookHandler(w http.ResponseWriter, r *http.Request) {
+	owner, err := webhookOwner(r)
+	if err != nil {
+		writeWebhookOwnerError(w, err)
+		return
+	}
+
+	var input service.WebhookInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	endpoint, err := as.webhookService.CreateWebhook(r.Context(), owner, &input)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, endpoint)
+}
+
+func (as appState) getWebhooksHandler(w http.ResponseWriter, r *http.Request) {
+	owner, err := webhookOwner(r)
+	if err != nil {
+		writeWebhookOwnerError(w, err)
+		return
+	}
+
+	endpoints, err := as.webhookService.GetWebhooks(r.Context(), owner)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, endpoints)
+}
+
+func (as appState) updateWebhookHandler(w http.ResponseWriter, r *http.Request) {
+	owner, err := webhookOwner(r)
+	if err != nil {
+		writeWebhookOwnerError(w, err)
+		return
+	}
+
+	webhookID, err := pathID(r, "webhookID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	var input service.WebhookInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	endpoint, err := as.webhookService.UpdateWebhook(r.Context(), owner, webhookID, &input)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, endpoint)
+}
+
+func (as appState) deleteWebhookHandler(w http.ResponseWriter, r *http.Request) {
+	owner, err := webhookOwner(r)
+	if err != nil {
+		writeWebhookOwnerError(w, err)
+		return
+	}
+
+	webhookID, err := pathID(r, "webhookID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	if err := as.webhookService.DeleteWebhook(r.Context(), owner, webhookID); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (as appState) getWebhookDeliveriesHandler(w http.ResponseWriter, r *http.Request) {
+	owner, err := webhookOwner(r)
+	if err != nil {
+		writeWebhookOwnerError(w, err)
+		return
+	}
+
+	webhookID, err := pathID(r, "webhookID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	deliveries, err := as.webhookService.GetDeliveries(r.Context(), owner, webhookID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, deliveries)
+}
+
+func (as appState) redeliverWebhookHandler(w http.ResponseWriter, r *http.Request) {
+	owner, err := webhookOwner(r)
+	if err != nil {
+		writeWebhookOwnerError(w, err)
+		return
+	}
+
+	webhookID, err := pathID(r, "webhookID")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	deliveryID, err := strconv.ParseInt(r.PathValue("deliveryID"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	delivery, err := as.webhookService.Redeliver(r.Context(), owner, webhookID, deliveryID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, delivery)
+}
+
+// writeWebhookOwnerError writes the error from webhookOwner, a missing acting
+// user or a bad guild id.
+func writeWebhookOwnerError(w http.ResponseWriter, err error) {
+	if errorStatus(err) == http.StatusInternalServerError {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+	writeError(w, err)
+}
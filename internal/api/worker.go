@@ -0,0 +1,184 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tmaffia/dungeon-time-api/internal/discord"
+	"github.com/tmaffia/dungeon-time-api/internal/jobs"
+	"github.com/tmaffia/dungeon-time-api/internal/service"
+	"github.com/tmaffia/dungeon-time-api/internal/webhook"
+)
+
+// reminderPollInterval is how often runs are checked for due reminders.
+const reminderPollInterval = time.Minute
+
+// lfgMatchInterval is how often the looking for group queue is matched.
+const lfgMatchInterval = 30 * time.Second
+
+// jobPollInterval is how often delayed jobs are checked for. Jobs that are
+// ready wake a worker straight away.
+const jobPollInterval = 5 * time.Second
+
+// jobQueues are the job queues each process works, with how many jobs from
+// each run at once.
+var jobQueues = map[string]int{jobs.DefaultQueue: 4, service.WebhookQueue: 4}
+
+// StartWorker runs the background workers without the HTTP API until the
+// process is interrupted or terminated. Any number of workers can run beside
+// the API, each job is only picked up by one of them.
+func StartWorker() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	conf := newConfig()
+	dbpool, err := pgxpool.New(ctx, conf.databaseUrl)
+	if err != nil {
+		panic(err)
+	}
+	defer dbpool.Close()
+
+	log.Println("Starting Dungeon Time worker")
+	runWorkers(ctx, dbpool, conf).Wait()
+	log.Println("Worker stopped")
+}
+
+// runWorkers starts the notification, announcement and reminder workers, the
+// group matcher, the job worker and, if it is turned on, the stats summary
+// refresher. They stop when ctx is done and the returned WaitGroup is done once
+// all of them have. Jobs that are running then have their context cancelled
+// and are released for the next worker to pick up.
+func runWorkers(ctx context.Context, dbpool *pgxpool.Pool, conf *config) *sync.WaitGroup {
+	offsets, err := parseReminderOffsets(conf.reminderOffsets)
+	if err != nil {
+		panic(err)
+	}
+	statsRefresh, err := parseStatsRefreshInterval(conf.statsRefresh)
+	if err != nil {
+		panic(err)
+	}
+
+	notificationService := service.NewNotificationService(dbpool, notificationChannels(conf)...)
+	announcementService := service.NewAnnouncementService(dbpool, discord.NewClient(&http.Client{Timeout: webhookTimeout}))
+	reminderService := service.NewReminderService(dbpool, offsets)
+	webhookService := service.NewWebhookService(dbpool, webhook.NewClient(webhook.NewHTTPClient(webhookTimeout)))
+	statsService := service.NewStatsService(dbpool, statsRefresh > 0)
+	lfgService := service.NewLFGService(dbpool)
+	jobWorker := jobs.NewWorker(dbpool, jobQueues)
+	jobs.Register(jobWorker, webhookService.DeliverWebhook)
+	jobs.Register(jobWorker, service.NewReadyCheckService(dbpool).ExpireReadyCheck)
+	jobs.Register(jobWorker, statsService.RefreshStats)
+
+	workers := []func(){
+		func() { notificationService.Work(ctx, notificationPollInterval) },
+		func() { announcementService.Work(ctx, notificationPollInterval) },
+		func() { reminderService.Work(ctx, reminderPollInterval) },
+		func() { lfgService.Work(ctx, lfgMatchInterval) },
+		func() { jobWorker.Work(ctx, jobPollInterval) },
+	}
+	if statsRefresh > 0 {
+		workers = append(workers, func() { statsService.Work(ctx, statsRefresh) })
+	}
+
+	var wg sync.WaitGroup
+	for _, work := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			work()
+		}()
+	}
+	return &wg
+}
+
+// minStatsRefreshInterval is the shortest interval the stats summary can be
+// refreshed at, refreshing it reads every finished run.
+const minStatsRefreshInterval = time.Minute
+
+// parseStatsRefreshInterval parses how often the stats summary is refreshed,
+// like "15m". Empty turns the summary off, stats are then aggregated from the
+// runs on every request.
+func parseStatsRefreshInterval(s string) (time.Duration, error) {
+	if strings.TrimSpace(s) == "" {
+		return 0, nil
+	}
+
+	interval, err := time.ParseDuration(strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("invalid stats refresh interval: %w", err)
+	}
+	if interval < minStatsRefreshInterval {
+		return 0, fmt.Errorf("invalid stats refresh interval %q: must be at least %s", s, minStatsRefreshInterval)
+	}
+	return interval, nil
+}
+
+// parseWeeklyReset parses when the week resets, as a region, like "eu", or a
+// weekday, an hour and a timezone, like "Tuesday 15:00 UTC". Empty gives the
+// default reset.
+func parseWeeklyReset(s string) (service.WeeklyReset, error) {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return service.DefaultWeeklyReset, nil
+	}
+	if len(fields) == 1 {
+		reset, ok := service.RegionReset(service.Region(fields[0]))
+		if !ok {
+			return service.WeeklyReset{}, fmt.Errorf("invalid weekly reset %q: unknown region", s)
+		}
+		return reset, nil
+	}
+	if len(fields) != 3 {
+		return service.WeeklyReset{}, fmt.Errorf("invalid weekly reset %q: want a weekday, an hour and a timezone", s)
+	}
+
+	reset := service.WeeklyReset{Weekday: -1}
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(fields[0], day.String()) {
+			reset.Weekday = day
+		}
+	}
+	if reset.Weekday < 0 {
+		return service.WeeklyReset{}, fmt.Errorf("invalid weekly reset %q: unknown weekday", s)
+	}
+	hour, err := time.Parse("15:04", fields[1])
+	if err != nil || hour.Minute() != 0 {
+		return service.WeeklyReset{}, fmt.Errorf("invalid weekly reset %q: the reset must be on the hour", s)
+	}
+	reset.Hour = hour.Hour()
+	if reset.Location, err = time.LoadLocation(fields[2]); err != nil {
+		return service.WeeklyReset{}, fmt.Errorf("invalid weekly reset: %w", err)
+	}
+	return reset, nil
+}
+
+// parseReminderOffsets parses a comma separated list of durations before a run
+// starts to remind players at, like "24h,15m". Offsets must be whole minutes.
+// An empty list gives the default offsets.
+func parseReminderOffsets(s string) ([]time.Duration, error) {
+	if strings.TrimSpace(s) == "" {
+		return service.DefaultReminderOffsets, nil
+	}
+
+	var offsets []time.Duration
+	for _, part := range strings.Split(s, ",") {
+		offset, err := time.ParseDuration(strings.TrimSpace(part))
+		if err != nil {
+			return nil, fmt.Errorf("invalid reminder offset: %w", err)
+		}
+		if offset < time.Minute || offset%time.Minute != 0 {
+			return nil, fmt.Errorf("invalid reminder offset %q: must be a whole number of minutes", part)
+		}
+		offsets = append(offsets, offset)
+	}
+	return offsets, nil
+}
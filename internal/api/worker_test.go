@@ -0,0 +1,94 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tmaffia/dungeon-time-api/internal/service"
+)
+
+func Test_parseStatsRefreshInterval(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    time.Duration
+		wantErr bool
+	}{
+		{"Off", "", 0, false},
+		{"Interval", " 15m", 15 * time.Minute, false},
+		{"Not A Duration", "hourly", 0, true},
+		{"Too Often", "10s", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseStatsRefreshInterval(tt.s)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_parseReminderOffsets(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    []time.Duration
+		wantErr bool
+	}{
+		{"Default", "", service.DefaultReminderOffsets, false},
+		{"Custom", "24h, 1h30m,15m", []time.Duration{24 * time.Hour, 90 * time.Minute, 15 * time.Minute}, false},
+		{"Not A Duration", "tomorrow", nil, true},
+		{"Under A Minute", "30s", nil, true},
+		{"Part Minutes", "90s", nil, true},
+		{"Negative", "-15m", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseReminderOffsets(tt.s)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_parseWeeklyReset(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		s       string
+		want    service.WeeklyReset
+		wantErr bool
+	}{
+		{"Default", "", service.DefaultWeeklyReset, false},
+		{"Region", "EU", service.WeeklyReset{Weekday: time.Wednesday, Hour: 5, Location: paris}, false},
+		{"Custom", "wednesday 04:00 Europe/Paris", service.WeeklyReset{Weekday: time.Wednesday, Hour: 4, Location: paris},
+			false},
+		{"Unknown Region", "oceania", service.WeeklyReset{}, true},
+		{"Missing Timezone", "Tuesday 15:00", service.WeeklyReset{}, true},
+		{"Unknown Weekday", "Tue 15:00 UTC", service.WeeklyReset{}, true},
+		{"Not On The Hour", "Tuesday 15:30 UTC", service.WeeklyReset{}, true},
+		{"Unknown Timezone", "Tuesday 15:00 Azeroth/Orgrimmar", service.WeeklyReset{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseWeeklyReset(tt.s)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
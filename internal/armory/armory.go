@@ -0,0 +1,245 @@
+// Package armory reads character profiles from armory exports, such as a dump
+// of the Blizzard character profile API or a CSV exported by an in-game addon.
+//
+// Importers read profiles through a Source, so a client of a live armory API
+// can stand in for a file, and a Static list of profiles for both in tests.
+package armory
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Export formats.
+const (
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+)
+
+// ErrInvalidExport is returned for exports that can not be read.
+var ErrInvalidExport = errors.New("invalid armory export")
+
+// Profile is a character as the armory knows it. Owner is the username of the
+// player the character belongs to, exports of a whole guild name it so new
+// characters can be given to the right member.
+type Profile struct {
+	Name      string    `json:"name"`
+	Realm     string    `json:"realm"`
+	Class     string    `json:"class"`
+	Spec      string    `json:"spec"`
+	ItemLevel int32     `json:"item_level"`
+	Keystone  *Keystone `json:"keystone,omitempty"`
+	Owner     string    `json:"owner,omitempty"`
+}
+
+// Keystone is the Mythic+ key a character holds. Dungeon is the name or code
+// of the dungeon.
+type Keystone struct {
+	Dungeon string `json:"dungeon"`
+	Level   int32  `json:"level"`
+}
+
+// Source provides the profiles to import.
+type Source interface {
+	Profiles(ctx context.Context) ([]Profile, error)
+}
+
+// Static is a Source of a fixed list of profiles.
+type Static []Profile
+
+// Profiles returns the profiles in s.
+func (s Static) Profiles(ctx context.Context) ([]Profile, error) {
+	return s, nil
+}
+
+// fileSource is a Source reading an export file.
+type fileSource struct {
+	path string
+}
+
+// NewFileSource returns a Source reading the export at path every time profiles
+// are requested. The format is told by the extension, files that do not end in
+// .csv are read as JSON.
+func NewFileSource(path string) Source {
+	return &fileSource{path: path}
+}
+
+// Profiles reads and parses the export file.
+func (s *fileSource) Profiles(ctx context.Context) ([]Profile, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(bytes.NewReader(data), FormatOf(s.path))
+}
+
+// FormatOf returns the export format of the file at path.
+func FormatOf(path string) string {
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		return FormatCSV
+	}
+	return FormatJSON
+}
+
+// Parse reads an export in format. Returns ErrInvalidExport for unknown
+// formats and exports that can not be read.
+func Parse(r io.Reader, format string) (Static, error) {
+	switch format {
+	case FormatJSON:
+		return parseJSON(r)
+	case FormatCSV:
+		return parseCSV(r)
+	default:
+		return nil, fmt.Errorf("%w: unknown format %q", ErrInvalidExport, format)
+	}
+}
+
+// armoryProfile is a character in the shape of the Blizzard character profile
+// API, along with the keystone and owner exports add.
+type armoryProfile struct {
+	Name  string `json:"name"`
+	Realm struct {
+		Name string `json:"name"`
+	} `json:"realm"`
+	CharacterClass struct {
+		Name string `json:"name"`
+	} `json:"character_class"`
+	ActiveSpec struct {
+		Name string `json:"name"`
+	} `json:"active_spec"`
+	EquippedItemLevel int32 `json:"equipped_item_level"`
+	CurrentKeystone   *struct {
+		Dungeon struct {
+			Name string `json:"name"`
+		} `json:"dungeon"`
+		KeystoneLevel int32 `json:"keystone_level"`
+	} `json:"current_keystone"`
+	Owner string `json:"owner"`
+}
+
+// parseJSON reads a list of profiles, either on its own or as the characters
+// of an object like a guild roster.
+func parseJSON(r io.Reader) (Static, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles []armoryProfile
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		err = json.Unmarshal(data, &profiles)
+	} else {
+		var roster struct {
+			Characters []armoryProfile `json:"characters"`
+		}
+		err = json.Unmarshal(data, &roster)
+		profiles = roster.Characters
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidExport, err)
+	}
+
+	static := make(Static, 0, len(profiles))
+	for _, p := range profiles {
+		profile := Profile{
+			Name:      strings.TrimSpace(p.Name),
+			Realm:     strings.TrimSpace(p.Realm.Name),
+			Class:     strings.TrimSpace(p.CharacterClass.Name),
+			Spec:      strings.TrimSpace(p.ActiveSpec.Name),
+			ItemLevel: p.EquippedItemLevel,
+			Owner:     strings.TrimSpace(p.Owner),
+		}
+		if p.CurrentKeystone != nil {
+			profile.Keystone = &Keystone{
+				Dungeon: strings.TrimSpace(p.CurrentKeystone.Dungeon.Name),
+				Level:   p.CurrentKeystone.KeystoneLevel,
+			}
+		}
+		static = append(static, profile)
+	}
+	return static, nil
+}
+
+// csvColumns are the columns of a CSV export, in any order. Only name and
+// realm are required, a key needs both key_dungeon and key_level.
+var csvColumns = []string{"name", "realm", "class", "spec", "item_level", "key_dungeon", "key_level", "owner"}
+
+// parseCSV reads an export with a header row naming its columns.
+func parseCSV(r io.Reader) (Static, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidExport, err)
+	}
+	if len(rows) == 0 {
+		return Static{}, nil
+	}
+
+	columns := make(map[string]int)
+	for i, name := range rows[0] {
+		name = strings.ToLower(strings.TrimSpace(name))
+		for _, column := range csvColumns {
+			if name == column {
+				columns[column] = i
+			}
+		}
+	}
+	for _, required := range []string{"name", "realm"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("%w: missing %s column", ErrInvalidExport, required)
+		}
+	}
+
+	static := make(Static, 0, len(rows)-1)
+	for i, row := range rows[1:] {
+		field := func(column string) string {
+			if j, ok := columns[column]; ok {
+				return strings.TrimSpace(row[j])
+			}
+			return ""
+		}
+		number := func(column string) (int32, error) {
+			value := field(column)
+			if value == "" {
+				return 0, nil
+			}
+			n, err := strconv.ParseInt(value, 10, 32)
+			if err != nil {
+				return 0, fmt.Errorf("%w: row %d: invalid %s %q", ErrInvalidExport, i+2, column, value)
+			}
+			return int32(n), nil
+		}
+
+		itemLevel, err := number("item_level")
+		if err != nil {
+			return nil, err
+		}
+		keyLevel, err := number("key_level")
+		if err != nil {
+			return nil, err
+		}
+		profile := Profile{
+			Name:      field("name"),
+			Realm:     field("realm"),
+			Class:     field("class"),
+			Spec:      field("spec"),
+			ItemLevel: itemLevel,
+			Owner:     field("owner"),
+		}
+		if dungeon := field("key_dungeon"); dungeon != "" && keyLevel > 0 {
+			profile.Keystone = &Keystone{Dungeon: dungeon, Level: keyLevel}
+		}
+		static = append(static, profile)
+	}
+	return static, nil
+}
@@ -0,0 +1,72 @@
+package armory
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileSource_Profiles(t *testing.T) {
+	thrall := Profile{Name: "Thrall", Realm: "Area 52", Class: "Shaman", Spec: "Restoration", ItemLevel: 623}
+	jaina := Profile{Name: "Jaina", Realm: "Kel'Thuzad", Class: "Mage", Spec: "Frost", ItemLevel: 618}
+
+	tests := []struct {
+		name string
+		path string
+		want []Profile
+	}{
+		{"Armory JSON", "testdata/guild.json", []Profile{
+			withKeystone(withOwner(thrall, "warchief"), "Ara-Kara, City of Echoes", 12), jaina}},
+		{"Addon CSV", "testdata/addon.csv", []Profile{withKeystone(thrall, "ARAK", 12), jaina}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewFileSource(tt.path).Profiles(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		input   string
+		wantLen int
+		wantErr error
+	}{
+		{"JSON List", FormatJSON, `[{"name": "Thrall", "realm": {"name": "Area 52"}}]`, 1, nil},
+		{"JSON Empty Roster", FormatJSON, `{"characters": []}`, 0, nil},
+		{"JSON Malformed", FormatJSON, `{"characters": [`, 0, ErrInvalidExport},
+		{"CSV Header Only", FormatCSV, "name,realm\n", 0, nil},
+		{"CSV Missing Realm", FormatCSV, "name,class\nThrall,Shaman\n", 0, ErrInvalidExport},
+		{"CSV Bad Item Level", FormatCSV, "name,realm,item_level\nThrall,Area 52,high\n", 0, ErrInvalidExport},
+		{"Unknown Format", "xml", "<characters/>", 0, ErrInvalidExport},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(strings.NewReader(tt.input), tt.format)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Len(t, got, tt.wantLen)
+		})
+	}
+}
+
+func TestFormatOf(t *testing.T) {
+	assert.Equal(t, FormatCSV, FormatOf("export/Roster.CSV"))
+	assert.Equal(t, FormatJSON, FormatOf("export/roster.json"))
+	assert.Equal(t, FormatJSON, FormatOf("roster"))
+}
+
+func withOwner(p Profile, owner string) Profile {
+	p.Owner = owner
+	return p
+}
+
+func withKeystone(p Profile, dungeon string, level int32) Profile {
+	p.Keystone = &Keystone{Dungeon: dungeon, Level: level}
+	return p
+}
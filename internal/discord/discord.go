@@ -0,0 +1,161 @@
+// Package discord posts messages to Discord channels through webhooks.
+package discord
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Embed colors.
+const (
+	ColorBlurple = 0x5865F2
+	ColorGreen   = 0x57F287
+	ColorYellow  = 0xFEE75C
+	ColorRed     = 0xED4245
+)
+
+// defaultRetryAfter is how long to wait after a 429 response that does not say.
+const defaultRetryAfter = time.Second
+
+// WebhookMessage is the body of an execute webhook request.
+type WebhookMessage struct {
+	Content         string           `json:"content,omitempty"`
+	Embeds          []Embed          `json:"embeds,omitempty"`
+	AllowedMentions *AllowedMentions `json:"allowed_mentions,omitempty"`
+}
+
+// AllowedMentions controls who a message can ping. An empty Parse list stops
+// user content like run notes from pinging @everyone.
+type AllowedMentions struct {
+	Parse []string `json:"parse"`
+}
+
+// Embed is a rich embed in a message.
+type Embed struct {
+	Title       string       `json:"title,omitempty"`
+	Description string       `json:"description,omitempty"`
+	Color       int          `json:"color,omitempty"`
+	Fields      []EmbedField `json:"fields,omitempty"`
+	Footer      *EmbedFooter `json:"footer,omitempty"`
+	Timestamp   string       `json:"timestamp,omitempty"`
+}
+
+// EmbedField is a name and value shown in an embed.
+type EmbedField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline,omitempty"`
+}
+
+// EmbedFooter is the small text at the bottom of an embed.
+type EmbedFooter struct {
+	Text string `json:"text"`
+}
+
+// Timestamp formats t as a Discord timestamp, which every client shows in the
+// reader's own timezone. style is a Discord timestamp style such as "F" for
+// the full date and time or "R" for relative time.
+func Timestamp(t time.Time, style string) string {
+	return fmt.Sprintf("<t:%d:%s>", t.Unix(), style)
+}
+
+// RateLimitError is returned when Discord responds 429. The request should
+// not be sent again to the webhook until RetryAfter has passed.
+type RateLimitError struct {
+	RetryAfter time.Duration
+	Global     bool
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limited by discord, retry after %s", e.RetryAfter)
+}
+
+// StatusError is returned for any other response that is not 2xx.
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("discord responded %d: %s", e.StatusCode, e.Body)
+}
+
+// Temporary reports whether the request may succeed if sent again. Client
+// errors such as a deleted webhook will not.
+func (e *StatusError) Temporary() bool {
+	return e.StatusCode >= 500
+}
+
+// Client executes Discord webhooks.
+type Client struct {
+	http *http.Client
+}
+
+// NewClient creates a Client that sends requests with httpClient.
+func NewClient(httpClient *http.Client) *Client {
+	return &Client{http: httpClient}
+}
+
+// ExecuteWebhook posts msg to the webhook at url. Returns a *RateLimitError
+// when rate limited and a *StatusError for other unsuccessful responses.
+func (c *Client) ExecuteWebhook(ctx context.Context, url string, msg *WebhookMessage) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Error bodies are short, anything longer is cut off.
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return rateLimitError(resp.Header, respBody)
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		return &StatusError{StatusCode: resp.StatusCode, Body: string(respBody)}
+	}
+	return nil
+}
+
+// rateLimitError reads how long to wait from a 429 response. Discord sends
+// retry_after in seconds in the body, with the Retry-After header as a fallback.
+func rateLimitError(header http.Header, body []byte) *RateLimitError {
+	var limit struct {
+		RetryAfter float64 `json:"retry_after"`
+		Global     bool    `json:"global"`
+	}
+	if err := json.Unmarshal(body, &limit); err == nil && limit.RetryAfter > 0 {
+		return &RateLimitError{
+			RetryAfter: time.Duration(limit.RetryAfter * float64(time.Second)),
+			Global:     limit.Global,
+		}
+	}
+
+	if seconds, err := strconv.ParseFloat(header.Get("Retry-After"), 64); err == nil && seconds > 0 {
+		return &RateLimitError{
+			RetryAfter: time.Duration(seconds * float64(time.Second)),
+			Global:     header.Get("X-RateLimit-Global") == "true",
+		}
+	}
+	return &RateLimitError{RetryAfter: defaultRetryAfter}
+}
@@ -0,0 +1,69 @@
+package discord
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimestamp(t *testing.T) {
+	at := time.Date(2025, 3, 5, 1, 0, 0, 0, time.UTC)
+	assert.Equal(t, "<t:1741136400:F>", Timestamp(at, "F"))
+	assert.Equal(t, "<t:1741136400:R>", Timestamp(at, "R"))
+}
+
+func TestClient_ExecuteWebhook(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		header  map[string]string
+		body    string
+		wantErr error
+	}{
+		{"Delivered", http.StatusNoContent, nil, "", nil},
+		{"Rate Limited", http.StatusTooManyRequests, nil, `{"message":"You are being rate limited.","retry_after":1.5,"global":false}`,
+			&RateLimitError{RetryAfter: 1500 * time.Millisecond}},
+		{"Rate Limited Globally", http.StatusTooManyRequests, nil, `{"retry_after":3,"global":true}`,
+			&RateLimitError{RetryAfter: 3 * time.Second, Global: true}},
+		{"Rate Limited Header Only", http.StatusTooManyRequests, map[string]string{"Retry-After": "2"}, "",
+			&RateLimitError{RetryAfter: 2 * time.Second}},
+		{"Rate Limited Without Delay", http.StatusTooManyRequests, nil, "", &RateLimitError{RetryAfter: time.Second}},
+		{"Unknown Webhook", http.StatusNotFound, nil, `{"message":"Unknown Webhook","code":10015}`,
+			&StatusError{StatusCode: 404, Body: `{"message":"Unknown Webhook","code":10015}`}},
+		{"Server Error", http.StatusBadGateway, nil, "bad gateway", &StatusError{StatusCode: 502, Body: "bad gateway"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got WebhookMessage
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+				for k, v := range tt.header {
+					w.Header().Set(k, v)
+				}
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			msg := &WebhookMessage{
+				Embeds:          []Embed{{Title: "New run", Fields: []EmbedField{{Name: "Tank", Value: "1 of 1 open", Inline: true}}}},
+				AllowedMentions: &AllowedMentions{Parse: []string{}},
+			}
+			err := NewClient(server.Client()).ExecuteWebhook(context.Background(), server.URL, msg)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, *msg, got)
+		})
+	}
+}
+
+func TestStatusError_Temporary(t *testing.T) {
+	assert.True(t, (&StatusError{StatusCode: 503}).Temporary())
+	assert.False(t, (&StatusError{StatusCode: 404}).Temporary())
+}
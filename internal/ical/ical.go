@@ -0,0 +1,261 @@
+// Package ical writes iCalendar (RFC 5545) calendars of events.
+// It only covers what calendar feeds of runs need: events with a start and
+// end in an IANA timezone, and the VTIMEZONE definitions those times refer to.
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// prodID identifies this application as the producer of calendars.
+const prodID = "-//Dungeon Time//Dungeon Time API//EN"
+
+// Date-time layouts. Local times are written with a TZID parameter, UTC times with a Z suffix.
+const (
+	localLayout = "20060102T150405"
+	utcLayout   = "20060102T150405Z"
+)
+
+// maxLineOctets is the longest a content line can be before it has to be folded.
+const maxLineOctets = 75
+
+// Event statuses.
+const (
+	StatusConfirmed = "CONFIRMED"
+	StatusCancelled = "CANCELLED"
+)
+
+// Event is a VEVENT. UID must stay the same for the life of the event so calendar
+// clients update it instead of adding a copy, and Sequence must increase every
+// time the event changes. Start and End are written as wall clock times in Location.
+type Event struct {
+	UID          string
+	Sequence     int32
+	Start        time.Time
+	End          time.Time
+	Location     *time.Location
+	Summary      string
+	Description  string
+	Status       string
+	LastModified time.Time
+}
+
+// Calendar is a VCALENDAR holding events.
+type Calendar struct {
+	Name   string
+	Events []Event
+}
+
+// Encode writes the calendar to w. stamp is written as the DTSTAMP of every
+// event, which is the time the calendar was generated.
+// A VTIMEZONE is included for every timezone an event uses.
+func Encode(w io.Writer, c *Calendar, stamp time.Time) error {
+	bw := bufio.NewWriter(w)
+	e := &encoder{w: bw}
+
+	e.line("BEGIN", "VCALENDAR")
+	e.line("VERSION", "2.0")
+	e.line("PRODID", prodID)
+	e.line("CALSCALE", "GREGORIAN")
+	e.line("METHOD", "PUBLISH")
+	if c.Name != "" {
+		e.line("X-WR-CALNAME", escapeText(c.Name))
+	}
+
+	for _, tz := range timezones(c.Events) {
+		e.timezone(tz)
+	}
+	for _, ev := range c.Events {
+		e.event(ev, stamp)
+	}
+
+	e.line("END", "VCALENDAR")
+	if e.err != nil {
+		return e.err
+	}
+	return bw.Flush()
+}
+
+// encoder writes content lines and keeps the first error so callers can
+// check it once at the end.
+type encoder struct {
+	w   io.Writer
+	err error
+}
+
+func (e *encoder) event(ev Event, stamp time.Time) {
+	loc := ev.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	e.line("BEGIN", "VEVENT")
+	e.line("UID", ev.UID)
+	e.line("SEQUENCE", fmt.Sprint(ev.Sequence))
+	e.line("DTSTAMP", stamp.UTC().Format(utcLayout))
+	e.dateTime("DTSTART", ev.Start, loc)
+	e.dateTime("DTEND", ev.End, loc)
+	e.line("SUMMARY", escapeText(ev.Summary))
+	if ev.Description != "" {
+		e.line("DESCRIPTION", escapeText(ev.Description))
+	}
+	if ev.Status != "" {
+		e.line("STATUS", ev.Status)
+	}
+	if !ev.LastModified.IsZero() {
+		e.line("LAST-MODIFIED", ev.LastModified.UTC().Format(utcLayout))
+	}
+	e.line("END", "VEVENT")
+}
+
+// dateTime writes a date-time property. UTC times are written with a Z suffix,
+// others as a wall clock time referring to the VTIMEZONE of loc.
+func (e *encoder) dateTime(name string, t time.Time, loc *time.Location) {
+	if loc == time.UTC {
+		e.line(name, t.UTC().Format(utcLayout))
+		return
+	}
+	e.line(name+";TZID="+loc.String(), t.In(loc).Format(localLayout))
+}
+
+// line writes a content line, folding it so no line is longer than 75 octets.
+func (e *encoder) line(name, value string) {
+	if e.err != nil {
+		return
+	}
+	_, e.err = io.WriteString(e.w, fold(name+":"+value))
+}
+
+// fold splits a content line into lines of at most 75 octets, each continuation
+// line starting with a space. Lines are only split between UTF-8 characters.
+func fold(line string) string {
+	var b strings.Builder
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// The leading space counts towards the length of continuation lines.
+		limit = maxLineOctets - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+	return b.String()
+}
+
+// escapeText escapes a TEXT value.
+func escapeText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(s)
+}
+
+// tzRange is a timezone and the span of time its definition has to cover.
+type tzRange struct {
+	loc   *time.Location
+	start time.Time
+	end   time.Time
+}
+
+// timezones returns the timezones used by events other than UTC, sorted by name.
+func timezones(events []Event) []tzRange {
+	byName := make(map[string]*tzRange)
+	for _, ev := range events {
+		if ev.Location == nil || ev.Location == time.UTC {
+			continue
+		}
+		tz, ok := byName[ev.Location.String()]
+		if !ok {
+			byName[ev.Location.String()] = &tzRange{loc: ev.Location, start: ev.Start, end: ev.End}
+			continue
+		}
+		if ev.Start.Before(tz.start) {
+			tz.start = ev.Start
+		}
+		if ev.End.After(tz.end) {
+			tz.end = ev.End
+		}
+	}
+
+	ranges := make([]tzRange, 0, len(byName))
+	for _, tz := range byName {
+		ranges = append(ranges, *tz)
+	}
+	slices.SortFunc(ranges, func(a, b tzRange) int { return strings.Compare(a.loc.String(), b.loc.String()) })
+	return ranges
+}
+
+// timezone writes a VTIMEZONE for tz. Go does not expose the recurrence rules
+// of a zone, so every offset change from the year before the first event to
+// the year after the last is written as its own observance.
+func (e *encoder) timezone(tz tzRange) {
+	from := tz.start.AddDate(-1, 0, 0).In(tz.loc)
+	to := tz.end.AddDate(1, 0, 0)
+
+	e.line("BEGIN", "VTIMEZONE")
+	e.line("TZID", tz.loc.String())
+
+	// The offset in effect at the start of the range, as if it began then.
+	start, end := from.ZoneBounds()
+	name, offset := from.Zone()
+	if start.IsZero() {
+		start = time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	e.observance(from.IsDST(), name, offset, offset, start.In(time.FixedZone("", offset)))
+
+	for !end.IsZero() && end.Before(to) {
+		t := end.In(tz.loc)
+		nextName, nextOffset := t.Zone()
+		// DTSTART of an observance is the wall clock time before the change.
+		e.observance(t.IsDST(), nextName, offset, nextOffset, end.In(time.FixedZone("", offset)))
+		offset = nextOffset
+		_, end = t.ZoneBounds()
+	}
+
+	e.line("END", "VTIMEZONE")
+}
+
+func (e *encoder) observance(dst bool, name string, offsetFrom, offsetTo int, start time.Time) {
+	kind := "STANDARD"
+	if dst {
+		kind = "DAYLIGHT"
+	}
+
+	e.line("BEGIN", kind)
+	e.line("DTSTART", start.Format(localLayout))
+	e.line("TZOFFSETFROM", formatOffset(offsetFrom))
+	e.line("TZOFFSETTO", formatOffset(offsetTo))
+	if name != "" {
+		e.line("TZNAME", name)
+	}
+	e.line("END", kind)
+}
+
+// formatOffset formats a UTC offset in seconds as +HHMM, or +HHMMSS when it
+// is not a whole number of minutes.
+func formatOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+	h, m, s := seconds/3600, seconds/60%60, seconds%60
+	if s != 0 {
+		return fmt.Sprintf("%s%02d%02d%02d", sign, h, m, s)
+	}
+	return fmt.Sprintf("%s%02d%02d", sign, h, m)
+}
@@ -0,0 +1,163 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("LoadLocation(%q) error = %v", name, err)
+	}
+	return loc
+}
+
+func TestEncode_UTC(t *testing.T) {
+	stamp := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	c := &Calendar{Name: "Runs", Events: []Event{{
+		UID:          "run-1@dungeon-time",
+		Sequence:     2,
+		Start:        time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC),
+		End:          time.Date(2025, 3, 15, 0, 45, 0, 0, time.UTC),
+		Location:     time.UTC,
+		Summary:      "Ara-Kara, City of Echoes +10",
+		Status:       StatusCancelled,
+		LastModified: stamp,
+	}}}
+
+	var b bytes.Buffer
+	assert.NoError(t, Encode(&b, c, stamp))
+	assert.Equal(t, strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Dungeon Time//Dungeon Time API//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:Runs",
+		"BEGIN:VEVENT",
+		"UID:run-1@dungeon-time",
+		"SEQUENCE:2",
+		"DTSTAMP:20250301T120000Z",
+		"DTSTART:20250315T000000Z",
+		"DTEND:20250315T004500Z",
+		`SUMMARY:Ara-Kara\, City of Echoes +10`,
+		"STATUS:CANCELLED",
+		"LAST-MODIFIED:20250301T120000Z",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n"), b.String())
+}
+
+func TestEncode_Timezone(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+	c := &Calendar{Events: []Event{{
+		UID:      "run-1@dungeon-time",
+		Start:    time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC),
+		End:      time.Date(2025, 3, 15, 0, 45, 0, 0, time.UTC),
+		Location: newYork,
+		Summary:  "Key",
+	}}}
+
+	var b bytes.Buffer
+	assert.NoError(t, Encode(&b, c, time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)))
+	out := b.String()
+
+	assert.Contains(t, out, "DTSTART;TZID=America/New_York:20250314T200000\r\n")
+	assert.Contains(t, out, "DTEND;TZID=America/New_York:20250314T204500\r\n")
+	assert.Equal(t, 1, strings.Count(out, "BEGIN:VTIMEZONE"))
+	assert.Contains(t, out, "TZID:America/New_York\r\n")
+	// The spring forward before the run, written in the offset before the change.
+	assert.Contains(t, out, strings.Join([]string{
+		"BEGIN:DAYLIGHT",
+		"DTSTART:20250309T020000",
+		"TZOFFSETFROM:-0500",
+		"TZOFFSETTO:-0400",
+		"TZNAME:EDT",
+		"END:DAYLIGHT",
+	}, "\r\n"))
+	assert.Contains(t, out, strings.Join([]string{
+		"BEGIN:STANDARD",
+		"DTSTART:20251102T020000",
+		"TZOFFSETFROM:-0400",
+		"TZOFFSETTO:-0500",
+		"TZNAME:EST",
+		"END:STANDARD",
+	}, "\r\n"))
+	// A year either side of the run is covered and nothing more.
+	assert.Contains(t, out, "DTSTART:20241103T020000")
+	assert.Contains(t, out, "DTSTART:20260308T020000")
+	assert.NotContains(t, out, "DTSTART:20261101T020000")
+}
+
+func TestEncode_SharedTimezone(t *testing.T) {
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+	tokyo := mustLoadLocation(t, "Asia/Tokyo")
+	start := time.Date(2025, 6, 1, 18, 0, 0, 0, time.UTC)
+	c := &Calendar{Events: []Event{
+		{UID: "a", Start: start, End: start.Add(time.Hour), Location: berlin},
+		{UID: "b", Start: start.AddDate(0, 0, 7), End: start.AddDate(0, 0, 7).Add(time.Hour), Location: berlin},
+		{UID: "c", Start: start, End: start.Add(time.Hour), Location: tokyo},
+	}}
+
+	var b bytes.Buffer
+	assert.NoError(t, Encode(&b, c, start))
+	out := b.String()
+
+	assert.Equal(t, 2, strings.Count(out, "BEGIN:VTIMEZONE"))
+	assert.Less(t, strings.Index(out, "TZID:Asia/Tokyo"), strings.Index(out, "TZID:Europe/Berlin"))
+	// Tokyo has no DST, a single observance covers it.
+	tokyoDef := out[strings.Index(out, "TZID:Asia/Tokyo"):strings.Index(out, "TZID:Europe/Berlin")]
+	assert.Equal(t, 1, strings.Count(tokyoDef, "BEGIN:STANDARD"))
+	assert.Contains(t, tokyoDef, "TZOFFSETTO:+0900")
+}
+
+func Test_fold(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want string
+	}{
+		{"Short", "SUMMARY:Key", "SUMMARY:Key\r\n"},
+		{"Exactly 75", strings.Repeat("a", 75), strings.Repeat("a", 75) + "\r\n"},
+		{"76", strings.Repeat("a", 76), strings.Repeat("a", 75) + "\r\n a\r\n"},
+		{"Continuation Lines Hold 74", strings.Repeat("a", 150),
+			strings.Repeat("a", 75) + "\r\n " + strings.Repeat("a", 74) + "\r\n a\r\n"},
+		{"Does Not Split Characters", strings.Repeat("a", 74) + "é",
+			strings.Repeat("a", 74) + "\r\n é\r\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, fold(tt.line))
+		})
+	}
+}
+
+func Test_escapeText(t *testing.T) {
+	assert.Equal(t, `Bring flasks\; no pugs\, please\nback\\slash`,
+		escapeText("Bring flasks; no pugs, please\nback\\slash"))
+}
+
+func Test_formatOffset(t *testing.T) {
+	tests := []struct {
+		seconds int
+		want    string
+	}{
+		{0, "+0000"},
+		{-5 * 3600, "-0500"},
+		{5*3600 + 45*60, "+0545"},
+		{-(3*3600 + 30*60), "-0330"},
+		{-(4*3600 + 56*60 + 2), "-045602"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, formatOffset(tt.seconds))
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// Package jobs runs background jobs stored in Postgres.
+//
+// Jobs are enqueued with the Querier of the transaction that needs them, so a
+// job only exists if the change it belongs to was committed. A Worker claims
+// jobs with FOR UPDATE SKIP LOCKED and leases them while they run, so any
+// number of workers can share the table and a job whose worker dies is picked
+// up again once its lease runs out. Workers are woken with LISTEN/NOTIFY when a job is ready and
+// poll for delayed jobs.
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// DefaultQueue is the queue jobs are enqueued on when none is given.
+const DefaultQueue = "default"
+
+// Job statuses. Jobs that succeed are deleted rather than kept.
+const (
+	StatusPending = "pending"
+	StatusRunning = "running"
+	StatusDead    = "dead"
+)
+
+// DefaultMaxAttempts is how many times a job is tried before it is dead.
+const DefaultMaxAttempts = 10
+
+// notifyChannel is the channel workers LISTEN on, see the jobs migration.
+const notifyChannel = "jobs"
+
+// Args are the arguments of a kind of job. They are stored as JSON and Kind
+// must return the same value for the zero value of the type.
+type Args interface {
+	Kind() string
+}
+
+// Job is a claimed job with its decoded arguments.
+type Job[T Args] struct {
+	ID int64
+	// Attempt is 1 the first time the job runs.
+	Attempt int32
+	Queue   string
+	Args    T
+}
+
+// EnqueueOptions changes how a job is enqueued. The zero value enqueues the job
+// on DefaultQueue to run now, with DefaultMaxAttempts.
+type EnqueueOptions struct {
+	Queue string
+	// RunAt delays the job until the given time.
+	RunAt time.Time
+	// UniqueKey makes the job unique among pending jobs of the same kind.
+	UniqueKey   string
+	MaxAttempts int32
+}
+
+// Enqueue adds a job with args using q, normally the Querier of the
+// transaction making the change the job is for. It reports false when the job
+// was unique and an identical job is already pending.
+func Enqueue(ctx context.Context, q repo.Querier, args Args, opts *EnqueueOptions) (bool, error) {
+	if opts == nil {
+		opts = &EnqueueOptions{}
+	}
+	encoded, err := json.Marshal(args)
+	if err != nil {
+		return false, err
+	}
+
+	params := repo.CreateJobParams{
+		Queue:       opts.Queue,
+		Kind:        args.Kind(),
+		Args:        encoded,
+		UniqueKey:   pgtype.Text{String: opts.UniqueKey, Valid: opts.UniqueKey != ""},
+		MaxAttempts: opts.MaxAttempts,
+	}
+	if params.Queue == "" {
+		params.Queue = DefaultQueue
+	}
+	if params.MaxAttempts <= 0 {
+		params.MaxAttempts = DefaultMaxAttempts
+	}
+	if !opts.RunAt.IsZero() {
+		params.RunAt = pgtype.Timestamptz{Time: opts.RunAt, Valid: true}
+	}
+
+	rows, err := q.CreateJob(ctx, params)
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
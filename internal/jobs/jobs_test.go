@@ -0,0 +1,60 @@
+package jobs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+type greetArgs struct {
+	Name string `json:"name"`
+}
+
+func (greetArgs) Kind() string {
+	return "greet"
+}
+
+func Test_Enqueue(t *testing.T) {
+	runAt := time.Date(2025, 3, 5, 1, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		opts *EnqueueOptions
+		rows int64
+		want repo.CreateJobParams
+		ok   bool
+	}{
+		{
+			"Defaults", nil, 1,
+			repo.CreateJobParams{Queue: "default", Kind: "greet", Args: []byte(`{"name":"Thrall"}`), MaxAttempts: 10},
+			true,
+		},
+		{
+			"Delayed", &EnqueueOptions{Queue: "mail", RunAt: runAt, MaxAttempts: 3}, 1,
+			repo.CreateJobParams{Queue: "mail", Kind: "greet", Args: []byte(`{"name":"Thrall"}`), MaxAttempts: 3,
+				RunAt: pgtype.Timestamptz{Time: runAt, Valid: true}},
+			true,
+		},
+		{
+			"Unique Duplicate", &EnqueueOptions{UniqueKey: "thrall"}, 0,
+			repo.CreateJobParams{Queue: "default", Kind: "greet", Args: []byte(`{"name":"Thrall"}`), MaxAttempts: 10,
+				UniqueKey: pgtype.Text{String: "thrall", Valid: true}},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			mockq.EXPECT().CreateJob(ctx, tt.want).Return(tt.rows, nil)
+
+			ok, err := Enqueue(ctx, mockq, greetArgs{Name: "Thrall"}, tt.opts)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.ok, ok)
+		})
+	}
+}
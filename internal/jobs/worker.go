@@ -0,0 +1,281 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// Retry and timing limits. A failed job is retried after retryDelay, doubling
+// with each attempt up to maxRetryDelay. A claimed job is leased to its worker
+// for jobLease, long enough to run for jobTimeout and record how it went.
+const (
+	retryDelay     = 15 * time.Second
+	maxRetryDelay  = time.Hour
+	jobTimeout     = 5 * time.Minute
+	jobLease       = jobTimeout + time.Minute
+	releaseTimeout = 5 * time.Second
+	reconnectDelay = 5 * time.Second
+)
+
+// errLeaseLost is returned when a job ran past its lease, so it was put back
+// or claimed by another worker before its outcome could be recorded.
+var errLeaseLost = errors.New("lease lost")
+
+// handlerFunc runs a claimed job with its arguments still encoded.
+type handlerFunc func(ctx context.Context, job repo.Job) error
+
+// Worker runs jobs from its queues, each with its own concurrency limit.
+type Worker struct {
+	dbPool   *pgxpool.Pool
+	queues   map[string]int
+	handlers map[string]handlerFunc
+	wake     map[string]chan struct{}
+	now      func() time.Time
+}
+
+// NewWorker creates a new Worker with the provided database connection pool,
+// running at most queues[name] jobs at once from each named queue.
+// It returns a pointer to the Worker.
+func NewWorker(dbPool *pgxpool.Pool, queues map[string]int) *Worker {
+	wake := make(map[string]chan struct{}, len(queues))
+	for queue, concurrency := range queues {
+		wake[queue] = make(chan struct{}, concurrency)
+	}
+	return &Worker{
+		dbPool:   dbPool,
+		queues:   queues,
+		handlers: make(map[string]handlerFunc),
+		wake:     wake,
+		now:      time.Now,
+	}
+}
+
+// Register makes w run jobs of the kind of T with handle. A job fails when
+// handle returns an error or panics, and is retried with backoff until it runs
+// out of attempts. Register handlers before calling Work. Workers only claim
+// jobs of kinds they have handlers for.
+func Register[T Args](w *Worker, handle func(context.Context, *Job[T]) error) {
+	var zero T
+	w.handlers[zero.Kind()] = func(ctx context.Context, j repo.Job) error {
+		var args T
+		if err := json.Unmarshal(j.Args, &args); err != nil {
+			return fmt.Errorf("decoding args: %w", err)
+		}
+		return handle(ctx, &Job[T]{ID: j.ID, Attempt: j.Attempts + 1, Queue: j.Queue, Args: args})
+	}
+}
+
+// Work runs jobs until ctx is done, checking for delayed jobs every interval.
+// When ctx is done, jobs that are running are released for the next worker,
+// and Work returns once they have stopped.
+func (w *Worker) Work(ctx context.Context, interval time.Duration) {
+	if len(w.handlers) == 0 {
+		return
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		w.listen(ctx)
+	}()
+	for queue, concurrency := range w.queues {
+		for range concurrency {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				w.process(ctx, queue, interval)
+			}()
+		}
+	}
+	wg.Wait()
+}
+
+// process runs jobs from queue until none are ready, then waits to be woken
+// or for interval to pass.
+func (w *Worker) process(ctx context.Context, queue string, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for ctx.Err() == nil {
+			claimed, err := w.runNext(ctx, queue)
+			if err != nil && ctx.Err() == nil {
+				log.Printf("running %s job: %v", queue, err)
+			}
+			if (err != nil && !errors.Is(err, errLeaseLost)) || !claimed {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.wake[queue]:
+		case <-ticker.C:
+		}
+	}
+}
+
+// runNext claims the next ready job on queue and runs it. The claim is
+// committed before the job runs, leasing the job to w rather than holding a
+// connection for as long as the job takes. Jobs whose lease ran out are put
+// back first, counting the attempt, so a job that keeps killing its worker
+// ends up dead. It reports whether there was a job to run.
+func (w *Worker) runNext(ctx context.Context, queue string) (bool, error) {
+	q := repo.New(w.dbPool)
+	now := w.now()
+	if err := q.ExpireJobLeases(ctx, pgtype.Timestamptz{Time: now, Valid: true}); err != nil {
+		return false, err
+	}
+	claimed, err := q.ClaimJobs(ctx, repo.ClaimJobsParams{
+		Queue:       queue,
+		Kinds:       slices.Collect(maps.Keys(w.handlers)),
+		Now:         pgtype.Timestamptz{Time: now, Valid: true},
+		LockedUntil: pgtype.Timestamptz{Time: now.Add(jobLease), Valid: true},
+		BatchSize:   1,
+	})
+	if err != nil || len(claimed) == 0 {
+		return false, err
+	}
+	return true, w.run(ctx, q, claimed[0])
+}
+
+// run runs a claimed job and records the outcome with q. Jobs that succeed are
+// deleted. Failed jobs are retried later, or marked dead once they are out of
+// attempts. The outcome is recorded even if ctx is done by the time the job
+// returns, so a job that finished is not run again when its lease runs out.
+// It is only recorded while w still holds the lease, otherwise run returns
+// errLeaseLost and leaves the job to whoever has it now.
+func (w *Worker) run(ctx context.Context, q repo.Querier, job repo.Job) error {
+	jobCtx, cancel := context.WithTimeout(ctx, jobTimeout)
+	defer cancel()
+
+	err := w.handle(jobCtx, job)
+	saveCtx, cancelSave := context.WithTimeout(context.WithoutCancel(ctx), releaseTimeout)
+	defer cancelSave()
+	if err == nil {
+		rows, err := q.DeleteJob(saveCtx, repo.DeleteJobParams{ID: job.ID, LockedUntil: job.LockedUntil})
+		return recorded(job, rows, err)
+	}
+	if ctx.Err() != nil {
+		// Shutting down, the job is released without counting an attempt.
+		if err := q.ReleaseJob(saveCtx, repo.ReleaseJobParams{ID: job.ID, LockedUntil: job.LockedUntil}); err != nil {
+			return err
+		}
+		return ctx.Err()
+	}
+
+	params := repo.UpdateJobFailureParams{
+		ID:          job.ID,
+		Status:      StatusPending,
+		Attempts:    job.Attempts + 1,
+		LastError:   err.Error(),
+		RunAt:       pgtype.Timestamptz{Time: w.now().Add(backoff(job.Attempts + 1)), Valid: true},
+		LockedUntil: job.LockedUntil,
+	}
+	if params.Attempts >= job.MaxAttempts {
+		params.Status = StatusDead
+		log.Printf("job %d (%s) is dead after %d attempts: %v", job.ID, job.Kind, params.Attempts, err)
+	}
+	rows, err := q.UpdateJobFailure(saveCtx, params)
+	return recorded(job, rows, err)
+}
+
+// recorded returns err from recording the outcome of job, or errLeaseLost if
+// the write matched no rows because the job's lease is no longer held.
+func recorded(job repo.Job, rows int64, err error) error {
+	if err == nil && rows == 0 {
+		return fmt.Errorf("job %d: %w", job.ID, errLeaseLost)
+	}
+	return err
+}
+
+// handle calls the handler for the kind of job, turning panics into errors.
+func (w *Worker) handle(ctx context.Context, job repo.Job) (err error) {
+	handler, ok := w.handlers[job.Kind]
+	if !ok {
+		return fmt.Errorf("no handler for job kind %q", job.Kind)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return handler(ctx, job)
+}
+
+// listen wakes a worker of a queue whenever a job on it becomes ready,
+// reconnecting until ctx is done.
+func (w *Worker) listen(ctx context.Context) {
+	for {
+		err := w.listenOnce(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("listening for jobs: %v", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconnectDelay):
+		}
+	}
+}
+
+// listenOnce listens for jobs on a connection of its own until it fails or ctx
+// is done. The connection is closed rather than returned to the pool, so no
+// pooled connection is left listening.
+func (w *Worker) listenOnce(ctx context.Context) error {
+	pooled, err := w.dbPool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+notifyChannel); err != nil {
+		return err
+	}
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		w.wakeQueue(notification.Payload)
+	}
+}
+
+// wakeQueue wakes one waiting worker of queue, if it is one of w's queues and
+// its workers are not all awake already.
+func (w *Worker) wakeQueue(queue string) {
+	wake, ok := w.wake[queue]
+	if !ok {
+		return
+	}
+	select {
+	case wake <- struct{}{}:
+	default:
+	}
+}
+
+// backoff is how long to wait before the next attempt after attempts failed ones.
+func backoff(attempts int32) time.Duration {
+	if attempts < 1 {
+		return retryDelay
+	}
+	delay := retryDelay
+	for i := int32(1); i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
@@ -0,0 +1,173 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+func Test_Worker_run(t *testing.T) {
+	now := time.Date(2025, 3, 5, 1, 0, 0, 0, time.UTC)
+	lease := pgtype.Timestamptz{Time: now.Add(jobLease), Valid: true}
+
+	tests := []struct {
+		name       string
+		job        repo.Job
+		handle     func(context.Context, *Job[greetArgs]) error
+		wantUpdate *repo.UpdateJobFailureParams
+	}{
+		{
+			"Succeeded Job Is Deleted",
+			repo.Job{ID: 1, Kind: "greet", Args: []byte(`{"name":"Thrall"}`), MaxAttempts: 10, LockedUntil: lease},
+			func(_ context.Context, j *Job[greetArgs]) error {
+				assert.Equal(t, "Thrall", j.Args.Name)
+				assert.Equal(t, int32(1), j.Attempt)
+				return nil
+			},
+			nil,
+		},
+		{
+			"Failed Job Is Retried",
+			repo.Job{ID: 1, Kind: "greet", Args: []byte(`{}`), Attempts: 2, MaxAttempts: 10, LockedUntil: lease},
+			func(context.Context, *Job[greetArgs]) error { return errors.New("no answer") },
+			&repo.UpdateJobFailureParams{ID: 1, Status: "pending", Attempts: 3, LastError: "no answer",
+				RunAt:       pgtype.Timestamptz{Time: now.Add(time.Minute), Valid: true},
+				LockedUntil: lease},
+		},
+		{
+			"Out Of Attempts",
+			repo.Job{ID: 1, Kind: "greet", Args: []byte(`{}`), Attempts: 2, MaxAttempts: 3, LockedUntil: lease},
+			func(context.Context, *Job[greetArgs]) error { return errors.New("no answer") },
+			&repo.UpdateJobFailureParams{ID: 1, Status: "dead", Attempts: 3, LastError: "no answer",
+				RunAt:       pgtype.Timestamptz{Time: now.Add(time.Minute), Valid: true},
+				LockedUntil: lease},
+		},
+		{
+			"Panic",
+			repo.Job{ID: 1, Kind: "greet", Args: []byte(`{}`), MaxAttempts: 10, LockedUntil: lease},
+			func(context.Context, *Job[greetArgs]) error { panic("boom") },
+			&repo.UpdateJobFailureParams{ID: 1, Status: "pending", Attempts: 1, LastError: "panic: boom",
+				RunAt:       pgtype.Timestamptz{Time: now.Add(15 * time.Second), Valid: true},
+				LockedUntil: lease},
+		},
+		{
+			"Bad Args",
+			repo.Job{ID: 1, Kind: "greet", Args: []byte(`[]`), MaxAttempts: 10, LockedUntil: lease},
+			func(context.Context, *Job[greetArgs]) error { return nil },
+			&repo.UpdateJobFailureParams{ID: 1, Status: "pending", Attempts: 1,
+				LastError:   "decoding args: json: cannot unmarshal array into Go value of type jobs.greetArgs",
+				RunAt:       pgtype.Timestamptz{Time: now.Add(15 * time.Second), Valid: true},
+				LockedUntil: lease},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			if tt.wantUpdate == nil {
+				mockq.EXPECT().DeleteJob(mock.Anything, repo.DeleteJobParams{ID: 1, LockedUntil: lease}).Return(1, nil)
+			} else {
+				mockq.EXPECT().UpdateJobFailure(mock.Anything, *tt.wantUpdate).Return(1, nil)
+			}
+
+			w := NewWorker(nil, map[string]int{DefaultQueue: 1})
+			w.now = func() time.Time { return now }
+			Register(w, tt.handle)
+
+			assert.NoError(t, w.run(ctx, mockq, tt.job))
+		})
+	}
+}
+
+func Test_Worker_run_shutdown(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	mockq := repo.NewMockQuerier(t)
+	mockq.EXPECT().ReleaseJob(mock.Anything, repo.ReleaseJobParams{ID: 1}).Return(nil)
+
+	w := NewWorker(nil, map[string]int{DefaultQueue: 1})
+	Register(w, func(ctx context.Context, _ *Job[greetArgs]) error {
+		cancel()
+		return ctx.Err()
+	})
+
+	err := w.run(ctx, mockq, repo.Job{ID: 1, Kind: "greet", Args: []byte(`{}`), MaxAttempts: 10})
+	assert.ErrorIs(t, err, context.Canceled, "the job is released without recording an attempt")
+}
+
+func Test_Worker_run_finishedAtShutdown(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	mockq := repo.NewMockQuerier(t)
+	mockq.EXPECT().DeleteJob(mock.MatchedBy(func(ctx context.Context) bool { return ctx.Err() == nil }),
+		repo.DeleteJobParams{ID: 1}).Return(1, nil)
+
+	w := NewWorker(nil, map[string]int{DefaultQueue: 1})
+	Register(w, func(context.Context, *Job[greetArgs]) error {
+		cancel()
+		return nil
+	})
+
+	err := w.run(ctx, mockq, repo.Job{ID: 1, Kind: "greet", Args: []byte(`{}`), MaxAttempts: 10})
+	assert.NoError(t, err, "a job that finished is deleted even when shutting down")
+}
+
+func Test_Worker_run_leaseLost(t *testing.T) {
+	lease := pgtype.Timestamptz{Time: time.Date(2025, 3, 5, 1, 6, 0, 0, time.UTC), Valid: true}
+
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{"Succeeded", nil},
+		{"Failed", errors.New("no answer")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockq := repo.NewMockQuerier(t)
+			if tt.err == nil {
+				mockq.EXPECT().DeleteJob(mock.Anything, repo.DeleteJobParams{ID: 1, LockedUntil: lease}).Return(0, nil)
+			} else {
+				mockq.EXPECT().UpdateJobFailure(mock.Anything, mock.MatchedBy(func(arg repo.UpdateJobFailureParams) bool {
+					return arg.ID == 1 && arg.LockedUntil == lease
+				})).Return(0, nil)
+			}
+
+			w := NewWorker(nil, map[string]int{DefaultQueue: 1})
+			Register(w, func(context.Context, *Job[greetArgs]) error { return tt.err })
+
+			err := w.run(context.Background(), mockq, repo.Job{ID: 1, Kind: "greet", Args: []byte(`{}`),
+				MaxAttempts: 10, LockedUntil: lease})
+			assert.ErrorIs(t, err, errLeaseLost, "the job is left to the worker that holds it now")
+		})
+	}
+}
+
+func Test_backoff(t *testing.T) {
+	tests := []struct {
+		attempts int32
+		want     time.Duration
+	}{
+		{1, 15 * time.Second},
+		{2, 30 * time.Second},
+		{5, 4 * time.Minute},
+		{9, time.Hour},
+		{40, time.Hour},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, backoff(tt.attempts), "attempts %d", tt.attempts)
+	}
+}
+
+func Test_Worker_wakeQueue(t *testing.T) {
+	w := NewWorker(nil, map[string]int{DefaultQueue: 2})
+	w.wakeQueue(DefaultQueue)
+	w.wakeQueue(DefaultQueue)
+	w.wakeQueue(DefaultQueue)
+	w.wakeQueue("unknown")
+	assert.Len(t, w.wake[DefaultQueue], 2, "wakes are not queued past the number of workers")
+}
@@ -0,0 +1,130 @@
+package notify
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// emailTimeout bounds connecting to the SMTP server and sending one email.
+// Notifications are leased to the worker sending them for a few minutes, so a
+// server that stops answering must not hold the send up for longer.
+const emailTimeout = 30 * time.Second
+
+// EmailChannel sends messages as plain text email through an SMTP server.
+type EmailChannel struct {
+	addr string
+	from string
+	auth smtp.Auth
+	// dial connects to the SMTP server, replaced in tests.
+	dial func(ctx context.Context, network, addr string) (net.Conn, error)
+}
+
+// NewEmailChannel creates an EmailChannel sending from the address from through
+// the SMTP server at addr, host:port. auth may be nil for servers without authentication.
+func NewEmailChannel(addr, from string, auth smtp.Auth) *EmailChannel {
+	dialer := &net.Dialer{Timeout: emailTimeout}
+	return &EmailChannel{addr: addr, from: from, auth: auth, dial: dialer.DialContext}
+}
+
+func (c *EmailChannel) Name() string {
+	return ChannelEmail
+}
+
+// Send sends the message like smtp.SendMail, giving up after emailTimeout or
+// once ctx is done.
+func (c *EmailChannel) Send(ctx context.Context, m Message) error {
+	if m.Email == "" {
+		return ErrNoAddress
+	}
+
+	conn, err := c.dial(ctx, "tcp", c.addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	deadline := time.Now().Add(emailTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		return err
+	}
+	// Closing the connection ends the exchange if ctx is done while it is blocked.
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	if err := c.sendMail(conn, m); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return err
+	}
+	return nil
+}
+
+// sendMail has the SMTP exchange of smtp.SendMail over conn, upgrading to TLS
+// when the server offers it and authenticating if c has auth.
+func (c *EmailChannel) sendMail(conn net.Conn, m Message) error {
+	host, _, err := net.SplitHostPort(c.addr)
+	if err != nil {
+		return err
+	}
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if c.auth != nil {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return fmt.Errorf("smtp: server doesn't support AUTH")
+		}
+		if err := client.Auth(c.auth); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(c.from); err != nil {
+		return err
+	}
+	if err := client.Rcpt(m.Email); err != nil {
+		return err
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(c.compose(m)); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// compose builds the email for m.
+func (c *EmailChannel) compose(m Message) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", c.from)
+	fmt.Fprintf(&b, "To: %s\r\n", m.Email)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", m.Subject))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(m.Body, "\n", "\r\n"))
+	b.WriteString("\r\n")
+	return []byte(b.String())
+}
@@ -0,0 +1,32 @@
+package notify
+
+import (
+	"context"
+
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// InAppChannel stores messages in the user's inbox. Storing a message twice
+// keeps the first copy.
+type InAppChannel struct {
+	q repo.Querier
+}
+
+// NewInAppChannel creates an InAppChannel that stores messages with q.
+func NewInAppChannel(q repo.Querier) *InAppChannel {
+	return &InAppChannel{q: q}
+}
+
+func (c *InAppChannel) Name() string {
+	return ChannelInApp
+}
+
+func (c *InAppChannel) Send(ctx context.Context, m Message) error {
+	return c.q.CreateInboxNotification(ctx, repo.CreateInboxNotificationParams{
+		UserID:         m.UserID,
+		NotificationID: m.NotificationID,
+		Type:           m.Type,
+		Subject:        m.Subject,
+		Body:           m.Body,
+	})
+}
@@ -0,0 +1,33 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// LogChannel writes messages to w instead of delivering them. It can stand in
+// for any channel in local development, named after the channel it replaces.
+type LogChannel struct {
+	name string
+	mu   sync.Mutex
+	w    io.Writer
+}
+
+// NewLogChannel creates a LogChannel called name that writes to w.
+func NewLogChannel(name string, w io.Writer) *LogChannel {
+	return &LogChannel{name: name, w: w}
+}
+
+func (c *LogChannel) Name() string {
+	return c.name
+}
+
+func (c *LogChannel) Send(ctx context.Context, m Message) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_, err := fmt.Fprintf(c.w, "[notify %s] to user %d (%s): %s\n%s\n\n", c.name, m.UserID, m.Type, m.Subject, m.Body)
+	return err
+}
@@ -0,0 +1,42 @@
+// Package notify delivers notifications to users over channels such as email,
+// webhooks and the in-app inbox.
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+)
+
+// Channel names. Users choose the channels they want by these names.
+const (
+	ChannelEmail   = "email"
+	ChannelWebhook = "webhook"
+	ChannelInApp   = "in_app"
+)
+
+// ErrNoAddress is returned by a channel that has nowhere to send a message for
+// the user, for example a webhook channel for a user without a webhook URL.
+// It is not retried.
+var ErrNoAddress = errors.New("user has no address for this channel")
+
+// Message is a notification rendered for one user, with everything a channel
+// needs to deliver it.
+type Message struct {
+	NotificationID int64           `json:"id"`
+	UserID         int32           `json:"user_id"`
+	Type           string          `json:"type"`
+	Subject        string          `json:"subject"`
+	Body           string          `json:"body"`
+	Payload        json.RawMessage `json:"payload"`
+	Email          string          `json:"-"`
+	WebhookURL     string          `json:"-"`
+}
+
+// Channel delivers messages. Send may be called again with the same message
+// after a failure, so channels that can should make delivery idempotent using
+// Message.NotificationID.
+type Channel interface {
+	Name() string
+	Send(context.Context, Message) error
+}
@@ -0,0 +1,157 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var message = Message{
+	NotificationID: 1,
+	UserID:         8,
+	Type:           "run.cancelled",
+	Subject:        "Run cancelled: Ara-Kara +12",
+	Body:           "Ara-Kara +12 on Tue Mar 4 20:00 EST has been cancelled.",
+	Payload:        json.RawMessage(`{"run_id":1}`),
+	Email:          "tank@example.com",
+	WebhookURL:     "",
+}
+
+func TestLogChannel_Send(t *testing.T) {
+	var buf bytes.Buffer
+	c := NewLogChannel(ChannelEmail, &buf)
+
+	assert.NoError(t, c.Send(context.Background(), message))
+	assert.Equal(t, ChannelEmail, c.Name())
+	assert.Equal(t, "[notify email] to user 8 (run.cancelled): Run cancelled: Ara-Kara +12\n"+
+		"Ara-Kara +12 on Tue Mar 4 20:00 EST has been cancelled.\n\n", buf.String())
+}
+
+func TestWebhookChannel_Send(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		noURL   bool
+		wantErr bool
+	}{
+		{"Delivered", http.StatusNoContent, false, false},
+		{"Server Error", http.StatusInternalServerError, false, true},
+		{"No URL", http.StatusOK, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got map[string]any
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				body, _ := io.ReadAll(r.Body)
+				assert.NoError(t, json.Unmarshal(body, &got))
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			m := message
+			if !tt.noURL {
+				m.WebhookURL = server.URL
+			}
+			err := NewWebhookChannel(server.Client()).Send(context.Background(), m)
+			if tt.noURL {
+				assert.ErrorIs(t, err, ErrNoAddress)
+				return
+			}
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]any{
+				"id": float64(1), "user_id": float64(8), "type": "run.cancelled",
+				"subject": message.Subject, "body": message.Body, "payload": map[string]any{"run_id": float64(1)},
+			}, got)
+		})
+	}
+}
+
+func TestEmailChannel_Send(t *testing.T) {
+	var gotTo, gotMsg string
+	served := make(chan struct{})
+	c := NewEmailChannel("smtp.example.com:587", "runs@example.com", nil)
+	c.dial = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		client, server := net.Pipe()
+		go func() {
+			defer close(served)
+			gotTo, gotMsg = serveSMTP(server)
+		}()
+		return client, nil
+	}
+
+	m := message
+	m.Subject = "You're in: Ara-Kara +12 ⚔"
+	m.Body = "Line one\nLine two"
+	assert.NoError(t, c.Send(context.Background(), m))
+	<-served
+	assert.Equal(t, "<tank@example.com>", gotTo)
+	assert.Equal(t, "From: runs@example.com\r\n"+
+		"To: tank@example.com\r\n"+
+		"Subject: =?utf-8?q?You're_in:_Ara-Kara_+12_=E2=9A=94?=\r\n"+
+		"MIME-Version: 1.0\r\n"+
+		"Content-Type: text/plain; charset=utf-8\r\n"+
+		"\r\n"+
+		"Line one\r\nLine two\r\n", gotMsg)
+
+	m.Email = ""
+	assert.ErrorIs(t, c.Send(context.Background(), m), ErrNoAddress)
+}
+
+func TestEmailChannel_Send_cancelled(t *testing.T) {
+	// The server accepts the connection but never greets.
+	c := NewEmailChannel("smtp.example.com:587", "runs@example.com", nil)
+	c.dial = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		client, _ := net.Pipe()
+		return client, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	assert.ErrorIs(t, c.Send(ctx, message), context.Canceled)
+}
+
+// serveSMTP answers one SMTP session on conn and returns the recipient and
+// the message it was sent.
+func serveSMTP(conn net.Conn) (to, msg string) {
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+	tp.PrintfLine("220 smtp.example.com ESMTP")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return to, msg
+		}
+		switch cmd, arg, _ := strings.Cut(line, " "); strings.ToUpper(cmd) {
+		case "EHLO":
+			tp.PrintfLine("250 smtp.example.com")
+		case "RCPT":
+			to = strings.TrimPrefix(arg, "TO:")
+			tp.PrintfLine("250 OK")
+		case "DATA":
+			tp.PrintfLine("354 Go ahead")
+			data, _ := tp.ReadDotBytes()
+			msg = strings.ReplaceAll(string(data), "\n", "\r\n")
+			tp.PrintfLine("250 OK")
+		case "QUIT":
+			tp.PrintfLine("221 Bye")
+			return to, msg
+		default:
+			tp.PrintfLine("250 OK")
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// WebhookChannel POSTs messages as JSON to the webhook URL of the user.
+type WebhookChannel struct {
+	client *http.Client
+}
+
+// NewWebhookChannel creates a WebhookChannel that sends requests with client.
+// Users choose the URLs, so client must only connect to public addresses and
+// not follow redirects, like the client from webhook.NewHTTPClient.
+func NewWebhookChannel(client *http.Client) *WebhookChannel {
+	return &WebhookChannel{client: client}
+}
+
+func (c *WebhookChannel) Name() string {
+	return ChannelWebhook
+}
+
+// Send posts the message. Any response other than 2xx is an error.
+func (c *WebhookChannel) Send(ctx context.Context, m Message) error {
+	if m.WebhookURL == "" {
+		return ErrNoAddress
+	}
+
+	body, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ package repo
 import (
 	context "context"
 
+	pgtype "github.com/jackc/pgx/v5/pgtype"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -21,26 +22,26 @@ func (_m *MockQuerier) EXPECT() *MockQuerier_Expecter {
 	return &MockQuerier_Expecter{mock: &_m.Mock}
 }
 
-// CreateUser provides a mock function with given fields: ctx, arg
-func (_m *MockQuerier) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
+// AddGuildMember provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) AddGuildMember(ctx context.Context, arg AddGuildMemberParams) (GuildMember, error) {
 	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateUser")
+		panic("no return value specified for AddGuildMember")
 	}
 
-	var r0 User
+	var r0 GuildMember
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, CreateUserParams) (User, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, AddGuildMemberParams) (GuildMember, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, CreateUserParams) User); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, AddGuildMemberParams) GuildMember); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(User)
+		r0 = ret.Get(0).(GuildMember)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, CreateUserParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, AddGuildMemberParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -49,56 +50,105 @@ func (_m *MockQuerier) CreateUser(ctx context.Context, arg CreateUserParams) (Us
 	return r0, r1
 }
 
-// MockQuerier_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
-type MockQuerier_CreateUser_Call struct {
+// MockQuerier_AddGuildMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddGuildMember'
+type MockQuerier_AddGuildMember_Call struct {
 	*mock.Call
 }
 
-// CreateUser is a helper method to define mock.On call
+// AddGuildMember is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg CreateUserParams
-func (_e *MockQuerier_Expecter) CreateUser(ctx interface{}, arg interface{}) *MockQuerier_CreateUser_Call {
-	return &MockQuerier_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, arg)}
+//   - arg AddGuildMemberParams
+func (_e *MockQuerier_Expecter) AddGuildMember(ctx interface{}, arg interface{}) *MockQuerier_AddGuildMember_Call {
+	return &MockQuerier_AddGuildMember_Call{Call: _e.mock.On("AddGuildMember", ctx, arg)}
 }
 
-func (_c *MockQuerier_CreateUser_Call) Run(run func(ctx context.Context, arg CreateUserParams)) *MockQuerier_CreateUser_Call {
+func (_c *MockQuerier_AddGuildMember_Call) Run(run func(ctx context.Context, arg AddGuildMemberParams)) *MockQuerier_AddGuildMember_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(CreateUserParams))
+		run(args[0].(context.Context), args[1].(AddGuildMemberParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_CreateUser_Call) Return(_a0 User, _a1 error) *MockQuerier_CreateUser_Call {
+func (_c *MockQuerier_AddGuildMember_Call) Return(_a0 GuildMember, _a1 error) *MockQuerier_AddGuildMember_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_CreateUser_Call) RunAndReturn(run func(context.Context, CreateUserParams) (User, error)) *MockQuerier_CreateUser_Call {
+func (_c *MockQuerier_AddGuildMember_Call) RunAndReturn(run func(context.Context, AddGuildMemberParams) (GuildMember, error)) *MockQuerier_AddGuildMember_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserByEmail provides a mock function with given fields: ctx, email
-func (_m *MockQuerier) GetUserByEmail(ctx context.Context, email string) (GetUserByEmailRow, error) {
-	ret := _m.Called(ctx, email)
+// AddLFGProposalMember provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) AddLFGProposalMember(ctx context.Context, arg AddLFGProposalMemberParams) error {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserByEmail")
+		panic("no return value specified for AddLFGProposalMember")
 	}
 
-	var r0 GetUserByEmailRow
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, AddLFGProposalMemberParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_AddLFGProposalMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddLFGProposalMember'
+type MockQuerier_AddLFGProposalMember_Call struct {
+	*mock.Call
+}
+
+// AddLFGProposalMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg AddLFGProposalMemberParams
+func (_e *MockQuerier_Expecter) AddLFGProposalMember(ctx interface{}, arg interface{}) *MockQuerier_AddLFGProposalMember_Call {
+	return &MockQuerier_AddLFGProposalMember_Call{Call: _e.mock.On("AddLFGProposalMember", ctx, arg)}
+}
+
+func (_c *MockQuerier_AddLFGProposalMember_Call) Run(run func(ctx context.Context, arg AddLFGProposalMemberParams)) *MockQuerier_AddLFGProposalMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(AddLFGProposalMemberParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_AddLFGProposalMember_Call) Return(_a0 error) *MockQuerier_AddLFGProposalMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_AddLFGProposalMember_Call) RunAndReturn(run func(context.Context, AddLFGProposalMemberParams) error) *MockQuerier_AddLFGProposalMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClaimGuildAnnouncements provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) ClaimGuildAnnouncements(ctx context.Context, arg ClaimGuildAnnouncementsParams) ([]GuildAnnouncement, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimGuildAnnouncements")
+	}
+
+	var r0 []GuildAnnouncement
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (GetUserByEmailRow, error)); ok {
-		return rf(ctx, email)
+	if rf, ok := ret.Get(0).(func(context.Context, ClaimGuildAnnouncementsParams) ([]GuildAnnouncement, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) GetUserByEmailRow); ok {
-		r0 = rf(ctx, email)
+	if rf, ok := ret.Get(0).(func(context.Context, ClaimGuildAnnouncementsParams) []GuildAnnouncement); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(GetUserByEmailRow)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]GuildAnnouncement)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, email)
+	if rf, ok := ret.Get(1).(func(context.Context, ClaimGuildAnnouncementsParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -106,56 +156,58 @@ func (_m *MockQuerier) GetUserByEmail(ctx context.Context, email string) (GetUse
 	return r0, r1
 }
 
-// MockQuerier_GetUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByEmail'
-type MockQuerier_GetUserByEmail_Call struct {
+// MockQuerier_ClaimGuildAnnouncements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimGuildAnnouncements'
+type MockQuerier_ClaimGuildAnnouncements_Call struct {
 	*mock.Call
 }
 
-// GetUserByEmail is a helper method to define mock.On call
+// ClaimGuildAnnouncements is a helper method to define mock.On call
 //   - ctx context.Context
-//   - email string
-func (_e *MockQuerier_Expecter) GetUserByEmail(ctx interface{}, email interface{}) *MockQuerier_GetUserByEmail_Call {
-	return &MockQuerier_GetUserByEmail_Call{Call: _e.mock.On("GetUserByEmail", ctx, email)}
+//   - arg ClaimGuildAnnouncementsParams
+func (_e *MockQuerier_Expecter) ClaimGuildAnnouncements(ctx interface{}, arg interface{}) *MockQuerier_ClaimGuildAnnouncements_Call {
+	return &MockQuerier_ClaimGuildAnnouncements_Call{Call: _e.mock.On("ClaimGuildAnnouncements", ctx, arg)}
 }
 
-func (_c *MockQuerier_GetUserByEmail_Call) Run(run func(ctx context.Context, email string)) *MockQuerier_GetUserByEmail_Call {
+func (_c *MockQuerier_ClaimGuildAnnouncements_Call) Run(run func(ctx context.Context, arg ClaimGuildAnnouncementsParams)) *MockQuerier_ClaimGuildAnnouncements_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(ClaimGuildAnnouncementsParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetUserByEmail_Call) Return(_a0 GetUserByEmailRow, _a1 error) *MockQuerier_GetUserByEmail_Call {
+func (_c *MockQuerier_ClaimGuildAnnouncements_Call) Return(_a0 []GuildAnnouncement, _a1 error) *MockQuerier_ClaimGuildAnnouncements_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetUserByEmail_Call) RunAndReturn(run func(context.Context, string) (GetUserByEmailRow, error)) *MockQuerier_GetUserByEmail_Call {
+func (_c *MockQuerier_ClaimGuildAnnouncements_Call) RunAndReturn(run func(context.Context, ClaimGuildAnnouncementsParams) ([]GuildAnnouncement, error)) *MockQuerier_ClaimGuildAnnouncements_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserByID provides a mock function with given fields: ctx, id
-func (_m *MockQuerier) GetUserByID(ctx context.Context, id int32) (GetUserByIDRow, error) {
-	ret := _m.Called(ctx, id)
+// ClaimJobs provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) ClaimJobs(ctx context.Context, arg ClaimJobsParams) ([]Job, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserByID")
+		panic("no return value specified for ClaimJobs")
 	}
 
-	var r0 GetUserByIDRow
+	var r0 []Job
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32) (GetUserByIDRow, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, ClaimJobsParams) ([]Job, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32) GetUserByIDRow); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, ClaimJobsParams) []Job); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(GetUserByIDRow)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Job)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, ClaimJobsParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -163,56 +215,58 @@ func (_m *MockQuerier) GetUserByID(ctx context.Context, id int32) (GetUserByIDRo
 	return r0, r1
 }
 
-// MockQuerier_GetUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByID'
-type MockQuerier_GetUserByID_Call struct {
+// MockQuerier_ClaimJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimJobs'
+type MockQuerier_ClaimJobs_Call struct {
 	*mock.Call
 }
 
-// GetUserByID is a helper method to define mock.On call
+// ClaimJobs is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int32
-func (_e *MockQuerier_Expecter) GetUserByID(ctx interface{}, id interface{}) *MockQuerier_GetUserByID_Call {
-	return &MockQuerier_GetUserByID_Call{Call: _e.mock.On("GetUserByID", ctx, id)}
+//   - arg ClaimJobsParams
+func (_e *MockQuerier_Expecter) ClaimJobs(ctx interface{}, arg interface{}) *MockQuerier_ClaimJobs_Call {
+	return &MockQuerier_ClaimJobs_Call{Call: _e.mock.On("ClaimJobs", ctx, arg)}
 }
 
-func (_c *MockQuerier_GetUserByID_Call) Run(run func(ctx context.Context, id int32)) *MockQuerier_GetUserByID_Call {
+func (_c *MockQuerier_ClaimJobs_Call) Run(run func(ctx context.Context, arg ClaimJobsParams)) *MockQuerier_ClaimJobs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int32))
+		run(args[0].(context.Context), args[1].(ClaimJobsParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetUserByID_Call) Return(_a0 GetUserByIDRow, _a1 error) *MockQuerier_GetUserByID_Call {
+func (_c *MockQuerier_ClaimJobs_Call) Return(_a0 []Job, _a1 error) *MockQuerier_ClaimJobs_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetUserByID_Call) RunAndReturn(run func(context.Context, int32) (GetUserByIDRow, error)) *MockQuerier_GetUserByID_Call {
+func (_c *MockQuerier_ClaimJobs_Call) RunAndReturn(run func(context.Context, ClaimJobsParams) ([]Job, error)) *MockQuerier_ClaimJobs_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserByUsername provides a mock function with given fields: ctx, username
-func (_m *MockQuerier) GetUserByUsername(ctx context.Context, username string) (GetUserByUsernameRow, error) {
-	ret := _m.Called(ctx, username)
+// ClaimNotifications provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) ClaimNotifications(ctx context.Context, arg ClaimNotificationsParams) ([]Notification, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserByUsername")
+		panic("no return value specified for ClaimNotifications")
 	}
 
-	var r0 GetUserByUsernameRow
+	var r0 []Notification
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (GetUserByUsernameRow, error)); ok {
-		return rf(ctx, username)
+	if rf, ok := ret.Get(0).(func(context.Context, ClaimNotificationsParams) ([]Notification, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) GetUserByUsernameRow); ok {
-		r0 = rf(ctx, username)
+	if rf, ok := ret.Get(0).(func(context.Context, ClaimNotificationsParams) []Notification); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(GetUserByUsernameRow)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Notification)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, username)
+	if rf, ok := ret.Get(1).(func(context.Context, ClaimNotificationsParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -220,56 +274,58 @@ func (_m *MockQuerier) GetUserByUsername(ctx context.Context, username string) (
 	return r0, r1
 }
 
-// MockQuerier_GetUserByUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByUsername'
-type MockQuerier_GetUserByUsername_Call struct {
+// MockQuerier_ClaimNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimNotifications'
+type MockQuerier_ClaimNotifications_Call struct {
 	*mock.Call
 }
 
-// GetUserByUsername is a helper method to define mock.On call
+// ClaimNotifications is a helper method to define mock.On call
 //   - ctx context.Context
-//   - username string
-func (_e *MockQuerier_Expecter) GetUserByUsername(ctx interface{}, username interface{}) *MockQuerier_GetUserByUsername_Call {
-	return &MockQuerier_GetUserByUsername_Call{Call: _e.mock.On("GetUserByUsername", ctx, username)}
+//   - arg ClaimNotificationsParams
+func (_e *MockQuerier_Expecter) ClaimNotifications(ctx interface{}, arg interface{}) *MockQuerier_ClaimNotifications_Call {
+	return &MockQuerier_ClaimNotifications_Call{Call: _e.mock.On("ClaimNotifications", ctx, arg)}
 }
 
-func (_c *MockQuerier_GetUserByUsername_Call) Run(run func(ctx context.Context, username string)) *MockQuerier_GetUserByUsername_Call {
+func (_c *MockQuerier_ClaimNotifications_Call) Run(run func(ctx context.Context, arg ClaimNotificationsParams)) *MockQuerier_ClaimNotifications_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(ClaimNotificationsParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetUserByUsername_Call) Return(_a0 GetUserByUsernameRow, _a1 error) *MockQuerier_GetUserByUsername_Call {
+func (_c *MockQuerier_ClaimNotifications_Call) Return(_a0 []Notification, _a1 error) *MockQuerier_ClaimNotifications_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetUserByUsername_Call) RunAndReturn(run func(context.Context, string) (GetUserByUsernameRow, error)) *MockQuerier_GetUserByUsername_Call {
+func (_c *MockQuerier_ClaimNotifications_Call) RunAndReturn(run func(context.Context, ClaimNotificationsParams) ([]Notification, error)) *MockQuerier_ClaimNotifications_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserFullByEmail provides a mock function with given fields: ctx, email
-func (_m *MockQuerier) GetUserFullByEmail(ctx context.Context, email string) (User, error) {
-	ret := _m.Called(ctx, email)
+// ClaimRunsDueReminders provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) ClaimRunsDueReminders(ctx context.Context, arg ClaimRunsDueRemindersParams) ([]Run, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserFullByEmail")
+		panic("no return value specified for ClaimRunsDueReminders")
 	}
 
-	var r0 User
+	var r0 []Run
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (User, error)); ok {
-		return rf(ctx, email)
+	if rf, ok := ret.Get(0).(func(context.Context, ClaimRunsDueRemindersParams) ([]Run, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) User); ok {
-		r0 = rf(ctx, email)
+	if rf, ok := ret.Get(0).(func(context.Context, ClaimRunsDueRemindersParams) []Run); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(User)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Run)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, email)
+	if rf, ok := ret.Get(1).(func(context.Context, ClaimRunsDueRemindersParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -277,58 +333,56 @@ func (_m *MockQuerier) GetUserFullByEmail(ctx context.Context, email string) (Us
 	return r0, r1
 }
 
-// MockQuerier_GetUserFullByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserFullByEmail'
-type MockQuerier_GetUserFullByEmail_Call struct {
+// MockQuerier_ClaimRunsDueReminders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimRunsDueReminders'
+type MockQuerier_ClaimRunsDueReminders_Call struct {
 	*mock.Call
 }
 
-// GetUserFullByEmail is a helper method to define mock.On call
+// ClaimRunsDueReminders is a helper method to define mock.On call
 //   - ctx context.Context
-//   - email string
-func (_e *MockQuerier_Expecter) GetUserFullByEmail(ctx interface{}, email interface{}) *MockQuerier_GetUserFullByEmail_Call {
-	return &MockQuerier_GetUserFullByEmail_Call{Call: _e.mock.On("GetUserFullByEmail", ctx, email)}
+//   - arg ClaimRunsDueRemindersParams
+func (_e *MockQuerier_Expecter) ClaimRunsDueReminders(ctx interface{}, arg interface{}) *MockQuerier_ClaimRunsDueReminders_Call {
+	return &MockQuerier_ClaimRunsDueReminders_Call{Call: _e.mock.On("ClaimRunsDueReminders", ctx, arg)}
 }
 
-func (_c *MockQuerier_GetUserFullByEmail_Call) Run(run func(ctx context.Context, email string)) *MockQuerier_GetUserFullByEmail_Call {
+func (_c *MockQuerier_ClaimRunsDueReminders_Call) Run(run func(ctx context.Context, arg ClaimRunsDueRemindersParams)) *MockQuerier_ClaimRunsDueReminders_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(ClaimRunsDueRemindersParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetUserFullByEmail_Call) Return(_a0 User, _a1 error) *MockQuerier_GetUserFullByEmail_Call {
+func (_c *MockQuerier_ClaimRunsDueReminders_Call) Return(_a0 []Run, _a1 error) *MockQuerier_ClaimRunsDueReminders_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetUserFullByEmail_Call) RunAndReturn(run func(context.Context, string) (User, error)) *MockQuerier_GetUserFullByEmail_Call {
+func (_c *MockQuerier_ClaimRunsDueReminders_Call) RunAndReturn(run func(context.Context, ClaimRunsDueRemindersParams) ([]Run, error)) *MockQuerier_ClaimRunsDueReminders_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUsers provides a mock function with given fields: ctx
-func (_m *MockQuerier) GetUsers(ctx context.Context) ([]GetUsersRow, error) {
-	ret := _m.Called(ctx)
+// CloseSeason provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CloseSeason(ctx context.Context, arg CloseSeasonParams) (Season, error) {
+	ret := _m.Called(ctx, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUsers")
+		panic("no return value specified for CloseSeason")
 	}
 
-	var r0 []GetUsersRow
+	var r0 Season
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]GetUsersRow, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, CloseSeasonParams) (Season, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []GetUsersRow); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, CloseSeasonParams) Season); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]GetUsersRow)
-		}
+		r0 = ret.Get(0).(Season)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, CloseSeasonParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -336,30 +390,8703 @@ func (_m *MockQuerier) GetUsers(ctx context.Context) ([]GetUsersRow, error) {
 	return r0, r1
 }
 
-// MockQuerier_GetUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsers'
-type MockQuerier_GetUsers_Call struct {
+// MockQuerier_CloseSeason_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseSeason'
+type MockQuerier_CloseSeason_Call struct {
 	*mock.Call
 }
 
-// GetUsers is a helper method to define mock.On call
+// CloseSeason is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockQuerier_Expecter) GetUsers(ctx interface{}) *MockQuerier_GetUsers_Call {
-	return &MockQuerier_GetUsers_Call{Call: _e.mock.On("GetUsers", ctx)}
+//   - arg CloseSeasonParams
+func (_e *MockQuerier_Expecter) CloseSeason(ctx interface{}, arg interface{}) *MockQuerier_CloseSeason_Call {
+	return &MockQuerier_CloseSeason_Call{Call: _e.mock.On("CloseSeason", ctx, arg)}
 }
 
-func (_c *MockQuerier_GetUsers_Call) Run(run func(ctx context.Context)) *MockQuerier_GetUsers_Call {
+func (_c *MockQuerier_CloseSeason_Call) Run(run func(ctx context.Context, arg CloseSeasonParams)) *MockQuerier_CloseSeason_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(CloseSeasonParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_GetUsers_Call) Return(_a0 []GetUsersRow, _a1 error) *MockQuerier_GetUsers_Call {
+func (_c *MockQuerier_CloseSeason_Call) Return(_a0 Season, _a1 error) *MockQuerier_CloseSeason_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_GetUsers_Call) RunAndReturn(run func(context.Context) ([]GetUsersRow, error)) *MockQuerier_GetUsers_Call {
+func (_c *MockQuerier_CloseSeason_Call) RunAndReturn(run func(context.Context, CloseSeasonParams) (Season, error)) *MockQuerier_CloseSeason_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountConfirmedSignups provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CountConfirmedSignups(ctx context.Context, arg CountConfirmedSignupsParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountConfirmedSignups")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, CountConfirmedSignupsParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, CountConfirmedSignupsParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, CountConfirmedSignupsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CountConfirmedSignups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountConfirmedSignups'
+type MockQuerier_CountConfirmedSignups_Call struct {
+	*mock.Call
+}
+
+// CountConfirmedSignups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CountConfirmedSignupsParams
+func (_e *MockQuerier_Expecter) CountConfirmedSignups(ctx interface{}, arg interface{}) *MockQuerier_CountConfirmedSignups_Call {
+	return &MockQuerier_CountConfirmedSignups_Call{Call: _e.mock.On("CountConfirmedSignups", ctx, arg)}
+}
+
+func (_c *MockQuerier_CountConfirmedSignups_Call) Run(run func(ctx context.Context, arg CountConfirmedSignupsParams)) *MockQuerier_CountConfirmedSignups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CountConfirmedSignupsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CountConfirmedSignups_Call) Return(_a0 int64, _a1 error) *MockQuerier_CountConfirmedSignups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CountConfirmedSignups_Call) RunAndReturn(run func(context.Context, CountConfirmedSignupsParams) (int64, error)) *MockQuerier_CountConfirmedSignups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAvailabilityException provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CreateAvailabilityException(ctx context.Context, arg CreateAvailabilityExceptionParams) (AvailabilityException, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAvailabilityException")
+	}
+
+	var r0 AvailabilityException
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, CreateAvailabilityExceptionParams) (AvailabilityException, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, CreateAvailabilityExceptionParams) AvailabilityException); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(AvailabilityException)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, CreateAvailabilityExceptionParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreateAvailabilityException_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAvailabilityException'
+type MockQuerier_CreateAvailabilityException_Call struct {
+	*mock.Call
+}
+
+// CreateAvailabilityException is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateAvailabilityExceptionParams
+func (_e *MockQuerier_Expecter) CreateAvailabilityException(ctx interface{}, arg interface{}) *MockQuerier_CreateAvailabilityException_Call {
+	return &MockQuerier_CreateAvailabilityException_Call{Call: _e.mock.On("CreateAvailabilityException", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateAvailabilityException_Call) Run(run func(ctx context.Context, arg CreateAvailabilityExceptionParams)) *MockQuerier_CreateAvailabilityException_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CreateAvailabilityExceptionParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateAvailabilityException_Call) Return(_a0 AvailabilityException, _a1 error) *MockQuerier_CreateAvailabilityException_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreateAvailabilityException_Call) RunAndReturn(run func(context.Context, CreateAvailabilityExceptionParams) (AvailabilityException, error)) *MockQuerier_CreateAvailabilityException_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAvailabilityWindow provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CreateAvailabilityWindow(ctx context.Context, arg CreateAvailabilityWindowParams) (AvailabilityWindow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAvailabilityWindow")
+	}
+
+	var r0 AvailabilityWindow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, CreateAvailabilityWindowParams) (AvailabilityWindow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, CreateAvailabilityWindowParams) AvailabilityWindow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(AvailabilityWindow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, CreateAvailabilityWindowParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreateAvailabilityWindow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAvailabilityWindow'
+type MockQuerier_CreateAvailabilityWindow_Call struct {
+	*mock.Call
+}
+
+// CreateAvailabilityWindow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateAvailabilityWindowParams
+func (_e *MockQuerier_Expecter) CreateAvailabilityWindow(ctx interface{}, arg interface{}) *MockQuerier_CreateAvailabilityWindow_Call {
+	return &MockQuerier_CreateAvailabilityWindow_Call{Call: _e.mock.On("CreateAvailabilityWindow", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateAvailabilityWindow_Call) Run(run func(ctx context.Context, arg CreateAvailabilityWindowParams)) *MockQuerier_CreateAvailabilityWindow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CreateAvailabilityWindowParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateAvailabilityWindow_Call) Return(_a0 AvailabilityWindow, _a1 error) *MockQuerier_CreateAvailabilityWindow_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreateAvailabilityWindow_Call) RunAndReturn(run func(context.Context, CreateAvailabilityWindowParams) (AvailabilityWindow, error)) *MockQuerier_CreateAvailabilityWindow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCharacter provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CreateCharacter(ctx context.Context, arg CreateCharacterParams) (Character, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCharacter")
+	}
+
+	var r0 Character
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, CreateCharacterParams) (Character, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, CreateCharacterParams) Character); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(Character)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, CreateCharacterParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreateCharacter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCharacter'
+type MockQuerier_CreateCharacter_Call struct {
+	*mock.Call
+}
+
+// CreateCharacter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateCharacterParams
+func (_e *MockQuerier_Expecter) CreateCharacter(ctx interface{}, arg interface{}) *MockQuerier_CreateCharacter_Call {
+	return &MockQuerier_CreateCharacter_Call{Call: _e.mock.On("CreateCharacter", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateCharacter_Call) Run(run func(ctx context.Context, arg CreateCharacterParams)) *MockQuerier_CreateCharacter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CreateCharacterParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateCharacter_Call) Return(_a0 Character, _a1 error) *MockQuerier_CreateCharacter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreateCharacter_Call) RunAndReturn(run func(context.Context, CreateCharacterParams) (Character, error)) *MockQuerier_CreateCharacter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateGuild provides a mock function with given fields: ctx, name
+func (_m *MockQuerier) CreateGuild(ctx context.Context, name string) (Guild, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateGuild")
+	}
+
+	var r0 Guild
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (Guild, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) Guild); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(Guild)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreateGuild_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateGuild'
+type MockQuerier_CreateGuild_Call struct {
+	*mock.Call
+}
+
+// CreateGuild is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockQuerier_Expecter) CreateGuild(ctx interface{}, name interface{}) *MockQuerier_CreateGuild_Call {
+	return &MockQuerier_CreateGuild_Call{Call: _e.mock.On("CreateGuild", ctx, name)}
+}
+
+func (_c *MockQuerier_CreateGuild_Call) Run(run func(ctx context.Context, name string)) *MockQuerier_CreateGuild_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateGuild_Call) Return(_a0 Guild, _a1 error) *MockQuerier_CreateGuild_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreateGuild_Call) RunAndReturn(run func(context.Context, string) (Guild, error)) *MockQuerier_CreateGuild_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateGuildAnnouncement provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CreateGuildAnnouncement(ctx context.Context, arg CreateGuildAnnouncementParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateGuildAnnouncement")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, CreateGuildAnnouncementParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_CreateGuildAnnouncement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateGuildAnnouncement'
+type MockQuerier_CreateGuildAnnouncement_Call struct {
+	*mock.Call
+}
+
+// CreateGuildAnnouncement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateGuildAnnouncementParams
+func (_e *MockQuerier_Expecter) CreateGuildAnnouncement(ctx interface{}, arg interface{}) *MockQuerier_CreateGuildAnnouncement_Call {
+	return &MockQuerier_CreateGuildAnnouncement_Call{Call: _e.mock.On("CreateGuildAnnouncement", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateGuildAnnouncement_Call) Run(run func(ctx context.Context, arg CreateGuildAnnouncementParams)) *MockQuerier_CreateGuildAnnouncement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CreateGuildAnnouncementParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateGuildAnnouncement_Call) Return(_a0 error) *MockQuerier_CreateGuildAnnouncement_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CreateGuildAnnouncement_Call) RunAndReturn(run func(context.Context, CreateGuildAnnouncementParams) error) *MockQuerier_CreateGuildAnnouncement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateInboxNotification provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CreateInboxNotification(ctx context.Context, arg CreateInboxNotificationParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInboxNotification")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, CreateInboxNotificationParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_CreateInboxNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInboxNotification'
+type MockQuerier_CreateInboxNotification_Call struct {
+	*mock.Call
+}
+
+// CreateInboxNotification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateInboxNotificationParams
+func (_e *MockQuerier_Expecter) CreateInboxNotification(ctx interface{}, arg interface{}) *MockQuerier_CreateInboxNotification_Call {
+	return &MockQuerier_CreateInboxNotification_Call{Call: _e.mock.On("CreateInboxNotification", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateInboxNotification_Call) Run(run func(ctx context.Context, arg CreateInboxNotificationParams)) *MockQuerier_CreateInboxNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CreateInboxNotificationParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateInboxNotification_Call) Return(_a0 error) *MockQuerier_CreateInboxNotification_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CreateInboxNotification_Call) RunAndReturn(run func(context.Context, CreateInboxNotificationParams) error) *MockQuerier_CreateInboxNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateJob provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CreateJob(ctx context.Context, arg CreateJobParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJob")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, CreateJobParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, CreateJobParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, CreateJobParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreateJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJob'
+type MockQuerier_CreateJob_Call struct {
+	*mock.Call
+}
+
+// CreateJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateJobParams
+func (_e *MockQuerier_Expecter) CreateJob(ctx interface{}, arg interface{}) *MockQuerier_CreateJob_Call {
+	return &MockQuerier_CreateJob_Call{Call: _e.mock.On("CreateJob", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateJob_Call) Run(run func(ctx context.Context, arg CreateJobParams)) *MockQuerier_CreateJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CreateJobParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateJob_Call) Return(_a0 int64, _a1 error) *MockQuerier_CreateJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreateJob_Call) RunAndReturn(run func(context.Context, CreateJobParams) (int64, error)) *MockQuerier_CreateJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateKeystone provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CreateKeystone(ctx context.Context, arg CreateKeystoneParams) (Keystone, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateKeystone")
+	}
+
+	var r0 Keystone
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, CreateKeystoneParams) (Keystone, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, CreateKeystoneParams) Keystone); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(Keystone)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, CreateKeystoneParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreateKeystone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateKeystone'
+type MockQuerier_CreateKeystone_Call struct {
+	*mock.Call
+}
+
+// CreateKeystone is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateKeystoneParams
+func (_e *MockQuerier_Expecter) CreateKeystone(ctx interface{}, arg interface{}) *MockQuerier_CreateKeystone_Call {
+	return &MockQuerier_CreateKeystone_Call{Call: _e.mock.On("CreateKeystone", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateKeystone_Call) Run(run func(ctx context.Context, arg CreateKeystoneParams)) *MockQuerier_CreateKeystone_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CreateKeystoneParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateKeystone_Call) Return(_a0 Keystone, _a1 error) *MockQuerier_CreateKeystone_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreateKeystone_Call) RunAndReturn(run func(context.Context, CreateKeystoneParams) (Keystone, error)) *MockQuerier_CreateKeystone_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateLFGEntry provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CreateLFGEntry(ctx context.Context, arg CreateLFGEntryParams) (LfgEntry, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLFGEntry")
+	}
+
+	var r0 LfgEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, CreateLFGEntryParams) (LfgEntry, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, CreateLFGEntryParams) LfgEntry); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(LfgEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, CreateLFGEntryParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreateLFGEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLFGEntry'
+type MockQuerier_CreateLFGEntry_Call struct {
+	*mock.Call
+}
+
+// CreateLFGEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateLFGEntryParams
+func (_e *MockQuerier_Expecter) CreateLFGEntry(ctx interface{}, arg interface{}) *MockQuerier_CreateLFGEntry_Call {
+	return &MockQuerier_CreateLFGEntry_Call{Call: _e.mock.On("CreateLFGEntry", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateLFGEntry_Call) Run(run func(ctx context.Context, arg CreateLFGEntryParams)) *MockQuerier_CreateLFGEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CreateLFGEntryParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateLFGEntry_Call) Return(_a0 LfgEntry, _a1 error) *MockQuerier_CreateLFGEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreateLFGEntry_Call) RunAndReturn(run func(context.Context, CreateLFGEntryParams) (LfgEntry, error)) *MockQuerier_CreateLFGEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateLFGProposal provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CreateLFGProposal(ctx context.Context, arg CreateLFGProposalParams) (LfgProposal, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLFGProposal")
+	}
+
+	var r0 LfgProposal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, CreateLFGProposalParams) (LfgProposal, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, CreateLFGProposalParams) LfgProposal); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(LfgProposal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, CreateLFGProposalParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreateLFGProposal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLFGProposal'
+type MockQuerier_CreateLFGProposal_Call struct {
+	*mock.Call
+}
+
+// CreateLFGProposal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateLFGProposalParams
+func (_e *MockQuerier_Expecter) CreateLFGProposal(ctx interface{}, arg interface{}) *MockQuerier_CreateLFGProposal_Call {
+	return &MockQuerier_CreateLFGProposal_Call{Call: _e.mock.On("CreateLFGProposal", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateLFGProposal_Call) Run(run func(ctx context.Context, arg CreateLFGProposalParams)) *MockQuerier_CreateLFGProposal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CreateLFGProposalParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateLFGProposal_Call) Return(_a0 LfgProposal, _a1 error) *MockQuerier_CreateLFGProposal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreateLFGProposal_Call) RunAndReturn(run func(context.Context, CreateLFGProposalParams) (LfgProposal, error)) *MockQuerier_CreateLFGProposal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateLeaderboardSnapshot provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CreateLeaderboardSnapshot(ctx context.Context, arg CreateLeaderboardSnapshotParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLeaderboardSnapshot")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, CreateLeaderboardSnapshotParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_CreateLeaderboardSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLeaderboardSnapshot'
+type MockQuerier_CreateLeaderboardSnapshot_Call struct {
+	*mock.Call
+}
+
+// CreateLeaderboardSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateLeaderboardSnapshotParams
+func (_e *MockQuerier_Expecter) CreateLeaderboardSnapshot(ctx interface{}, arg interface{}) *MockQuerier_CreateLeaderboardSnapshot_Call {
+	return &MockQuerier_CreateLeaderboardSnapshot_Call{Call: _e.mock.On("CreateLeaderboardSnapshot", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateLeaderboardSnapshot_Call) Run(run func(ctx context.Context, arg CreateLeaderboardSnapshotParams)) *MockQuerier_CreateLeaderboardSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CreateLeaderboardSnapshotParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateLeaderboardSnapshot_Call) Return(_a0 error) *MockQuerier_CreateLeaderboardSnapshot_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CreateLeaderboardSnapshot_Call) RunAndReturn(run func(context.Context, CreateLeaderboardSnapshotParams) error) *MockQuerier_CreateLeaderboardSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateNotification provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CreateNotification(ctx context.Context, arg CreateNotificationParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNotification")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, CreateNotificationParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_CreateNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNotification'
+type MockQuerier_CreateNotification_Call struct {
+	*mock.Call
+}
+
+// CreateNotification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateNotificationParams
+func (_e *MockQuerier_Expecter) CreateNotification(ctx interface{}, arg interface{}) *MockQuerier_CreateNotification_Call {
+	return &MockQuerier_CreateNotification_Call{Call: _e.mock.On("CreateNotification", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateNotification_Call) Run(run func(ctx context.Context, arg CreateNotificationParams)) *MockQuerier_CreateNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CreateNotificationParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateNotification_Call) Return(_a0 error) *MockQuerier_CreateNotification_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CreateNotification_Call) RunAndReturn(run func(context.Context, CreateNotificationParams) error) *MockQuerier_CreateNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateNotificationPreference provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CreateNotificationPreference(ctx context.Context, arg CreateNotificationPreferenceParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNotificationPreference")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, CreateNotificationPreferenceParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_CreateNotificationPreference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNotificationPreference'
+type MockQuerier_CreateNotificationPreference_Call struct {
+	*mock.Call
+}
+
+// CreateNotificationPreference is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateNotificationPreferenceParams
+func (_e *MockQuerier_Expecter) CreateNotificationPreference(ctx interface{}, arg interface{}) *MockQuerier_CreateNotificationPreference_Call {
+	return &MockQuerier_CreateNotificationPreference_Call{Call: _e.mock.On("CreateNotificationPreference", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateNotificationPreference_Call) Run(run func(ctx context.Context, arg CreateNotificationPreferenceParams)) *MockQuerier_CreateNotificationPreference_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CreateNotificationPreferenceParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateNotificationPreference_Call) Return(_a0 error) *MockQuerier_CreateNotificationPreference_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CreateNotificationPreference_Call) RunAndReturn(run func(context.Context, CreateNotificationPreferenceParams) error) *MockQuerier_CreateNotificationPreference_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateReadyCheck provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CreateReadyCheck(ctx context.Context, arg CreateReadyCheckParams) (ReadyCheck, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReadyCheck")
+	}
+
+	var r0 ReadyCheck
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, CreateReadyCheckParams) (ReadyCheck, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, CreateReadyCheckParams) ReadyCheck); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(ReadyCheck)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, CreateReadyCheckParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreateReadyCheck_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReadyCheck'
+type MockQuerier_CreateReadyCheck_Call struct {
+	*mock.Call
+}
+
+// CreateReadyCheck is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateReadyCheckParams
+func (_e *MockQuerier_Expecter) CreateReadyCheck(ctx interface{}, arg interface{}) *MockQuerier_CreateReadyCheck_Call {
+	return &MockQuerier_CreateReadyCheck_Call{Call: _e.mock.On("CreateReadyCheck", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateReadyCheck_Call) Run(run func(ctx context.Context, arg CreateReadyCheckParams)) *MockQuerier_CreateReadyCheck_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CreateReadyCheckParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateReadyCheck_Call) Return(_a0 ReadyCheck, _a1 error) *MockQuerier_CreateReadyCheck_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreateReadyCheck_Call) RunAndReturn(run func(context.Context, CreateReadyCheckParams) (ReadyCheck, error)) *MockQuerier_CreateReadyCheck_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRun provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CreateRun(ctx context.Context, arg CreateRunParams) (Run, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRun")
+	}
+
+	var r0 Run
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, CreateRunParams) (Run, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, CreateRunParams) Run); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(Run)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, CreateRunParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreateRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRun'
+type MockQuerier_CreateRun_Call struct {
+	*mock.Call
+}
+
+// CreateRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateRunParams
+func (_e *MockQuerier_Expecter) CreateRun(ctx interface{}, arg interface{}) *MockQuerier_CreateRun_Call {
+	return &MockQuerier_CreateRun_Call{Call: _e.mock.On("CreateRun", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateRun_Call) Run(run func(ctx context.Context, arg CreateRunParams)) *MockQuerier_CreateRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CreateRunParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateRun_Call) Return(_a0 Run, _a1 error) *MockQuerier_CreateRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreateRun_Call) RunAndReturn(run func(context.Context, CreateRunParams) (Run, error)) *MockQuerier_CreateRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRunEvent provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CreateRunEvent(ctx context.Context, arg CreateRunEventParams) (RunEvent, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRunEvent")
+	}
+
+	var r0 RunEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, CreateRunEventParams) (RunEvent, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, CreateRunEventParams) RunEvent); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(RunEvent)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, CreateRunEventParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreateRunEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRunEvent'
+type MockQuerier_CreateRunEvent_Call struct {
+	*mock.Call
+}
+
+// CreateRunEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateRunEventParams
+func (_e *MockQuerier_Expecter) CreateRunEvent(ctx interface{}, arg interface{}) *MockQuerier_CreateRunEvent_Call {
+	return &MockQuerier_CreateRunEvent_Call{Call: _e.mock.On("CreateRunEvent", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateRunEvent_Call) Run(run func(ctx context.Context, arg CreateRunEventParams)) *MockQuerier_CreateRunEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CreateRunEventParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateRunEvent_Call) Return(_a0 RunEvent, _a1 error) *MockQuerier_CreateRunEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreateRunEvent_Call) RunAndReturn(run func(context.Context, CreateRunEventParams) (RunEvent, error)) *MockQuerier_CreateRunEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRunReminder provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CreateRunReminder(ctx context.Context, arg CreateRunReminderParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRunReminder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, CreateRunReminderParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_CreateRunReminder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRunReminder'
+type MockQuerier_CreateRunReminder_Call struct {
+	*mock.Call
+}
+
+// CreateRunReminder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateRunReminderParams
+func (_e *MockQuerier_Expecter) CreateRunReminder(ctx interface{}, arg interface{}) *MockQuerier_CreateRunReminder_Call {
+	return &MockQuerier_CreateRunReminder_Call{Call: _e.mock.On("CreateRunReminder", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateRunReminder_Call) Run(run func(ctx context.Context, arg CreateRunReminderParams)) *MockQuerier_CreateRunReminder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CreateRunReminderParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateRunReminder_Call) Return(_a0 error) *MockQuerier_CreateRunReminder_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CreateRunReminder_Call) RunAndReturn(run func(context.Context, CreateRunReminderParams) error) *MockQuerier_CreateRunReminder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSeason provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CreateSeason(ctx context.Context, arg CreateSeasonParams) (Season, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSeason")
+	}
+
+	var r0 Season
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, CreateSeasonParams) (Season, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, CreateSeasonParams) Season); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(Season)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, CreateSeasonParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreateSeason_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSeason'
+type MockQuerier_CreateSeason_Call struct {
+	*mock.Call
+}
+
+// CreateSeason is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateSeasonParams
+func (_e *MockQuerier_Expecter) CreateSeason(ctx interface{}, arg interface{}) *MockQuerier_CreateSeason_Call {
+	return &MockQuerier_CreateSeason_Call{Call: _e.mock.On("CreateSeason", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateSeason_Call) Run(run func(ctx context.Context, arg CreateSeasonParams)) *MockQuerier_CreateSeason_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CreateSeasonParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateSeason_Call) Return(_a0 Season, _a1 error) *MockQuerier_CreateSeason_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreateSeason_Call) RunAndReturn(run func(context.Context, CreateSeasonParams) (Season, error)) *MockQuerier_CreateSeason_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSeasonAffixes provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CreateSeasonAffixes(ctx context.Context, arg CreateSeasonAffixesParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSeasonAffixes")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, CreateSeasonAffixesParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_CreateSeasonAffixes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSeasonAffixes'
+type MockQuerier_CreateSeasonAffixes_Call struct {
+	*mock.Call
+}
+
+// CreateSeasonAffixes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateSeasonAffixesParams
+func (_e *MockQuerier_Expecter) CreateSeasonAffixes(ctx interface{}, arg interface{}) *MockQuerier_CreateSeasonAffixes_Call {
+	return &MockQuerier_CreateSeasonAffixes_Call{Call: _e.mock.On("CreateSeasonAffixes", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateSeasonAffixes_Call) Run(run func(ctx context.Context, arg CreateSeasonAffixesParams)) *MockQuerier_CreateSeasonAffixes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CreateSeasonAffixesParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateSeasonAffixes_Call) Return(_a0 error) *MockQuerier_CreateSeasonAffixes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CreateSeasonAffixes_Call) RunAndReturn(run func(context.Context, CreateSeasonAffixesParams) error) *MockQuerier_CreateSeasonAffixes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSeries provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CreateSeries(ctx context.Context, arg CreateSeriesParams) (RunSeries, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSeries")
+	}
+
+	var r0 RunSeries
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, CreateSeriesParams) (RunSeries, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, CreateSeriesParams) RunSeries); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(RunSeries)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, CreateSeriesParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreateSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSeries'
+type MockQuerier_CreateSeries_Call struct {
+	*mock.Call
+}
+
+// CreateSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateSeriesParams
+func (_e *MockQuerier_Expecter) CreateSeries(ctx interface{}, arg interface{}) *MockQuerier_CreateSeries_Call {
+	return &MockQuerier_CreateSeries_Call{Call: _e.mock.On("CreateSeries", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateSeries_Call) Run(run func(ctx context.Context, arg CreateSeriesParams)) *MockQuerier_CreateSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CreateSeriesParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateSeries_Call) Return(_a0 RunSeries, _a1 error) *MockQuerier_CreateSeries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreateSeries_Call) RunAndReturn(run func(context.Context, CreateSeriesParams) (RunSeries, error)) *MockQuerier_CreateSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSignup provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CreateSignup(ctx context.Context, arg CreateSignupParams) (RunSignup, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSignup")
+	}
+
+	var r0 RunSignup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, CreateSignupParams) (RunSignup, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, CreateSignupParams) RunSignup); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(RunSignup)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, CreateSignupParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreateSignup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSignup'
+type MockQuerier_CreateSignup_Call struct {
+	*mock.Call
+}
+
+// CreateSignup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateSignupParams
+func (_e *MockQuerier_Expecter) CreateSignup(ctx interface{}, arg interface{}) *MockQuerier_CreateSignup_Call {
+	return &MockQuerier_CreateSignup_Call{Call: _e.mock.On("CreateSignup", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateSignup_Call) Run(run func(ctx context.Context, arg CreateSignupParams)) *MockQuerier_CreateSignup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CreateSignupParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateSignup_Call) Return(_a0 RunSignup, _a1 error) *MockQuerier_CreateSignup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreateSignup_Call) RunAndReturn(run func(context.Context, CreateSignupParams) (RunSignup, error)) *MockQuerier_CreateSignup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateUser provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUser")
+	}
+
+	var r0 User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, CreateUserParams) (User, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, CreateUserParams) User); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, CreateUserParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
+type MockQuerier_CreateUser_Call struct {
+	*mock.Call
+}
+
+// CreateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateUserParams
+func (_e *MockQuerier_Expecter) CreateUser(ctx interface{}, arg interface{}) *MockQuerier_CreateUser_Call {
+	return &MockQuerier_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateUser_Call) Run(run func(ctx context.Context, arg CreateUserParams)) *MockQuerier_CreateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CreateUserParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateUser_Call) Return(_a0 User, _a1 error) *MockQuerier_CreateUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreateUser_Call) RunAndReturn(run func(context.Context, CreateUserParams) (User, error)) *MockQuerier_CreateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWebhookDelivery provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (WebhookDelivery, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhookDelivery")
+	}
+
+	var r0 WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, CreateWebhookDeliveryParams) (WebhookDelivery, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, CreateWebhookDeliveryParams) WebhookDelivery); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(WebhookDelivery)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, CreateWebhookDeliveryParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreateWebhookDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhookDelivery'
+type MockQuerier_CreateWebhookDelivery_Call struct {
+	*mock.Call
+}
+
+// CreateWebhookDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateWebhookDeliveryParams
+func (_e *MockQuerier_Expecter) CreateWebhookDelivery(ctx interface{}, arg interface{}) *MockQuerier_CreateWebhookDelivery_Call {
+	return &MockQuerier_CreateWebhookDelivery_Call{Call: _e.mock.On("CreateWebhookDelivery", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateWebhookDelivery_Call) Run(run func(ctx context.Context, arg CreateWebhookDeliveryParams)) *MockQuerier_CreateWebhookDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CreateWebhookDeliveryParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateWebhookDelivery_Call) Return(_a0 WebhookDelivery, _a1 error) *MockQuerier_CreateWebhookDelivery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreateWebhookDelivery_Call) RunAndReturn(run func(context.Context, CreateWebhookDeliveryParams) (WebhookDelivery, error)) *MockQuerier_CreateWebhookDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWebhookEndpoint provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CreateWebhookEndpoint(ctx context.Context, arg CreateWebhookEndpointParams) (WebhookEndpoint, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhookEndpoint")
+	}
+
+	var r0 WebhookEndpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, CreateWebhookEndpointParams) (WebhookEndpoint, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, CreateWebhookEndpointParams) WebhookEndpoint); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(WebhookEndpoint)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, CreateWebhookEndpointParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreateWebhookEndpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhookEndpoint'
+type MockQuerier_CreateWebhookEndpoint_Call struct {
+	*mock.Call
+}
+
+// CreateWebhookEndpoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CreateWebhookEndpointParams
+func (_e *MockQuerier_Expecter) CreateWebhookEndpoint(ctx interface{}, arg interface{}) *MockQuerier_CreateWebhookEndpoint_Call {
+	return &MockQuerier_CreateWebhookEndpoint_Call{Call: _e.mock.On("CreateWebhookEndpoint", ctx, arg)}
+}
+
+func (_c *MockQuerier_CreateWebhookEndpoint_Call) Run(run func(ctx context.Context, arg CreateWebhookEndpointParams)) *MockQuerier_CreateWebhookEndpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CreateWebhookEndpointParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateWebhookEndpoint_Call) Return(_a0 WebhookEndpoint, _a1 error) *MockQuerier_CreateWebhookEndpoint_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreateWebhookEndpoint_Call) RunAndReturn(run func(context.Context, CreateWebhookEndpointParams) (WebhookEndpoint, error)) *MockQuerier_CreateWebhookEndpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeactivateAffixes provides a mock function with given fields: ctx
+func (_m *MockQuerier) DeactivateAffixes(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeactivateAffixes")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_DeactivateAffixes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeactivateAffixes'
+type MockQuerier_DeactivateAffixes_Call struct {
+	*mock.Call
+}
+
+// DeactivateAffixes is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuerier_Expecter) DeactivateAffixes(ctx interface{}) *MockQuerier_DeactivateAffixes_Call {
+	return &MockQuerier_DeactivateAffixes_Call{Call: _e.mock.On("DeactivateAffixes", ctx)}
+}
+
+func (_c *MockQuerier_DeactivateAffixes_Call) Run(run func(ctx context.Context)) *MockQuerier_DeactivateAffixes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeactivateAffixes_Call) Return(_a0 error) *MockQuerier_DeactivateAffixes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_DeactivateAffixes_Call) RunAndReturn(run func(context.Context) error) *MockQuerier_DeactivateAffixes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeactivateDungeons provides a mock function with given fields: ctx
+func (_m *MockQuerier) DeactivateDungeons(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeactivateDungeons")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_DeactivateDungeons_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeactivateDungeons'
+type MockQuerier_DeactivateDungeons_Call struct {
+	*mock.Call
+}
+
+// DeactivateDungeons is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuerier_Expecter) DeactivateDungeons(ctx interface{}) *MockQuerier_DeactivateDungeons_Call {
+	return &MockQuerier_DeactivateDungeons_Call{Call: _e.mock.On("DeactivateDungeons", ctx)}
+}
+
+func (_c *MockQuerier_DeactivateDungeons_Call) Run(run func(ctx context.Context)) *MockQuerier_DeactivateDungeons_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeactivateDungeons_Call) Return(_a0 error) *MockQuerier_DeactivateDungeons_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_DeactivateDungeons_Call) RunAndReturn(run func(context.Context) error) *MockQuerier_DeactivateDungeons_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeferGuildAnnouncements provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DeferGuildAnnouncements(ctx context.Context, arg DeferGuildAnnouncementsParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeferGuildAnnouncements")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DeferGuildAnnouncementsParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_DeferGuildAnnouncements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeferGuildAnnouncements'
+type MockQuerier_DeferGuildAnnouncements_Call struct {
+	*mock.Call
+}
+
+// DeferGuildAnnouncements is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg DeferGuildAnnouncementsParams
+func (_e *MockQuerier_Expecter) DeferGuildAnnouncements(ctx interface{}, arg interface{}) *MockQuerier_DeferGuildAnnouncements_Call {
+	return &MockQuerier_DeferGuildAnnouncements_Call{Call: _e.mock.On("DeferGuildAnnouncements", ctx, arg)}
+}
+
+func (_c *MockQuerier_DeferGuildAnnouncements_Call) Run(run func(ctx context.Context, arg DeferGuildAnnouncementsParams)) *MockQuerier_DeferGuildAnnouncements_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DeferGuildAnnouncementsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeferGuildAnnouncements_Call) Return(_a0 error) *MockQuerier_DeferGuildAnnouncements_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_DeferGuildAnnouncements_Call) RunAndReturn(run func(context.Context, DeferGuildAnnouncementsParams) error) *MockQuerier_DeferGuildAnnouncements_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeferNotification provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DeferNotification(ctx context.Context, arg DeferNotificationParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeferNotification")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DeferNotificationParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DeferNotificationParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DeferNotificationParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_DeferNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeferNotification'
+type MockQuerier_DeferNotification_Call struct {
+	*mock.Call
+}
+
+// DeferNotification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg DeferNotificationParams
+func (_e *MockQuerier_Expecter) DeferNotification(ctx interface{}, arg interface{}) *MockQuerier_DeferNotification_Call {
+	return &MockQuerier_DeferNotification_Call{Call: _e.mock.On("DeferNotification", ctx, arg)}
+}
+
+func (_c *MockQuerier_DeferNotification_Call) Run(run func(ctx context.Context, arg DeferNotificationParams)) *MockQuerier_DeferNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DeferNotificationParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeferNotification_Call) Return(_a0 int64, _a1 error) *MockQuerier_DeferNotification_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_DeferNotification_Call) RunAndReturn(run func(context.Context, DeferNotificationParams) (int64, error)) *MockQuerier_DeferNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAvailabilityException provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DeleteAvailabilityException(ctx context.Context, arg DeleteAvailabilityExceptionParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAvailabilityException")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DeleteAvailabilityExceptionParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DeleteAvailabilityExceptionParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DeleteAvailabilityExceptionParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_DeleteAvailabilityException_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAvailabilityException'
+type MockQuerier_DeleteAvailabilityException_Call struct {
+	*mock.Call
+}
+
+// DeleteAvailabilityException is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg DeleteAvailabilityExceptionParams
+func (_e *MockQuerier_Expecter) DeleteAvailabilityException(ctx interface{}, arg interface{}) *MockQuerier_DeleteAvailabilityException_Call {
+	return &MockQuerier_DeleteAvailabilityException_Call{Call: _e.mock.On("DeleteAvailabilityException", ctx, arg)}
+}
+
+func (_c *MockQuerier_DeleteAvailabilityException_Call) Run(run func(ctx context.Context, arg DeleteAvailabilityExceptionParams)) *MockQuerier_DeleteAvailabilityException_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DeleteAvailabilityExceptionParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeleteAvailabilityException_Call) Return(_a0 int64, _a1 error) *MockQuerier_DeleteAvailabilityException_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_DeleteAvailabilityException_Call) RunAndReturn(run func(context.Context, DeleteAvailabilityExceptionParams) (int64, error)) *MockQuerier_DeleteAvailabilityException_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAvailabilityWindows provides a mock function with given fields: ctx, userID
+func (_m *MockQuerier) DeleteAvailabilityWindows(ctx context.Context, userID int32) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAvailabilityWindows")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_DeleteAvailabilityWindows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAvailabilityWindows'
+type MockQuerier_DeleteAvailabilityWindows_Call struct {
+	*mock.Call
+}
+
+// DeleteAvailabilityWindows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int32
+func (_e *MockQuerier_Expecter) DeleteAvailabilityWindows(ctx interface{}, userID interface{}) *MockQuerier_DeleteAvailabilityWindows_Call {
+	return &MockQuerier_DeleteAvailabilityWindows_Call{Call: _e.mock.On("DeleteAvailabilityWindows", ctx, userID)}
+}
+
+func (_c *MockQuerier_DeleteAvailabilityWindows_Call) Run(run func(ctx context.Context, userID int32)) *MockQuerier_DeleteAvailabilityWindows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeleteAvailabilityWindows_Call) Return(_a0 error) *MockQuerier_DeleteAvailabilityWindows_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_DeleteAvailabilityWindows_Call) RunAndReturn(run func(context.Context, int32) error) *MockQuerier_DeleteAvailabilityWindows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCharacter provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DeleteCharacter(ctx context.Context, arg DeleteCharacterParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCharacter")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DeleteCharacterParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DeleteCharacterParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DeleteCharacterParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_DeleteCharacter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCharacter'
+type MockQuerier_DeleteCharacter_Call struct {
+	*mock.Call
+}
+
+// DeleteCharacter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg DeleteCharacterParams
+func (_e *MockQuerier_Expecter) DeleteCharacter(ctx interface{}, arg interface{}) *MockQuerier_DeleteCharacter_Call {
+	return &MockQuerier_DeleteCharacter_Call{Call: _e.mock.On("DeleteCharacter", ctx, arg)}
+}
+
+func (_c *MockQuerier_DeleteCharacter_Call) Run(run func(ctx context.Context, arg DeleteCharacterParams)) *MockQuerier_DeleteCharacter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DeleteCharacterParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeleteCharacter_Call) Return(_a0 int64, _a1 error) *MockQuerier_DeleteCharacter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_DeleteCharacter_Call) RunAndReturn(run func(context.Context, DeleteCharacterParams) (int64, error)) *MockQuerier_DeleteCharacter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteJob provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DeleteJob(ctx context.Context, arg DeleteJobParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteJob")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DeleteJobParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DeleteJobParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DeleteJobParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_DeleteJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteJob'
+type MockQuerier_DeleteJob_Call struct {
+	*mock.Call
+}
+
+// DeleteJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg DeleteJobParams
+func (_e *MockQuerier_Expecter) DeleteJob(ctx interface{}, arg interface{}) *MockQuerier_DeleteJob_Call {
+	return &MockQuerier_DeleteJob_Call{Call: _e.mock.On("DeleteJob", ctx, arg)}
+}
+
+func (_c *MockQuerier_DeleteJob_Call) Run(run func(ctx context.Context, arg DeleteJobParams)) *MockQuerier_DeleteJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DeleteJobParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeleteJob_Call) Return(_a0 int64, _a1 error) *MockQuerier_DeleteJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_DeleteJob_Call) RunAndReturn(run func(context.Context, DeleteJobParams) (int64, error)) *MockQuerier_DeleteJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteKeystone provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DeleteKeystone(ctx context.Context, arg DeleteKeystoneParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteKeystone")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DeleteKeystoneParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DeleteKeystoneParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DeleteKeystoneParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_DeleteKeystone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteKeystone'
+type MockQuerier_DeleteKeystone_Call struct {
+	*mock.Call
+}
+
+// DeleteKeystone is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg DeleteKeystoneParams
+func (_e *MockQuerier_Expecter) DeleteKeystone(ctx interface{}, arg interface{}) *MockQuerier_DeleteKeystone_Call {
+	return &MockQuerier_DeleteKeystone_Call{Call: _e.mock.On("DeleteKeystone", ctx, arg)}
+}
+
+func (_c *MockQuerier_DeleteKeystone_Call) Run(run func(ctx context.Context, arg DeleteKeystoneParams)) *MockQuerier_DeleteKeystone_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DeleteKeystoneParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeleteKeystone_Call) Return(_a0 int64, _a1 error) *MockQuerier_DeleteKeystone_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_DeleteKeystone_Call) RunAndReturn(run func(context.Context, DeleteKeystoneParams) (int64, error)) *MockQuerier_DeleteKeystone_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteNotificationPreferences provides a mock function with given fields: ctx, userID
+func (_m *MockQuerier) DeleteNotificationPreferences(ctx context.Context, userID int32) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteNotificationPreferences")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_DeleteNotificationPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteNotificationPreferences'
+type MockQuerier_DeleteNotificationPreferences_Call struct {
+	*mock.Call
+}
+
+// DeleteNotificationPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int32
+func (_e *MockQuerier_Expecter) DeleteNotificationPreferences(ctx interface{}, userID interface{}) *MockQuerier_DeleteNotificationPreferences_Call {
+	return &MockQuerier_DeleteNotificationPreferences_Call{Call: _e.mock.On("DeleteNotificationPreferences", ctx, userID)}
+}
+
+func (_c *MockQuerier_DeleteNotificationPreferences_Call) Run(run func(ctx context.Context, userID int32)) *MockQuerier_DeleteNotificationPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeleteNotificationPreferences_Call) Return(_a0 error) *MockQuerier_DeleteNotificationPreferences_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_DeleteNotificationPreferences_Call) RunAndReturn(run func(context.Context, int32) error) *MockQuerier_DeleteNotificationPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRunReminders provides a mock function with given fields: ctx, runID
+func (_m *MockQuerier) DeleteRunReminders(ctx context.Context, runID int32) error {
+	ret := _m.Called(ctx, runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRunReminders")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, runID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_DeleteRunReminders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRunReminders'
+type MockQuerier_DeleteRunReminders_Call struct {
+	*mock.Call
+}
+
+// DeleteRunReminders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runID int32
+func (_e *MockQuerier_Expecter) DeleteRunReminders(ctx interface{}, runID interface{}) *MockQuerier_DeleteRunReminders_Call {
+	return &MockQuerier_DeleteRunReminders_Call{Call: _e.mock.On("DeleteRunReminders", ctx, runID)}
+}
+
+func (_c *MockQuerier_DeleteRunReminders_Call) Run(run func(ctx context.Context, runID int32)) *MockQuerier_DeleteRunReminders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeleteRunReminders_Call) Return(_a0 error) *MockQuerier_DeleteRunReminders_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_DeleteRunReminders_Call) RunAndReturn(run func(context.Context, int32) error) *MockQuerier_DeleteRunReminders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSeasonAffixes provides a mock function with given fields: ctx, seasonID
+func (_m *MockQuerier) DeleteSeasonAffixes(ctx context.Context, seasonID int32) error {
+	ret := _m.Called(ctx, seasonID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSeasonAffixes")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, seasonID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_DeleteSeasonAffixes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSeasonAffixes'
+type MockQuerier_DeleteSeasonAffixes_Call struct {
+	*mock.Call
+}
+
+// DeleteSeasonAffixes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - seasonID int32
+func (_e *MockQuerier_Expecter) DeleteSeasonAffixes(ctx interface{}, seasonID interface{}) *MockQuerier_DeleteSeasonAffixes_Call {
+	return &MockQuerier_DeleteSeasonAffixes_Call{Call: _e.mock.On("DeleteSeasonAffixes", ctx, seasonID)}
+}
+
+func (_c *MockQuerier_DeleteSeasonAffixes_Call) Run(run func(ctx context.Context, seasonID int32)) *MockQuerier_DeleteSeasonAffixes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeleteSeasonAffixes_Call) Return(_a0 error) *MockQuerier_DeleteSeasonAffixes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_DeleteSeasonAffixes_Call) RunAndReturn(run func(context.Context, int32) error) *MockQuerier_DeleteSeasonAffixes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhookEndpoint provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) DeleteWebhookEndpoint(ctx context.Context, id int32) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhookEndpoint")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_DeleteWebhookEndpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhookEndpoint'
+type MockQuerier_DeleteWebhookEndpoint_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhookEndpoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockQuerier_Expecter) DeleteWebhookEndpoint(ctx interface{}, id interface{}) *MockQuerier_DeleteWebhookEndpoint_Call {
+	return &MockQuerier_DeleteWebhookEndpoint_Call{Call: _e.mock.On("DeleteWebhookEndpoint", ctx, id)}
+}
+
+func (_c *MockQuerier_DeleteWebhookEndpoint_Call) Run(run func(ctx context.Context, id int32)) *MockQuerier_DeleteWebhookEndpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeleteWebhookEndpoint_Call) Return(_a0 error) *MockQuerier_DeleteWebhookEndpoint_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_DeleteWebhookEndpoint_Call) RunAndReturn(run func(context.Context, int32) error) *MockQuerier_DeleteWebhookEndpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EndSeries provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) EndSeries(ctx context.Context, arg EndSeriesParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EndSeries")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, EndSeriesParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_EndSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EndSeries'
+type MockQuerier_EndSeries_Call struct {
+	*mock.Call
+}
+
+// EndSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg EndSeriesParams
+func (_e *MockQuerier_Expecter) EndSeries(ctx interface{}, arg interface{}) *MockQuerier_EndSeries_Call {
+	return &MockQuerier_EndSeries_Call{Call: _e.mock.On("EndSeries", ctx, arg)}
+}
+
+func (_c *MockQuerier_EndSeries_Call) Run(run func(ctx context.Context, arg EndSeriesParams)) *MockQuerier_EndSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(EndSeriesParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_EndSeries_Call) Return(_a0 error) *MockQuerier_EndSeries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_EndSeries_Call) RunAndReturn(run func(context.Context, EndSeriesParams) error) *MockQuerier_EndSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpireJobLeases provides a mock function with given fields: ctx, now
+func (_m *MockQuerier) ExpireJobLeases(ctx context.Context, now pgtype.Timestamptz) error {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireJobLeases")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) error); ok {
+		r0 = rf(ctx, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_ExpireJobLeases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireJobLeases'
+type MockQuerier_ExpireJobLeases_Call struct {
+	*mock.Call
+}
+
+// ExpireJobLeases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now pgtype.Timestamptz
+func (_e *MockQuerier_Expecter) ExpireJobLeases(ctx interface{}, now interface{}) *MockQuerier_ExpireJobLeases_Call {
+	return &MockQuerier_ExpireJobLeases_Call{Call: _e.mock.On("ExpireJobLeases", ctx, now)}
+}
+
+func (_c *MockQuerier_ExpireJobLeases_Call) Run(run func(ctx context.Context, now pgtype.Timestamptz)) *MockQuerier_ExpireJobLeases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Timestamptz))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ExpireJobLeases_Call) Return(_a0 error) *MockQuerier_ExpireJobLeases_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_ExpireJobLeases_Call) RunAndReturn(run func(context.Context, pgtype.Timestamptz) error) *MockQuerier_ExpireJobLeases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinishReadyCheck provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) FinishReadyCheck(ctx context.Context, arg FinishReadyCheckParams) (ReadyCheck, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinishReadyCheck")
+	}
+
+	var r0 ReadyCheck
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, FinishReadyCheckParams) (ReadyCheck, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, FinishReadyCheckParams) ReadyCheck); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(ReadyCheck)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, FinishReadyCheckParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_FinishReadyCheck_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishReadyCheck'
+type MockQuerier_FinishReadyCheck_Call struct {
+	*mock.Call
+}
+
+// FinishReadyCheck is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg FinishReadyCheckParams
+func (_e *MockQuerier_Expecter) FinishReadyCheck(ctx interface{}, arg interface{}) *MockQuerier_FinishReadyCheck_Call {
+	return &MockQuerier_FinishReadyCheck_Call{Call: _e.mock.On("FinishReadyCheck", ctx, arg)}
+}
+
+func (_c *MockQuerier_FinishReadyCheck_Call) Run(run func(ctx context.Context, arg FinishReadyCheckParams)) *MockQuerier_FinishReadyCheck_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(FinishReadyCheckParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_FinishReadyCheck_Call) Return(_a0 ReadyCheck, _a1 error) *MockQuerier_FinishReadyCheck_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_FinishReadyCheck_Call) RunAndReturn(run func(context.Context, FinishReadyCheckParams) (ReadyCheck, error)) *MockQuerier_FinishReadyCheck_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveLFGEntry provides a mock function with given fields: ctx, userID
+func (_m *MockQuerier) GetActiveLFGEntry(ctx context.Context, userID int32) (GetActiveLFGEntryRow, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveLFGEntry")
+	}
+
+	var r0 GetActiveLFGEntryRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (GetActiveLFGEntryRow, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) GetActiveLFGEntryRow); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(GetActiveLFGEntryRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetActiveLFGEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveLFGEntry'
+type MockQuerier_GetActiveLFGEntry_Call struct {
+	*mock.Call
+}
+
+// GetActiveLFGEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int32
+func (_e *MockQuerier_Expecter) GetActiveLFGEntry(ctx interface{}, userID interface{}) *MockQuerier_GetActiveLFGEntry_Call {
+	return &MockQuerier_GetActiveLFGEntry_Call{Call: _e.mock.On("GetActiveLFGEntry", ctx, userID)}
+}
+
+func (_c *MockQuerier_GetActiveLFGEntry_Call) Run(run func(ctx context.Context, userID int32)) *MockQuerier_GetActiveLFGEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetActiveLFGEntry_Call) Return(_a0 GetActiveLFGEntryRow, _a1 error) *MockQuerier_GetActiveLFGEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetActiveLFGEntry_Call) RunAndReturn(run func(context.Context, int32) (GetActiveLFGEntryRow, error)) *MockQuerier_GetActiveLFGEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveSignup provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) GetActiveSignup(ctx context.Context, arg GetActiveSignupParams) (RunSignup, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveSignup")
+	}
+
+	var r0 RunSignup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, GetActiveSignupParams) (RunSignup, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, GetActiveSignupParams) RunSignup); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(RunSignup)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, GetActiveSignupParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetActiveSignup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveSignup'
+type MockQuerier_GetActiveSignup_Call struct {
+	*mock.Call
+}
+
+// GetActiveSignup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg GetActiveSignupParams
+func (_e *MockQuerier_Expecter) GetActiveSignup(ctx interface{}, arg interface{}) *MockQuerier_GetActiveSignup_Call {
+	return &MockQuerier_GetActiveSignup_Call{Call: _e.mock.On("GetActiveSignup", ctx, arg)}
+}
+
+func (_c *MockQuerier_GetActiveSignup_Call) Run(run func(ctx context.Context, arg GetActiveSignupParams)) *MockQuerier_GetActiveSignup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetActiveSignupParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetActiveSignup_Call) Return(_a0 RunSignup, _a1 error) *MockQuerier_GetActiveSignup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetActiveSignup_Call) RunAndReturn(run func(context.Context, GetActiveSignupParams) (RunSignup, error)) *MockQuerier_GetActiveSignup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAffixByCode provides a mock function with given fields: ctx, code
+func (_m *MockQuerier) GetAffixByCode(ctx context.Context, code string) (Affix, error) {
+	ret := _m.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAffixByCode")
+	}
+
+	var r0 Affix
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (Affix, error)); ok {
+		return rf(ctx, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) Affix); ok {
+		r0 = rf(ctx, code)
+	} else {
+		r0 = ret.Get(0).(Affix)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetAffixByCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAffixByCode'
+type MockQuerier_GetAffixByCode_Call struct {
+	*mock.Call
+}
+
+// GetAffixByCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+func (_e *MockQuerier_Expecter) GetAffixByCode(ctx interface{}, code interface{}) *MockQuerier_GetAffixByCode_Call {
+	return &MockQuerier_GetAffixByCode_Call{Call: _e.mock.On("GetAffixByCode", ctx, code)}
+}
+
+func (_c *MockQuerier_GetAffixByCode_Call) Run(run func(ctx context.Context, code string)) *MockQuerier_GetAffixByCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetAffixByCode_Call) Return(_a0 Affix, _a1 error) *MockQuerier_GetAffixByCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetAffixByCode_Call) RunAndReturn(run func(context.Context, string) (Affix, error)) *MockQuerier_GetAffixByCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAffixes provides a mock function with given fields: ctx
+func (_m *MockQuerier) GetAffixes(ctx context.Context) ([]Affix, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAffixes")
+	}
+
+	var r0 []Affix
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]Affix, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []Affix); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Affix)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetAffixes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAffixes'
+type MockQuerier_GetAffixes_Call struct {
+	*mock.Call
+}
+
+// GetAffixes is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuerier_Expecter) GetAffixes(ctx interface{}) *MockQuerier_GetAffixes_Call {
+	return &MockQuerier_GetAffixes_Call{Call: _e.mock.On("GetAffixes", ctx)}
+}
+
+func (_c *MockQuerier_GetAffixes_Call) Run(run func(ctx context.Context)) *MockQuerier_GetAffixes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetAffixes_Call) Return(_a0 []Affix, _a1 error) *MockQuerier_GetAffixes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetAffixes_Call) RunAndReturn(run func(context.Context) ([]Affix, error)) *MockQuerier_GetAffixes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAvailabilityExceptions provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) GetAvailabilityExceptions(ctx context.Context, arg GetAvailabilityExceptionsParams) ([]AvailabilityException, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAvailabilityExceptions")
+	}
+
+	var r0 []AvailabilityException
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, GetAvailabilityExceptionsParams) ([]AvailabilityException, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, GetAvailabilityExceptionsParams) []AvailabilityException); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]AvailabilityException)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, GetAvailabilityExceptionsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetAvailabilityExceptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAvailabilityExceptions'
+type MockQuerier_GetAvailabilityExceptions_Call struct {
+	*mock.Call
+}
+
+// GetAvailabilityExceptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg GetAvailabilityExceptionsParams
+func (_e *MockQuerier_Expecter) GetAvailabilityExceptions(ctx interface{}, arg interface{}) *MockQuerier_GetAvailabilityExceptions_Call {
+	return &MockQuerier_GetAvailabilityExceptions_Call{Call: _e.mock.On("GetAvailabilityExceptions", ctx, arg)}
+}
+
+func (_c *MockQuerier_GetAvailabilityExceptions_Call) Run(run func(ctx context.Context, arg GetAvailabilityExceptionsParams)) *MockQuerier_GetAvailabilityExceptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetAvailabilityExceptionsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetAvailabilityExceptions_Call) Return(_a0 []AvailabilityException, _a1 error) *MockQuerier_GetAvailabilityExceptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetAvailabilityExceptions_Call) RunAndReturn(run func(context.Context, GetAvailabilityExceptionsParams) ([]AvailabilityException, error)) *MockQuerier_GetAvailabilityExceptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAvailabilityWindows provides a mock function with given fields: ctx, userIds
+func (_m *MockQuerier) GetAvailabilityWindows(ctx context.Context, userIds []int32) ([]AvailabilityWindow, error) {
+	ret := _m.Called(ctx, userIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAvailabilityWindows")
+	}
+
+	var r0 []AvailabilityWindow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int32) ([]AvailabilityWindow, error)); ok {
+		return rf(ctx, userIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int32) []AvailabilityWindow); ok {
+		r0 = rf(ctx, userIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]AvailabilityWindow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int32) error); ok {
+		r1 = rf(ctx, userIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetAvailabilityWindows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAvailabilityWindows'
+type MockQuerier_GetAvailabilityWindows_Call struct {
+	*mock.Call
+}
+
+// GetAvailabilityWindows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userIds []int32
+func (_e *MockQuerier_Expecter) GetAvailabilityWindows(ctx interface{}, userIds interface{}) *MockQuerier_GetAvailabilityWindows_Call {
+	return &MockQuerier_GetAvailabilityWindows_Call{Call: _e.mock.On("GetAvailabilityWindows", ctx, userIds)}
+}
+
+func (_c *MockQuerier_GetAvailabilityWindows_Call) Run(run func(ctx context.Context, userIds []int32)) *MockQuerier_GetAvailabilityWindows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetAvailabilityWindows_Call) Return(_a0 []AvailabilityWindow, _a1 error) *MockQuerier_GetAvailabilityWindows_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetAvailabilityWindows_Call) RunAndReturn(run func(context.Context, []int32) ([]AvailabilityWindow, error)) *MockQuerier_GetAvailabilityWindows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCatalogVersion provides a mock function with given fields: ctx, catalog
+func (_m *MockQuerier) GetCatalogVersion(ctx context.Context, catalog string) (int32, error) {
+	ret := _m.Called(ctx, catalog)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCatalogVersion")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int32, error)); ok {
+		return rf(ctx, catalog)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int32); ok {
+		r0 = rf(ctx, catalog)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, catalog)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetCatalogVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCatalogVersion'
+type MockQuerier_GetCatalogVersion_Call struct {
+	*mock.Call
+}
+
+// GetCatalogVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - catalog string
+func (_e *MockQuerier_Expecter) GetCatalogVersion(ctx interface{}, catalog interface{}) *MockQuerier_GetCatalogVersion_Call {
+	return &MockQuerier_GetCatalogVersion_Call{Call: _e.mock.On("GetCatalogVersion", ctx, catalog)}
+}
+
+func (_c *MockQuerier_GetCatalogVersion_Call) Run(run func(ctx context.Context, catalog string)) *MockQuerier_GetCatalogVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetCatalogVersion_Call) Return(_a0 int32, _a1 error) *MockQuerier_GetCatalogVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetCatalogVersion_Call) RunAndReturn(run func(context.Context, string) (int32, error)) *MockQuerier_GetCatalogVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCharacterByID provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) GetCharacterByID(ctx context.Context, id int32) (GetCharacterByIDRow, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCharacterByID")
+	}
+
+	var r0 GetCharacterByIDRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (GetCharacterByIDRow, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) GetCharacterByIDRow); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(GetCharacterByIDRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetCharacterByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCharacterByID'
+type MockQuerier_GetCharacterByID_Call struct {
+	*mock.Call
+}
+
+// GetCharacterByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockQuerier_Expecter) GetCharacterByID(ctx interface{}, id interface{}) *MockQuerier_GetCharacterByID_Call {
+	return &MockQuerier_GetCharacterByID_Call{Call: _e.mock.On("GetCharacterByID", ctx, id)}
+}
+
+func (_c *MockQuerier_GetCharacterByID_Call) Run(run func(ctx context.Context, id int32)) *MockQuerier_GetCharacterByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetCharacterByID_Call) Return(_a0 GetCharacterByIDRow, _a1 error) *MockQuerier_GetCharacterByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetCharacterByID_Call) RunAndReturn(run func(context.Context, int32) (GetCharacterByIDRow, error)) *MockQuerier_GetCharacterByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCharacterByName provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) GetCharacterByName(ctx context.Context, arg GetCharacterByNameParams) (GetCharacterByNameRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCharacterByName")
+	}
+
+	var r0 GetCharacterByNameRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, GetCharacterByNameParams) (GetCharacterByNameRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, GetCharacterByNameParams) GetCharacterByNameRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(GetCharacterByNameRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, GetCharacterByNameParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetCharacterByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCharacterByName'
+type MockQuerier_GetCharacterByName_Call struct {
+	*mock.Call
+}
+
+// GetCharacterByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg GetCharacterByNameParams
+func (_e *MockQuerier_Expecter) GetCharacterByName(ctx interface{}, arg interface{}) *MockQuerier_GetCharacterByName_Call {
+	return &MockQuerier_GetCharacterByName_Call{Call: _e.mock.On("GetCharacterByName", ctx, arg)}
+}
+
+func (_c *MockQuerier_GetCharacterByName_Call) Run(run func(ctx context.Context, arg GetCharacterByNameParams)) *MockQuerier_GetCharacterByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetCharacterByNameParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetCharacterByName_Call) Return(_a0 GetCharacterByNameRow, _a1 error) *MockQuerier_GetCharacterByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetCharacterByName_Call) RunAndReturn(run func(context.Context, GetCharacterByNameParams) (GetCharacterByNameRow, error)) *MockQuerier_GetCharacterByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDungeonByCode provides a mock function with given fields: ctx, code
+func (_m *MockQuerier) GetDungeonByCode(ctx context.Context, code string) (Dungeon, error) {
+	ret := _m.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDungeonByCode")
+	}
+
+	var r0 Dungeon
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (Dungeon, error)); ok {
+		return rf(ctx, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) Dungeon); ok {
+		r0 = rf(ctx, code)
+	} else {
+		r0 = ret.Get(0).(Dungeon)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetDungeonByCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDungeonByCode'
+type MockQuerier_GetDungeonByCode_Call struct {
+	*mock.Call
+}
+
+// GetDungeonByCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+func (_e *MockQuerier_Expecter) GetDungeonByCode(ctx interface{}, code interface{}) *MockQuerier_GetDungeonByCode_Call {
+	return &MockQuerier_GetDungeonByCode_Call{Call: _e.mock.On("GetDungeonByCode", ctx, code)}
+}
+
+func (_c *MockQuerier_GetDungeonByCode_Call) Run(run func(ctx context.Context, code string)) *MockQuerier_GetDungeonByCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetDungeonByCode_Call) Return(_a0 Dungeon, _a1 error) *MockQuerier_GetDungeonByCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetDungeonByCode_Call) RunAndReturn(run func(context.Context, string) (Dungeon, error)) *MockQuerier_GetDungeonByCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDungeonByID provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) GetDungeonByID(ctx context.Context, id int32) (Dungeon, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDungeonByID")
+	}
+
+	var r0 Dungeon
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (Dungeon, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) Dungeon); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(Dungeon)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetDungeonByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDungeonByID'
+type MockQuerier_GetDungeonByID_Call struct {
+	*mock.Call
+}
+
+// GetDungeonByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockQuerier_Expecter) GetDungeonByID(ctx interface{}, id interface{}) *MockQuerier_GetDungeonByID_Call {
+	return &MockQuerier_GetDungeonByID_Call{Call: _e.mock.On("GetDungeonByID", ctx, id)}
+}
+
+func (_c *MockQuerier_GetDungeonByID_Call) Run(run func(ctx context.Context, id int32)) *MockQuerier_GetDungeonByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetDungeonByID_Call) Return(_a0 Dungeon, _a1 error) *MockQuerier_GetDungeonByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetDungeonByID_Call) RunAndReturn(run func(context.Context, int32) (Dungeon, error)) *MockQuerier_GetDungeonByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDungeons provides a mock function with given fields: ctx
+func (_m *MockQuerier) GetDungeons(ctx context.Context) ([]Dungeon, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDungeons")
+	}
+
+	var r0 []Dungeon
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]Dungeon, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []Dungeon); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Dungeon)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetDungeons_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDungeons'
+type MockQuerier_GetDungeons_Call struct {
+	*mock.Call
+}
+
+// GetDungeons is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuerier_Expecter) GetDungeons(ctx interface{}) *MockQuerier_GetDungeons_Call {
+	return &MockQuerier_GetDungeons_Call{Call: _e.mock.On("GetDungeons", ctx)}
+}
+
+func (_c *MockQuerier_GetDungeons_Call) Run(run func(ctx context.Context)) *MockQuerier_GetDungeons_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetDungeons_Call) Return(_a0 []Dungeon, _a1 error) *MockQuerier_GetDungeons_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetDungeons_Call) RunAndReturn(run func(context.Context) ([]Dungeon, error)) *MockQuerier_GetDungeons_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGuildByID provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) GetGuildByID(ctx context.Context, id int32) (Guild, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGuildByID")
+	}
+
+	var r0 Guild
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (Guild, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) Guild); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(Guild)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetGuildByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGuildByID'
+type MockQuerier_GetGuildByID_Call struct {
+	*mock.Call
+}
+
+// GetGuildByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockQuerier_Expecter) GetGuildByID(ctx interface{}, id interface{}) *MockQuerier_GetGuildByID_Call {
+	return &MockQuerier_GetGuildByID_Call{Call: _e.mock.On("GetGuildByID", ctx, id)}
+}
+
+func (_c *MockQuerier_GetGuildByID_Call) Run(run func(ctx context.Context, id int32)) *MockQuerier_GetGuildByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetGuildByID_Call) Return(_a0 Guild, _a1 error) *MockQuerier_GetGuildByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetGuildByID_Call) RunAndReturn(run func(context.Context, int32) (Guild, error)) *MockQuerier_GetGuildByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGuildByName provides a mock function with given fields: ctx, name
+func (_m *MockQuerier) GetGuildByName(ctx context.Context, name string) (Guild, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGuildByName")
+	}
+
+	var r0 Guild
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (Guild, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) Guild); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(Guild)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetGuildByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGuildByName'
+type MockQuerier_GetGuildByName_Call struct {
+	*mock.Call
+}
+
+// GetGuildByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockQuerier_Expecter) GetGuildByName(ctx interface{}, name interface{}) *MockQuerier_GetGuildByName_Call {
+	return &MockQuerier_GetGuildByName_Call{Call: _e.mock.On("GetGuildByName", ctx, name)}
+}
+
+func (_c *MockQuerier_GetGuildByName_Call) Run(run func(ctx context.Context, name string)) *MockQuerier_GetGuildByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetGuildByName_Call) Return(_a0 Guild, _a1 error) *MockQuerier_GetGuildByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetGuildByName_Call) RunAndReturn(run func(context.Context, string) (Guild, error)) *MockQuerier_GetGuildByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGuildKeystones provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) GetGuildKeystones(ctx context.Context, arg GetGuildKeystonesParams) ([]GetGuildKeystonesRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGuildKeystones")
+	}
+
+	var r0 []GetGuildKeystonesRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, GetGuildKeystonesParams) ([]GetGuildKeystonesRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, GetGuildKeystonesParams) []GetGuildKeystonesRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]GetGuildKeystonesRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, GetGuildKeystonesParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetGuildKeystones_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGuildKeystones'
+type MockQuerier_GetGuildKeystones_Call struct {
+	*mock.Call
+}
+
+// GetGuildKeystones is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg GetGuildKeystonesParams
+func (_e *MockQuerier_Expecter) GetGuildKeystones(ctx interface{}, arg interface{}) *MockQuerier_GetGuildKeystones_Call {
+	return &MockQuerier_GetGuildKeystones_Call{Call: _e.mock.On("GetGuildKeystones", ctx, arg)}
+}
+
+func (_c *MockQuerier_GetGuildKeystones_Call) Run(run func(ctx context.Context, arg GetGuildKeystonesParams)) *MockQuerier_GetGuildKeystones_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetGuildKeystonesParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetGuildKeystones_Call) Return(_a0 []GetGuildKeystonesRow, _a1 error) *MockQuerier_GetGuildKeystones_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetGuildKeystones_Call) RunAndReturn(run func(context.Context, GetGuildKeystonesParams) ([]GetGuildKeystonesRow, error)) *MockQuerier_GetGuildKeystones_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGuildMember provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) GetGuildMember(ctx context.Context, arg GetGuildMemberParams) (GuildMember, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGuildMember")
+	}
+
+	var r0 GuildMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, GetGuildMemberParams) (GuildMember, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, GetGuildMemberParams) GuildMember); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(GuildMember)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, GetGuildMemberParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetGuildMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGuildMember'
+type MockQuerier_GetGuildMember_Call struct {
+	*mock.Call
+}
+
+// GetGuildMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg GetGuildMemberParams
+func (_e *MockQuerier_Expecter) GetGuildMember(ctx interface{}, arg interface{}) *MockQuerier_GetGuildMember_Call {
+	return &MockQuerier_GetGuildMember_Call{Call: _e.mock.On("GetGuildMember", ctx, arg)}
+}
+
+func (_c *MockQuerier_GetGuildMember_Call) Run(run func(ctx context.Context, arg GetGuildMemberParams)) *MockQuerier_GetGuildMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetGuildMemberParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetGuildMember_Call) Return(_a0 GuildMember, _a1 error) *MockQuerier_GetGuildMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetGuildMember_Call) RunAndReturn(run func(context.Context, GetGuildMemberParams) (GuildMember, error)) *MockQuerier_GetGuildMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGuildMembers provides a mock function with given fields: ctx, guildID
+func (_m *MockQuerier) GetGuildMembers(ctx context.Context, guildID int32) ([]GetGuildMembersRow, error) {
+	ret := _m.Called(ctx, guildID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGuildMembers")
+	}
+
+	var r0 []GetGuildMembersRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]GetGuildMembersRow, error)); ok {
+		return rf(ctx, guildID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []GetGuildMembersRow); ok {
+		r0 = rf(ctx, guildID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]GetGuildMembersRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, guildID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetGuildMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGuildMembers'
+type MockQuerier_GetGuildMembers_Call struct {
+	*mock.Call
+}
+
+// GetGuildMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID int32
+func (_e *MockQuerier_Expecter) GetGuildMembers(ctx interface{}, guildID interface{}) *MockQuerier_GetGuildMembers_Call {
+	return &MockQuerier_GetGuildMembers_Call{Call: _e.mock.On("GetGuildMembers", ctx, guildID)}
+}
+
+func (_c *MockQuerier_GetGuildMembers_Call) Run(run func(ctx context.Context, guildID int32)) *MockQuerier_GetGuildMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetGuildMembers_Call) Return(_a0 []GetGuildMembersRow, _a1 error) *MockQuerier_GetGuildMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetGuildMembers_Call) RunAndReturn(run func(context.Context, int32) ([]GetGuildMembersRow, error)) *MockQuerier_GetGuildMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGuilds provides a mock function with given fields: ctx
+func (_m *MockQuerier) GetGuilds(ctx context.Context) ([]Guild, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGuilds")
+	}
+
+	var r0 []Guild
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]Guild, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []Guild); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Guild)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetGuilds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGuilds'
+type MockQuerier_GetGuilds_Call struct {
+	*mock.Call
+}
+
+// GetGuilds is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuerier_Expecter) GetGuilds(ctx interface{}) *MockQuerier_GetGuilds_Call {
+	return &MockQuerier_GetGuilds_Call{Call: _e.mock.On("GetGuilds", ctx)}
+}
+
+func (_c *MockQuerier_GetGuilds_Call) Run(run func(ctx context.Context)) *MockQuerier_GetGuilds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetGuilds_Call) Return(_a0 []Guild, _a1 error) *MockQuerier_GetGuilds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetGuilds_Call) RunAndReturn(run func(context.Context) ([]Guild, error)) *MockQuerier_GetGuilds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInboxNotifications provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) GetInboxNotifications(ctx context.Context, arg GetInboxNotificationsParams) ([]InboxNotification, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInboxNotifications")
+	}
+
+	var r0 []InboxNotification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, GetInboxNotificationsParams) ([]InboxNotification, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, GetInboxNotificationsParams) []InboxNotification); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]InboxNotification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, GetInboxNotificationsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetInboxNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInboxNotifications'
+type MockQuerier_GetInboxNotifications_Call struct {
+	*mock.Call
+}
+
+// GetInboxNotifications is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg GetInboxNotificationsParams
+func (_e *MockQuerier_Expecter) GetInboxNotifications(ctx interface{}, arg interface{}) *MockQuerier_GetInboxNotifications_Call {
+	return &MockQuerier_GetInboxNotifications_Call{Call: _e.mock.On("GetInboxNotifications", ctx, arg)}
+}
+
+func (_c *MockQuerier_GetInboxNotifications_Call) Run(run func(ctx context.Context, arg GetInboxNotificationsParams)) *MockQuerier_GetInboxNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetInboxNotificationsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetInboxNotifications_Call) Return(_a0 []InboxNotification, _a1 error) *MockQuerier_GetInboxNotifications_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetInboxNotifications_Call) RunAndReturn(run func(context.Context, GetInboxNotificationsParams) ([]InboxNotification, error)) *MockQuerier_GetInboxNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobByID provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) GetJobByID(ctx context.Context, id int64) (Job, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobByID")
+	}
+
+	var r0 Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (Job, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) Job); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(Job)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetJobByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobByID'
+type MockQuerier_GetJobByID_Call struct {
+	*mock.Call
+}
+
+// GetJobByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockQuerier_Expecter) GetJobByID(ctx interface{}, id interface{}) *MockQuerier_GetJobByID_Call {
+	return &MockQuerier_GetJobByID_Call{Call: _e.mock.On("GetJobByID", ctx, id)}
+}
+
+func (_c *MockQuerier_GetJobByID_Call) Run(run func(ctx context.Context, id int64)) *MockQuerier_GetJobByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetJobByID_Call) Return(_a0 Job, _a1 error) *MockQuerier_GetJobByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetJobByID_Call) RunAndReturn(run func(context.Context, int64) (Job, error)) *MockQuerier_GetJobByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobs provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) GetJobs(ctx context.Context, arg GetJobsParams) ([]Job, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobs")
+	}
+
+	var r0 []Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, GetJobsParams) ([]Job, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, GetJobsParams) []Job); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, GetJobsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobs'
+type MockQuerier_GetJobs_Call struct {
+	*mock.Call
+}
+
+// GetJobs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg GetJobsParams
+func (_e *MockQuerier_Expecter) GetJobs(ctx interface{}, arg interface{}) *MockQuerier_GetJobs_Call {
+	return &MockQuerier_GetJobs_Call{Call: _e.mock.On("GetJobs", ctx, arg)}
+}
+
+func (_c *MockQuerier_GetJobs_Call) Run(run func(ctx context.Context, arg GetJobsParams)) *MockQuerier_GetJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetJobsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetJobs_Call) Return(_a0 []Job, _a1 error) *MockQuerier_GetJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetJobs_Call) RunAndReturn(run func(context.Context, GetJobsParams) ([]Job, error)) *MockQuerier_GetJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLFGProposal provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) GetLFGProposal(ctx context.Context, id int32) (GetLFGProposalRow, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLFGProposal")
+	}
+
+	var r0 GetLFGProposalRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (GetLFGProposalRow, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) GetLFGProposalRow); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(GetLFGProposalRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetLFGProposal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLFGProposal'
+type MockQuerier_GetLFGProposal_Call struct {
+	*mock.Call
+}
+
+// GetLFGProposal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockQuerier_Expecter) GetLFGProposal(ctx interface{}, id interface{}) *MockQuerier_GetLFGProposal_Call {
+	return &MockQuerier_GetLFGProposal_Call{Call: _e.mock.On("GetLFGProposal", ctx, id)}
+}
+
+func (_c *MockQuerier_GetLFGProposal_Call) Run(run func(ctx context.Context, id int32)) *MockQuerier_GetLFGProposal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetLFGProposal_Call) Return(_a0 GetLFGProposalRow, _a1 error) *MockQuerier_GetLFGProposal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetLFGProposal_Call) RunAndReturn(run func(context.Context, int32) (GetLFGProposalRow, error)) *MockQuerier_GetLFGProposal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLFGProposalMembers provides a mock function with given fields: ctx, proposalID
+func (_m *MockQuerier) GetLFGProposalMembers(ctx context.Context, proposalID int32) ([]GetLFGProposalMembersRow, error) {
+	ret := _m.Called(ctx, proposalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLFGProposalMembers")
+	}
+
+	var r0 []GetLFGProposalMembersRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]GetLFGProposalMembersRow, error)); ok {
+		return rf(ctx, proposalID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []GetLFGProposalMembersRow); ok {
+		r0 = rf(ctx, proposalID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]GetLFGProposalMembersRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, proposalID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetLFGProposalMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLFGProposalMembers'
+type MockQuerier_GetLFGProposalMembers_Call struct {
+	*mock.Call
+}
+
+// GetLFGProposalMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - proposalID int32
+func (_e *MockQuerier_Expecter) GetLFGProposalMembers(ctx interface{}, proposalID interface{}) *MockQuerier_GetLFGProposalMembers_Call {
+	return &MockQuerier_GetLFGProposalMembers_Call{Call: _e.mock.On("GetLFGProposalMembers", ctx, proposalID)}
+}
+
+func (_c *MockQuerier_GetLFGProposalMembers_Call) Run(run func(ctx context.Context, proposalID int32)) *MockQuerier_GetLFGProposalMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetLFGProposalMembers_Call) Return(_a0 []GetLFGProposalMembersRow, _a1 error) *MockQuerier_GetLFGProposalMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetLFGProposalMembers_Call) RunAndReturn(run func(context.Context, int32) ([]GetLFGProposalMembersRow, error)) *MockQuerier_GetLFGProposalMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestReadyCheck provides a mock function with given fields: ctx, runID
+func (_m *MockQuerier) GetLatestReadyCheck(ctx context.Context, runID int32) (ReadyCheck, error) {
+	ret := _m.Called(ctx, runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestReadyCheck")
+	}
+
+	var r0 ReadyCheck
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (ReadyCheck, error)); ok {
+		return rf(ctx, runID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ReadyCheck); ok {
+		r0 = rf(ctx, runID)
+	} else {
+		r0 = ret.Get(0).(ReadyCheck)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, runID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetLatestReadyCheck_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestReadyCheck'
+type MockQuerier_GetLatestReadyCheck_Call struct {
+	*mock.Call
+}
+
+// GetLatestReadyCheck is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runID int32
+func (_e *MockQuerier_Expecter) GetLatestReadyCheck(ctx interface{}, runID interface{}) *MockQuerier_GetLatestReadyCheck_Call {
+	return &MockQuerier_GetLatestReadyCheck_Call{Call: _e.mock.On("GetLatestReadyCheck", ctx, runID)}
+}
+
+func (_c *MockQuerier_GetLatestReadyCheck_Call) Run(run func(ctx context.Context, runID int32)) *MockQuerier_GetLatestReadyCheck_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetLatestReadyCheck_Call) Return(_a0 ReadyCheck, _a1 error) *MockQuerier_GetLatestReadyCheck_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetLatestReadyCheck_Call) RunAndReturn(run func(context.Context, int32) (ReadyCheck, error)) *MockQuerier_GetLatestReadyCheck_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLeaderboardSnapshot provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) GetLeaderboardSnapshot(ctx context.Context, arg GetLeaderboardSnapshotParams) ([]LeaderboardSnapshot, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLeaderboardSnapshot")
+	}
+
+	var r0 []LeaderboardSnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, GetLeaderboardSnapshotParams) ([]LeaderboardSnapshot, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, GetLeaderboardSnapshotParams) []LeaderboardSnapshot); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]LeaderboardSnapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, GetLeaderboardSnapshotParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetLeaderboardSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLeaderboardSnapshot'
+type MockQuerier_GetLeaderboardSnapshot_Call struct {
+	*mock.Call
+}
+
+// GetLeaderboardSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg GetLeaderboardSnapshotParams
+func (_e *MockQuerier_Expecter) GetLeaderboardSnapshot(ctx interface{}, arg interface{}) *MockQuerier_GetLeaderboardSnapshot_Call {
+	return &MockQuerier_GetLeaderboardSnapshot_Call{Call: _e.mock.On("GetLeaderboardSnapshot", ctx, arg)}
+}
+
+func (_c *MockQuerier_GetLeaderboardSnapshot_Call) Run(run func(ctx context.Context, arg GetLeaderboardSnapshotParams)) *MockQuerier_GetLeaderboardSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetLeaderboardSnapshotParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetLeaderboardSnapshot_Call) Return(_a0 []LeaderboardSnapshot, _a1 error) *MockQuerier_GetLeaderboardSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetLeaderboardSnapshot_Call) RunAndReturn(run func(context.Context, GetLeaderboardSnapshotParams) ([]LeaderboardSnapshot, error)) *MockQuerier_GetLeaderboardSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotificationPreference provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) GetNotificationPreference(ctx context.Context, arg GetNotificationPreferenceParams) ([]string, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationPreference")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, GetNotificationPreferenceParams) ([]string, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, GetNotificationPreferenceParams) []string); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, GetNotificationPreferenceParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetNotificationPreference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotificationPreference'
+type MockQuerier_GetNotificationPreference_Call struct {
+	*mock.Call
+}
+
+// GetNotificationPreference is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg GetNotificationPreferenceParams
+func (_e *MockQuerier_Expecter) GetNotificationPreference(ctx interface{}, arg interface{}) *MockQuerier_GetNotificationPreference_Call {
+	return &MockQuerier_GetNotificationPreference_Call{Call: _e.mock.On("GetNotificationPreference", ctx, arg)}
+}
+
+func (_c *MockQuerier_GetNotificationPreference_Call) Run(run func(ctx context.Context, arg GetNotificationPreferenceParams)) *MockQuerier_GetNotificationPreference_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetNotificationPreferenceParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetNotificationPreference_Call) Return(_a0 []string, _a1 error) *MockQuerier_GetNotificationPreference_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetNotificationPreference_Call) RunAndReturn(run func(context.Context, GetNotificationPreferenceParams) ([]string, error)) *MockQuerier_GetNotificationPreference_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotificationPreferences provides a mock function with given fields: ctx, userID
+func (_m *MockQuerier) GetNotificationPreferences(ctx context.Context, userID int32) ([]NotificationPreference, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationPreferences")
+	}
+
+	var r0 []NotificationPreference
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]NotificationPreference, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []NotificationPreference); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NotificationPreference)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetNotificationPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotificationPreferences'
+type MockQuerier_GetNotificationPreferences_Call struct {
+	*mock.Call
+}
+
+// GetNotificationPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int32
+func (_e *MockQuerier_Expecter) GetNotificationPreferences(ctx interface{}, userID interface{}) *MockQuerier_GetNotificationPreferences_Call {
+	return &MockQuerier_GetNotificationPreferences_Call{Call: _e.mock.On("GetNotificationPreferences", ctx, userID)}
+}
+
+func (_c *MockQuerier_GetNotificationPreferences_Call) Run(run func(ctx context.Context, userID int32)) *MockQuerier_GetNotificationPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetNotificationPreferences_Call) Return(_a0 []NotificationPreference, _a1 error) *MockQuerier_GetNotificationPreferences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetNotificationPreferences_Call) RunAndReturn(run func(context.Context, int32) ([]NotificationPreference, error)) *MockQuerier_GetNotificationPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotificationRecipient provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) GetNotificationRecipient(ctx context.Context, id int32) (GetNotificationRecipientRow, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationRecipient")
+	}
+
+	var r0 GetNotificationRecipientRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (GetNotificationRecipientRow, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) GetNotificationRecipientRow); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(GetNotificationRecipientRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetNotificationRecipient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotificationRecipient'
+type MockQuerier_GetNotificationRecipient_Call struct {
+	*mock.Call
+}
+
+// GetNotificationRecipient is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockQuerier_Expecter) GetNotificationRecipient(ctx interface{}, id interface{}) *MockQuerier_GetNotificationRecipient_Call {
+	return &MockQuerier_GetNotificationRecipient_Call{Call: _e.mock.On("GetNotificationRecipient", ctx, id)}
+}
+
+func (_c *MockQuerier_GetNotificationRecipient_Call) Run(run func(ctx context.Context, id int32)) *MockQuerier_GetNotificationRecipient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetNotificationRecipient_Call) Return(_a0 GetNotificationRecipientRow, _a1 error) *MockQuerier_GetNotificationRecipient_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetNotificationRecipient_Call) RunAndReturn(run func(context.Context, int32) (GetNotificationRecipientRow, error)) *MockQuerier_GetNotificationRecipient_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotificationSettings provides a mock function with given fields: ctx, userID
+func (_m *MockQuerier) GetNotificationSettings(ctx context.Context, userID int32) (NotificationSetting, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationSettings")
+	}
+
+	var r0 NotificationSetting
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (NotificationSetting, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) NotificationSetting); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(NotificationSetting)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetNotificationSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotificationSettings'
+type MockQuerier_GetNotificationSettings_Call struct {
+	*mock.Call
+}
+
+// GetNotificationSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int32
+func (_e *MockQuerier_Expecter) GetNotificationSettings(ctx interface{}, userID interface{}) *MockQuerier_GetNotificationSettings_Call {
+	return &MockQuerier_GetNotificationSettings_Call{Call: _e.mock.On("GetNotificationSettings", ctx, userID)}
+}
+
+func (_c *MockQuerier_GetNotificationSettings_Call) Run(run func(ctx context.Context, userID int32)) *MockQuerier_GetNotificationSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetNotificationSettings_Call) Return(_a0 NotificationSetting, _a1 error) *MockQuerier_GetNotificationSettings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetNotificationSettings_Call) RunAndReturn(run func(context.Context, int32) (NotificationSetting, error)) *MockQuerier_GetNotificationSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOpenSeason provides a mock function with given fields: ctx
+func (_m *MockQuerier) GetOpenSeason(ctx context.Context) (Season, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOpenSeason")
+	}
+
+	var r0 Season
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (Season, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) Season); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(Season)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetOpenSeason_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOpenSeason'
+type MockQuerier_GetOpenSeason_Call struct {
+	*mock.Call
+}
+
+// GetOpenSeason is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuerier_Expecter) GetOpenSeason(ctx interface{}) *MockQuerier_GetOpenSeason_Call {
+	return &MockQuerier_GetOpenSeason_Call{Call: _e.mock.On("GetOpenSeason", ctx)}
+}
+
+func (_c *MockQuerier_GetOpenSeason_Call) Run(run func(ctx context.Context)) *MockQuerier_GetOpenSeason_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetOpenSeason_Call) Return(_a0 Season, _a1 error) *MockQuerier_GetOpenSeason_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetOpenSeason_Call) RunAndReturn(run func(context.Context) (Season, error)) *MockQuerier_GetOpenSeason_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReadyCheck provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) GetReadyCheck(ctx context.Context, id int32) (ReadyCheck, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReadyCheck")
+	}
+
+	var r0 ReadyCheck
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (ReadyCheck, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ReadyCheck); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(ReadyCheck)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetReadyCheck_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReadyCheck'
+type MockQuerier_GetReadyCheck_Call struct {
+	*mock.Call
+}
+
+// GetReadyCheck is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockQuerier_Expecter) GetReadyCheck(ctx interface{}, id interface{}) *MockQuerier_GetReadyCheck_Call {
+	return &MockQuerier_GetReadyCheck_Call{Call: _e.mock.On("GetReadyCheck", ctx, id)}
+}
+
+func (_c *MockQuerier_GetReadyCheck_Call) Run(run func(ctx context.Context, id int32)) *MockQuerier_GetReadyCheck_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetReadyCheck_Call) Return(_a0 ReadyCheck, _a1 error) *MockQuerier_GetReadyCheck_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetReadyCheck_Call) RunAndReturn(run func(context.Context, int32) (ReadyCheck, error)) *MockQuerier_GetReadyCheck_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReadyCheckResponses provides a mock function with given fields: ctx, readyCheckID
+func (_m *MockQuerier) GetReadyCheckResponses(ctx context.Context, readyCheckID int32) ([]ReadyCheckResponse, error) {
+	ret := _m.Called(ctx, readyCheckID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReadyCheckResponses")
+	}
+
+	var r0 []ReadyCheckResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]ReadyCheckResponse, error)); ok {
+		return rf(ctx, readyCheckID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []ReadyCheckResponse); ok {
+		r0 = rf(ctx, readyCheckID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ReadyCheckResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, readyCheckID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetReadyCheckResponses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReadyCheckResponses'
+type MockQuerier_GetReadyCheckResponses_Call struct {
+	*mock.Call
+}
+
+// GetReadyCheckResponses is a helper method to define mock.On call
+//   - ctx context.Context
+//   - readyCheckID int32
+func (_e *MockQuerier_Expecter) GetReadyCheckResponses(ctx interface{}, readyCheckID interface{}) *MockQuerier_GetReadyCheckResponses_Call {
+	return &MockQuerier_GetReadyCheckResponses_Call{Call: _e.mock.On("GetReadyCheckResponses", ctx, readyCheckID)}
+}
+
+func (_c *MockQuerier_GetReadyCheckResponses_Call) Run(run func(ctx context.Context, readyCheckID int32)) *MockQuerier_GetReadyCheckResponses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetReadyCheckResponses_Call) Return(_a0 []ReadyCheckResponse, _a1 error) *MockQuerier_GetReadyCheckResponses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetReadyCheckResponses_Call) RunAndReturn(run func(context.Context, int32) ([]ReadyCheckResponse, error)) *MockQuerier_GetReadyCheckResponses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRunAttendance provides a mock function with given fields: ctx, runID
+func (_m *MockQuerier) GetRunAttendance(ctx context.Context, runID int32) ([]GetRunAttendanceRow, error) {
+	ret := _m.Called(ctx, runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRunAttendance")
+	}
+
+	var r0 []GetRunAttendanceRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]GetRunAttendanceRow, error)); ok {
+		return rf(ctx, runID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []GetRunAttendanceRow); ok {
+		r0 = rf(ctx, runID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]GetRunAttendanceRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, runID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetRunAttendance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRunAttendance'
+type MockQuerier_GetRunAttendance_Call struct {
+	*mock.Call
+}
+
+// GetRunAttendance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runID int32
+func (_e *MockQuerier_Expecter) GetRunAttendance(ctx interface{}, runID interface{}) *MockQuerier_GetRunAttendance_Call {
+	return &MockQuerier_GetRunAttendance_Call{Call: _e.mock.On("GetRunAttendance", ctx, runID)}
+}
+
+func (_c *MockQuerier_GetRunAttendance_Call) Run(run func(ctx context.Context, runID int32)) *MockQuerier_GetRunAttendance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetRunAttendance_Call) Return(_a0 []GetRunAttendanceRow, _a1 error) *MockQuerier_GetRunAttendance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetRunAttendance_Call) RunAndReturn(run func(context.Context, int32) ([]GetRunAttendanceRow, error)) *MockQuerier_GetRunAttendance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRunByID provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) GetRunByID(ctx context.Context, id int32) (GetRunByIDRow, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRunByID")
+	}
+
+	var r0 GetRunByIDRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (GetRunByIDRow, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) GetRunByIDRow); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(GetRunByIDRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetRunByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRunByID'
+type MockQuerier_GetRunByID_Call struct {
+	*mock.Call
+}
+
+// GetRunByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockQuerier_Expecter) GetRunByID(ctx interface{}, id interface{}) *MockQuerier_GetRunByID_Call {
+	return &MockQuerier_GetRunByID_Call{Call: _e.mock.On("GetRunByID", ctx, id)}
+}
+
+func (_c *MockQuerier_GetRunByID_Call) Run(run func(ctx context.Context, id int32)) *MockQuerier_GetRunByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetRunByID_Call) Return(_a0 GetRunByIDRow, _a1 error) *MockQuerier_GetRunByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetRunByID_Call) RunAndReturn(run func(context.Context, int32) (GetRunByIDRow, error)) *MockQuerier_GetRunByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRunEvent provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) GetRunEvent(ctx context.Context, id int64) (RunEvent, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRunEvent")
+	}
+
+	var r0 RunEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (RunEvent, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) RunEvent); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(RunEvent)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetRunEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRunEvent'
+type MockQuerier_GetRunEvent_Call struct {
+	*mock.Call
+}
+
+// GetRunEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockQuerier_Expecter) GetRunEvent(ctx interface{}, id interface{}) *MockQuerier_GetRunEvent_Call {
+	return &MockQuerier_GetRunEvent_Call{Call: _e.mock.On("GetRunEvent", ctx, id)}
+}
+
+func (_c *MockQuerier_GetRunEvent_Call) Run(run func(ctx context.Context, id int64)) *MockQuerier_GetRunEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetRunEvent_Call) Return(_a0 RunEvent, _a1 error) *MockQuerier_GetRunEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetRunEvent_Call) RunAndReturn(run func(context.Context, int64) (RunEvent, error)) *MockQuerier_GetRunEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRunEventsAfter provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) GetRunEventsAfter(ctx context.Context, arg GetRunEventsAfterParams) ([]RunEvent, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRunEventsAfter")
+	}
+
+	var r0 []RunEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, GetRunEventsAfterParams) ([]RunEvent, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, GetRunEventsAfterParams) []RunEvent); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]RunEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, GetRunEventsAfterParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetRunEventsAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRunEventsAfter'
+type MockQuerier_GetRunEventsAfter_Call struct {
+	*mock.Call
+}
+
+// GetRunEventsAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg GetRunEventsAfterParams
+func (_e *MockQuerier_Expecter) GetRunEventsAfter(ctx interface{}, arg interface{}) *MockQuerier_GetRunEventsAfter_Call {
+	return &MockQuerier_GetRunEventsAfter_Call{Call: _e.mock.On("GetRunEventsAfter", ctx, arg)}
+}
+
+func (_c *MockQuerier_GetRunEventsAfter_Call) Run(run func(ctx context.Context, arg GetRunEventsAfterParams)) *MockQuerier_GetRunEventsAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetRunEventsAfterParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetRunEventsAfter_Call) Return(_a0 []RunEvent, _a1 error) *MockQuerier_GetRunEventsAfter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetRunEventsAfter_Call) RunAndReturn(run func(context.Context, GetRunEventsAfterParams) ([]RunEvent, error)) *MockQuerier_GetRunEventsAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRunReminderOffsets provides a mock function with given fields: ctx, runID
+func (_m *MockQuerier) GetRunReminderOffsets(ctx context.Context, runID int32) ([]int32, error) {
+	ret := _m.Called(ctx, runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRunReminderOffsets")
+	}
+
+	var r0 []int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]int32, error)); ok {
+		return rf(ctx, runID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []int32); ok {
+		r0 = rf(ctx, runID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int32)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, runID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetRunReminderOffsets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRunReminderOffsets'
+type MockQuerier_GetRunReminderOffsets_Call struct {
+	*mock.Call
+}
+
+// GetRunReminderOffsets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runID int32
+func (_e *MockQuerier_Expecter) GetRunReminderOffsets(ctx interface{}, runID interface{}) *MockQuerier_GetRunReminderOffsets_Call {
+	return &MockQuerier_GetRunReminderOffsets_Call{Call: _e.mock.On("GetRunReminderOffsets", ctx, runID)}
+}
+
+func (_c *MockQuerier_GetRunReminderOffsets_Call) Run(run func(ctx context.Context, runID int32)) *MockQuerier_GetRunReminderOffsets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetRunReminderOffsets_Call) Return(_a0 []int32, _a1 error) *MockQuerier_GetRunReminderOffsets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetRunReminderOffsets_Call) RunAndReturn(run func(context.Context, int32) ([]int32, error)) *MockQuerier_GetRunReminderOffsets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRunSignups provides a mock function with given fields: ctx, runID
+func (_m *MockQuerier) GetRunSignups(ctx context.Context, runID int32) ([]GetRunSignupsRow, error) {
+	ret := _m.Called(ctx, runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRunSignups")
+	}
+
+	var r0 []GetRunSignupsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]GetRunSignupsRow, error)); ok {
+		return rf(ctx, runID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []GetRunSignupsRow); ok {
+		r0 = rf(ctx, runID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]GetRunSignupsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, runID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetRunSignups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRunSignups'
+type MockQuerier_GetRunSignups_Call struct {
+	*mock.Call
+}
+
+// GetRunSignups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runID int32
+func (_e *MockQuerier_Expecter) GetRunSignups(ctx interface{}, runID interface{}) *MockQuerier_GetRunSignups_Call {
+	return &MockQuerier_GetRunSignups_Call{Call: _e.mock.On("GetRunSignups", ctx, runID)}
+}
+
+func (_c *MockQuerier_GetRunSignups_Call) Run(run func(ctx context.Context, runID int32)) *MockQuerier_GetRunSignups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetRunSignups_Call) Return(_a0 []GetRunSignupsRow, _a1 error) *MockQuerier_GetRunSignups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetRunSignups_Call) RunAndReturn(run func(context.Context, int32) ([]GetRunSignupsRow, error)) *MockQuerier_GetRunSignups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRuns provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) GetRuns(ctx context.Context, arg GetRunsParams) ([]GetRunsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRuns")
+	}
+
+	var r0 []GetRunsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, GetRunsParams) ([]GetRunsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, GetRunsParams) []GetRunsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]GetRunsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, GetRunsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRuns'
+type MockQuerier_GetRuns_Call struct {
+	*mock.Call
+}
+
+// GetRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg GetRunsParams
+func (_e *MockQuerier_Expecter) GetRuns(ctx interface{}, arg interface{}) *MockQuerier_GetRuns_Call {
+	return &MockQuerier_GetRuns_Call{Call: _e.mock.On("GetRuns", ctx, arg)}
+}
+
+func (_c *MockQuerier_GetRuns_Call) Run(run func(ctx context.Context, arg GetRunsParams)) *MockQuerier_GetRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetRunsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetRuns_Call) Return(_a0 []GetRunsRow, _a1 error) *MockQuerier_GetRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetRuns_Call) RunAndReturn(run func(context.Context, GetRunsParams) ([]GetRunsRow, error)) *MockQuerier_GetRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSeasonAffixes provides a mock function with given fields: ctx, seasonID
+func (_m *MockQuerier) GetSeasonAffixes(ctx context.Context, seasonID int32) ([]SeasonAffix, error) {
+	ret := _m.Called(ctx, seasonID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeasonAffixes")
+	}
+
+	var r0 []SeasonAffix
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]SeasonAffix, error)); ok {
+		return rf(ctx, seasonID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []SeasonAffix); ok {
+		r0 = rf(ctx, seasonID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]SeasonAffix)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, seasonID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetSeasonAffixes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeasonAffixes'
+type MockQuerier_GetSeasonAffixes_Call struct {
+	*mock.Call
+}
+
+// GetSeasonAffixes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - seasonID int32
+func (_e *MockQuerier_Expecter) GetSeasonAffixes(ctx interface{}, seasonID interface{}) *MockQuerier_GetSeasonAffixes_Call {
+	return &MockQuerier_GetSeasonAffixes_Call{Call: _e.mock.On("GetSeasonAffixes", ctx, seasonID)}
+}
+
+func (_c *MockQuerier_GetSeasonAffixes_Call) Run(run func(ctx context.Context, seasonID int32)) *MockQuerier_GetSeasonAffixes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetSeasonAffixes_Call) Return(_a0 []SeasonAffix, _a1 error) *MockQuerier_GetSeasonAffixes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetSeasonAffixes_Call) RunAndReturn(run func(context.Context, int32) ([]SeasonAffix, error)) *MockQuerier_GetSeasonAffixes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSeasonByCode provides a mock function with given fields: ctx, code
+func (_m *MockQuerier) GetSeasonByCode(ctx context.Context, code string) (Season, error) {
+	ret := _m.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeasonByCode")
+	}
+
+	var r0 Season
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (Season, error)); ok {
+		return rf(ctx, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) Season); ok {
+		r0 = rf(ctx, code)
+	} else {
+		r0 = ret.Get(0).(Season)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetSeasonByCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeasonByCode'
+type MockQuerier_GetSeasonByCode_Call struct {
+	*mock.Call
+}
+
+// GetSeasonByCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+func (_e *MockQuerier_Expecter) GetSeasonByCode(ctx interface{}, code interface{}) *MockQuerier_GetSeasonByCode_Call {
+	return &MockQuerier_GetSeasonByCode_Call{Call: _e.mock.On("GetSeasonByCode", ctx, code)}
+}
+
+func (_c *MockQuerier_GetSeasonByCode_Call) Run(run func(ctx context.Context, code string)) *MockQuerier_GetSeasonByCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetSeasonByCode_Call) Return(_a0 Season, _a1 error) *MockQuerier_GetSeasonByCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetSeasonByCode_Call) RunAndReturn(run func(context.Context, string) (Season, error)) *MockQuerier_GetSeasonByCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSeasonTimedRuns provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) GetSeasonTimedRuns(ctx context.Context, arg GetSeasonTimedRunsParams) ([]GetSeasonTimedRunsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeasonTimedRuns")
+	}
+
+	var r0 []GetSeasonTimedRunsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, GetSeasonTimedRunsParams) ([]GetSeasonTimedRunsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, GetSeasonTimedRunsParams) []GetSeasonTimedRunsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]GetSeasonTimedRunsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, GetSeasonTimedRunsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetSeasonTimedRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeasonTimedRuns'
+type MockQuerier_GetSeasonTimedRuns_Call struct {
+	*mock.Call
+}
+
+// GetSeasonTimedRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg GetSeasonTimedRunsParams
+func (_e *MockQuerier_Expecter) GetSeasonTimedRuns(ctx interface{}, arg interface{}) *MockQuerier_GetSeasonTimedRuns_Call {
+	return &MockQuerier_GetSeasonTimedRuns_Call{Call: _e.mock.On("GetSeasonTimedRuns", ctx, arg)}
+}
+
+func (_c *MockQuerier_GetSeasonTimedRuns_Call) Run(run func(ctx context.Context, arg GetSeasonTimedRunsParams)) *MockQuerier_GetSeasonTimedRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetSeasonTimedRunsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetSeasonTimedRuns_Call) Return(_a0 []GetSeasonTimedRunsRow, _a1 error) *MockQuerier_GetSeasonTimedRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetSeasonTimedRuns_Call) RunAndReturn(run func(context.Context, GetSeasonTimedRunsParams) ([]GetSeasonTimedRunsRow, error)) *MockQuerier_GetSeasonTimedRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSeasons provides a mock function with given fields: ctx
+func (_m *MockQuerier) GetSeasons(ctx context.Context) ([]Season, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeasons")
+	}
+
+	var r0 []Season
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]Season, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []Season); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Season)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetSeasons_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeasons'
+type MockQuerier_GetSeasons_Call struct {
+	*mock.Call
+}
+
+// GetSeasons is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuerier_Expecter) GetSeasons(ctx interface{}) *MockQuerier_GetSeasons_Call {
+	return &MockQuerier_GetSeasons_Call{Call: _e.mock.On("GetSeasons", ctx)}
+}
+
+func (_c *MockQuerier_GetSeasons_Call) Run(run func(ctx context.Context)) *MockQuerier_GetSeasons_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetSeasons_Call) Return(_a0 []Season, _a1 error) *MockQuerier_GetSeasons_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetSeasons_Call) RunAndReturn(run func(context.Context) ([]Season, error)) *MockQuerier_GetSeasons_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSeriesByID provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) GetSeriesByID(ctx context.Context, id int32) (GetSeriesByIDRow, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeriesByID")
+	}
+
+	var r0 GetSeriesByIDRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (GetSeriesByIDRow, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) GetSeriesByIDRow); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(GetSeriesByIDRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetSeriesByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeriesByID'
+type MockQuerier_GetSeriesByID_Call struct {
+	*mock.Call
+}
+
+// GetSeriesByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockQuerier_Expecter) GetSeriesByID(ctx interface{}, id interface{}) *MockQuerier_GetSeriesByID_Call {
+	return &MockQuerier_GetSeriesByID_Call{Call: _e.mock.On("GetSeriesByID", ctx, id)}
+}
+
+func (_c *MockQuerier_GetSeriesByID_Call) Run(run func(ctx context.Context, id int32)) *MockQuerier_GetSeriesByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetSeriesByID_Call) Return(_a0 GetSeriesByIDRow, _a1 error) *MockQuerier_GetSeriesByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetSeriesByID_Call) RunAndReturn(run func(context.Context, int32) (GetSeriesByIDRow, error)) *MockQuerier_GetSeriesByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSeriesRun provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) GetSeriesRun(ctx context.Context, arg GetSeriesRunParams) (Run, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeriesRun")
+	}
+
+	var r0 Run
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, GetSeriesRunParams) (Run, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, GetSeriesRunParams) Run); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(Run)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, GetSeriesRunParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetSeriesRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeriesRun'
+type MockQuerier_GetSeriesRun_Call struct {
+	*mock.Call
+}
+
+// GetSeriesRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg GetSeriesRunParams
+func (_e *MockQuerier_Expecter) GetSeriesRun(ctx interface{}, arg interface{}) *MockQuerier_GetSeriesRun_Call {
+	return &MockQuerier_GetSeriesRun_Call{Call: _e.mock.On("GetSeriesRun", ctx, arg)}
+}
+
+func (_c *MockQuerier_GetSeriesRun_Call) Run(run func(ctx context.Context, arg GetSeriesRunParams)) *MockQuerier_GetSeriesRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetSeriesRunParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetSeriesRun_Call) Return(_a0 Run, _a1 error) *MockQuerier_GetSeriesRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetSeriesRun_Call) RunAndReturn(run func(context.Context, GetSeriesRunParams) (Run, error)) *MockQuerier_GetSeriesRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSeriesRuns provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) GetSeriesRuns(ctx context.Context, arg GetSeriesRunsParams) ([]GetSeriesRunsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeriesRuns")
+	}
+
+	var r0 []GetSeriesRunsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, GetSeriesRunsParams) ([]GetSeriesRunsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, GetSeriesRunsParams) []GetSeriesRunsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]GetSeriesRunsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, GetSeriesRunsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetSeriesRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeriesRuns'
+type MockQuerier_GetSeriesRuns_Call struct {
+	*mock.Call
+}
+
+// GetSeriesRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg GetSeriesRunsParams
+func (_e *MockQuerier_Expecter) GetSeriesRuns(ctx interface{}, arg interface{}) *MockQuerier_GetSeriesRuns_Call {
+	return &MockQuerier_GetSeriesRuns_Call{Call: _e.mock.On("GetSeriesRuns", ctx, arg)}
+}
+
+func (_c *MockQuerier_GetSeriesRuns_Call) Run(run func(ctx context.Context, arg GetSeriesRunsParams)) *MockQuerier_GetSeriesRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetSeriesRunsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetSeriesRuns_Call) Return(_a0 []GetSeriesRunsRow, _a1 error) *MockQuerier_GetSeriesRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetSeriesRuns_Call) RunAndReturn(run func(context.Context, GetSeriesRunsParams) ([]GetSeriesRunsRow, error)) *MockQuerier_GetSeriesRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSeriesRunsFrom provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) GetSeriesRunsFrom(ctx context.Context, arg GetSeriesRunsFromParams) ([]GetSeriesRunsFromRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeriesRunsFrom")
+	}
+
+	var r0 []GetSeriesRunsFromRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, GetSeriesRunsFromParams) ([]GetSeriesRunsFromRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, GetSeriesRunsFromParams) []GetSeriesRunsFromRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]GetSeriesRunsFromRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, GetSeriesRunsFromParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetSeriesRunsFrom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeriesRunsFrom'
+type MockQuerier_GetSeriesRunsFrom_Call struct {
+	*mock.Call
+}
+
+// GetSeriesRunsFrom is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg GetSeriesRunsFromParams
+func (_e *MockQuerier_Expecter) GetSeriesRunsFrom(ctx interface{}, arg interface{}) *MockQuerier_GetSeriesRunsFrom_Call {
+	return &MockQuerier_GetSeriesRunsFrom_Call{Call: _e.mock.On("GetSeriesRunsFrom", ctx, arg)}
+}
+
+func (_c *MockQuerier_GetSeriesRunsFrom_Call) Run(run func(ctx context.Context, arg GetSeriesRunsFromParams)) *MockQuerier_GetSeriesRunsFrom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetSeriesRunsFromParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetSeriesRunsFrom_Call) Return(_a0 []GetSeriesRunsFromRow, _a1 error) *MockQuerier_GetSeriesRunsFrom_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetSeriesRunsFrom_Call) RunAndReturn(run func(context.Context, GetSeriesRunsFromParams) ([]GetSeriesRunsFromRow, error)) *MockQuerier_GetSeriesRunsFrom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSeriesSkipsFrom provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) GetSeriesSkipsFrom(ctx context.Context, arg GetSeriesSkipsFromParams) ([]pgtype.Timestamptz, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeriesSkipsFrom")
+	}
+
+	var r0 []pgtype.Timestamptz
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, GetSeriesSkipsFromParams) ([]pgtype.Timestamptz, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, GetSeriesSkipsFromParams) []pgtype.Timestamptz); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pgtype.Timestamptz)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, GetSeriesSkipsFromParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetSeriesSkipsFrom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeriesSkipsFrom'
+type MockQuerier_GetSeriesSkipsFrom_Call struct {
+	*mock.Call
+}
+
+// GetSeriesSkipsFrom is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg GetSeriesSkipsFromParams
+func (_e *MockQuerier_Expecter) GetSeriesSkipsFrom(ctx interface{}, arg interface{}) *MockQuerier_GetSeriesSkipsFrom_Call {
+	return &MockQuerier_GetSeriesSkipsFrom_Call{Call: _e.mock.On("GetSeriesSkipsFrom", ctx, arg)}
+}
+
+func (_c *MockQuerier_GetSeriesSkipsFrom_Call) Run(run func(ctx context.Context, arg GetSeriesSkipsFromParams)) *MockQuerier_GetSeriesSkipsFrom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetSeriesSkipsFromParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetSeriesSkipsFrom_Call) Return(_a0 []pgtype.Timestamptz, _a1 error) *MockQuerier_GetSeriesSkipsFrom_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetSeriesSkipsFrom_Call) RunAndReturn(run func(context.Context, GetSeriesSkipsFromParams) ([]pgtype.Timestamptz, error)) *MockQuerier_GetSeriesSkipsFrom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubscribedWebhookEndpoints provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) GetSubscribedWebhookEndpoints(ctx context.Context, arg GetSubscribedWebhookEndpointsParams) ([]WebhookEndpoint, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubscribedWebhookEndpoints")
+	}
+
+	var r0 []WebhookEndpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, GetSubscribedWebhookEndpointsParams) ([]WebhookEndpoint, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, GetSubscribedWebhookEndpointsParams) []WebhookEndpoint); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]WebhookEndpoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, GetSubscribedWebhookEndpointsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetSubscribedWebhookEndpoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubscribedWebhookEndpoints'
+type MockQuerier_GetSubscribedWebhookEndpoints_Call struct {
+	*mock.Call
+}
+
+// GetSubscribedWebhookEndpoints is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg GetSubscribedWebhookEndpointsParams
+func (_e *MockQuerier_Expecter) GetSubscribedWebhookEndpoints(ctx interface{}, arg interface{}) *MockQuerier_GetSubscribedWebhookEndpoints_Call {
+	return &MockQuerier_GetSubscribedWebhookEndpoints_Call{Call: _e.mock.On("GetSubscribedWebhookEndpoints", ctx, arg)}
+}
+
+func (_c *MockQuerier_GetSubscribedWebhookEndpoints_Call) Run(run func(ctx context.Context, arg GetSubscribedWebhookEndpointsParams)) *MockQuerier_GetSubscribedWebhookEndpoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetSubscribedWebhookEndpointsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetSubscribedWebhookEndpoints_Call) Return(_a0 []WebhookEndpoint, _a1 error) *MockQuerier_GetSubscribedWebhookEndpoints_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetSubscribedWebhookEndpoints_Call) RunAndReturn(run func(context.Context, GetSubscribedWebhookEndpointsParams) ([]WebhookEndpoint, error)) *MockQuerier_GetSubscribedWebhookEndpoints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserAffixRunStats provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) GetUserAffixRunStats(ctx context.Context, arg GetUserAffixRunStatsParams) ([]GetUserAffixRunStatsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserAffixRunStats")
+	}
+
+	var r0 []GetUserAffixRunStatsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, GetUserAffixRunStatsParams) ([]GetUserAffixRunStatsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, GetUserAffixRunStatsParams) []GetUserAffixRunStatsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]GetUserAffixRunStatsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, GetUserAffixRunStatsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetUserAffixRunStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserAffixRunStats'
+type MockQuerier_GetUserAffixRunStats_Call struct {
+	*mock.Call
+}
+
+// GetUserAffixRunStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg GetUserAffixRunStatsParams
+func (_e *MockQuerier_Expecter) GetUserAffixRunStats(ctx interface{}, arg interface{}) *MockQuerier_GetUserAffixRunStats_Call {
+	return &MockQuerier_GetUserAffixRunStats_Call{Call: _e.mock.On("GetUserAffixRunStats", ctx, arg)}
+}
+
+func (_c *MockQuerier_GetUserAffixRunStats_Call) Run(run func(ctx context.Context, arg GetUserAffixRunStatsParams)) *MockQuerier_GetUserAffixRunStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetUserAffixRunStatsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUserAffixRunStats_Call) Return(_a0 []GetUserAffixRunStatsRow, _a1 error) *MockQuerier_GetUserAffixRunStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUserAffixRunStats_Call) RunAndReturn(run func(context.Context, GetUserAffixRunStatsParams) ([]GetUserAffixRunStatsRow, error)) *MockQuerier_GetUserAffixRunStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserAttendance provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) GetUserAttendance(ctx context.Context, arg GetUserAttendanceParams) ([]GetUserAttendanceRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserAttendance")
+	}
+
+	var r0 []GetUserAttendanceRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, GetUserAttendanceParams) ([]GetUserAttendanceRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, GetUserAttendanceParams) []GetUserAttendanceRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]GetUserAttendanceRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, GetUserAttendanceParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetUserAttendance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserAttendance'
+type MockQuerier_GetUserAttendance_Call struct {
+	*mock.Call
+}
+
+// GetUserAttendance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg GetUserAttendanceParams
+func (_e *MockQuerier_Expecter) GetUserAttendance(ctx interface{}, arg interface{}) *MockQuerier_GetUserAttendance_Call {
+	return &MockQuerier_GetUserAttendance_Call{Call: _e.mock.On("GetUserAttendance", ctx, arg)}
+}
+
+func (_c *MockQuerier_GetUserAttendance_Call) Run(run func(ctx context.Context, arg GetUserAttendanceParams)) *MockQuerier_GetUserAttendance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetUserAttendanceParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUserAttendance_Call) Return(_a0 []GetUserAttendanceRow, _a1 error) *MockQuerier_GetUserAttendance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUserAttendance_Call) RunAndReturn(run func(context.Context, GetUserAttendanceParams) ([]GetUserAttendanceRow, error)) *MockQuerier_GetUserAttendance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByEmail provides a mock function with given fields: ctx, email
+func (_m *MockQuerier) GetUserByEmail(ctx context.Context, email string) (GetUserByEmailRow, error) {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByEmail")
+	}
+
+	var r0 GetUserByEmailRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (GetUserByEmailRow, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) GetUserByEmailRow); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Get(0).(GetUserByEmailRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetUserByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByEmail'
+type MockQuerier_GetUserByEmail_Call struct {
+	*mock.Call
+}
+
+// GetUserByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *MockQuerier_Expecter) GetUserByEmail(ctx interface{}, email interface{}) *MockQuerier_GetUserByEmail_Call {
+	return &MockQuerier_GetUserByEmail_Call{Call: _e.mock.On("GetUserByEmail", ctx, email)}
+}
+
+func (_c *MockQuerier_GetUserByEmail_Call) Run(run func(ctx context.Context, email string)) *MockQuerier_GetUserByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUserByEmail_Call) Return(_a0 GetUserByEmailRow, _a1 error) *MockQuerier_GetUserByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUserByEmail_Call) RunAndReturn(run func(context.Context, string) (GetUserByEmailRow, error)) *MockQuerier_GetUserByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByID provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) GetUserByID(ctx context.Context, id int32) (GetUserByIDRow, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByID")
+	}
+
+	var r0 GetUserByIDRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (GetUserByIDRow, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) GetUserByIDRow); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(GetUserByIDRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetUserByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByID'
+type MockQuerier_GetUserByID_Call struct {
+	*mock.Call
+}
+
+// GetUserByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockQuerier_Expecter) GetUserByID(ctx interface{}, id interface{}) *MockQuerier_GetUserByID_Call {
+	return &MockQuerier_GetUserByID_Call{Call: _e.mock.On("GetUserByID", ctx, id)}
+}
+
+func (_c *MockQuerier_GetUserByID_Call) Run(run func(ctx context.Context, id int32)) *MockQuerier_GetUserByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUserByID_Call) Return(_a0 GetUserByIDRow, _a1 error) *MockQuerier_GetUserByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUserByID_Call) RunAndReturn(run func(context.Context, int32) (GetUserByIDRow, error)) *MockQuerier_GetUserByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByUsername provides a mock function with given fields: ctx, username
+func (_m *MockQuerier) GetUserByUsername(ctx context.Context, username string) (GetUserByUsernameRow, error) {
+	ret := _m.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByUsername")
+	}
+
+	var r0 GetUserByUsernameRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (GetUserByUsernameRow, error)); ok {
+		return rf(ctx, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) GetUserByUsernameRow); ok {
+		r0 = rf(ctx, username)
+	} else {
+		r0 = ret.Get(0).(GetUserByUsernameRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetUserByUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByUsername'
+type MockQuerier_GetUserByUsername_Call struct {
+	*mock.Call
+}
+
+// GetUserByUsername is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+func (_e *MockQuerier_Expecter) GetUserByUsername(ctx interface{}, username interface{}) *MockQuerier_GetUserByUsername_Call {
+	return &MockQuerier_GetUserByUsername_Call{Call: _e.mock.On("GetUserByUsername", ctx, username)}
+}
+
+func (_c *MockQuerier_GetUserByUsername_Call) Run(run func(ctx context.Context, username string)) *MockQuerier_GetUserByUsername_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUserByUsername_Call) Return(_a0 GetUserByUsernameRow, _a1 error) *MockQuerier_GetUserByUsername_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUserByUsername_Call) RunAndReturn(run func(context.Context, string) (GetUserByUsernameRow, error)) *MockQuerier_GetUserByUsername_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserCalendarRuns provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) GetUserCalendarRuns(ctx context.Context, arg GetUserCalendarRunsParams) ([]GetUserCalendarRunsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserCalendarRuns")
+	}
+
+	var r0 []GetUserCalendarRunsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, GetUserCalendarRunsParams) ([]GetUserCalendarRunsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, GetUserCalendarRunsParams) []GetUserCalendarRunsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]GetUserCalendarRunsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, GetUserCalendarRunsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetUserCalendarRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserCalendarRuns'
+type MockQuerier_GetUserCalendarRuns_Call struct {
+	*mock.Call
+}
+
+// GetUserCalendarRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg GetUserCalendarRunsParams
+func (_e *MockQuerier_Expecter) GetUserCalendarRuns(ctx interface{}, arg interface{}) *MockQuerier_GetUserCalendarRuns_Call {
+	return &MockQuerier_GetUserCalendarRuns_Call{Call: _e.mock.On("GetUserCalendarRuns", ctx, arg)}
+}
+
+func (_c *MockQuerier_GetUserCalendarRuns_Call) Run(run func(ctx context.Context, arg GetUserCalendarRunsParams)) *MockQuerier_GetUserCalendarRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetUserCalendarRunsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUserCalendarRuns_Call) Return(_a0 []GetUserCalendarRunsRow, _a1 error) *MockQuerier_GetUserCalendarRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUserCalendarRuns_Call) RunAndReturn(run func(context.Context, GetUserCalendarRunsParams) ([]GetUserCalendarRunsRow, error)) *MockQuerier_GetUserCalendarRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserCalendarToken provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) GetUserCalendarToken(ctx context.Context, id int32) (pgtype.Text, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserCalendarToken")
+	}
+
+	var r0 pgtype.Text
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (pgtype.Text, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) pgtype.Text); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(pgtype.Text)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetUserCalendarToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserCalendarToken'
+type MockQuerier_GetUserCalendarToken_Call struct {
+	*mock.Call
+}
+
+// GetUserCalendarToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockQuerier_Expecter) GetUserCalendarToken(ctx interface{}, id interface{}) *MockQuerier_GetUserCalendarToken_Call {
+	return &MockQuerier_GetUserCalendarToken_Call{Call: _e.mock.On("GetUserCalendarToken", ctx, id)}
+}
+
+func (_c *MockQuerier_GetUserCalendarToken_Call) Run(run func(ctx context.Context, id int32)) *MockQuerier_GetUserCalendarToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUserCalendarToken_Call) Return(_a0 pgtype.Text, _a1 error) *MockQuerier_GetUserCalendarToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUserCalendarToken_Call) RunAndReturn(run func(context.Context, int32) (pgtype.Text, error)) *MockQuerier_GetUserCalendarToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserCharacters provides a mock function with given fields: ctx, userID
+func (_m *MockQuerier) GetUserCharacters(ctx context.Context, userID int32) ([]GetUserCharactersRow, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserCharacters")
+	}
+
+	var r0 []GetUserCharactersRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]GetUserCharactersRow, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []GetUserCharactersRow); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]GetUserCharactersRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetUserCharacters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserCharacters'
+type MockQuerier_GetUserCharacters_Call struct {
+	*mock.Call
+}
+
+// GetUserCharacters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int32
+func (_e *MockQuerier_Expecter) GetUserCharacters(ctx interface{}, userID interface{}) *MockQuerier_GetUserCharacters_Call {
+	return &MockQuerier_GetUserCharacters_Call{Call: _e.mock.On("GetUserCharacters", ctx, userID)}
+}
+
+func (_c *MockQuerier_GetUserCharacters_Call) Run(run func(ctx context.Context, userID int32)) *MockQuerier_GetUserCharacters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUserCharacters_Call) Return(_a0 []GetUserCharactersRow, _a1 error) *MockQuerier_GetUserCharacters_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUserCharacters_Call) RunAndReturn(run func(context.Context, int32) ([]GetUserCharactersRow, error)) *MockQuerier_GetUserCharacters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserFullByEmail provides a mock function with given fields: ctx, email
+func (_m *MockQuerier) GetUserFullByEmail(ctx context.Context, email string) (User, error) {
+	ret := _m.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserFullByEmail")
+	}
+
+	var r0 User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (User, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) User); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Get(0).(User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetUserFullByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserFullByEmail'
+type MockQuerier_GetUserFullByEmail_Call struct {
+	*mock.Call
+}
+
+// GetUserFullByEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+func (_e *MockQuerier_Expecter) GetUserFullByEmail(ctx interface{}, email interface{}) *MockQuerier_GetUserFullByEmail_Call {
+	return &MockQuerier_GetUserFullByEmail_Call{Call: _e.mock.On("GetUserFullByEmail", ctx, email)}
+}
+
+func (_c *MockQuerier_GetUserFullByEmail_Call) Run(run func(ctx context.Context, email string)) *MockQuerier_GetUserFullByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUserFullByEmail_Call) Return(_a0 User, _a1 error) *MockQuerier_GetUserFullByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUserFullByEmail_Call) RunAndReturn(run func(context.Context, string) (User, error)) *MockQuerier_GetUserFullByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserKeystones provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) GetUserKeystones(ctx context.Context, arg GetUserKeystonesParams) ([]GetUserKeystonesRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserKeystones")
+	}
+
+	var r0 []GetUserKeystonesRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, GetUserKeystonesParams) ([]GetUserKeystonesRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, GetUserKeystonesParams) []GetUserKeystonesRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]GetUserKeystonesRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, GetUserKeystonesParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetUserKeystones_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserKeystones'
+type MockQuerier_GetUserKeystones_Call struct {
+	*mock.Call
+}
+
+// GetUserKeystones is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg GetUserKeystonesParams
+func (_e *MockQuerier_Expecter) GetUserKeystones(ctx interface{}, arg interface{}) *MockQuerier_GetUserKeystones_Call {
+	return &MockQuerier_GetUserKeystones_Call{Call: _e.mock.On("GetUserKeystones", ctx, arg)}
+}
+
+func (_c *MockQuerier_GetUserKeystones_Call) Run(run func(ctx context.Context, arg GetUserKeystonesParams)) *MockQuerier_GetUserKeystones_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetUserKeystonesParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUserKeystones_Call) Return(_a0 []GetUserKeystonesRow, _a1 error) *MockQuerier_GetUserKeystones_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUserKeystones_Call) RunAndReturn(run func(context.Context, GetUserKeystonesParams) ([]GetUserKeystonesRow, error)) *MockQuerier_GetUserKeystones_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserRunStats provides a mock function with given fields: ctx, userID
+func (_m *MockQuerier) GetUserRunStats(ctx context.Context, userID int32) ([]GetUserRunStatsRow, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserRunStats")
+	}
+
+	var r0 []GetUserRunStatsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]GetUserRunStatsRow, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []GetUserRunStatsRow); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]GetUserRunStatsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetUserRunStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserRunStats'
+type MockQuerier_GetUserRunStats_Call struct {
+	*mock.Call
+}
+
+// GetUserRunStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int32
+func (_e *MockQuerier_Expecter) GetUserRunStats(ctx interface{}, userID interface{}) *MockQuerier_GetUserRunStats_Call {
+	return &MockQuerier_GetUserRunStats_Call{Call: _e.mock.On("GetUserRunStats", ctx, userID)}
+}
+
+func (_c *MockQuerier_GetUserRunStats_Call) Run(run func(ctx context.Context, userID int32)) *MockQuerier_GetUserRunStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUserRunStats_Call) Return(_a0 []GetUserRunStatsRow, _a1 error) *MockQuerier_GetUserRunStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUserRunStats_Call) RunAndReturn(run func(context.Context, int32) ([]GetUserRunStatsRow, error)) *MockQuerier_GetUserRunStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserRunStatsSummary provides a mock function with given fields: ctx, userID
+func (_m *MockQuerier) GetUserRunStatsSummary(ctx context.Context, userID int32) ([]GetUserRunStatsSummaryRow, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserRunStatsSummary")
+	}
+
+	var r0 []GetUserRunStatsSummaryRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]GetUserRunStatsSummaryRow, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []GetUserRunStatsSummaryRow); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]GetUserRunStatsSummaryRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetUserRunStatsSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserRunStatsSummary'
+type MockQuerier_GetUserRunStatsSummary_Call struct {
+	*mock.Call
+}
+
+// GetUserRunStatsSummary is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int32
+func (_e *MockQuerier_Expecter) GetUserRunStatsSummary(ctx interface{}, userID interface{}) *MockQuerier_GetUserRunStatsSummary_Call {
+	return &MockQuerier_GetUserRunStatsSummary_Call{Call: _e.mock.On("GetUserRunStatsSummary", ctx, userID)}
+}
+
+func (_c *MockQuerier_GetUserRunStatsSummary_Call) Run(run func(ctx context.Context, userID int32)) *MockQuerier_GetUserRunStatsSummary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUserRunStatsSummary_Call) Return(_a0 []GetUserRunStatsSummaryRow, _a1 error) *MockQuerier_GetUserRunStatsSummary_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUserRunStatsSummary_Call) RunAndReturn(run func(context.Context, int32) ([]GetUserRunStatsSummaryRow, error)) *MockQuerier_GetUserRunStatsSummary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserSignupStats provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) GetUserSignupStats(ctx context.Context, arg GetUserSignupStatsParams) (GetUserSignupStatsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserSignupStats")
+	}
+
+	var r0 GetUserSignupStatsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, GetUserSignupStatsParams) (GetUserSignupStatsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, GetUserSignupStatsParams) GetUserSignupStatsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(GetUserSignupStatsRow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, GetUserSignupStatsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetUserSignupStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserSignupStats'
+type MockQuerier_GetUserSignupStats_Call struct {
+	*mock.Call
+}
+
+// GetUserSignupStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg GetUserSignupStatsParams
+func (_e *MockQuerier_Expecter) GetUserSignupStats(ctx interface{}, arg interface{}) *MockQuerier_GetUserSignupStats_Call {
+	return &MockQuerier_GetUserSignupStats_Call{Call: _e.mock.On("GetUserSignupStats", ctx, arg)}
+}
+
+func (_c *MockQuerier_GetUserSignupStats_Call) Run(run func(ctx context.Context, arg GetUserSignupStatsParams)) *MockQuerier_GetUserSignupStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetUserSignupStatsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUserSignupStats_Call) Return(_a0 GetUserSignupStatsRow, _a1 error) *MockQuerier_GetUserSignupStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUserSignupStats_Call) RunAndReturn(run func(context.Context, GetUserSignupStatsParams) (GetUserSignupStatsRow, error)) *MockQuerier_GetUserSignupStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUsers provides a mock function with given fields: ctx
+func (_m *MockQuerier) GetUsers(ctx context.Context) ([]GetUsersRow, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsers")
+	}
+
+	var r0 []GetUsersRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]GetUsersRow, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []GetUsersRow); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]GetUsersRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsers'
+type MockQuerier_GetUsers_Call struct {
+	*mock.Call
+}
+
+// GetUsers is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuerier_Expecter) GetUsers(ctx interface{}) *MockQuerier_GetUsers_Call {
+	return &MockQuerier_GetUsers_Call{Call: _e.mock.On("GetUsers", ctx)}
+}
+
+func (_c *MockQuerier_GetUsers_Call) Run(run func(ctx context.Context)) *MockQuerier_GetUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUsers_Call) Return(_a0 []GetUsersRow, _a1 error) *MockQuerier_GetUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUsers_Call) RunAndReturn(run func(context.Context) ([]GetUsersRow, error)) *MockQuerier_GetUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUsersByIDs provides a mock function with given fields: ctx, ids
+func (_m *MockQuerier) GetUsersByIDs(ctx context.Context, ids []int32) ([]GetUsersByIDsRow, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsersByIDs")
+	}
+
+	var r0 []GetUsersByIDsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int32) ([]GetUsersByIDsRow, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int32) []GetUsersByIDsRow); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]GetUsersByIDsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int32) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetUsersByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsersByIDs'
+type MockQuerier_GetUsersByIDs_Call struct {
+	*mock.Call
+}
+
+// GetUsersByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int32
+func (_e *MockQuerier_Expecter) GetUsersByIDs(ctx interface{}, ids interface{}) *MockQuerier_GetUsersByIDs_Call {
+	return &MockQuerier_GetUsersByIDs_Call{Call: _e.mock.On("GetUsersByIDs", ctx, ids)}
+}
+
+func (_c *MockQuerier_GetUsersByIDs_Call) Run(run func(ctx context.Context, ids []int32)) *MockQuerier_GetUsersByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetUsersByIDs_Call) Return(_a0 []GetUsersByIDsRow, _a1 error) *MockQuerier_GetUsersByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetUsersByIDs_Call) RunAndReturn(run func(context.Context, []int32) ([]GetUsersByIDsRow, error)) *MockQuerier_GetUsersByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWaitlist provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) GetWaitlist(ctx context.Context, arg GetWaitlistParams) ([]GetWaitlistRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWaitlist")
+	}
+
+	var r0 []GetWaitlistRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, GetWaitlistParams) ([]GetWaitlistRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, GetWaitlistParams) []GetWaitlistRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]GetWaitlistRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, GetWaitlistParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetWaitlist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWaitlist'
+type MockQuerier_GetWaitlist_Call struct {
+	*mock.Call
+}
+
+// GetWaitlist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg GetWaitlistParams
+func (_e *MockQuerier_Expecter) GetWaitlist(ctx interface{}, arg interface{}) *MockQuerier_GetWaitlist_Call {
+	return &MockQuerier_GetWaitlist_Call{Call: _e.mock.On("GetWaitlist", ctx, arg)}
+}
+
+func (_c *MockQuerier_GetWaitlist_Call) Run(run func(ctx context.Context, arg GetWaitlistParams)) *MockQuerier_GetWaitlist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetWaitlistParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetWaitlist_Call) Return(_a0 []GetWaitlistRow, _a1 error) *MockQuerier_GetWaitlist_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetWaitlist_Call) RunAndReturn(run func(context.Context, GetWaitlistParams) ([]GetWaitlistRow, error)) *MockQuerier_GetWaitlist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhookDeliveries provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) GetWebhookDeliveries(ctx context.Context, arg GetWebhookDeliveriesParams) ([]WebhookDelivery, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookDeliveries")
+	}
+
+	var r0 []WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, GetWebhookDeliveriesParams) ([]WebhookDelivery, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, GetWebhookDeliveriesParams) []WebhookDelivery); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, GetWebhookDeliveriesParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhookDeliveries'
+type MockQuerier_GetWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// GetWebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg GetWebhookDeliveriesParams
+func (_e *MockQuerier_Expecter) GetWebhookDeliveries(ctx interface{}, arg interface{}) *MockQuerier_GetWebhookDeliveries_Call {
+	return &MockQuerier_GetWebhookDeliveries_Call{Call: _e.mock.On("GetWebhookDeliveries", ctx, arg)}
+}
+
+func (_c *MockQuerier_GetWebhookDeliveries_Call) Run(run func(ctx context.Context, arg GetWebhookDeliveriesParams)) *MockQuerier_GetWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetWebhookDeliveriesParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetWebhookDeliveries_Call) Return(_a0 []WebhookDelivery, _a1 error) *MockQuerier_GetWebhookDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetWebhookDeliveries_Call) RunAndReturn(run func(context.Context, GetWebhookDeliveriesParams) ([]WebhookDelivery, error)) *MockQuerier_GetWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhookDelivery provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) GetWebhookDelivery(ctx context.Context, id int64) (WebhookDelivery, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookDelivery")
+	}
+
+	var r0 WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (WebhookDelivery, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) WebhookDelivery); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(WebhookDelivery)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetWebhookDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhookDelivery'
+type MockQuerier_GetWebhookDelivery_Call struct {
+	*mock.Call
+}
+
+// GetWebhookDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockQuerier_Expecter) GetWebhookDelivery(ctx interface{}, id interface{}) *MockQuerier_GetWebhookDelivery_Call {
+	return &MockQuerier_GetWebhookDelivery_Call{Call: _e.mock.On("GetWebhookDelivery", ctx, id)}
+}
+
+func (_c *MockQuerier_GetWebhookDelivery_Call) Run(run func(ctx context.Context, id int64)) *MockQuerier_GetWebhookDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetWebhookDelivery_Call) Return(_a0 WebhookDelivery, _a1 error) *MockQuerier_GetWebhookDelivery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetWebhookDelivery_Call) RunAndReturn(run func(context.Context, int64) (WebhookDelivery, error)) *MockQuerier_GetWebhookDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhookEndpoint provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) GetWebhookEndpoint(ctx context.Context, id int32) (WebhookEndpoint, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookEndpoint")
+	}
+
+	var r0 WebhookEndpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (WebhookEndpoint, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) WebhookEndpoint); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(WebhookEndpoint)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetWebhookEndpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhookEndpoint'
+type MockQuerier_GetWebhookEndpoint_Call struct {
+	*mock.Call
+}
+
+// GetWebhookEndpoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockQuerier_Expecter) GetWebhookEndpoint(ctx interface{}, id interface{}) *MockQuerier_GetWebhookEndpoint_Call {
+	return &MockQuerier_GetWebhookEndpoint_Call{Call: _e.mock.On("GetWebhookEndpoint", ctx, id)}
+}
+
+func (_c *MockQuerier_GetWebhookEndpoint_Call) Run(run func(ctx context.Context, id int32)) *MockQuerier_GetWebhookEndpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetWebhookEndpoint_Call) Return(_a0 WebhookEndpoint, _a1 error) *MockQuerier_GetWebhookEndpoint_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetWebhookEndpoint_Call) RunAndReturn(run func(context.Context, int32) (WebhookEndpoint, error)) *MockQuerier_GetWebhookEndpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhookEndpoints provides a mock function with given fields: ctx, guildID
+func (_m *MockQuerier) GetWebhookEndpoints(ctx context.Context, guildID pgtype.Int4) ([]WebhookEndpoint, error) {
+	ret := _m.Called(ctx, guildID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookEndpoints")
+	}
+
+	var r0 []WebhookEndpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Int4) ([]WebhookEndpoint, error)); ok {
+		return rf(ctx, guildID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Int4) []WebhookEndpoint); ok {
+		r0 = rf(ctx, guildID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]WebhookEndpoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Int4) error); ok {
+		r1 = rf(ctx, guildID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetWebhookEndpoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhookEndpoints'
+type MockQuerier_GetWebhookEndpoints_Call struct {
+	*mock.Call
+}
+
+// GetWebhookEndpoints is a helper method to define mock.On call
+//   - ctx context.Context
+//   - guildID pgtype.Int4
+func (_e *MockQuerier_Expecter) GetWebhookEndpoints(ctx interface{}, guildID interface{}) *MockQuerier_GetWebhookEndpoints_Call {
+	return &MockQuerier_GetWebhookEndpoints_Call{Call: _e.mock.On("GetWebhookEndpoints", ctx, guildID)}
+}
+
+func (_c *MockQuerier_GetWebhookEndpoints_Call) Run(run func(ctx context.Context, guildID pgtype.Int4)) *MockQuerier_GetWebhookEndpoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Int4))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetWebhookEndpoints_Call) Return(_a0 []WebhookEndpoint, _a1 error) *MockQuerier_GetWebhookEndpoints_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetWebhookEndpoints_Call) RunAndReturn(run func(context.Context, pgtype.Int4) ([]WebhookEndpoint, error)) *MockQuerier_GetWebhookEndpoints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWeeklyTimedRuns provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) GetWeeklyTimedRuns(ctx context.Context, arg GetWeeklyTimedRunsParams) ([]GetWeeklyTimedRunsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWeeklyTimedRuns")
+	}
+
+	var r0 []GetWeeklyTimedRunsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, GetWeeklyTimedRunsParams) ([]GetWeeklyTimedRunsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, GetWeeklyTimedRunsParams) []GetWeeklyTimedRunsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]GetWeeklyTimedRunsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, GetWeeklyTimedRunsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetWeeklyTimedRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWeeklyTimedRuns'
+type MockQuerier_GetWeeklyTimedRuns_Call struct {
+	*mock.Call
+}
+
+// GetWeeklyTimedRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg GetWeeklyTimedRunsParams
+func (_e *MockQuerier_Expecter) GetWeeklyTimedRuns(ctx interface{}, arg interface{}) *MockQuerier_GetWeeklyTimedRuns_Call {
+	return &MockQuerier_GetWeeklyTimedRuns_Call{Call: _e.mock.On("GetWeeklyTimedRuns", ctx, arg)}
+}
+
+func (_c *MockQuerier_GetWeeklyTimedRuns_Call) Run(run func(ctx context.Context, arg GetWeeklyTimedRunsParams)) *MockQuerier_GetWeeklyTimedRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(GetWeeklyTimedRunsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetWeeklyTimedRuns_Call) Return(_a0 []GetWeeklyTimedRunsRow, _a1 error) *MockQuerier_GetWeeklyTimedRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetWeeklyTimedRuns_Call) RunAndReturn(run func(context.Context, GetWeeklyTimedRunsParams) ([]GetWeeklyTimedRunsRow, error)) *MockQuerier_GetWeeklyTimedRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockActiveLFGEntry provides a mock function with given fields: ctx, userID
+func (_m *MockQuerier) LockActiveLFGEntry(ctx context.Context, userID int32) (LfgEntry, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockActiveLFGEntry")
+	}
+
+	var r0 LfgEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (LfgEntry, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) LfgEntry); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(LfgEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_LockActiveLFGEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockActiveLFGEntry'
+type MockQuerier_LockActiveLFGEntry_Call struct {
+	*mock.Call
+}
+
+// LockActiveLFGEntry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int32
+func (_e *MockQuerier_Expecter) LockActiveLFGEntry(ctx interface{}, userID interface{}) *MockQuerier_LockActiveLFGEntry_Call {
+	return &MockQuerier_LockActiveLFGEntry_Call{Call: _e.mock.On("LockActiveLFGEntry", ctx, userID)}
+}
+
+func (_c *MockQuerier_LockActiveLFGEntry_Call) Run(run func(ctx context.Context, userID int32)) *MockQuerier_LockActiveLFGEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_LockActiveLFGEntry_Call) Return(_a0 LfgEntry, _a1 error) *MockQuerier_LockActiveLFGEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_LockActiveLFGEntry_Call) RunAndReturn(run func(context.Context, int32) (LfgEntry, error)) *MockQuerier_LockActiveLFGEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockCatalog provides a mock function with given fields: ctx, catalog
+func (_m *MockQuerier) LockCatalog(ctx context.Context, catalog string) error {
+	ret := _m.Called(ctx, catalog)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockCatalog")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, catalog)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_LockCatalog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockCatalog'
+type MockQuerier_LockCatalog_Call struct {
+	*mock.Call
+}
+
+// LockCatalog is a helper method to define mock.On call
+//   - ctx context.Context
+//   - catalog string
+func (_e *MockQuerier_Expecter) LockCatalog(ctx interface{}, catalog interface{}) *MockQuerier_LockCatalog_Call {
+	return &MockQuerier_LockCatalog_Call{Call: _e.mock.On("LockCatalog", ctx, catalog)}
+}
+
+func (_c *MockQuerier_LockCatalog_Call) Run(run func(ctx context.Context, catalog string)) *MockQuerier_LockCatalog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_LockCatalog_Call) Return(_a0 error) *MockQuerier_LockCatalog_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_LockCatalog_Call) RunAndReturn(run func(context.Context, string) error) *MockQuerier_LockCatalog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockExpiredLFGProposals provides a mock function with given fields: ctx, now
+func (_m *MockQuerier) LockExpiredLFGProposals(ctx context.Context, now pgtype.Timestamptz) ([]LfgProposal, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockExpiredLFGProposals")
+	}
+
+	var r0 []LfgProposal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) ([]LfgProposal, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) []LfgProposal); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]LfgProposal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Timestamptz) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_LockExpiredLFGProposals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockExpiredLFGProposals'
+type MockQuerier_LockExpiredLFGProposals_Call struct {
+	*mock.Call
+}
+
+// LockExpiredLFGProposals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now pgtype.Timestamptz
+func (_e *MockQuerier_Expecter) LockExpiredLFGProposals(ctx interface{}, now interface{}) *MockQuerier_LockExpiredLFGProposals_Call {
+	return &MockQuerier_LockExpiredLFGProposals_Call{Call: _e.mock.On("LockExpiredLFGProposals", ctx, now)}
+}
+
+func (_c *MockQuerier_LockExpiredLFGProposals_Call) Run(run func(ctx context.Context, now pgtype.Timestamptz)) *MockQuerier_LockExpiredLFGProposals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Timestamptz))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_LockExpiredLFGProposals_Call) Return(_a0 []LfgProposal, _a1 error) *MockQuerier_LockExpiredLFGProposals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_LockExpiredLFGProposals_Call) RunAndReturn(run func(context.Context, pgtype.Timestamptz) ([]LfgProposal, error)) *MockQuerier_LockExpiredLFGProposals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockLFGProposal provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) LockLFGProposal(ctx context.Context, id int32) (LfgProposal, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockLFGProposal")
+	}
+
+	var r0 LfgProposal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (LfgProposal, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) LfgProposal); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(LfgProposal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_LockLFGProposal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockLFGProposal'
+type MockQuerier_LockLFGProposal_Call struct {
+	*mock.Call
+}
+
+// LockLFGProposal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockQuerier_Expecter) LockLFGProposal(ctx interface{}, id interface{}) *MockQuerier_LockLFGProposal_Call {
+	return &MockQuerier_LockLFGProposal_Call{Call: _e.mock.On("LockLFGProposal", ctx, id)}
+}
+
+func (_c *MockQuerier_LockLFGProposal_Call) Run(run func(ctx context.Context, id int32)) *MockQuerier_LockLFGProposal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_LockLFGProposal_Call) Return(_a0 LfgProposal, _a1 error) *MockQuerier_LockLFGProposal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_LockLFGProposal_Call) RunAndReturn(run func(context.Context, int32) (LfgProposal, error)) *MockQuerier_LockLFGProposal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockPendingReadyCheck provides a mock function with given fields: ctx, runID
+func (_m *MockQuerier) LockPendingReadyCheck(ctx context.Context, runID int32) (ReadyCheck, error) {
+	ret := _m.Called(ctx, runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockPendingReadyCheck")
+	}
+
+	var r0 ReadyCheck
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (ReadyCheck, error)); ok {
+		return rf(ctx, runID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ReadyCheck); ok {
+		r0 = rf(ctx, runID)
+	} else {
+		r0 = ret.Get(0).(ReadyCheck)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, runID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_LockPendingReadyCheck_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockPendingReadyCheck'
+type MockQuerier_LockPendingReadyCheck_Call struct {
+	*mock.Call
+}
+
+// LockPendingReadyCheck is a helper method to define mock.On call
+//   - ctx context.Context
+//   - runID int32
+func (_e *MockQuerier_Expecter) LockPendingReadyCheck(ctx interface{}, runID interface{}) *MockQuerier_LockPendingReadyCheck_Call {
+	return &MockQuerier_LockPendingReadyCheck_Call{Call: _e.mock.On("LockPendingReadyCheck", ctx, runID)}
+}
+
+func (_c *MockQuerier_LockPendingReadyCheck_Call) Run(run func(ctx context.Context, runID int32)) *MockQuerier_LockPendingReadyCheck_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_LockPendingReadyCheck_Call) Return(_a0 ReadyCheck, _a1 error) *MockQuerier_LockPendingReadyCheck_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_LockPendingReadyCheck_Call) RunAndReturn(run func(context.Context, int32) (ReadyCheck, error)) *MockQuerier_LockPendingReadyCheck_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockQueuedLFGEntries provides a mock function with given fields: ctx, now
+func (_m *MockQuerier) LockQueuedLFGEntries(ctx context.Context, now pgtype.Timestamptz) ([]LockQueuedLFGEntriesRow, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockQueuedLFGEntries")
+	}
+
+	var r0 []LockQueuedLFGEntriesRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) ([]LockQueuedLFGEntriesRow, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) []LockQueuedLFGEntriesRow); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]LockQueuedLFGEntriesRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Timestamptz) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_LockQueuedLFGEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockQueuedLFGEntries'
+type MockQuerier_LockQueuedLFGEntries_Call struct {
+	*mock.Call
+}
+
+// LockQueuedLFGEntries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now pgtype.Timestamptz
+func (_e *MockQuerier_Expecter) LockQueuedLFGEntries(ctx interface{}, now interface{}) *MockQuerier_LockQueuedLFGEntries_Call {
+	return &MockQuerier_LockQueuedLFGEntries_Call{Call: _e.mock.On("LockQueuedLFGEntries", ctx, now)}
+}
+
+func (_c *MockQuerier_LockQueuedLFGEntries_Call) Run(run func(ctx context.Context, now pgtype.Timestamptz)) *MockQuerier_LockQueuedLFGEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Timestamptz))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_LockQueuedLFGEntries_Call) Return(_a0 []LockQueuedLFGEntriesRow, _a1 error) *MockQuerier_LockQueuedLFGEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_LockQueuedLFGEntries_Call) RunAndReturn(run func(context.Context, pgtype.Timestamptz) ([]LockQueuedLFGEntriesRow, error)) *MockQuerier_LockQueuedLFGEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockReadyCheck provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) LockReadyCheck(ctx context.Context, id int32) (ReadyCheck, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockReadyCheck")
+	}
+
+	var r0 ReadyCheck
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (ReadyCheck, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ReadyCheck); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(ReadyCheck)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_LockReadyCheck_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockReadyCheck'
+type MockQuerier_LockReadyCheck_Call struct {
+	*mock.Call
+}
+
+// LockReadyCheck is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockQuerier_Expecter) LockReadyCheck(ctx interface{}, id interface{}) *MockQuerier_LockReadyCheck_Call {
+	return &MockQuerier_LockReadyCheck_Call{Call: _e.mock.On("LockReadyCheck", ctx, id)}
+}
+
+func (_c *MockQuerier_LockReadyCheck_Call) Run(run func(ctx context.Context, id int32)) *MockQuerier_LockReadyCheck_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_LockReadyCheck_Call) Return(_a0 ReadyCheck, _a1 error) *MockQuerier_LockReadyCheck_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_LockReadyCheck_Call) RunAndReturn(run func(context.Context, int32) (ReadyCheck, error)) *MockQuerier_LockReadyCheck_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockRun provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) LockRun(ctx context.Context, id int32) (Run, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockRun")
+	}
+
+	var r0 Run
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (Run, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) Run); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(Run)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_LockRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockRun'
+type MockQuerier_LockRun_Call struct {
+	*mock.Call
+}
+
+// LockRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockQuerier_Expecter) LockRun(ctx interface{}, id interface{}) *MockQuerier_LockRun_Call {
+	return &MockQuerier_LockRun_Call{Call: _e.mock.On("LockRun", ctx, id)}
+}
+
+func (_c *MockQuerier_LockRun_Call) Run(run func(ctx context.Context, id int32)) *MockQuerier_LockRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_LockRun_Call) Return(_a0 Run, _a1 error) *MockQuerier_LockRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_LockRun_Call) RunAndReturn(run func(context.Context, int32) (Run, error)) *MockQuerier_LockRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockSeriesRun provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) LockSeriesRun(ctx context.Context, arg LockSeriesRunParams) (Run, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockSeriesRun")
+	}
+
+	var r0 Run
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, LockSeriesRunParams) (Run, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, LockSeriesRunParams) Run); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(Run)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, LockSeriesRunParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_LockSeriesRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockSeriesRun'
+type MockQuerier_LockSeriesRun_Call struct {
+	*mock.Call
+}
+
+// LockSeriesRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg LockSeriesRunParams
+func (_e *MockQuerier_Expecter) LockSeriesRun(ctx interface{}, arg interface{}) *MockQuerier_LockSeriesRun_Call {
+	return &MockQuerier_LockSeriesRun_Call{Call: _e.mock.On("LockSeriesRun", ctx, arg)}
+}
+
+func (_c *MockQuerier_LockSeriesRun_Call) Run(run func(ctx context.Context, arg LockSeriesRunParams)) *MockQuerier_LockSeriesRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(LockSeriesRunParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_LockSeriesRun_Call) Return(_a0 Run, _a1 error) *MockQuerier_LockSeriesRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_LockSeriesRun_Call) RunAndReturn(run func(context.Context, LockSeriesRunParams) (Run, error)) *MockQuerier_LockSeriesRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkAttendance provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) MarkAttendance(ctx context.Context, arg MarkAttendanceParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAttendance")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, MarkAttendanceParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, MarkAttendanceParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, MarkAttendanceParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_MarkAttendance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAttendance'
+type MockQuerier_MarkAttendance_Call struct {
+	*mock.Call
+}
+
+// MarkAttendance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg MarkAttendanceParams
+func (_e *MockQuerier_Expecter) MarkAttendance(ctx interface{}, arg interface{}) *MockQuerier_MarkAttendance_Call {
+	return &MockQuerier_MarkAttendance_Call{Call: _e.mock.On("MarkAttendance", ctx, arg)}
+}
+
+func (_c *MockQuerier_MarkAttendance_Call) Run(run func(ctx context.Context, arg MarkAttendanceParams)) *MockQuerier_MarkAttendance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(MarkAttendanceParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_MarkAttendance_Call) Return(_a0 int64, _a1 error) *MockQuerier_MarkAttendance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_MarkAttendance_Call) RunAndReturn(run func(context.Context, MarkAttendanceParams) (int64, error)) *MockQuerier_MarkAttendance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkInboxNotificationRead provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) MarkInboxNotificationRead(ctx context.Context, arg MarkInboxNotificationReadParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkInboxNotificationRead")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, MarkInboxNotificationReadParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, MarkInboxNotificationReadParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, MarkInboxNotificationReadParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_MarkInboxNotificationRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkInboxNotificationRead'
+type MockQuerier_MarkInboxNotificationRead_Call struct {
+	*mock.Call
+}
+
+// MarkInboxNotificationRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg MarkInboxNotificationReadParams
+func (_e *MockQuerier_Expecter) MarkInboxNotificationRead(ctx interface{}, arg interface{}) *MockQuerier_MarkInboxNotificationRead_Call {
+	return &MockQuerier_MarkInboxNotificationRead_Call{Call: _e.mock.On("MarkInboxNotificationRead", ctx, arg)}
+}
+
+func (_c *MockQuerier_MarkInboxNotificationRead_Call) Run(run func(ctx context.Context, arg MarkInboxNotificationReadParams)) *MockQuerier_MarkInboxNotificationRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(MarkInboxNotificationReadParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_MarkInboxNotificationRead_Call) Return(_a0 int64, _a1 error) *MockQuerier_MarkInboxNotificationRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_MarkInboxNotificationRead_Call) RunAndReturn(run func(context.Context, MarkInboxNotificationReadParams) (int64, error)) *MockQuerier_MarkInboxNotificationRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MaterializeSeriesRun provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) MaterializeSeriesRun(ctx context.Context, arg MaterializeSeriesRunParams) (Run, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaterializeSeriesRun")
+	}
+
+	var r0 Run
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, MaterializeSeriesRunParams) (Run, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, MaterializeSeriesRunParams) Run); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(Run)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, MaterializeSeriesRunParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_MaterializeSeriesRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaterializeSeriesRun'
+type MockQuerier_MaterializeSeriesRun_Call struct {
+	*mock.Call
+}
+
+// MaterializeSeriesRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg MaterializeSeriesRunParams
+func (_e *MockQuerier_Expecter) MaterializeSeriesRun(ctx interface{}, arg interface{}) *MockQuerier_MaterializeSeriesRun_Call {
+	return &MockQuerier_MaterializeSeriesRun_Call{Call: _e.mock.On("MaterializeSeriesRun", ctx, arg)}
+}
+
+func (_c *MockQuerier_MaterializeSeriesRun_Call) Run(run func(ctx context.Context, arg MaterializeSeriesRunParams)) *MockQuerier_MaterializeSeriesRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(MaterializeSeriesRunParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_MaterializeSeriesRun_Call) Return(_a0 Run, _a1 error) *MockQuerier_MaterializeSeriesRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_MaterializeSeriesRun_Call) RunAndReturn(run func(context.Context, MaterializeSeriesRunParams) (Run, error)) *MockQuerier_MaterializeSeriesRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveSeriesRun provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) MoveSeriesRun(ctx context.Context, arg MoveSeriesRunParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveSeriesRun")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, MoveSeriesRunParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_MoveSeriesRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveSeriesRun'
+type MockQuerier_MoveSeriesRun_Call struct {
+	*mock.Call
+}
+
+// MoveSeriesRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg MoveSeriesRunParams
+func (_e *MockQuerier_Expecter) MoveSeriesRun(ctx interface{}, arg interface{}) *MockQuerier_MoveSeriesRun_Call {
+	return &MockQuerier_MoveSeriesRun_Call{Call: _e.mock.On("MoveSeriesRun", ctx, arg)}
+}
+
+func (_c *MockQuerier_MoveSeriesRun_Call) Run(run func(ctx context.Context, arg MoveSeriesRunParams)) *MockQuerier_MoveSeriesRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(MoveSeriesRunParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_MoveSeriesRun_Call) Return(_a0 error) *MockQuerier_MoveSeriesRun_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_MoveSeriesRun_Call) RunAndReturn(run func(context.Context, MoveSeriesRunParams) error) *MockQuerier_MoveSeriesRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NextWaitlistPosition provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) NextWaitlistPosition(ctx context.Context, arg NextWaitlistPositionParams) (int32, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NextWaitlistPosition")
+	}
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, NextWaitlistPositionParams) (int32, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, NextWaitlistPositionParams) int32); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, NextWaitlistPositionParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_NextWaitlistPosition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NextWaitlistPosition'
+type MockQuerier_NextWaitlistPosition_Call struct {
+	*mock.Call
+}
+
+// NextWaitlistPosition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg NextWaitlistPositionParams
+func (_e *MockQuerier_Expecter) NextWaitlistPosition(ctx interface{}, arg interface{}) *MockQuerier_NextWaitlistPosition_Call {
+	return &MockQuerier_NextWaitlistPosition_Call{Call: _e.mock.On("NextWaitlistPosition", ctx, arg)}
+}
+
+func (_c *MockQuerier_NextWaitlistPosition_Call) Run(run func(ctx context.Context, arg NextWaitlistPositionParams)) *MockQuerier_NextWaitlistPosition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(NextWaitlistPositionParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_NextWaitlistPosition_Call) Return(_a0 int32, _a1 error) *MockQuerier_NextWaitlistPosition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_NextWaitlistPosition_Call) RunAndReturn(run func(context.Context, NextWaitlistPositionParams) (int32, error)) *MockQuerier_NextWaitlistPosition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PromoteSignup provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) PromoteSignup(ctx context.Context, id int32) (RunSignup, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PromoteSignup")
+	}
+
+	var r0 RunSignup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (RunSignup, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) RunSignup); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(RunSignup)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_PromoteSignup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PromoteSignup'
+type MockQuerier_PromoteSignup_Call struct {
+	*mock.Call
+}
+
+// PromoteSignup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockQuerier_Expecter) PromoteSignup(ctx interface{}, id interface{}) *MockQuerier_PromoteSignup_Call {
+	return &MockQuerier_PromoteSignup_Call{Call: _e.mock.On("PromoteSignup", ctx, id)}
+}
+
+func (_c *MockQuerier_PromoteSignup_Call) Run(run func(ctx context.Context, id int32)) *MockQuerier_PromoteSignup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_PromoteSignup_Call) Return(_a0 RunSignup, _a1 error) *MockQuerier_PromoteSignup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_PromoteSignup_Call) RunAndReturn(run func(context.Context, int32) (RunSignup, error)) *MockQuerier_PromoteSignup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordWebhookFailure provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) RecordWebhookFailure(ctx context.Context, arg RecordWebhookFailureParams) (WebhookEndpoint, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordWebhookFailure")
+	}
+
+	var r0 WebhookEndpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, RecordWebhookFailureParams) (WebhookEndpoint, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, RecordWebhookFailureParams) WebhookEndpoint); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(WebhookEndpoint)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, RecordWebhookFailureParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_RecordWebhookFailure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordWebhookFailure'
+type MockQuerier_RecordWebhookFailure_Call struct {
+	*mock.Call
+}
+
+// RecordWebhookFailure is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg RecordWebhookFailureParams
+func (_e *MockQuerier_Expecter) RecordWebhookFailure(ctx interface{}, arg interface{}) *MockQuerier_RecordWebhookFailure_Call {
+	return &MockQuerier_RecordWebhookFailure_Call{Call: _e.mock.On("RecordWebhookFailure", ctx, arg)}
+}
+
+func (_c *MockQuerier_RecordWebhookFailure_Call) Run(run func(ctx context.Context, arg RecordWebhookFailureParams)) *MockQuerier_RecordWebhookFailure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(RecordWebhookFailureParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RecordWebhookFailure_Call) Return(_a0 WebhookEndpoint, _a1 error) *MockQuerier_RecordWebhookFailure_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_RecordWebhookFailure_Call) RunAndReturn(run func(context.Context, RecordWebhookFailureParams) (WebhookEndpoint, error)) *MockQuerier_RecordWebhookFailure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshUserRunStatsSummary provides a mock function with given fields: ctx
+func (_m *MockQuerier) RefreshUserRunStatsSummary(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshUserRunStatsSummary")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_RefreshUserRunStatsSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshUserRunStatsSummary'
+type MockQuerier_RefreshUserRunStatsSummary_Call struct {
+	*mock.Call
+}
+
+// RefreshUserRunStatsSummary is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuerier_Expecter) RefreshUserRunStatsSummary(ctx interface{}) *MockQuerier_RefreshUserRunStatsSummary_Call {
+	return &MockQuerier_RefreshUserRunStatsSummary_Call{Call: _e.mock.On("RefreshUserRunStatsSummary", ctx)}
+}
+
+func (_c *MockQuerier_RefreshUserRunStatsSummary_Call) Run(run func(ctx context.Context)) *MockQuerier_RefreshUserRunStatsSummary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RefreshUserRunStatsSummary_Call) Return(_a0 error) *MockQuerier_RefreshUserRunStatsSummary_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_RefreshUserRunStatsSummary_Call) RunAndReturn(run func(context.Context) error) *MockQuerier_RefreshUserRunStatsSummary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseJob provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) ReleaseJob(ctx context.Context, arg ReleaseJobParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ReleaseJobParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_ReleaseJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseJob'
+type MockQuerier_ReleaseJob_Call struct {
+	*mock.Call
+}
+
+// ReleaseJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg ReleaseJobParams
+func (_e *MockQuerier_Expecter) ReleaseJob(ctx interface{}, arg interface{}) *MockQuerier_ReleaseJob_Call {
+	return &MockQuerier_ReleaseJob_Call{Call: _e.mock.On("ReleaseJob", ctx, arg)}
+}
+
+func (_c *MockQuerier_ReleaseJob_Call) Run(run func(ctx context.Context, arg ReleaseJobParams)) *MockQuerier_ReleaseJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ReleaseJobParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ReleaseJob_Call) Return(_a0 error) *MockQuerier_ReleaseJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_ReleaseJob_Call) RunAndReturn(run func(context.Context, ReleaseJobParams) error) *MockQuerier_ReleaseJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveGuildMember provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) RemoveGuildMember(ctx context.Context, arg RemoveGuildMemberParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveGuildMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, RemoveGuildMemberParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_RemoveGuildMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveGuildMember'
+type MockQuerier_RemoveGuildMember_Call struct {
+	*mock.Call
+}
+
+// RemoveGuildMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg RemoveGuildMemberParams
+func (_e *MockQuerier_Expecter) RemoveGuildMember(ctx interface{}, arg interface{}) *MockQuerier_RemoveGuildMember_Call {
+	return &MockQuerier_RemoveGuildMember_Call{Call: _e.mock.On("RemoveGuildMember", ctx, arg)}
+}
+
+func (_c *MockQuerier_RemoveGuildMember_Call) Run(run func(ctx context.Context, arg RemoveGuildMemberParams)) *MockQuerier_RemoveGuildMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(RemoveGuildMemberParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RemoveGuildMember_Call) Return(_a0 error) *MockQuerier_RemoveGuildMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_RemoveGuildMember_Call) RunAndReturn(run func(context.Context, RemoveGuildMemberParams) error) *MockQuerier_RemoveGuildMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetWebhookFailures provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) ResetWebhookFailures(ctx context.Context, id int32) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetWebhookFailures")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_ResetWebhookFailures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetWebhookFailures'
+type MockQuerier_ResetWebhookFailures_Call struct {
+	*mock.Call
+}
+
+// ResetWebhookFailures is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockQuerier_Expecter) ResetWebhookFailures(ctx interface{}, id interface{}) *MockQuerier_ResetWebhookFailures_Call {
+	return &MockQuerier_ResetWebhookFailures_Call{Call: _e.mock.On("ResetWebhookFailures", ctx, id)}
+}
+
+func (_c *MockQuerier_ResetWebhookFailures_Call) Run(run func(ctx context.Context, id int32)) *MockQuerier_ResetWebhookFailures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ResetWebhookFailures_Call) Return(_a0 error) *MockQuerier_ResetWebhookFailures_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_ResetWebhookFailures_Call) RunAndReturn(run func(context.Context, int32) error) *MockQuerier_ResetWebhookFailures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetryJob provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) RetryJob(ctx context.Context, id int64) (Job, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryJob")
+	}
+
+	var r0 Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (Job, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) Job); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(Job)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_RetryJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryJob'
+type MockQuerier_RetryJob_Call struct {
+	*mock.Call
+}
+
+// RetryJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockQuerier_Expecter) RetryJob(ctx interface{}, id interface{}) *MockQuerier_RetryJob_Call {
+	return &MockQuerier_RetryJob_Call{Call: _e.mock.On("RetryJob", ctx, id)}
+}
+
+func (_c *MockQuerier_RetryJob_Call) Run(run func(ctx context.Context, id int64)) *MockQuerier_RetryJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RetryJob_Call) Return(_a0 Job, _a1 error) *MockQuerier_RetryJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_RetryJob_Call) RunAndReturn(run func(context.Context, int64) (Job, error)) *MockQuerier_RetryJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCatalogVersion provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) SetCatalogVersion(ctx context.Context, arg SetCatalogVersionParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCatalogVersion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, SetCatalogVersionParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_SetCatalogVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCatalogVersion'
+type MockQuerier_SetCatalogVersion_Call struct {
+	*mock.Call
+}
+
+// SetCatalogVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg SetCatalogVersionParams
+func (_e *MockQuerier_Expecter) SetCatalogVersion(ctx interface{}, arg interface{}) *MockQuerier_SetCatalogVersion_Call {
+	return &MockQuerier_SetCatalogVersion_Call{Call: _e.mock.On("SetCatalogVersion", ctx, arg)}
+}
+
+func (_c *MockQuerier_SetCatalogVersion_Call) Run(run func(ctx context.Context, arg SetCatalogVersionParams)) *MockQuerier_SetCatalogVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(SetCatalogVersionParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_SetCatalogVersion_Call) Return(_a0 error) *MockQuerier_SetCatalogVersion_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_SetCatalogVersion_Call) RunAndReturn(run func(context.Context, SetCatalogVersionParams) error) *MockQuerier_SetCatalogVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCharacterArmory provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) SetCharacterArmory(ctx context.Context, arg SetCharacterArmoryParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCharacterArmory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, SetCharacterArmoryParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_SetCharacterArmory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCharacterArmory'
+type MockQuerier_SetCharacterArmory_Call struct {
+	*mock.Call
+}
+
+// SetCharacterArmory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg SetCharacterArmoryParams
+func (_e *MockQuerier_Expecter) SetCharacterArmory(ctx interface{}, arg interface{}) *MockQuerier_SetCharacterArmory_Call {
+	return &MockQuerier_SetCharacterArmory_Call{Call: _e.mock.On("SetCharacterArmory", ctx, arg)}
+}
+
+func (_c *MockQuerier_SetCharacterArmory_Call) Run(run func(ctx context.Context, arg SetCharacterArmoryParams)) *MockQuerier_SetCharacterArmory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(SetCharacterArmoryParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_SetCharacterArmory_Call) Return(_a0 error) *MockQuerier_SetCharacterArmory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_SetCharacterArmory_Call) RunAndReturn(run func(context.Context, SetCharacterArmoryParams) error) *MockQuerier_SetCharacterArmory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetGuildDiscordWebhook provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) SetGuildDiscordWebhook(ctx context.Context, arg SetGuildDiscordWebhookParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetGuildDiscordWebhook")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, SetGuildDiscordWebhookParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_SetGuildDiscordWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetGuildDiscordWebhook'
+type MockQuerier_SetGuildDiscordWebhook_Call struct {
+	*mock.Call
+}
+
+// SetGuildDiscordWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg SetGuildDiscordWebhookParams
+func (_e *MockQuerier_Expecter) SetGuildDiscordWebhook(ctx interface{}, arg interface{}) *MockQuerier_SetGuildDiscordWebhook_Call {
+	return &MockQuerier_SetGuildDiscordWebhook_Call{Call: _e.mock.On("SetGuildDiscordWebhook", ctx, arg)}
+}
+
+func (_c *MockQuerier_SetGuildDiscordWebhook_Call) Run(run func(ctx context.Context, arg SetGuildDiscordWebhookParams)) *MockQuerier_SetGuildDiscordWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(SetGuildDiscordWebhookParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_SetGuildDiscordWebhook_Call) Return(_a0 error) *MockQuerier_SetGuildDiscordWebhook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_SetGuildDiscordWebhook_Call) RunAndReturn(run func(context.Context, SetGuildDiscordWebhookParams) error) *MockQuerier_SetGuildDiscordWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetGuildLeaderboardScoring provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) SetGuildLeaderboardScoring(ctx context.Context, arg SetGuildLeaderboardScoringParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetGuildLeaderboardScoring")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, SetGuildLeaderboardScoringParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_SetGuildLeaderboardScoring_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetGuildLeaderboardScoring'
+type MockQuerier_SetGuildLeaderboardScoring_Call struct {
+	*mock.Call
+}
+
+// SetGuildLeaderboardScoring is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg SetGuildLeaderboardScoringParams
+func (_e *MockQuerier_Expecter) SetGuildLeaderboardScoring(ctx interface{}, arg interface{}) *MockQuerier_SetGuildLeaderboardScoring_Call {
+	return &MockQuerier_SetGuildLeaderboardScoring_Call{Call: _e.mock.On("SetGuildLeaderboardScoring", ctx, arg)}
+}
+
+func (_c *MockQuerier_SetGuildLeaderboardScoring_Call) Run(run func(ctx context.Context, arg SetGuildLeaderboardScoringParams)) *MockQuerier_SetGuildLeaderboardScoring_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(SetGuildLeaderboardScoringParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_SetGuildLeaderboardScoring_Call) Return(_a0 error) *MockQuerier_SetGuildLeaderboardScoring_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_SetGuildLeaderboardScoring_Call) RunAndReturn(run func(context.Context, SetGuildLeaderboardScoringParams) error) *MockQuerier_SetGuildLeaderboardScoring_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetGuildSignupPolicy provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) SetGuildSignupPolicy(ctx context.Context, arg SetGuildSignupPolicyParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetGuildSignupPolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, SetGuildSignupPolicyParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_SetGuildSignupPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetGuildSignupPolicy'
+type MockQuerier_SetGuildSignupPolicy_Call struct {
+	*mock.Call
+}
+
+// SetGuildSignupPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg SetGuildSignupPolicyParams
+func (_e *MockQuerier_Expecter) SetGuildSignupPolicy(ctx interface{}, arg interface{}) *MockQuerier_SetGuildSignupPolicy_Call {
+	return &MockQuerier_SetGuildSignupPolicy_Call{Call: _e.mock.On("SetGuildSignupPolicy", ctx, arg)}
+}
+
+func (_c *MockQuerier_SetGuildSignupPolicy_Call) Run(run func(ctx context.Context, arg SetGuildSignupPolicyParams)) *MockQuerier_SetGuildSignupPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(SetGuildSignupPolicyParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_SetGuildSignupPolicy_Call) Return(_a0 error) *MockQuerier_SetGuildSignupPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_SetGuildSignupPolicy_Call) RunAndReturn(run func(context.Context, SetGuildSignupPolicyParams) error) *MockQuerier_SetGuildSignupPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetLFGEntryStatus provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) SetLFGEntryStatus(ctx context.Context, arg SetLFGEntryStatusParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLFGEntryStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, SetLFGEntryStatusParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_SetLFGEntryStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetLFGEntryStatus'
+type MockQuerier_SetLFGEntryStatus_Call struct {
+	*mock.Call
+}
+
+// SetLFGEntryStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg SetLFGEntryStatusParams
+func (_e *MockQuerier_Expecter) SetLFGEntryStatus(ctx interface{}, arg interface{}) *MockQuerier_SetLFGEntryStatus_Call {
+	return &MockQuerier_SetLFGEntryStatus_Call{Call: _e.mock.On("SetLFGEntryStatus", ctx, arg)}
+}
+
+func (_c *MockQuerier_SetLFGEntryStatus_Call) Run(run func(ctx context.Context, arg SetLFGEntryStatusParams)) *MockQuerier_SetLFGEntryStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(SetLFGEntryStatusParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_SetLFGEntryStatus_Call) Return(_a0 error) *MockQuerier_SetLFGEntryStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_SetLFGEntryStatus_Call) RunAndReturn(run func(context.Context, SetLFGEntryStatusParams) error) *MockQuerier_SetLFGEntryStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetLFGProposalResponse provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) SetLFGProposalResponse(ctx context.Context, arg SetLFGProposalResponseParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLFGProposalResponse")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, SetLFGProposalResponseParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, SetLFGProposalResponseParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, SetLFGProposalResponseParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_SetLFGProposalResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetLFGProposalResponse'
+type MockQuerier_SetLFGProposalResponse_Call struct {
+	*mock.Call
+}
+
+// SetLFGProposalResponse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg SetLFGProposalResponseParams
+func (_e *MockQuerier_Expecter) SetLFGProposalResponse(ctx interface{}, arg interface{}) *MockQuerier_SetLFGProposalResponse_Call {
+	return &MockQuerier_SetLFGProposalResponse_Call{Call: _e.mock.On("SetLFGProposalResponse", ctx, arg)}
+}
+
+func (_c *MockQuerier_SetLFGProposalResponse_Call) Run(run func(ctx context.Context, arg SetLFGProposalResponseParams)) *MockQuerier_SetLFGProposalResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(SetLFGProposalResponseParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_SetLFGProposalResponse_Call) Return(_a0 int64, _a1 error) *MockQuerier_SetLFGProposalResponse_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_SetLFGProposalResponse_Call) RunAndReturn(run func(context.Context, SetLFGProposalResponseParams) (int64, error)) *MockQuerier_SetLFGProposalResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetLFGProposalStatus provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) SetLFGProposalStatus(ctx context.Context, arg SetLFGProposalStatusParams) (LfgProposal, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLFGProposalStatus")
+	}
+
+	var r0 LfgProposal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, SetLFGProposalStatusParams) (LfgProposal, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, SetLFGProposalStatusParams) LfgProposal); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(LfgProposal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, SetLFGProposalStatusParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_SetLFGProposalStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetLFGProposalStatus'
+type MockQuerier_SetLFGProposalStatus_Call struct {
+	*mock.Call
+}
+
+// SetLFGProposalStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg SetLFGProposalStatusParams
+func (_e *MockQuerier_Expecter) SetLFGProposalStatus(ctx interface{}, arg interface{}) *MockQuerier_SetLFGProposalStatus_Call {
+	return &MockQuerier_SetLFGProposalStatus_Call{Call: _e.mock.On("SetLFGProposalStatus", ctx, arg)}
+}
+
+func (_c *MockQuerier_SetLFGProposalStatus_Call) Run(run func(ctx context.Context, arg SetLFGProposalStatusParams)) *MockQuerier_SetLFGProposalStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(SetLFGProposalStatusParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_SetLFGProposalStatus_Call) Return(_a0 LfgProposal, _a1 error) *MockQuerier_SetLFGProposalStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_SetLFGProposalStatus_Call) RunAndReturn(run func(context.Context, SetLFGProposalStatusParams) (LfgProposal, error)) *MockQuerier_SetLFGProposalStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetRunStatus provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) SetRunStatus(ctx context.Context, arg SetRunStatusParams) (Run, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRunStatus")
+	}
+
+	var r0 Run
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, SetRunStatusParams) (Run, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, SetRunStatusParams) Run); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(Run)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, SetRunStatusParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_SetRunStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRunStatus'
+type MockQuerier_SetRunStatus_Call struct {
+	*mock.Call
+}
+
+// SetRunStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg SetRunStatusParams
+func (_e *MockQuerier_Expecter) SetRunStatus(ctx interface{}, arg interface{}) *MockQuerier_SetRunStatus_Call {
+	return &MockQuerier_SetRunStatus_Call{Call: _e.mock.On("SetRunStatus", ctx, arg)}
+}
+
+func (_c *MockQuerier_SetRunStatus_Call) Run(run func(ctx context.Context, arg SetRunStatusParams)) *MockQuerier_SetRunStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(SetRunStatusParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_SetRunStatus_Call) Return(_a0 Run, _a1 error) *MockQuerier_SetRunStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_SetRunStatus_Call) RunAndReturn(run func(context.Context, SetRunStatusParams) (Run, error)) *MockQuerier_SetRunStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUserCalendarToken provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) SetUserCalendarToken(ctx context.Context, arg SetUserCalendarTokenParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUserCalendarToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, SetUserCalendarTokenParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_SetUserCalendarToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUserCalendarToken'
+type MockQuerier_SetUserCalendarToken_Call struct {
+	*mock.Call
+}
+
+// SetUserCalendarToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg SetUserCalendarTokenParams
+func (_e *MockQuerier_Expecter) SetUserCalendarToken(ctx interface{}, arg interface{}) *MockQuerier_SetUserCalendarToken_Call {
+	return &MockQuerier_SetUserCalendarToken_Call{Call: _e.mock.On("SetUserCalendarToken", ctx, arg)}
+}
+
+func (_c *MockQuerier_SetUserCalendarToken_Call) Run(run func(ctx context.Context, arg SetUserCalendarTokenParams)) *MockQuerier_SetUserCalendarToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(SetUserCalendarTokenParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_SetUserCalendarToken_Call) Return(_a0 error) *MockQuerier_SetUserCalendarToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_SetUserCalendarToken_Call) RunAndReturn(run func(context.Context, SetUserCalendarTokenParams) error) *MockQuerier_SetUserCalendarToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetWaitlistPosition provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) SetWaitlistPosition(ctx context.Context, arg SetWaitlistPositionParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetWaitlistPosition")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, SetWaitlistPositionParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_SetWaitlistPosition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetWaitlistPosition'
+type MockQuerier_SetWaitlistPosition_Call struct {
+	*mock.Call
+}
+
+// SetWaitlistPosition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg SetWaitlistPositionParams
+func (_e *MockQuerier_Expecter) SetWaitlistPosition(ctx interface{}, arg interface{}) *MockQuerier_SetWaitlistPosition_Call {
+	return &MockQuerier_SetWaitlistPosition_Call{Call: _e.mock.On("SetWaitlistPosition", ctx, arg)}
+}
+
+func (_c *MockQuerier_SetWaitlistPosition_Call) Run(run func(ctx context.Context, arg SetWaitlistPositionParams)) *MockQuerier_SetWaitlistPosition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(SetWaitlistPositionParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_SetWaitlistPosition_Call) Return(_a0 error) *MockQuerier_SetWaitlistPosition_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_SetWaitlistPosition_Call) RunAndReturn(run func(context.Context, SetWaitlistPositionParams) error) *MockQuerier_SetWaitlistPosition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransitionRun provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) TransitionRun(ctx context.Context, arg TransitionRunParams) (Run, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransitionRun")
+	}
+
+	var r0 Run
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, TransitionRunParams) (Run, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, TransitionRunParams) Run); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(Run)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, TransitionRunParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_TransitionRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransitionRun'
+type MockQuerier_TransitionRun_Call struct {
+	*mock.Call
+}
+
+// TransitionRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg TransitionRunParams
+func (_e *MockQuerier_Expecter) TransitionRun(ctx interface{}, arg interface{}) *MockQuerier_TransitionRun_Call {
+	return &MockQuerier_TransitionRun_Call{Call: _e.mock.On("TransitionRun", ctx, arg)}
+}
+
+func (_c *MockQuerier_TransitionRun_Call) Run(run func(ctx context.Context, arg TransitionRunParams)) *MockQuerier_TransitionRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(TransitionRunParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_TransitionRun_Call) Return(_a0 Run, _a1 error) *MockQuerier_TransitionRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_TransitionRun_Call) RunAndReturn(run func(context.Context, TransitionRunParams) (Run, error)) *MockQuerier_TransitionRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCharacter provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) UpdateCharacter(ctx context.Context, arg UpdateCharacterParams) (Character, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCharacter")
+	}
+
+	var r0 Character
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, UpdateCharacterParams) (Character, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, UpdateCharacterParams) Character); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(Character)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, UpdateCharacterParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_UpdateCharacter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCharacter'
+type MockQuerier_UpdateCharacter_Call struct {
+	*mock.Call
+}
+
+// UpdateCharacter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg UpdateCharacterParams
+func (_e *MockQuerier_Expecter) UpdateCharacter(ctx interface{}, arg interface{}) *MockQuerier_UpdateCharacter_Call {
+	return &MockQuerier_UpdateCharacter_Call{Call: _e.mock.On("UpdateCharacter", ctx, arg)}
+}
+
+func (_c *MockQuerier_UpdateCharacter_Call) Run(run func(ctx context.Context, arg UpdateCharacterParams)) *MockQuerier_UpdateCharacter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(UpdateCharacterParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpdateCharacter_Call) Return(_a0 Character, _a1 error) *MockQuerier_UpdateCharacter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_UpdateCharacter_Call) RunAndReturn(run func(context.Context, UpdateCharacterParams) (Character, error)) *MockQuerier_UpdateCharacter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateGuildAnnouncementDelivery provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) UpdateGuildAnnouncementDelivery(ctx context.Context, arg UpdateGuildAnnouncementDeliveryParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateGuildAnnouncementDelivery")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, UpdateGuildAnnouncementDeliveryParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, UpdateGuildAnnouncementDeliveryParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, UpdateGuildAnnouncementDeliveryParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_UpdateGuildAnnouncementDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateGuildAnnouncementDelivery'
+type MockQuerier_UpdateGuildAnnouncementDelivery_Call struct {
+	*mock.Call
+}
+
+// UpdateGuildAnnouncementDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg UpdateGuildAnnouncementDeliveryParams
+func (_e *MockQuerier_Expecter) UpdateGuildAnnouncementDelivery(ctx interface{}, arg interface{}) *MockQuerier_UpdateGuildAnnouncementDelivery_Call {
+	return &MockQuerier_UpdateGuildAnnouncementDelivery_Call{Call: _e.mock.On("UpdateGuildAnnouncementDelivery", ctx, arg)}
+}
+
+func (_c *MockQuerier_UpdateGuildAnnouncementDelivery_Call) Run(run func(ctx context.Context, arg UpdateGuildAnnouncementDeliveryParams)) *MockQuerier_UpdateGuildAnnouncementDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(UpdateGuildAnnouncementDeliveryParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpdateGuildAnnouncementDelivery_Call) Return(_a0 int64, _a1 error) *MockQuerier_UpdateGuildAnnouncementDelivery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_UpdateGuildAnnouncementDelivery_Call) RunAndReturn(run func(context.Context, UpdateGuildAnnouncementDeliveryParams) (int64, error)) *MockQuerier_UpdateGuildAnnouncementDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateJobFailure provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) UpdateJobFailure(ctx context.Context, arg UpdateJobFailureParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateJobFailure")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, UpdateJobFailureParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, UpdateJobFailureParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, UpdateJobFailureParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_UpdateJobFailure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateJobFailure'
+type MockQuerier_UpdateJobFailure_Call struct {
+	*mock.Call
+}
+
+// UpdateJobFailure is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg UpdateJobFailureParams
+func (_e *MockQuerier_Expecter) UpdateJobFailure(ctx interface{}, arg interface{}) *MockQuerier_UpdateJobFailure_Call {
+	return &MockQuerier_UpdateJobFailure_Call{Call: _e.mock.On("UpdateJobFailure", ctx, arg)}
+}
+
+func (_c *MockQuerier_UpdateJobFailure_Call) Run(run func(ctx context.Context, arg UpdateJobFailureParams)) *MockQuerier_UpdateJobFailure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(UpdateJobFailureParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpdateJobFailure_Call) Return(_a0 int64, _a1 error) *MockQuerier_UpdateJobFailure_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_UpdateJobFailure_Call) RunAndReturn(run func(context.Context, UpdateJobFailureParams) (int64, error)) *MockQuerier_UpdateJobFailure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateNotificationDelivery provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) UpdateNotificationDelivery(ctx context.Context, arg UpdateNotificationDeliveryParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNotificationDelivery")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, UpdateNotificationDeliveryParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, UpdateNotificationDeliveryParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, UpdateNotificationDeliveryParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_UpdateNotificationDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNotificationDelivery'
+type MockQuerier_UpdateNotificationDelivery_Call struct {
+	*mock.Call
+}
+
+// UpdateNotificationDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg UpdateNotificationDeliveryParams
+func (_e *MockQuerier_Expecter) UpdateNotificationDelivery(ctx interface{}, arg interface{}) *MockQuerier_UpdateNotificationDelivery_Call {
+	return &MockQuerier_UpdateNotificationDelivery_Call{Call: _e.mock.On("UpdateNotificationDelivery", ctx, arg)}
+}
+
+func (_c *MockQuerier_UpdateNotificationDelivery_Call) Run(run func(ctx context.Context, arg UpdateNotificationDeliveryParams)) *MockQuerier_UpdateNotificationDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(UpdateNotificationDeliveryParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpdateNotificationDelivery_Call) Return(_a0 int64, _a1 error) *MockQuerier_UpdateNotificationDelivery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_UpdateNotificationDelivery_Call) RunAndReturn(run func(context.Context, UpdateNotificationDeliveryParams) (int64, error)) *MockQuerier_UpdateNotificationDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateRun provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) UpdateRun(ctx context.Context, arg UpdateRunParams) (Run, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRun")
+	}
+
+	var r0 Run
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, UpdateRunParams) (Run, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, UpdateRunParams) Run); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(Run)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, UpdateRunParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_UpdateRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRun'
+type MockQuerier_UpdateRun_Call struct {
+	*mock.Call
+}
+
+// UpdateRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg UpdateRunParams
+func (_e *MockQuerier_Expecter) UpdateRun(ctx interface{}, arg interface{}) *MockQuerier_UpdateRun_Call {
+	return &MockQuerier_UpdateRun_Call{Call: _e.mock.On("UpdateRun", ctx, arg)}
+}
+
+func (_c *MockQuerier_UpdateRun_Call) Run(run func(ctx context.Context, arg UpdateRunParams)) *MockQuerier_UpdateRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(UpdateRunParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpdateRun_Call) Return(_a0 Run, _a1 error) *MockQuerier_UpdateRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_UpdateRun_Call) RunAndReturn(run func(context.Context, UpdateRunParams) (Run, error)) *MockQuerier_UpdateRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWebhookDelivery provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) UpdateWebhookDelivery(ctx context.Context, arg UpdateWebhookDeliveryParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWebhookDelivery")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, UpdateWebhookDeliveryParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_UpdateWebhookDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWebhookDelivery'
+type MockQuerier_UpdateWebhookDelivery_Call struct {
+	*mock.Call
+}
+
+// UpdateWebhookDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg UpdateWebhookDeliveryParams
+func (_e *MockQuerier_Expecter) UpdateWebhookDelivery(ctx interface{}, arg interface{}) *MockQuerier_UpdateWebhookDelivery_Call {
+	return &MockQuerier_UpdateWebhookDelivery_Call{Call: _e.mock.On("UpdateWebhookDelivery", ctx, arg)}
+}
+
+func (_c *MockQuerier_UpdateWebhookDelivery_Call) Run(run func(ctx context.Context, arg UpdateWebhookDeliveryParams)) *MockQuerier_UpdateWebhookDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(UpdateWebhookDeliveryParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpdateWebhookDelivery_Call) Return(_a0 error) *MockQuerier_UpdateWebhookDelivery_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_UpdateWebhookDelivery_Call) RunAndReturn(run func(context.Context, UpdateWebhookDeliveryParams) error) *MockQuerier_UpdateWebhookDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWebhookEndpoint provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) UpdateWebhookEndpoint(ctx context.Context, arg UpdateWebhookEndpointParams) (WebhookEndpoint, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWebhookEndpoint")
+	}
+
+	var r0 WebhookEndpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, UpdateWebhookEndpointParams) (WebhookEndpoint, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, UpdateWebhookEndpointParams) WebhookEndpoint); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(WebhookEndpoint)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, UpdateWebhookEndpointParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_UpdateWebhookEndpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWebhookEndpoint'
+type MockQuerier_UpdateWebhookEndpoint_Call struct {
+	*mock.Call
+}
+
+// UpdateWebhookEndpoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg UpdateWebhookEndpointParams
+func (_e *MockQuerier_Expecter) UpdateWebhookEndpoint(ctx interface{}, arg interface{}) *MockQuerier_UpdateWebhookEndpoint_Call {
+	return &MockQuerier_UpdateWebhookEndpoint_Call{Call: _e.mock.On("UpdateWebhookEndpoint", ctx, arg)}
+}
+
+func (_c *MockQuerier_UpdateWebhookEndpoint_Call) Run(run func(ctx context.Context, arg UpdateWebhookEndpointParams)) *MockQuerier_UpdateWebhookEndpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(UpdateWebhookEndpointParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpdateWebhookEndpoint_Call) Return(_a0 WebhookEndpoint, _a1 error) *MockQuerier_UpdateWebhookEndpoint_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_UpdateWebhookEndpoint_Call) RunAndReturn(run func(context.Context, UpdateWebhookEndpointParams) (WebhookEndpoint, error)) *MockQuerier_UpdateWebhookEndpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertAffix provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) UpsertAffix(ctx context.Context, arg UpsertAffixParams) (Affix, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertAffix")
+	}
+
+	var r0 Affix
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, UpsertAffixParams) (Affix, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, UpsertAffixParams) Affix); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(Affix)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, UpsertAffixParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_UpsertAffix_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertAffix'
+type MockQuerier_UpsertAffix_Call struct {
+	*mock.Call
+}
+
+// UpsertAffix is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg UpsertAffixParams
+func (_e *MockQuerier_Expecter) UpsertAffix(ctx interface{}, arg interface{}) *MockQuerier_UpsertAffix_Call {
+	return &MockQuerier_UpsertAffix_Call{Call: _e.mock.On("UpsertAffix", ctx, arg)}
+}
+
+func (_c *MockQuerier_UpsertAffix_Call) Run(run func(ctx context.Context, arg UpsertAffixParams)) *MockQuerier_UpsertAffix_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(UpsertAffixParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpsertAffix_Call) Return(_a0 Affix, _a1 error) *MockQuerier_UpsertAffix_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_UpsertAffix_Call) RunAndReturn(run func(context.Context, UpsertAffixParams) (Affix, error)) *MockQuerier_UpsertAffix_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertDungeon provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) UpsertDungeon(ctx context.Context, arg UpsertDungeonParams) (Dungeon, error) {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertDungeon")
+	}
+
+	var r0 Dungeon
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, UpsertDungeonParams) (Dungeon, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, UpsertDungeonParams) Dungeon); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(Dungeon)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, UpsertDungeonParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_UpsertDungeon_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertDungeon'
+type MockQuerier_UpsertDungeon_Call struct {
+	*mock.Call
+}
+
+// UpsertDungeon is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg UpsertDungeonParams
+func (_e *MockQuerier_Expecter) UpsertDungeon(ctx interface{}, arg interface{}) *MockQuerier_UpsertDungeon_Call {
+	return &MockQuerier_UpsertDungeon_Call{Call: _e.mock.On("UpsertDungeon", ctx, arg)}
+}
+
+func (_c *MockQuerier_UpsertDungeon_Call) Run(run func(ctx context.Context, arg UpsertDungeonParams)) *MockQuerier_UpsertDungeon_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(UpsertDungeonParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpsertDungeon_Call) Return(_a0 Dungeon, _a1 error) *MockQuerier_UpsertDungeon_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_UpsertDungeon_Call) RunAndReturn(run func(context.Context, UpsertDungeonParams) (Dungeon, error)) *MockQuerier_UpsertDungeon_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertNotificationSettings provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) UpsertNotificationSettings(ctx context.Context, arg UpsertNotificationSettingsParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertNotificationSettings")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, UpsertNotificationSettingsParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_UpsertNotificationSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertNotificationSettings'
+type MockQuerier_UpsertNotificationSettings_Call struct {
+	*mock.Call
+}
+
+// UpsertNotificationSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg UpsertNotificationSettingsParams
+func (_e *MockQuerier_Expecter) UpsertNotificationSettings(ctx interface{}, arg interface{}) *MockQuerier_UpsertNotificationSettings_Call {
+	return &MockQuerier_UpsertNotificationSettings_Call{Call: _e.mock.On("UpsertNotificationSettings", ctx, arg)}
+}
+
+func (_c *MockQuerier_UpsertNotificationSettings_Call) Run(run func(ctx context.Context, arg UpsertNotificationSettingsParams)) *MockQuerier_UpsertNotificationSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(UpsertNotificationSettingsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpsertNotificationSettings_Call) Return(_a0 error) *MockQuerier_UpsertNotificationSettings_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_UpsertNotificationSettings_Call) RunAndReturn(run func(context.Context, UpsertNotificationSettingsParams) error) *MockQuerier_UpsertNotificationSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertReadyCheckResponse provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) UpsertReadyCheckResponse(ctx context.Context, arg UpsertReadyCheckResponseParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertReadyCheckResponse")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, UpsertReadyCheckResponseParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_UpsertReadyCheckResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertReadyCheckResponse'
+type MockQuerier_UpsertReadyCheckResponse_Call struct {
+	*mock.Call
+}
+
+// UpsertReadyCheckResponse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg UpsertReadyCheckResponseParams
+func (_e *MockQuerier_Expecter) UpsertReadyCheckResponse(ctx interface{}, arg interface{}) *MockQuerier_UpsertReadyCheckResponse_Call {
+	return &MockQuerier_UpsertReadyCheckResponse_Call{Call: _e.mock.On("UpsertReadyCheckResponse", ctx, arg)}
+}
+
+func (_c *MockQuerier_UpsertReadyCheckResponse_Call) Run(run func(ctx context.Context, arg UpsertReadyCheckResponseParams)) *MockQuerier_UpsertReadyCheckResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(UpsertReadyCheckResponseParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpsertReadyCheckResponse_Call) Return(_a0 error) *MockQuerier_UpsertReadyCheckResponse_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_UpsertReadyCheckResponse_Call) RunAndReturn(run func(context.Context, UpsertReadyCheckResponseParams) error) *MockQuerier_UpsertReadyCheckResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertSeriesException provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) UpsertSeriesException(ctx context.Context, arg UpsertSeriesExceptionParams) error {
+	ret := _m.Called(ctx, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertSeriesException")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, UpsertSeriesExceptionParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_UpsertSeriesException_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertSeriesException'
+type MockQuerier_UpsertSeriesException_Call struct {
+	*mock.Call
+}
+
+// UpsertSeriesException is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg UpsertSeriesExceptionParams
+func (_e *MockQuerier_Expecter) UpsertSeriesException(ctx interface{}, arg interface{}) *MockQuerier_UpsertSeriesException_Call {
+	return &MockQuerier_UpsertSeriesException_Call{Call: _e.mock.On("UpsertSeriesException", ctx, arg)}
+}
+
+func (_c *MockQuerier_UpsertSeriesException_Call) Run(run func(ctx context.Context, arg UpsertSeriesExceptionParams)) *MockQuerier_UpsertSeriesException_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(UpsertSeriesExceptionParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpsertSeriesException_Call) Return(_a0 error) *MockQuerier_UpsertSeriesException_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_UpsertSeriesException_Call) RunAndReturn(run func(context.Context, UpsertSeriesExceptionParams) error) *MockQuerier_UpsertSeriesException_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithdrawSignup provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) WithdrawSignup(ctx context.Context, id int32) (RunSignup, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithdrawSignup")
+	}
+
+	var r0 RunSignup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (RunSignup, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) RunSignup); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(RunSignup)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_WithdrawSignup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithdrawSignup'
+type MockQuerier_WithdrawSignup_Call struct {
+	*mock.Call
+}
+
+// WithdrawSignup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockQuerier_Expecter) WithdrawSignup(ctx interface{}, id interface{}) *MockQuerier_WithdrawSignup_Call {
+	return &MockQuerier_WithdrawSignup_Call{Call: _e.mock.On("WithdrawSignup", ctx, id)}
+}
+
+func (_c *MockQuerier_WithdrawSignup_Call) Run(run func(ctx context.Context, id int32)) *MockQuerier_WithdrawSignup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_WithdrawSignup_Call) Return(_a0 RunSignup, _a1 error) *MockQuerier_WithdrawSignup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_WithdrawSignup_Call) RunAndReturn(run func(context.Context, int32) (RunSignup, error)) *MockQuerier_WithdrawSignup_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -8,13 +8,401 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-type User struct {
+type Affix struct {
+	ID          int32
+	Code        string
+	Name        string
+	Description string
+	Active      bool
+	CreatedAt   pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
+}
+
+type AvailabilityException struct {
+	ID        int32
+	UserID    int32
+	StartsAt  pgtype.Timestamptz
+	EndsAt    pgtype.Timestamptz
+	Available bool
+	CreatedAt pgtype.Timestamptz
+}
+
+type AvailabilityWindow struct {
+	ID          int32
+	UserID      int32
+	Weekday     int32
+	StartMinute int32
+	EndMinute   int32
+	CreatedAt   pgtype.Timestamptz
+}
+
+type CatalogVersion struct {
+	Catalog   string
+	Version   int32
+	AppliedAt pgtype.Timestamptz
+}
+
+type Character struct {
+	ID         int32
+	UserID     int32
+	Name       string
+	Realm      string
+	Class      string
+	Spec       string
+	ItemLevel  int32
+	Roles      []string
+	CreatedAt  pgtype.Timestamptz
+	UpdatedAt  pgtype.Timestamptz
+	ImportedAt pgtype.Timestamptz
+}
+
+type Dungeon struct {
 	ID           int32
-	Username     string
-	Email        string
-	PasswordHash string
-	Timezone     string
+	Code         string
+	Name         string
+	Expansion    string
+	Season       string
+	ParSeconds   int32
+	BossCount    int32
+	Difficulties []string
+	Active       bool
 	CreatedAt    pgtype.Timestamptz
 	UpdatedAt    pgtype.Timestamptz
-	Roles        []string
+}
+
+type Guild struct {
+	ID                       int32
+	Name                     string
+	CreatedAt                pgtype.Timestamptz
+	UpdatedAt                pgtype.Timestamptz
+	DiscordWebhookUrl        string
+	LeaderboardScoring       string
+	WaitlistBelowReliability pgtype.Int4
+}
+
+type GuildAnnouncement struct {
+	ID           int64
+	GuildID      int32
+	RunID        int32
+	Kind         string
+	Status       string
+	Attempts     int32
+	LastError    string
+	DeliverAfter pgtype.Timestamptz
+	DeliveredAt  pgtype.Timestamptz
+	CreatedAt    pgtype.Timestamptz
+	UpdatedAt    pgtype.Timestamptz
+}
+
+type GuildMember struct {
+	GuildID   int32
+	UserID    int32
+	Role      string
+	CreatedAt pgtype.Timestamptz
+}
+
+type InboxNotification struct {
+	ID             int64
+	UserID         int32
+	NotificationID int64
+	Type           string
+	Subject        string
+	Body           string
+	ReadAt         pgtype.Timestamptz
+	CreatedAt      pgtype.Timestamptz
+}
+
+type Job struct {
+	ID          int64
+	Queue       string
+	Kind        string
+	Args        []byte
+	UniqueKey   pgtype.Text
+	Status      string
+	Attempts    int32
+	MaxAttempts int32
+	LastError   string
+	RunAt       pgtype.Timestamptz
+	CreatedAt   pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
+	LockedUntil pgtype.Timestamptz
+}
+
+type Keystone struct {
+	ID          int32
+	UserID      int32
+	CharacterID pgtype.Int4
+	DungeonID   int32
+	Level       int32
+	RecordedAt  pgtype.Timestamptz
+	CreatedAt   pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
+}
+
+type LeaderboardSnapshot struct {
+	SeasonID       int32
+	GuildID        int32
+	UserID         int32
+	Rank           int32
+	Username       string
+	Rating         float64
+	ElapsedSeconds int32
+	BestRuns       []byte
+	Scoring        string
+	CreatedAt      pgtype.Timestamptz
+}
+
+type LfgEntry struct {
+	ID             int32
+	UserID         int32
+	DungeonID      int32
+	MinKeyLevel    int32
+	MaxKeyLevel    int32
+	Roles          []string
+	AvailableFrom  pgtype.Timestamptz
+	AvailableUntil pgtype.Timestamptz
+	Status         string
+	ProposalID     pgtype.Int4
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+	CharacterID    pgtype.Int4
+}
+
+type LfgProposal struct {
+	ID        int32
+	DungeonID int32
+	KeyLevel  int32
+	StartsAt  pgtype.Timestamptz
+	Score     float64
+	Seed      int64
+	Status    string
+	ExpiresAt pgtype.Timestamptz
+	RunID     pgtype.Int4
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+}
+
+type LfgProposalMember struct {
+	ProposalID int32
+	UserID     int32
+	EntryID    int32
+	Role       string
+	Response   string
+}
+
+type Notification struct {
+	ID                int64
+	UserID            int32
+	Type              string
+	RunID             pgtype.Int4
+	Payload           []byte
+	Status            string
+	Attempts          int32
+	DeliveredChannels []string
+	LastError         string
+	DeliverAfter      pgtype.Timestamptz
+	DeliveredAt       pgtype.Timestamptz
+	CreatedAt         pgtype.Timestamptz
+	UpdatedAt         pgtype.Timestamptz
+	ExpiresAt         pgtype.Timestamptz
+}
+
+type NotificationPreference struct {
+	UserID   int32
+	Type     string
+	Channels []string
+}
+
+type NotificationSetting struct {
+	UserID           int32
+	QuietStartMinute pgtype.Int4
+	QuietEndMinute   pgtype.Int4
+	WebhookUrl       string
+	CreatedAt        pgtype.Timestamptz
+	UpdatedAt        pgtype.Timestamptz
+}
+
+type ReadyCheck struct {
+	ID         int32
+	RunID      int32
+	StartedBy  pgtype.Int4
+	Status     string
+	ExpiresAt  pgtype.Timestamptz
+	FinishedAt pgtype.Timestamptz
+	CreatedAt  pgtype.Timestamptz
+	UpdatedAt  pgtype.Timestamptz
+}
+
+type ReadyCheckResponse struct {
+	ReadyCheckID int32
+	UserID       int32
+	Ready        bool
+	RespondedAt  pgtype.Timestamptz
+}
+
+type Run struct {
+	ID              int32
+	DungeonID       int32
+	Difficulty      string
+	KeyLevel        pgtype.Int4
+	OrganizerID     int32
+	StartsAt        pgtype.Timestamptz
+	Timezone        string
+	DurationMinutes int32
+	Notes           string
+	Status          string
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
+	TankSlots       int32
+	HealerSlots     int32
+	DpsSlots        int32
+	SeriesID        pgtype.Int4
+	OccurrenceAt    pgtype.Timestamptz
+	Sequence        int32
+	GuildID         pgtype.Int4
+	StartedAt       pgtype.Timestamptz
+	FinishedAt      pgtype.Timestamptz
+	UpgradeLevel    pgtype.Int4
+	Affixes         []string
+}
+
+type RunEvent struct {
+	ID        int64
+	RunID     int32
+	Type      string
+	UserID    pgtype.Int4
+	Payload   []byte
+	CreatedAt pgtype.Timestamptz
+}
+
+type RunParticipant struct {
+	UserID int32
+	RunID  int32
+	Role   string
+}
+
+type RunReminder struct {
+	RunID         int32
+	OffsetMinutes int32
+	SentAt        pgtype.Timestamptz
+}
+
+type RunSeries struct {
+	ID              int32
+	OrganizerID     int32
+	DungeonID       int32
+	Difficulty      string
+	KeyLevel        pgtype.Int4
+	Rrule           string
+	Timezone        string
+	StartsAt        pgtype.Timestamptz
+	UntilAt         pgtype.Timestamptz
+	DurationMinutes int32
+	Notes           string
+	TankSlots       int32
+	HealerSlots     int32
+	DpsSlots        int32
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
+	GuildID         pgtype.Int4
+}
+
+type RunSeriesException struct {
+	SeriesID     int32
+	OccurrenceAt pgtype.Timestamptz
+	Kind         string
+	CreatedAt    pgtype.Timestamptz
+}
+
+type RunSignup struct {
+	ID               int32
+	RunID            int32
+	UserID           int32
+	Role             string
+	Status           string
+	WithdrawnAt      pgtype.Timestamptz
+	CreatedAt        pgtype.Timestamptz
+	UpdatedAt        pgtype.Timestamptz
+	WaitlistPosition pgtype.Int4
+	Attendance       pgtype.Text
+	CharacterID      pgtype.Int4
+}
+
+type Season struct {
+	ID        int32
+	Code      string
+	Name      string
+	StartsAt  pgtype.Timestamptz
+	EndsAt    pgtype.Timestamptz
+	ClosedAt  pgtype.Timestamptz
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+}
+
+type SeasonAffix struct {
+	SeasonID int32
+	Week     int32
+	Affixes  []string
+}
+
+type User struct {
+	ID            int32
+	Username      string
+	Email         string
+	PasswordHash  string
+	Timezone      string
+	CreatedAt     pgtype.Timestamptz
+	UpdatedAt     pgtype.Timestamptz
+	Roles         []string
+	CalendarToken pgtype.Text
+}
+
+type UserRunStat struct {
+	UserID         int32
+	DungeonID      int32
+	Role           string
+	Completed      int32
+	Timed          int32
+	BestTimedLevel int32
+	ElapsedSeconds int64
+	ParSeconds     int64
+}
+
+type UserRunStatsSummary struct {
+	UserID         int32
+	DungeonID      int32
+	Role           string
+	Completed      int32
+	Timed          int32
+	BestTimedLevel int32
+	ElapsedSeconds int64
+	ParSeconds     int64
+}
+
+type WebhookDelivery struct {
+	ID             int64
+	EndpointID     int32
+	EventID        string
+	Event          string
+	Payload        []byte
+	Status         string
+	Attempts       int32
+	ResponseStatus pgtype.Int4
+	LastError      string
+	DeliveredAt    pgtype.Timestamptz
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+}
+
+type WebhookEndpoint struct {
+	ID                  int32
+	GuildID             pgtype.Int4
+	Url                 string
+	Secret              string
+	Events              []string
+	Active              bool
+	ConsecutiveFailures int32
+	DisabledAt          pgtype.Timestamptz
+	CreatedAt           pgtype.Timestamptz
+	UpdatedAt           pgtype.Timestamptz
 }
@@ -6,15 +6,186 @@ package repo
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
+	AddGuildMember(ctx context.Context, arg AddGuildMemberParams) (GuildMember, error)
+	AddLFGProposalMember(ctx context.Context, arg AddLFGProposalMemberParams) error
+	ClaimGuildAnnouncements(ctx context.Context, arg ClaimGuildAnnouncementsParams) ([]GuildAnnouncement, error)
+	ClaimJobs(ctx context.Context, arg ClaimJobsParams) ([]Job, error)
+	ClaimNotifications(ctx context.Context, arg ClaimNotificationsParams) ([]Notification, error)
+	ClaimRunsDueReminders(ctx context.Context, arg ClaimRunsDueRemindersParams) ([]Run, error)
+	CloseSeason(ctx context.Context, arg CloseSeasonParams) (Season, error)
+	CountConfirmedSignups(ctx context.Context, arg CountConfirmedSignupsParams) (int64, error)
+	CreateAvailabilityException(ctx context.Context, arg CreateAvailabilityExceptionParams) (AvailabilityException, error)
+	CreateAvailabilityWindow(ctx context.Context, arg CreateAvailabilityWindowParams) (AvailabilityWindow, error)
+	CreateCharacter(ctx context.Context, arg CreateCharacterParams) (Character, error)
+	CreateGuild(ctx context.Context, name string) (Guild, error)
+	CreateGuildAnnouncement(ctx context.Context, arg CreateGuildAnnouncementParams) error
+	CreateInboxNotification(ctx context.Context, arg CreateInboxNotificationParams) error
+	CreateJob(ctx context.Context, arg CreateJobParams) (int64, error)
+	CreateKeystone(ctx context.Context, arg CreateKeystoneParams) (Keystone, error)
+	CreateLFGEntry(ctx context.Context, arg CreateLFGEntryParams) (LfgEntry, error)
+	CreateLFGProposal(ctx context.Context, arg CreateLFGProposalParams) (LfgProposal, error)
+	CreateLeaderboardSnapshot(ctx context.Context, arg CreateLeaderboardSnapshotParams) error
+	CreateNotification(ctx context.Context, arg CreateNotificationParams) error
+	CreateNotificationPreference(ctx context.Context, arg CreateNotificationPreferenceParams) error
+	CreateReadyCheck(ctx context.Context, arg CreateReadyCheckParams) (ReadyCheck, error)
+	CreateRun(ctx context.Context, arg CreateRunParams) (Run, error)
+	CreateRunEvent(ctx context.Context, arg CreateRunEventParams) (RunEvent, error)
+	CreateRunReminder(ctx context.Context, arg CreateRunReminderParams) error
+	CreateSeason(ctx context.Context, arg CreateSeasonParams) (Season, error)
+	CreateSeasonAffixes(ctx context.Context, arg CreateSeasonAffixesParams) error
+	CreateSeries(ctx context.Context, arg CreateSeriesParams) (RunSeries, error)
+	CreateSignup(ctx context.Context, arg CreateSignupParams) (RunSignup, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (WebhookDelivery, error)
+	CreateWebhookEndpoint(ctx context.Context, arg CreateWebhookEndpointParams) (WebhookEndpoint, error)
+	DeactivateAffixes(ctx context.Context) error
+	DeactivateDungeons(ctx context.Context) error
+	DeferGuildAnnouncements(ctx context.Context, arg DeferGuildAnnouncementsParams) error
+	DeferNotification(ctx context.Context, arg DeferNotificationParams) (int64, error)
+	DeleteAvailabilityException(ctx context.Context, arg DeleteAvailabilityExceptionParams) (int64, error)
+	DeleteAvailabilityWindows(ctx context.Context, userID int32) error
+	DeleteCharacter(ctx context.Context, arg DeleteCharacterParams) (int64, error)
+	DeleteJob(ctx context.Context, arg DeleteJobParams) (int64, error)
+	DeleteKeystone(ctx context.Context, arg DeleteKeystoneParams) (int64, error)
+	DeleteNotificationPreferences(ctx context.Context, userID int32) error
+	DeleteRunReminders(ctx context.Context, runID int32) error
+	DeleteSeasonAffixes(ctx context.Context, seasonID int32) error
+	DeleteWebhookEndpoint(ctx context.Context, id int32) error
+	EndSeries(ctx context.Context, arg EndSeriesParams) error
+	ExpireJobLeases(ctx context.Context, now pgtype.Timestamptz) error
+	FinishReadyCheck(ctx context.Context, arg FinishReadyCheckParams) (ReadyCheck, error)
+	GetActiveLFGEntry(ctx context.Context, userID int32) (GetActiveLFGEntryRow, error)
+	GetActiveSignup(ctx context.Context, arg GetActiveSignupParams) (RunSignup, error)
+	GetAffixByCode(ctx context.Context, code string) (Affix, error)
+	GetAffixes(ctx context.Context) ([]Affix, error)
+	GetAvailabilityExceptions(ctx context.Context, arg GetAvailabilityExceptionsParams) ([]AvailabilityException, error)
+	GetAvailabilityWindows(ctx context.Context, userIds []int32) ([]AvailabilityWindow, error)
+	GetCatalogVersion(ctx context.Context, catalog string) (int32, error)
+	GetCharacterByID(ctx context.Context, id int32) (GetCharacterByIDRow, error)
+	GetCharacterByName(ctx context.Context, arg GetCharacterByNameParams) (GetCharacterByNameRow, error)
+	GetDungeonByCode(ctx context.Context, code string) (Dungeon, error)
+	GetDungeonByID(ctx context.Context, id int32) (Dungeon, error)
+	GetDungeons(ctx context.Context) ([]Dungeon, error)
+	GetGuildByID(ctx context.Context, id int32) (Guild, error)
+	GetGuildByName(ctx context.Context, name string) (Guild, error)
+	GetGuildKeystones(ctx context.Context, arg GetGuildKeystonesParams) ([]GetGuildKeystonesRow, error)
+	GetGuildMember(ctx context.Context, arg GetGuildMemberParams) (GuildMember, error)
+	GetGuildMembers(ctx context.Context, guildID int32) ([]GetGuildMembersRow, error)
+	GetGuilds(ctx context.Context) ([]Guild, error)
+	GetInboxNotifications(ctx context.Context, arg GetInboxNotificationsParams) ([]InboxNotification, error)
+	GetJobByID(ctx context.Context, id int64) (Job, error)
+	GetJobs(ctx context.Context, arg GetJobsParams) ([]Job, error)
+	GetLFGProposal(ctx context.Context, id int32) (GetLFGProposalRow, error)
+	GetLFGProposalMembers(ctx context.Context, proposalID int32) ([]GetLFGProposalMembersRow, error)
+	GetLatestReadyCheck(ctx context.Context, runID int32) (ReadyCheck, error)
+	GetLeaderboardSnapshot(ctx context.Context, arg GetLeaderboardSnapshotParams) ([]LeaderboardSnapshot, error)
+	GetNotificationPreference(ctx context.Context, arg GetNotificationPreferenceParams) ([]string, error)
+	GetNotificationPreferences(ctx context.Context, userID int32) ([]NotificationPreference, error)
+	GetNotificationRecipient(ctx context.Context, id int32) (GetNotificationRecipientRow, error)
+	GetNotificationSettings(ctx context.Context, userID int32) (NotificationSetting, error)
+	GetOpenSeason(ctx context.Context) (Season, error)
+	GetReadyCheck(ctx context.Context, id int32) (ReadyCheck, error)
+	GetReadyCheckResponses(ctx context.Context, readyCheckID int32) ([]ReadyCheckResponse, error)
+	// The confirmed signups of a run and those withdrawn by users that did not sign
+	// up again, with how long before the start they withdrew.
+	GetRunAttendance(ctx context.Context, runID int32) ([]GetRunAttendanceRow, error)
+	GetRunByID(ctx context.Context, id int32) (GetRunByIDRow, error)
+	GetRunEvent(ctx context.Context, id int64) (RunEvent, error)
+	GetRunEventsAfter(ctx context.Context, arg GetRunEventsAfterParams) ([]RunEvent, error)
+	GetRunReminderOffsets(ctx context.Context, runID int32) ([]int32, error)
+	GetRunSignups(ctx context.Context, runID int32) ([]GetRunSignupsRow, error)
+	GetRuns(ctx context.Context, arg GetRunsParams) ([]GetRunsRow, error)
+	GetSeasonAffixes(ctx context.Context, seasonID int32) ([]SeasonAffix, error)
+	GetSeasonByCode(ctx context.Context, code string) (Season, error)
+	GetSeasonTimedRuns(ctx context.Context, arg GetSeasonTimedRunsParams) ([]GetSeasonTimedRunsRow, error)
+	GetSeasons(ctx context.Context) ([]Season, error)
+	GetSeriesByID(ctx context.Context, id int32) (GetSeriesByIDRow, error)
+	GetSeriesRun(ctx context.Context, arg GetSeriesRunParams) (Run, error)
+	GetSeriesRuns(ctx context.Context, arg GetSeriesRunsParams) ([]GetSeriesRunsRow, error)
+	GetSeriesRunsFrom(ctx context.Context, arg GetSeriesRunsFromParams) ([]GetSeriesRunsFromRow, error)
+	GetSeriesSkipsFrom(ctx context.Context, arg GetSeriesSkipsFromParams) ([]pgtype.Timestamptz, error)
+	GetSubscribedWebhookEndpoints(ctx context.Context, arg GetSubscribedWebhookEndpointsParams) ([]WebhookEndpoint, error)
+	// user_run_stats of a user, counting only the runs started while the affix was active.
+	GetUserAffixRunStats(ctx context.Context, arg GetUserAffixRunStatsParams) ([]GetUserAffixRunStatsRow, error)
+	// The marked signups and withdrawals of a user in runs that were not cancelled,
+	// starting after since.
+	GetUserAttendance(ctx context.Context, arg GetUserAttendanceParams) ([]GetUserAttendanceRow, error)
 	GetUserByEmail(ctx context.Context, email string) (GetUserByEmailRow, error)
 	GetUserByID(ctx context.Context, id int32) (GetUserByIDRow, error)
 	GetUserByUsername(ctx context.Context, username string) (GetUserByUsernameRow, error)
+	GetUserCalendarRuns(ctx context.Context, arg GetUserCalendarRunsParams) ([]GetUserCalendarRunsRow, error)
+	GetUserCalendarToken(ctx context.Context, id int32) (pgtype.Text, error)
+	GetUserCharacters(ctx context.Context, userID int32) ([]GetUserCharactersRow, error)
 	GetUserFullByEmail(ctx context.Context, email string) (User, error)
+	GetUserKeystones(ctx context.Context, arg GetUserKeystonesParams) ([]GetUserKeystonesRow, error)
+	GetUserRunStats(ctx context.Context, userID int32) ([]GetUserRunStatsRow, error)
+	GetUserRunStatsSummary(ctx context.Context, userID int32) ([]GetUserRunStatsSummaryRow, error)
+	GetUserSignupStats(ctx context.Context, arg GetUserSignupStatsParams) (GetUserSignupStatsRow, error)
 	GetUsers(ctx context.Context) ([]GetUsersRow, error)
+	GetUsersByIDs(ctx context.Context, ids []int32) ([]GetUsersByIDsRow, error)
+	// user_roles are the roles of the character signed up, or of the user for
+	// signups without one.
+	GetWaitlist(ctx context.Context, arg GetWaitlistParams) ([]GetWaitlistRow, error)
+	GetWebhookDeliveries(ctx context.Context, arg GetWebhookDeliveriesParams) ([]WebhookDelivery, error)
+	GetWebhookDelivery(ctx context.Context, id int64) (WebhookDelivery, error)
+	GetWebhookEndpoint(ctx context.Context, id int32) (WebhookEndpoint, error)
+	GetWebhookEndpoints(ctx context.Context, guildID pgtype.Int4) ([]WebhookEndpoint, error)
+	// The key levels of the Mythic+ runs each of the users timed in a week, at
+	// min_level or above, highest first.
+	GetWeeklyTimedRuns(ctx context.Context, arg GetWeeklyTimedRunsParams) ([]GetWeeklyTimedRunsRow, error)
+	LockActiveLFGEntry(ctx context.Context, userID int32) (LfgEntry, error)
+	LockCatalog(ctx context.Context, catalog string) error
+	LockExpiredLFGProposals(ctx context.Context, now pgtype.Timestamptz) ([]LfgProposal, error)
+	LockLFGProposal(ctx context.Context, id int32) (LfgProposal, error)
+	LockPendingReadyCheck(ctx context.Context, runID int32) (ReadyCheck, error)
+	// Entries of users that are still available, oldest first. Entries another
+	// matcher holds are skipped.
+	LockQueuedLFGEntries(ctx context.Context, now pgtype.Timestamptz) ([]LockQueuedLFGEntriesRow, error)
+	LockReadyCheck(ctx context.Context, id int32) (ReadyCheck, error)
+	LockRun(ctx context.Context, id int32) (Run, error)
+	LockSeriesRun(ctx context.Context, arg LockSeriesRunParams) (Run, error)
+	MarkAttendance(ctx context.Context, arg MarkAttendanceParams) (int64, error)
+	MarkInboxNotificationRead(ctx context.Context, arg MarkInboxNotificationReadParams) (int64, error)
+	MaterializeSeriesRun(ctx context.Context, arg MaterializeSeriesRunParams) (Run, error)
+	MoveSeriesRun(ctx context.Context, arg MoveSeriesRunParams) error
+	NextWaitlistPosition(ctx context.Context, arg NextWaitlistPositionParams) (int32, error)
+	PromoteSignup(ctx context.Context, id int32) (RunSignup, error)
+	RecordWebhookFailure(ctx context.Context, arg RecordWebhookFailureParams) (WebhookEndpoint, error)
+	RefreshUserRunStatsSummary(ctx context.Context) error
+	ReleaseJob(ctx context.Context, arg ReleaseJobParams) error
+	RemoveGuildMember(ctx context.Context, arg RemoveGuildMemberParams) error
+	ResetWebhookFailures(ctx context.Context, id int32) error
+	RetryJob(ctx context.Context, id int64) (Job, error)
+	SetCatalogVersion(ctx context.Context, arg SetCatalogVersionParams) error
+	SetCharacterArmory(ctx context.Context, arg SetCharacterArmoryParams) error
+	SetGuildDiscordWebhook(ctx context.Context, arg SetGuildDiscordWebhookParams) error
+	SetGuildLeaderboardScoring(ctx context.Context, arg SetGuildLeaderboardScoringParams) error
+	SetGuildSignupPolicy(ctx context.Context, arg SetGuildSignupPolicyParams) error
+	SetLFGEntryStatus(ctx context.Context, arg SetLFGEntryStatusParams) error
+	SetLFGProposalResponse(ctx context.Context, arg SetLFGProposalResponseParams) (int64, error)
+	SetLFGProposalStatus(ctx context.Context, arg SetLFGProposalStatusParams) (LfgProposal, error)
+	SetRunStatus(ctx context.Context, arg SetRunStatusParams) (Run, error)
+	SetUserCalendarToken(ctx context.Context, arg SetUserCalendarTokenParams) error
+	SetWaitlistPosition(ctx context.Context, arg SetWaitlistPositionParams) error
+	TransitionRun(ctx context.Context, arg TransitionRunParams) (Run, error)
+	UpdateCharacter(ctx context.Context, arg UpdateCharacterParams) (Character, error)
+	UpdateGuildAnnouncementDelivery(ctx context.Context, arg UpdateGuildAnnouncementDeliveryParams) (int64, error)
+	UpdateJobFailure(ctx context.Context, arg UpdateJobFailureParams) (int64, error)
+	UpdateNotificationDelivery(ctx context.Context, arg UpdateNotificationDeliveryParams) (int64, error)
+	UpdateRun(ctx context.Context, arg UpdateRunParams) (Run, error)
+	UpdateWebhookDelivery(ctx context.Context, arg UpdateWebhookDeliveryParams) error
+	UpdateWebhookEndpoint(ctx context.Context, arg UpdateWebhookEndpointParams) (WebhookEndpoint, error)
+	UpsertAffix(ctx context.Context, arg UpsertAffixParams) (Affix, error)
+	UpsertDungeon(ctx context.Context, arg UpsertDungeonParams) (Dungeon, error)
+	UpsertNotificationSettings(ctx context.Context, arg UpsertNotificationSettingsParams) error
+	UpsertReadyCheckResponse(ctx context.Context, arg UpsertReadyCheckResponseParams) error
+	UpsertSeriesException(ctx context.Context, arg UpsertSeriesExceptionParams) error
+	WithdrawSignup(ctx context.Context, id int32) (RunSignup, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -7,177 +7,5369 @@ package repo
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const addGuildMember = `-- name: AddGuildMember :one
+INSERT INTO guild_members (guild_id, user_id, role)
+VALUES ($1, $2, $3)
+RETURNING guild_id, user_id, role, created_at
+`
+
+type AddGuildMemberParams struct {
+	GuildID int32
+	UserID  int32
+	Role    string
+}
+
+func (q *Queries) AddGuildMember(ctx context.Context, arg AddGuildMemberParams) (GuildMember, error) {
+	row := q.db.QueryRow(ctx, addGuildMember, arg.GuildID, arg.UserID, arg.Role)
+	var i GuildMember
+	err := row.Scan(
+		&i.GuildID,
+		&i.UserID,
+		&i.Role,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const addLFGProposalMember = `-- name: AddLFGProposalMember :exec
+INSERT INTO lfg_proposal_members (proposal_id, user_id, entry_id, role)
+VALUES ($1, $2, $3, $4)
+`
+
+type AddLFGProposalMemberParams struct {
+	ProposalID int32
+	UserID     int32
+	EntryID    int32
+	Role       string
+}
+
+func (q *Queries) AddLFGProposalMember(ctx context.Context, arg AddLFGProposalMemberParams) error {
+	_, err := q.db.Exec(ctx, addLFGProposalMember,
+		arg.ProposalID,
+		arg.UserID,
+		arg.EntryID,
+		arg.Role,
+	)
+	return err
+}
+
+const claimGuildAnnouncements = `-- name: ClaimGuildAnnouncements :many
+UPDATE guild_announcements SET deliver_after = $1
+WHERE id IN (
+    SELECT due.id FROM guild_announcements AS due
+    WHERE due.status = 'pending' AND due.deliver_after <= $2
+    ORDER BY due.deliver_after, due.id
+    LIMIT $3
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, guild_id, run_id, kind, status, attempts, last_error, deliver_after, delivered_at, created_at, updated_at
+`
+
+type ClaimGuildAnnouncementsParams struct {
+	LeasedUntil pgtype.Timestamptz
+	Now         pgtype.Timestamptz
+	BatchSize   int32
+}
+
+func (q *Queries) ClaimGuildAnnouncements(ctx context.Context, arg ClaimGuildAnnouncementsParams) ([]GuildAnnouncement, error) {
+	rows, err := q.db.Query(ctx, claimGuildAnnouncements, arg.LeasedUntil, arg.Now, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GuildAnnouncement
+	for rows.Next() {
+		var i GuildAnnouncement
+		if err := rows.Scan(
+			&i.ID,
+			&i.GuildID,
+			&i.RunID,
+			&i.Kind,
+			&i.Status,
+			&i.Attempts,
+			&i.LastError,
+			&i.DeliverAfter,
+			&i.DeliveredAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claimJobs = `-- name: ClaimJobs :many
+UPDATE jobs SET status = 'running', locked_until = $1
+WHERE id IN (
+    SELECT ready.id FROM jobs AS ready
+    WHERE ready.queue = $2 AND ready.kind = ANY($3::text[])
+        AND ready.status = 'pending' AND ready.run_at <= $4
+    ORDER BY ready.run_at, ready.id
+    LIMIT $5
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, queue, kind, args, unique_key, status, attempts, max_attempts, last_error, run_at, created_at, updated_at, locked_until
+`
+
+type ClaimJobsParams struct {
+	LockedUntil pgtype.Timestamptz
+	Queue       string
+	Kinds       []string
+	Now         pgtype.Timestamptz
+	BatchSize   int32
+}
+
+func (q *Queries) ClaimJobs(ctx context.Context, arg ClaimJobsParams) ([]Job, error) {
+	rows, err := q.db.Query(ctx, claimJobs,
+		arg.LockedUntil,
+		arg.Queue,
+		arg.Kinds,
+		arg.Now,
+		arg.BatchSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Job
+	for rows.Next() {
+		var i Job
+		if err := rows.Scan(
+			&i.ID,
+			&i.Queue,
+			&i.Kind,
+			&i.Args,
+			&i.UniqueKey,
+			&i.Status,
+			&i.Attempts,
+			&i.MaxAttempts,
+			&i.LastError,
+			&i.RunAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.LockedUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claimNotifications = `-- name: ClaimNotifications :many
+UPDATE notifications SET deliver_after = $1
+WHERE id IN (
+    SELECT due.id FROM notifications AS due
+    WHERE due.status = 'pending' AND due.deliver_after <= $2
+    ORDER BY due.deliver_after, due.id
+    LIMIT $3
+    FOR UPDATE SKIP LOCKED
 )
+RETURNING id, user_id, type, run_id, payload, status, attempts, delivered_channels, last_error, deliver_after, delivered_at, created_at, updated_at, expires_at
+`
+
+type ClaimNotificationsParams struct {
+	LeasedUntil pgtype.Timestamptz
+	Now         pgtype.Timestamptz
+	BatchSize   int32
+}
+
+func (q *Queries) ClaimNotifications(ctx context.Context, arg ClaimNotificationsParams) ([]Notification, error) {
+	rows, err := q.db.Query(ctx, claimNotifications, arg.LeasedUntil, arg.Now, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Notification
+	for rows.Next() {
+		var i Notification
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Type,
+			&i.RunID,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.DeliveredChannels,
+			&i.LastError,
+			&i.DeliverAfter,
+			&i.DeliveredAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claimRunsDueReminders = `-- name: ClaimRunsDueReminders :many
+SELECT id, dungeon_id, difficulty, key_level, organizer_id, starts_at, timezone, duration_minutes, notes, status, created_at, updated_at, tank_slots, healer_slots, dps_slots, series_id, occurrence_at, sequence, guild_id, started_at, finished_at, upgrade_level, affixes FROM runs
+WHERE runs.status IN ('scheduled', 'forming') AND runs.starts_at > $1
+    AND EXISTS (
+        SELECT 1 FROM unnest($2::int[]) AS offsets(minutes)
+        WHERE runs.starts_at - make_interval(mins => offsets.minutes) <= $1
+            AND NOT EXISTS (
+                SELECT 1 FROM run_reminders
+                WHERE run_reminders.run_id = runs.id AND run_reminders.offset_minutes = offsets.minutes
+            )
+    )
+ORDER BY runs.starts_at, runs.id
+LIMIT $3
+FOR UPDATE SKIP LOCKED
+`
+
+type ClaimRunsDueRemindersParams struct {
+	Now           pgtype.Timestamptz
+	OffsetMinutes []int32
+	BatchSize     int32
+}
+
+func (q *Queries) ClaimRunsDueReminders(ctx context.Context, arg ClaimRunsDueRemindersParams) ([]Run, error) {
+	rows, err := q.db.Query(ctx, claimRunsDueReminders, arg.Now, arg.OffsetMinutes, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Run
+	for rows.Next() {
+		var i Run
+		if err := rows.Scan(
+			&i.ID,
+			&i.DungeonID,
+			&i.Difficulty,
+			&i.KeyLevel,
+			&i.OrganizerID,
+			&i.StartsAt,
+			&i.Timezone,
+			&i.DurationMinutes,
+			&i.Notes,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TankSlots,
+			&i.HealerSlots,
+			&i.DpsSlots,
+			&i.SeriesID,
+			&i.OccurrenceAt,
+			&i.Sequence,
+			&i.GuildID,
+			&i.StartedAt,
+			&i.FinishedAt,
+			&i.UpgradeLevel,
+			&i.Affixes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const closeSeason = `-- name: CloseSeason :one
+UPDATE seasons SET ends_at = $1, closed_at = $1
+WHERE id = $2 AND closed_at IS NULL
+RETURNING id, code, name, starts_at, ends_at, closed_at, created_at, updated_at
+`
+
+type CloseSeasonParams struct {
+	ClosedAt pgtype.Timestamptz
+	ID       int32
+}
+
+func (q *Queries) CloseSeason(ctx context.Context, arg CloseSeasonParams) (Season, error) {
+	row := q.db.QueryRow(ctx, closeSeason, arg.ClosedAt, arg.ID)
+	var i Season
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.Name,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.ClosedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const countConfirmedSignups = `-- name: CountConfirmedSignups :one
+SELECT count(*) FROM run_signups
+WHERE run_id = $1 AND role = $2 AND status = 'confirmed'
+`
+
+type CountConfirmedSignupsParams struct {
+	RunID int32
+	Role  string
+}
+
+func (q *Queries) CountConfirmedSignups(ctx context.Context, arg CountConfirmedSignupsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countConfirmedSignups, arg.RunID, arg.Role)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAvailabilityException = `-- name: CreateAvailabilityException :one
+INSERT INTO availability_exceptions (user_id, starts_at, ends_at, available)
+VALUES ($1, $2, $3, $4)
+RETURNING id, user_id, starts_at, ends_at, available, created_at
+`
+
+type CreateAvailabilityExceptionParams struct {
+	UserID    int32
+	StartsAt  pgtype.Timestamptz
+	EndsAt    pgtype.Timestamptz
+	Available bool
+}
+
+func (q *Queries) CreateAvailabilityException(ctx context.Context, arg CreateAvailabilityExceptionParams) (AvailabilityException, error) {
+	row := q.db.QueryRow(ctx, createAvailabilityException,
+		arg.UserID,
+		arg.StartsAt,
+		arg.EndsAt,
+		arg.Available,
+	)
+	var i AvailabilityException
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.Available,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createAvailabilityWindow = `-- name: CreateAvailabilityWindow :one
+INSERT INTO availability_windows (user_id, weekday, start_minute, end_minute)
+VALUES ($1, $2, $3, $4)
+RETURNING id, user_id, weekday, start_minute, end_minute, created_at
+`
+
+type CreateAvailabilityWindowParams struct {
+	UserID      int32
+	Weekday     int32
+	StartMinute int32
+	EndMinute   int32
+}
+
+func (q *Queries) CreateAvailabilityWindow(ctx context.Context, arg CreateAvailabilityWindowParams) (AvailabilityWindow, error) {
+	row := q.db.QueryRow(ctx, createAvailabilityWindow,
+		arg.UserID,
+		arg.Weekday,
+		arg.StartMinute,
+		arg.EndMinute,
+	)
+	var i AvailabilityWindow
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Weekday,
+		&i.StartMinute,
+		&i.EndMinute,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createCharacter = `-- name: CreateCharacter :one
+INSERT INTO characters (user_id, name, realm, class, spec, item_level, roles)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, user_id, name, realm, class, spec, item_level, roles, created_at, updated_at, imported_at
+`
+
+type CreateCharacterParams struct {
+	UserID    int32
+	Name      string
+	Realm     string
+	Class     string
+	Spec      string
+	ItemLevel int32
+	Roles     []string
+}
+
+func (q *Queries) CreateCharacter(ctx context.Context, arg CreateCharacterParams) (Character, error) {
+	row := q.db.QueryRow(ctx, createCharacter,
+		arg.UserID,
+		arg.Name,
+		arg.Realm,
+		arg.Class,
+		arg.Spec,
+		arg.ItemLevel,
+		arg.Roles,
+	)
+	var i Character
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.Realm,
+		&i.Class,
+		&i.Spec,
+		&i.ItemLevel,
+		&i.Roles,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ImportedAt,
+	)
+	return i, err
+}
+
+const createGuild = `-- name: CreateGuild :one
+INSERT INTO guilds (name)
+VALUES ($1)
+RETURNING id, name, created_at, updated_at, discord_webhook_url, leaderboard_scoring, waitlist_below_reliability
+`
+
+func (q *Queries) CreateGuild(ctx context.Context, name string) (Guild, error) {
+	row := q.db.QueryRow(ctx, createGuild, name)
+	var i Guild
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DiscordWebhookUrl,
+		&i.LeaderboardScoring,
+		&i.WaitlistBelowReliability,
+	)
+	return i, err
+}
+
+const createGuildAnnouncement = `-- name: CreateGuildAnnouncement :exec
+INSERT INTO guild_announcements (guild_id, run_id, kind)
+SELECT guilds.id, $1, $2 FROM guilds
+WHERE guilds.id = $3 AND guilds.discord_webhook_url <> ''
+`
+
+type CreateGuildAnnouncementParams struct {
+	RunID   int32
+	Kind    string
+	GuildID int32
+}
+
+func (q *Queries) CreateGuildAnnouncement(ctx context.Context, arg CreateGuildAnnouncementParams) error {
+	_, err := q.db.Exec(ctx, createGuildAnnouncement, arg.RunID, arg.Kind, arg.GuildID)
+	return err
+}
+
+const createInboxNotification = `-- name: CreateInboxNotification :exec
+INSERT INTO inbox_notifications (user_id, notification_id, type, subject, body)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (notification_id) DO NOTHING
+`
+
+type CreateInboxNotificationParams struct {
+	UserID         int32
+	NotificationID int64
+	Type           string
+	Subject        string
+	Body           string
+}
+
+func (q *Queries) CreateInboxNotification(ctx context.Context, arg CreateInboxNotificationParams) error {
+	_, err := q.db.Exec(ctx, createInboxNotification,
+		arg.UserID,
+		arg.NotificationID,
+		arg.Type,
+		arg.Subject,
+		arg.Body,
+	)
+	return err
+}
+
+const createJob = `-- name: CreateJob :execrows
+INSERT INTO jobs (queue, kind, args, unique_key, max_attempts, run_at)
+VALUES ($1, $2, $3, $4, $5, COALESCE($6::timestamptz, CURRENT_TIMESTAMP))
+ON CONFLICT (kind, unique_key) WHERE unique_key IS NOT NULL AND status IN ('pending', 'running') DO NOTHING
+`
+
+type CreateJobParams struct {
+	Queue       string
+	Kind        string
+	Args        []byte
+	UniqueKey   pgtype.Text
+	MaxAttempts int32
+	RunAt       pgtype.Timestamptz
+}
+
+func (q *Queries) CreateJob(ctx context.Context, arg CreateJobParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createJob,
+		arg.Queue,
+		arg.Kind,
+		arg.Args,
+		arg.UniqueKey,
+		arg.MaxAttempts,
+		arg.RunAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const createKeystone = `-- name: CreateKeystone :one
+INSERT INTO keystones (user_id, character_id, dungeon_id, level, recorded_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, user_id, character_id, dungeon_id, level, recorded_at, created_at, updated_at
+`
+
+type CreateKeystoneParams struct {
+	UserID      int32
+	CharacterID pgtype.Int4
+	DungeonID   int32
+	Level       int32
+	RecordedAt  pgtype.Timestamptz
+}
+
+func (q *Queries) CreateKeystone(ctx context.Context, arg CreateKeystoneParams) (Keystone, error) {
+	row := q.db.QueryRow(ctx, createKeystone,
+		arg.UserID,
+		arg.CharacterID,
+		arg.DungeonID,
+		arg.Level,
+		arg.RecordedAt,
+	)
+	var i Keystone
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.CharacterID,
+		&i.DungeonID,
+		&i.Level,
+		&i.RecordedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createLFGEntry = `-- name: CreateLFGEntry :one
+INSERT INTO lfg_entries (user_id, dungeon_id, min_key_level, max_key_level, roles, available_from, available_until,
+    character_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, user_id, dungeon_id, min_key_level, max_key_level, roles, available_from, available_until, status, proposal_id, created_at, updated_at, character_id
+`
+
+type CreateLFGEntryParams struct {
+	UserID         int32
+	DungeonID      int32
+	MinKeyLevel    int32
+	MaxKeyLevel    int32
+	Roles          []string
+	AvailableFrom  pgtype.Timestamptz
+	AvailableUntil pgtype.Timestamptz
+	CharacterID    pgtype.Int4
+}
+
+func (q *Queries) CreateLFGEntry(ctx context.Context, arg CreateLFGEntryParams) (LfgEntry, error) {
+	row := q.db.QueryRow(ctx, createLFGEntry,
+		arg.UserID,
+		arg.DungeonID,
+		arg.MinKeyLevel,
+		arg.MaxKeyLevel,
+		arg.Roles,
+		arg.AvailableFrom,
+		arg.AvailableUntil,
+		arg.CharacterID,
+	)
+	var i LfgEntry
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.DungeonID,
+		&i.MinKeyLevel,
+		&i.MaxKeyLevel,
+		&i.Roles,
+		&i.AvailableFrom,
+		&i.AvailableUntil,
+		&i.Status,
+		&i.ProposalID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CharacterID,
+	)
+	return i, err
+}
+
+const createLFGProposal = `-- name: CreateLFGProposal :one
+INSERT INTO lfg_proposals (dungeon_id, key_level, starts_at, score, seed, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, dungeon_id, key_level, starts_at, score, seed, status, expires_at, run_id, created_at, updated_at
+`
+
+type CreateLFGProposalParams struct {
+	DungeonID int32
+	KeyLevel  int32
+	StartsAt  pgtype.Timestamptz
+	Score     float64
+	Seed      int64
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) CreateLFGProposal(ctx context.Context, arg CreateLFGProposalParams) (LfgProposal, error) {
+	row := q.db.QueryRow(ctx, createLFGProposal,
+		arg.DungeonID,
+		arg.KeyLevel,
+		arg.StartsAt,
+		arg.Score,
+		arg.Seed,
+		arg.ExpiresAt,
+	)
+	var i LfgProposal
+	err := row.Scan(
+		&i.ID,
+		&i.DungeonID,
+		&i.KeyLevel,
+		&i.StartsAt,
+		&i.Score,
+		&i.Seed,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.RunID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createLeaderboardSnapshot = `-- name: CreateLeaderboardSnapshot :exec
+INSERT INTO leaderboard_snapshots (season_id, guild_id, user_id, rank, username, rating, elapsed_seconds,
+    best_runs, scoring)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+`
+
+type CreateLeaderboardSnapshotParams struct {
+	SeasonID       int32
+	GuildID        int32
+	UserID         int32
+	Rank           int32
+	Username       string
+	Rating         float64
+	ElapsedSeconds int32
+	BestRuns       []byte
+	Scoring        string
+}
+
+func (q *Queries) CreateLeaderboardSnapshot(ctx context.Context, arg CreateLeaderboardSnapshotParams) error {
+	_, err := q.db.Exec(ctx, createLeaderboardSnapshot,
+		arg.SeasonID,
+		arg.GuildID,
+		arg.UserID,
+		arg.Rank,
+		arg.Username,
+		arg.Rating,
+		arg.ElapsedSeconds,
+		arg.BestRuns,
+		arg.Scoring,
+	)
+	return err
+}
+
+const createNotification = `-- name: CreateNotification :exec
+INSERT INTO notifications (user_id, type, run_id, payload, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+`
+
+type CreateNotificationParams struct {
+	UserID    int32
+	Type      string
+	RunID     pgtype.Int4
+	Payload   []byte
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) CreateNotification(ctx context.Context, arg CreateNotificationParams) error {
+	_, err := q.db.Exec(ctx, createNotification,
+		arg.UserID,
+		arg.Type,
+		arg.RunID,
+		arg.Payload,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const createNotificationPreference = `-- name: CreateNotificationPreference :exec
+INSERT INTO notification_preferences (user_id, type, channels)
+VALUES ($1, $2, $3)
+`
+
+type CreateNotificationPreferenceParams struct {
+	UserID   int32
+	Type     string
+	Channels []string
+}
+
+func (q *Queries) CreateNotificationPreference(ctx context.Context, arg CreateNotificationPreferenceParams) error {
+	_, err := q.db.Exec(ctx, createNotificationPreference, arg.UserID, arg.Type, arg.Channels)
+	return err
+}
+
+const createReadyCheck = `-- name: CreateReadyCheck :one
+INSERT INTO ready_checks (run_id, started_by, expires_at)
+VALUES ($1, $2, $3)
+RETURNING id, run_id, started_by, status, expires_at, finished_at, created_at, updated_at
+`
+
+type CreateReadyCheckParams struct {
+	RunID     int32
+	StartedBy pgtype.Int4
+	ExpiresAt pgtype.Timestamptz
+}
+
+func (q *Queries) CreateReadyCheck(ctx context.Context, arg CreateReadyCheckParams) (ReadyCheck, error) {
+	row := q.db.QueryRow(ctx, createReadyCheck, arg.RunID, arg.StartedBy, arg.ExpiresAt)
+	var i ReadyCheck
+	err := row.Scan(
+		&i.ID,
+		&i.RunID,
+		&i.StartedBy,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.FinishedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createRun = `-- name: CreateRun :one
+INSERT INTO runs (dungeon_id, difficulty, key_level, organizer_id, starts_at, timezone, duration_minutes, notes,
+    tank_slots, healer_slots, dps_slots, guild_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+RETURNING id, dungeon_id, difficulty, key_level, organizer_id, starts_at, timezone, duration_minutes, notes, status, created_at, updated_at, tank_slots, healer_slots, dps_slots, series_id, occurrence_at, sequence, guild_id, started_at, finished_at, upgrade_level, affixes
+`
+
+type CreateRunParams struct {
+	DungeonID       int32
+	Difficulty      string
+	KeyLevel        pgtype.Int4
+	OrganizerID     int32
+	StartsAt        pgtype.Timestamptz
+	Timezone        string
+	DurationMinutes int32
+	Notes           string
+	TankSlots       int32
+	HealerSlots     int32
+	DpsSlots        int32
+	GuildID         pgtype.Int4
+}
+
+func (q *Queries) CreateRun(ctx context.Context, arg CreateRunParams) (Run, error) {
+	row := q.db.QueryRow(ctx, createRun,
+		arg.DungeonID,
+		arg.Difficulty,
+		arg.KeyLevel,
+		arg.OrganizerID,
+		arg.StartsAt,
+		arg.Timezone,
+		arg.DurationMinutes,
+		arg.Notes,
+		arg.TankSlots,
+		arg.HealerSlots,
+		arg.DpsSlots,
+		arg.GuildID,
+	)
+	var i Run
+	err := row.Scan(
+		&i.ID,
+		&i.DungeonID,
+		&i.Difficulty,
+		&i.KeyLevel,
+		&i.OrganizerID,
+		&i.StartsAt,
+		&i.Timezone,
+		&i.DurationMinutes,
+		&i.Notes,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TankSlots,
+		&i.HealerSlots,
+		&i.DpsSlots,
+		&i.SeriesID,
+		&i.OccurrenceAt,
+		&i.Sequence,
+		&i.GuildID,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.UpgradeLevel,
+		&i.Affixes,
+	)
+	return i, err
+}
+
+const createRunEvent = `-- name: CreateRunEvent :one
+INSERT INTO run_events (run_id, type, user_id, payload)
+VALUES ($1, $2, $3, $4)
+RETURNING id, run_id, type, user_id, payload, created_at
+`
+
+type CreateRunEventParams struct {
+	RunID   int32
+	Type    string
+	UserID  pgtype.Int4
+	Payload []byte
+}
+
+func (q *Queries) CreateRunEvent(ctx context.Context, arg CreateRunEventParams) (RunEvent, error) {
+	row := q.db.QueryRow(ctx, createRunEvent,
+		arg.RunID,
+		arg.Type,
+		arg.UserID,
+		arg.Payload,
+	)
+	var i RunEvent
+	err := row.Scan(
+		&i.ID,
+		&i.RunID,
+		&i.Type,
+		&i.UserID,
+		&i.Payload,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createRunReminder = `-- name: CreateRunReminder :exec
+INSERT INTO run_reminders (run_id, offset_minutes)
+VALUES ($1, $2)
+ON CONFLICT (run_id, offset_minutes) DO NOTHING
+`
+
+type CreateRunReminderParams struct {
+	RunID         int32
+	OffsetMinutes int32
+}
+
+func (q *Queries) CreateRunReminder(ctx context.Context, arg CreateRunReminderParams) error {
+	_, err := q.db.Exec(ctx, createRunReminder, arg.RunID, arg.OffsetMinutes)
+	return err
+}
+
+const createSeason = `-- name: CreateSeason :one
+INSERT INTO seasons (code, name, starts_at, ends_at)
+VALUES ($1, $2, $3, $4)
+RETURNING id, code, name, starts_at, ends_at, closed_at, created_at, updated_at
+`
+
+type CreateSeasonParams struct {
+	Code     string
+	Name     string
+	StartsAt pgtype.Timestamptz
+	EndsAt   pgtype.Timestamptz
+}
+
+func (q *Queries) CreateSeason(ctx context.Context, arg CreateSeasonParams) (Season, error) {
+	row := q.db.QueryRow(ctx, createSeason,
+		arg.Code,
+		arg.Name,
+		arg.StartsAt,
+		arg.EndsAt,
+	)
+	var i Season
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.Name,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.ClosedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createSeasonAffixes = `-- name: CreateSeasonAffixes :exec
+INSERT INTO season_affixes (season_id, week, affixes)
+VALUES ($1, $2, $3)
+`
+
+type CreateSeasonAffixesParams struct {
+	SeasonID int32
+	Week     int32
+	Affixes  []string
+}
+
+func (q *Queries) CreateSeasonAffixes(ctx context.Context, arg CreateSeasonAffixesParams) error {
+	_, err := q.db.Exec(ctx, createSeasonAffixes, arg.SeasonID, arg.Week, arg.Affixes)
+	return err
+}
+
+const createSeries = `-- name: CreateSeries :one
+INSERT INTO run_series (organizer_id, dungeon_id, difficulty, key_level, rrule, timezone, starts_at,
+    duration_minutes, notes, tank_slots, healer_slots, dps_slots, guild_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+RETURNING id, organizer_id, dungeon_id, difficulty, key_level, rrule, timezone, starts_at, until_at, duration_minutes, notes, tank_slots, healer_slots, dps_slots, created_at, updated_at, guild_id
+`
+
+type CreateSeriesParams struct {
+	OrganizerID     int32
+	DungeonID       int32
+	Difficulty      string
+	KeyLevel        pgtype.Int4
+	Rrule           string
+	Timezone        string
+	StartsAt        pgtype.Timestamptz
+	DurationMinutes int32
+	Notes           string
+	TankSlots       int32
+	HealerSlots     int32
+	DpsSlots        int32
+	GuildID         pgtype.Int4
+}
+
+func (q *Queries) CreateSeries(ctx context.Context, arg CreateSeriesParams) (RunSeries, error) {
+	row := q.db.QueryRow(ctx, createSeries,
+		arg.OrganizerID,
+		arg.DungeonID,
+		arg.Difficulty,
+		arg.KeyLevel,
+		arg.Rrule,
+		arg.Timezone,
+		arg.StartsAt,
+		arg.DurationMinutes,
+		arg.Notes,
+		arg.TankSlots,
+		arg.HealerSlots,
+		arg.DpsSlots,
+		arg.GuildID,
+	)
+	var i RunSeries
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizerID,
+		&i.DungeonID,
+		&i.Difficulty,
+		&i.KeyLevel,
+		&i.Rrule,
+		&i.Timezone,
+		&i.StartsAt,
+		&i.UntilAt,
+		&i.DurationMinutes,
+		&i.Notes,
+		&i.TankSlots,
+		&i.HealerSlots,
+		&i.DpsSlots,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.GuildID,
+	)
+	return i, err
+}
+
+const createSignup = `-- name: CreateSignup :one
+INSERT INTO run_signups (run_id, user_id, role, status, waitlist_position, character_id)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, run_id, user_id, role, status, withdrawn_at, created_at, updated_at, waitlist_position, attendance, character_id
+`
+
+type CreateSignupParams struct {
+	RunID            int32
+	UserID           int32
+	Role             string
+	Status           string
+	WaitlistPosition pgtype.Int4
+	CharacterID      pgtype.Int4
+}
+
+func (q *Queries) CreateSignup(ctx context.Context, arg CreateSignupParams) (RunSignup, error) {
+	row := q.db.QueryRow(ctx, createSignup,
+		arg.RunID,
+		arg.UserID,
+		arg.Role,
+		arg.Status,
+		arg.WaitlistPosition,
+		arg.CharacterID,
+	)
+	var i RunSignup
+	err := row.Scan(
+		&i.ID,
+		&i.RunID,
+		&i.UserID,
+		&i.Role,
+		&i.Status,
+		&i.WithdrawnAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.WaitlistPosition,
+		&i.Attendance,
+		&i.CharacterID,
+	)
+	return i, err
+}
+
+const createUser = `-- name: CreateUser :one
+INSERT INTO users (username, email, password_hash, roles, timezone)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, username, email, password_hash, timezone, created_at, updated_at, roles, calendar_token
+`
+
+type CreateUserParams struct {
+	Username     string
+	Email        string
+	PasswordHash string
+	Roles        []string
+	Timezone     string
+}
+
+func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
+	row := q.db.QueryRow(ctx, createUser,
+		arg.Username,
+		arg.Email,
+		arg.PasswordHash,
+		arg.Roles,
+		arg.Timezone,
+	)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Email,
+		&i.PasswordHash,
+		&i.Timezone,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Roles,
+		&i.CalendarToken,
+	)
+	return i, err
+}
+
+const createWebhookDelivery = `-- name: CreateWebhookDelivery :one
+INSERT INTO webhook_deliveries (endpoint_id, event_id, event, payload)
+VALUES ($1, $2, $3, $4)
+RETURNING id, endpoint_id, event_id, event, payload, status, attempts, response_status, last_error, delivered_at, created_at, updated_at
+`
+
+type CreateWebhookDeliveryParams struct {
+	EndpointID int32
+	EventID    string
+	Event      string
+	Payload    []byte
+}
+
+func (q *Queries) CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (WebhookDelivery, error) {
+	row := q.db.QueryRow(ctx, createWebhookDelivery,
+		arg.EndpointID,
+		arg.EventID,
+		arg.Event,
+		arg.Payload,
+	)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.EndpointID,
+		&i.EventID,
+		&i.Event,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.ResponseStatus,
+		&i.LastError,
+		&i.DeliveredAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createWebhookEndpoint = `-- name: CreateWebhookEndpoint :one
+INSERT INTO webhook_endpoints (guild_id, url, secret, events)
+VALUES ($1, $2, $3, $4)
+RETURNING id, guild_id, url, secret, events, active, consecutive_failures, disabled_at, created_at, updated_at
+`
+
+type CreateWebhookEndpointParams struct {
+	GuildID pgtype.Int4
+	Url     string
+	Secret  string
+	Events  []string
+}
+
+func (q *Queries) CreateWebhookEndpoint(ctx context.Context, arg CreateWebhookEndpointParams) (WebhookEndpoint, error) {
+	row := q.db.QueryRow(ctx, createWebhookEndpoint,
+		arg.GuildID,
+		arg.Url,
+		arg.Secret,
+		arg.Events,
+	)
+	var i WebhookEndpoint
+	err := row.Scan(
+		&i.ID,
+		&i.GuildID,
+		&i.Url,
+		&i.Secret,
+		&i.Events,
+		&i.Active,
+		&i.ConsecutiveFailures,
+		&i.DisabledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deactivateAffixes = `-- name: DeactivateAffixes :exec
+UPDATE affixes SET active = FALSE
+WHERE active
+`
+
+func (q *Queries) DeactivateAffixes(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, deactivateAffixes)
+	return err
+}
+
+const deactivateDungeons = `-- name: DeactivateDungeons :exec
+UPDATE dungeons SET active = FALSE
+WHERE active
+`
+
+func (q *Queries) DeactivateDungeons(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, deactivateDungeons)
+	return err
+}
+
+const deferGuildAnnouncements = `-- name: DeferGuildAnnouncements :exec
+UPDATE guild_announcements SET deliver_after = $1
+WHERE guild_id = $2 AND status = 'pending'
+    AND (deliver_after <= $3 OR (id = $4 AND deliver_after = $5))
+`
+
+type DeferGuildAnnouncementsParams struct {
+	DeliverAfter pgtype.Timestamptz
+	GuildID      int32
+	Now          pgtype.Timestamptz
+	ID           int64
+	LeasedUntil  pgtype.Timestamptz
+}
+
+func (q *Queries) DeferGuildAnnouncements(ctx context.Context, arg DeferGuildAnnouncementsParams) error {
+	_, err := q.db.Exec(ctx, deferGuildAnnouncements,
+		arg.DeliverAfter,
+		arg.GuildID,
+		arg.Now,
+		arg.ID,
+		arg.LeasedUntil,
+	)
+	return err
+}
+
+const deferNotification = `-- name: DeferNotification :execrows
+UPDATE notifications SET deliver_after = $1
+WHERE id = $2 AND status = 'pending' AND deliver_after = $3
+`
+
+type DeferNotificationParams struct {
+	DeliverAfter pgtype.Timestamptz
+	ID           int64
+	LeasedUntil  pgtype.Timestamptz
+}
+
+func (q *Queries) DeferNotification(ctx context.Context, arg DeferNotificationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deferNotification, arg.DeliverAfter, arg.ID, arg.LeasedUntil)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteAvailabilityException = `-- name: DeleteAvailabilityException :execrows
+DELETE FROM availability_exceptions
+WHERE id = $1 AND user_id = $2
+`
+
+type DeleteAvailabilityExceptionParams struct {
+	ID     int32
+	UserID int32
+}
+
+func (q *Queries) DeleteAvailabilityException(ctx context.Context, arg DeleteAvailabilityExceptionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteAvailabilityException, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteAvailabilityWindows = `-- name: DeleteAvailabilityWindows :exec
+DELETE FROM availability_windows
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteAvailabilityWindows(ctx context.Context, userID int32) error {
+	_, err := q.db.Exec(ctx, deleteAvailabilityWindows, userID)
+	return err
+}
+
+const deleteCharacter = `-- name: DeleteCharacter :execrows
+DELETE FROM characters
+WHERE id = $1 AND user_id = $2
+`
+
+type DeleteCharacterParams struct {
+	ID     int32
+	UserID int32
+}
+
+func (q *Queries) DeleteCharacter(ctx context.Context, arg DeleteCharacterParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteCharacter, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteJob = `-- name: DeleteJob :execrows
+DELETE FROM jobs
+WHERE id = $1 AND locked_until = $2
+`
+
+type DeleteJobParams struct {
+	ID          int64
+	LockedUntil pgtype.Timestamptz
+}
+
+func (q *Queries) DeleteJob(ctx context.Context, arg DeleteJobParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteJob, arg.ID, arg.LockedUntil)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteKeystone = `-- name: DeleteKeystone :execrows
+DELETE FROM keystones
+WHERE user_id = $1 AND character_id IS NOT DISTINCT FROM $2
+`
+
+type DeleteKeystoneParams struct {
+	UserID      int32
+	CharacterID pgtype.Int4
+}
+
+func (q *Queries) DeleteKeystone(ctx context.Context, arg DeleteKeystoneParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteKeystone, arg.UserID, arg.CharacterID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteNotificationPreferences = `-- name: DeleteNotificationPreferences :exec
+DELETE FROM notification_preferences
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteNotificationPreferences(ctx context.Context, userID int32) error {
+	_, err := q.db.Exec(ctx, deleteNotificationPreferences, userID)
+	return err
+}
+
+const deleteRunReminders = `-- name: DeleteRunReminders :exec
+DELETE FROM run_reminders
+WHERE run_id = $1
+`
+
+func (q *Queries) DeleteRunReminders(ctx context.Context, runID int32) error {
+	_, err := q.db.Exec(ctx, deleteRunReminders, runID)
+	return err
+}
+
+const deleteSeasonAffixes = `-- name: DeleteSeasonAffixes :exec
+DELETE FROM season_affixes
+WHERE season_id = $1
+`
+
+func (q *Queries) DeleteSeasonAffixes(ctx context.Context, seasonID int32) error {
+	_, err := q.db.Exec(ctx, deleteSeasonAffixes, seasonID)
+	return err
+}
+
+const deleteWebhookEndpoint = `-- name: DeleteWebhookEndpoint :exec
+DELETE FROM webhook_endpoints
+WHERE id = $1
+`
+
+func (q *Queries) DeleteWebhookEndpoint(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, deleteWebhookEndpoint, id)
+	return err
+}
+
+const endSeries = `-- name: EndSeries :exec
+UPDATE run_series SET until_at = $2
+WHERE id = $1
+`
+
+type EndSeriesParams struct {
+	ID      int32
+	UntilAt pgtype.Timestamptz
+}
+
+func (q *Queries) EndSeries(ctx context.Context, arg EndSeriesParams) error {
+	_, err := q.db.Exec(ctx, endSeries, arg.ID, arg.UntilAt)
+	return err
+}
+
+const expireJobLeases = `-- name: ExpireJobLeases :exec
+UPDATE jobs SET status = CASE WHEN attempts + 1 >= max_attempts THEN 'dead' ELSE 'pending' END,
+    attempts = attempts + 1, last_error = 'lease expired', locked_until = NULL
+WHERE status = 'running' AND locked_until <= $1
+`
+
+func (q *Queries) ExpireJobLeases(ctx context.Context, now pgtype.Timestamptz) error {
+	_, err := q.db.Exec(ctx, expireJobLeases, now)
+	return err
+}
+
+const finishReadyCheck = `-- name: FinishReadyCheck :one
+UPDATE ready_checks SET status = $2, finished_at = $3
+WHERE id = $1
+RETURNING id, run_id, started_by, status, expires_at, finished_at, created_at, updated_at
+`
+
+type FinishReadyCheckParams struct {
+	ID         int32
+	Status     string
+	FinishedAt pgtype.Timestamptz
+}
+
+func (q *Queries) FinishReadyCheck(ctx context.Context, arg FinishReadyCheckParams) (ReadyCheck, error) {
+	row := q.db.QueryRow(ctx, finishReadyCheck, arg.ID, arg.Status, arg.FinishedAt)
+	var i ReadyCheck
+	err := row.Scan(
+		&i.ID,
+		&i.RunID,
+		&i.StartedBy,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.FinishedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getActiveLFGEntry = `-- name: GetActiveLFGEntry :one
+SELECT lfg_entries.id, lfg_entries.user_id, lfg_entries.dungeon_id, lfg_entries.min_key_level, lfg_entries.max_key_level, lfg_entries.roles, lfg_entries.available_from, lfg_entries.available_until, lfg_entries.status, lfg_entries.proposal_id, lfg_entries.created_at, lfg_entries.updated_at, lfg_entries.character_id, dungeons.id, dungeons.code, dungeons.name, dungeons.expansion, dungeons.season, dungeons.par_seconds, dungeons.boss_count, dungeons.difficulties, dungeons.active, dungeons.created_at, dungeons.updated_at FROM lfg_entries
+JOIN dungeons ON dungeons.id = lfg_entries.dungeon_id
+WHERE lfg_entries.user_id = $1 AND lfg_entries.status IN ('queued', 'proposed')
+`
+
+type GetActiveLFGEntryRow struct {
+	LfgEntry LfgEntry
+	Dungeon  Dungeon
+}
+
+func (q *Queries) GetActiveLFGEntry(ctx context.Context, userID int32) (GetActiveLFGEntryRow, error) {
+	row := q.db.QueryRow(ctx, getActiveLFGEntry, userID)
+	var i GetActiveLFGEntryRow
+	err := row.Scan(
+		&i.LfgEntry.ID,
+		&i.LfgEntry.UserID,
+		&i.LfgEntry.DungeonID,
+		&i.LfgEntry.MinKeyLevel,
+		&i.LfgEntry.MaxKeyLevel,
+		&i.LfgEntry.Roles,
+		&i.LfgEntry.AvailableFrom,
+		&i.LfgEntry.AvailableUntil,
+		&i.LfgEntry.Status,
+		&i.LfgEntry.ProposalID,
+		&i.LfgEntry.CreatedAt,
+		&i.LfgEntry.UpdatedAt,
+		&i.LfgEntry.CharacterID,
+		&i.Dungeon.ID,
+		&i.Dungeon.Code,
+		&i.Dungeon.Name,
+		&i.Dungeon.Expansion,
+		&i.Dungeon.Season,
+		&i.Dungeon.ParSeconds,
+		&i.Dungeon.BossCount,
+		&i.Dungeon.Difficulties,
+		&i.Dungeon.Active,
+		&i.Dungeon.CreatedAt,
+		&i.Dungeon.UpdatedAt,
+	)
+	return i, err
+}
+
+const getActiveSignup = `-- name: GetActiveSignup :one
+SELECT id, run_id, user_id, role, status, withdrawn_at, created_at, updated_at, waitlist_position, attendance, character_id FROM run_signups
+WHERE run_id = $1 AND user_id = $2 AND status <> 'withdrawn'
+LIMIT 1
+`
+
+type GetActiveSignupParams struct {
+	RunID  int32
+	UserID int32
+}
+
+func (q *Queries) GetActiveSignup(ctx context.Context, arg GetActiveSignupParams) (RunSignup, error) {
+	row := q.db.QueryRow(ctx, getActiveSignup, arg.RunID, arg.UserID)
+	var i RunSignup
+	err := row.Scan(
+		&i.ID,
+		&i.RunID,
+		&i.UserID,
+		&i.Role,
+		&i.Status,
+		&i.WithdrawnAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.WaitlistPosition,
+		&i.Attendance,
+		&i.CharacterID,
+	)
+	return i, err
+}
+
+const getAffixByCode = `-- name: GetAffixByCode :one
+SELECT id, code, name, description, active, created_at, updated_at FROM affixes
+WHERE code = $1 LIMIT 1
+`
+
+func (q *Queries) GetAffixByCode(ctx context.Context, code string) (Affix, error) {
+	row := q.db.QueryRow(ctx, getAffixByCode, code)
+	var i Affix
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.Name,
+		&i.Description,
+		&i.Active,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getAffixes = `-- name: GetAffixes :many
+SELECT id, code, name, description, active, created_at, updated_at FROM affixes
+WHERE active
+ORDER BY name
+`
+
+func (q *Queries) GetAffixes(ctx context.Context) ([]Affix, error) {
+	rows, err := q.db.Query(ctx, getAffixes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Affix
+	for rows.Next() {
+		var i Affix
+		if err := rows.Scan(
+			&i.ID,
+			&i.Code,
+			&i.Name,
+			&i.Description,
+			&i.Active,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAvailabilityExceptions = `-- name: GetAvailabilityExceptions :many
+SELECT id, user_id, starts_at, ends_at, available, created_at FROM availability_exceptions
+WHERE user_id = ANY($1::int[]) AND ends_at > $2 AND starts_at < $3
+ORDER BY user_id, starts_at
+`
+
+type GetAvailabilityExceptionsParams struct {
+	UserIds      []int32
+	StartsAfter  pgtype.Timestamptz
+	StartsBefore pgtype.Timestamptz
+}
+
+func (q *Queries) GetAvailabilityExceptions(ctx context.Context, arg GetAvailabilityExceptionsParams) ([]AvailabilityException, error) {
+	rows, err := q.db.Query(ctx, getAvailabilityExceptions, arg.UserIds, arg.StartsAfter, arg.StartsBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AvailabilityException
+	for rows.Next() {
+		var i AvailabilityException
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.Available,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAvailabilityWindows = `-- name: GetAvailabilityWindows :many
+SELECT id, user_id, weekday, start_minute, end_minute, created_at FROM availability_windows
+WHERE user_id = ANY($1::int[])
+ORDER BY user_id, weekday, start_minute
+`
+
+func (q *Queries) GetAvailabilityWindows(ctx context.Context, userIds []int32) ([]AvailabilityWindow, error) {
+	rows, err := q.db.Query(ctx, getAvailabilityWindows, userIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AvailabilityWindow
+	for rows.Next() {
+		var i AvailabilityWindow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Weekday,
+			&i.StartMinute,
+			&i.EndMinute,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCatalogVersion = `-- name: GetCatalogVersion :one
+SELECT version FROM catalog_versions
+WHERE catalog = $1
+`
+
+func (q *Queries) GetCatalogVersion(ctx context.Context, catalog string) (int32, error) {
+	row := q.db.QueryRow(ctx, getCatalogVersion, catalog)
+	var version int32
+	err := row.Scan(&version)
+	return version, err
+}
+
+const getCharacterByID = `-- name: GetCharacterByID :one
+SELECT characters.id, characters.user_id, characters.name, characters.realm, characters.class, characters.spec, characters.item_level, characters.roles, characters.created_at, characters.updated_at, characters.imported_at, dungeons.code AS key_dungeon, keystones.level AS key_level,
+    keystones.recorded_at AS key_recorded_at
+FROM characters
+LEFT JOIN keystones ON keystones.character_id = characters.id
+LEFT JOIN dungeons ON dungeons.id = keystones.dungeon_id
+WHERE characters.id = $1
+`
+
+type GetCharacterByIDRow struct {
+	Character     Character
+	KeyDungeon    pgtype.Text
+	KeyLevel      pgtype.Int4
+	KeyRecordedAt pgtype.Timestamptz
+}
+
+func (q *Queries) GetCharacterByID(ctx context.Context, id int32) (GetCharacterByIDRow, error) {
+	row := q.db.QueryRow(ctx, getCharacterByID, id)
+	var i GetCharacterByIDRow
+	err := row.Scan(
+		&i.Character.ID,
+		&i.Character.UserID,
+		&i.Character.Name,
+		&i.Character.Realm,
+		&i.Character.Class,
+		&i.Character.Spec,
+		&i.Character.ItemLevel,
+		&i.Character.Roles,
+		&i.Character.CreatedAt,
+		&i.Character.UpdatedAt,
+		&i.Character.ImportedAt,
+		&i.KeyDungeon,
+		&i.KeyLevel,
+		&i.KeyRecordedAt,
+	)
+	return i, err
+}
+
+const getCharacterByName = `-- name: GetCharacterByName :one
+SELECT characters.id, characters.user_id, characters.name, characters.realm, characters.class, characters.spec, characters.item_level, characters.roles, characters.created_at, characters.updated_at, characters.imported_at, dungeons.code AS key_dungeon, keystones.level AS key_level,
+    keystones.recorded_at AS key_recorded_at
+FROM characters
+LEFT JOIN keystones ON keystones.character_id = characters.id
+LEFT JOIN dungeons ON dungeons.id = keystones.dungeon_id
+WHERE lower(characters.realm) = lower($1) AND lower(characters.name) = lower($2)
+`
+
+type GetCharacterByNameParams struct {
+	Realm string
+	Name  string
+}
+
+type GetCharacterByNameRow struct {
+	Character     Character
+	KeyDungeon    pgtype.Text
+	KeyLevel      pgtype.Int4
+	KeyRecordedAt pgtype.Timestamptz
+}
+
+func (q *Queries) GetCharacterByName(ctx context.Context, arg GetCharacterByNameParams) (GetCharacterByNameRow, error) {
+	row := q.db.QueryRow(ctx, getCharacterByName, arg.Realm, arg.Name)
+	var i GetCharacterByNameRow
+	err := row.Scan(
+		&i.Character.ID,
+		&i.Character.UserID,
+		&i.Character.Name,
+		&i.Character.Realm,
+		&i.Character.Class,
+		&i.Character.Spec,
+		&i.Character.ItemLevel,
+		&i.Character.Roles,
+		&i.Character.CreatedAt,
+		&i.Character.UpdatedAt,
+		&i.Character.ImportedAt,
+		&i.KeyDungeon,
+		&i.KeyLevel,
+		&i.KeyRecordedAt,
+	)
+	return i, err
+}
+
+const getDungeonByCode = `-- name: GetDungeonByCode :one
+SELECT id, code, name, expansion, season, par_seconds, boss_count, difficulties, active, created_at, updated_at FROM dungeons
+WHERE code = $1 LIMIT 1
+`
+
+func (q *Queries) GetDungeonByCode(ctx context.Context, code string) (Dungeon, error) {
+	row := q.db.QueryRow(ctx, getDungeonByCode, code)
+	var i Dungeon
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.Name,
+		&i.Expansion,
+		&i.Season,
+		&i.ParSeconds,
+		&i.BossCount,
+		&i.Difficulties,
+		&i.Active,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getDungeonByID = `-- name: GetDungeonByID :one
+SELECT id, code, name, expansion, season, par_seconds, boss_count, difficulties, active, created_at, updated_at FROM dungeons
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetDungeonByID(ctx context.Context, id int32) (Dungeon, error) {
+	row := q.db.QueryRow(ctx, getDungeonByID, id)
+	var i Dungeon
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.Name,
+		&i.Expansion,
+		&i.Season,
+		&i.ParSeconds,
+		&i.BossCount,
+		&i.Difficulties,
+		&i.Active,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getDungeons = `-- name: GetDungeons :many
+SELECT id, code, name, expansion, season, par_seconds, boss_count, difficulties, active, created_at, updated_at FROM dungeons
+WHERE active
+ORDER BY name
+`
+
+func (q *Queries) GetDungeons(ctx context.Context) ([]Dungeon, error) {
+	rows, err := q.db.Query(ctx, getDungeons)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Dungeon
+	for rows.Next() {
+		var i Dungeon
+		if err := rows.Scan(
+			&i.ID,
+			&i.Code,
+			&i.Name,
+			&i.Expansion,
+			&i.Season,
+			&i.ParSeconds,
+			&i.BossCount,
+			&i.Difficulties,
+			&i.Active,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGuildByID = `-- name: GetGuildByID :one
+SELECT id, name, created_at, updated_at, discord_webhook_url, leaderboard_scoring, waitlist_below_reliability FROM guilds
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetGuildByID(ctx context.Context, id int32) (Guild, error) {
+	row := q.db.QueryRow(ctx, getGuildByID, id)
+	var i Guild
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DiscordWebhookUrl,
+		&i.LeaderboardScoring,
+		&i.WaitlistBelowReliability,
+	)
+	return i, err
+}
+
+const getGuildByName = `-- name: GetGuildByName :one
+SELECT id, name, created_at, updated_at, discord_webhook_url, leaderboard_scoring, waitlist_below_reliability FROM guilds
+WHERE name = $1 LIMIT 1
+`
+
+func (q *Queries) GetGuildByName(ctx context.Context, name string) (Guild, error) {
+	row := q.db.QueryRow(ctx, getGuildByName, name)
+	var i Guild
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DiscordWebhookUrl,
+		&i.LeaderboardScoring,
+		&i.WaitlistBelowReliability,
+	)
+	return i, err
+}
+
+const getGuildKeystones = `-- name: GetGuildKeystones :many
+SELECT keystones.id, keystones.user_id, keystones.character_id, keystones.dungeon_id, keystones.level, keystones.recorded_at, keystones.created_at, keystones.updated_at, dungeons.id, dungeons.code, dungeons.name, dungeons.expansion, dungeons.season, dungeons.par_seconds, dungeons.boss_count, dungeons.difficulties, dungeons.active, dungeons.created_at, dungeons.updated_at, users.username, characters.name AS character_name,
+    COALESCE(characters.roles, users.roles)::text[] AS holder_roles
+FROM keystones
+JOIN guild_members ON guild_members.user_id = keystones.user_id
+JOIN dungeons ON dungeons.id = keystones.dungeon_id
+JOIN users ON users.id = keystones.user_id
+LEFT JOIN characters ON characters.id = keystones.character_id
+WHERE guild_members.guild_id = $1 AND keystones.recorded_at >= $2
+ORDER BY keystones.level DESC, keystones.id
+`
+
+type GetGuildKeystonesParams struct {
+	GuildID       int32
+	RecordedAfter pgtype.Timestamptz
+}
+
+type GetGuildKeystonesRow struct {
+	Keystone      Keystone
+	Dungeon       Dungeon
+	Username      string
+	CharacterName pgtype.Text
+	HolderRoles   []string
+}
+
+func (q *Queries) GetGuildKeystones(ctx context.Context, arg GetGuildKeystonesParams) ([]GetGuildKeystonesRow, error) {
+	rows, err := q.db.Query(ctx, getGuildKeystones, arg.GuildID, arg.RecordedAfter)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetGuildKeystonesRow
+	for rows.Next() {
+		var i GetGuildKeystonesRow
+		if err := rows.Scan(
+			&i.Keystone.ID,
+			&i.Keystone.UserID,
+			&i.Keystone.CharacterID,
+			&i.Keystone.DungeonID,
+			&i.Keystone.Level,
+			&i.Keystone.RecordedAt,
+			&i.Keystone.CreatedAt,
+			&i.Keystone.UpdatedAt,
+			&i.Dungeon.ID,
+			&i.Dungeon.Code,
+			&i.Dungeon.Name,
+			&i.Dungeon.Expansion,
+			&i.Dungeon.Season,
+			&i.Dungeon.ParSeconds,
+			&i.Dungeon.BossCount,
+			&i.Dungeon.Difficulties,
+			&i.Dungeon.Active,
+			&i.Dungeon.CreatedAt,
+			&i.Dungeon.UpdatedAt,
+			&i.Username,
+			&i.CharacterName,
+			&i.HolderRoles,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGuildMember = `-- name: GetGuildMember :one
+SELECT guild_id, user_id, role, created_at FROM guild_members
+WHERE guild_id = $1 AND user_id = $2 LIMIT 1
+`
+
+type GetGuildMemberParams struct {
+	GuildID int32
+	UserID  int32
+}
+
+func (q *Queries) GetGuildMember(ctx context.Context, arg GetGuildMemberParams) (GuildMember, error) {
+	row := q.db.QueryRow(ctx, getGuildMember, arg.GuildID, arg.UserID)
+	var i GuildMember
+	err := row.Scan(
+		&i.GuildID,
+		&i.UserID,
+		&i.Role,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getGuildMembers = `-- name: GetGuildMembers :many
+SELECT guild_members.guild_id, guild_members.user_id, guild_members.role, guild_members.created_at, users.username, users.roles AS user_roles, users.timezone FROM guild_members
+JOIN users ON users.id = guild_members.user_id
+WHERE guild_members.guild_id = $1
+ORDER BY guild_members.created_at, guild_members.user_id
+`
+
+type GetGuildMembersRow struct {
+	GuildID   int32
+	UserID    int32
+	Role      string
+	CreatedAt pgtype.Timestamptz
+	Username  string
+	UserRoles []string
+	Timezone  string
+}
+
+func (q *Queries) GetGuildMembers(ctx context.Context, guildID int32) ([]GetGuildMembersRow, error) {
+	rows, err := q.db.Query(ctx, getGuildMembers, guildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetGuildMembersRow
+	for rows.Next() {
+		var i GetGuildMembersRow
+		if err := rows.Scan(
+			&i.GuildID,
+			&i.UserID,
+			&i.Role,
+			&i.CreatedAt,
+			&i.Username,
+			&i.UserRoles,
+			&i.Timezone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGuilds = `-- name: GetGuilds :many
+SELECT id, name, created_at, updated_at, discord_webhook_url, leaderboard_scoring, waitlist_below_reliability FROM guilds
+ORDER BY id
+`
+
+func (q *Queries) GetGuilds(ctx context.Context) ([]Guild, error) {
+	rows, err := q.db.Query(ctx, getGuilds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Guild
+	for rows.Next() {
+		var i Guild
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DiscordWebhookUrl,
+			&i.LeaderboardScoring,
+			&i.WaitlistBelowReliability,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getInboxNotifications = `-- name: GetInboxNotifications :many
+SELECT id, user_id, notification_id, type, subject, body, read_at, created_at FROM inbox_notifications
+WHERE user_id = $1
+ORDER BY id DESC
+LIMIT $2
+`
+
+type GetInboxNotificationsParams struct {
+	UserID int32
+	Limit  int32
+}
+
+func (q *Queries) GetInboxNotifications(ctx context.Context, arg GetInboxNotificationsParams) ([]InboxNotification, error) {
+	rows, err := q.db.Query(ctx, getInboxNotifications, arg.UserID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InboxNotification
+	for rows.Next() {
+		var i InboxNotification
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.NotificationID,
+			&i.Type,
+			&i.Subject,
+			&i.Body,
+			&i.ReadAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getJobByID = `-- name: GetJobByID :one
+SELECT id, queue, kind, args, unique_key, status, attempts, max_attempts, last_error, run_at, created_at, updated_at, locked_until FROM jobs
+WHERE id = $1
+`
+
+func (q *Queries) GetJobByID(ctx context.Context, id int64) (Job, error) {
+	row := q.db.QueryRow(ctx, getJobByID, id)
+	var i Job
+	err := row.Scan(
+		&i.ID,
+		&i.Queue,
+		&i.Kind,
+		&i.Args,
+		&i.UniqueKey,
+		&i.Status,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.LastError,
+		&i.RunAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LockedUntil,
+	)
+	return i, err
+}
+
+const getJobs = `-- name: GetJobs :many
+SELECT id, queue, kind, args, unique_key, status, attempts, max_attempts, last_error, run_at, created_at, updated_at, locked_until FROM jobs
+WHERE ($1::text IS NULL OR status = $1)
+    AND ($2::text IS NULL OR queue = $2)
+ORDER BY id DESC
+LIMIT $3
+`
+
+type GetJobsParams struct {
+	Status  pgtype.Text
+	Queue   pgtype.Text
+	MaxJobs int32
+}
+
+func (q *Queries) GetJobs(ctx context.Context, arg GetJobsParams) ([]Job, error) {
+	rows, err := q.db.Query(ctx, getJobs, arg.Status, arg.Queue, arg.MaxJobs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Job
+	for rows.Next() {
+		var i Job
+		if err := rows.Scan(
+			&i.ID,
+			&i.Queue,
+			&i.Kind,
+			&i.Args,
+			&i.UniqueKey,
+			&i.Status,
+			&i.Attempts,
+			&i.MaxAttempts,
+			&i.LastError,
+			&i.RunAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.LockedUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLFGProposal = `-- name: GetLFGProposal :one
+SELECT lfg_proposals.id, lfg_proposals.dungeon_id, lfg_proposals.key_level, lfg_proposals.starts_at, lfg_proposals.score, lfg_proposals.seed, lfg_proposals.status, lfg_proposals.expires_at, lfg_proposals.run_id, lfg_proposals.created_at, lfg_proposals.updated_at, dungeons.id, dungeons.code, dungeons.name, dungeons.expansion, dungeons.season, dungeons.par_seconds, dungeons.boss_count, dungeons.difficulties, dungeons.active, dungeons.created_at, dungeons.updated_at FROM lfg_proposals
+JOIN dungeons ON dungeons.id = lfg_proposals.dungeon_id
+WHERE lfg_proposals.id = $1
+`
+
+type GetLFGProposalRow struct {
+	LfgProposal LfgProposal
+	Dungeon     Dungeon
+}
+
+func (q *Queries) GetLFGProposal(ctx context.Context, id int32) (GetLFGProposalRow, error) {
+	row := q.db.QueryRow(ctx, getLFGProposal, id)
+	var i GetLFGProposalRow
+	err := row.Scan(
+		&i.LfgProposal.ID,
+		&i.LfgProposal.DungeonID,
+		&i.LfgProposal.KeyLevel,
+		&i.LfgProposal.StartsAt,
+		&i.LfgProposal.Score,
+		&i.LfgProposal.Seed,
+		&i.LfgProposal.Status,
+		&i.LfgProposal.ExpiresAt,
+		&i.LfgProposal.RunID,
+		&i.LfgProposal.CreatedAt,
+		&i.LfgProposal.UpdatedAt,
+		&i.Dungeon.ID,
+		&i.Dungeon.Code,
+		&i.Dungeon.Name,
+		&i.Dungeon.Expansion,
+		&i.Dungeon.Season,
+		&i.Dungeon.ParSeconds,
+		&i.Dungeon.BossCount,
+		&i.Dungeon.Difficulties,
+		&i.Dungeon.Active,
+		&i.Dungeon.CreatedAt,
+		&i.Dungeon.UpdatedAt,
+	)
+	return i, err
+}
+
+const getLFGProposalMembers = `-- name: GetLFGProposalMembers :many
+SELECT lfg_proposal_members.proposal_id, lfg_proposal_members.user_id, lfg_proposal_members.entry_id, lfg_proposal_members.role, lfg_proposal_members.response, users.username, users.timezone, lfg_entries.character_id,
+    characters.name AS character_name
+FROM lfg_proposal_members
+JOIN users ON users.id = lfg_proposal_members.user_id
+JOIN lfg_entries ON lfg_entries.id = lfg_proposal_members.entry_id
+LEFT JOIN characters ON characters.id = lfg_entries.character_id
+WHERE lfg_proposal_members.proposal_id = $1
+ORDER BY lfg_proposal_members.user_id
+`
+
+type GetLFGProposalMembersRow struct {
+	ProposalID    int32
+	UserID        int32
+	EntryID       int32
+	Role          string
+	Response      string
+	Username      string
+	Timezone      string
+	CharacterID   pgtype.Int4
+	CharacterName pgtype.Text
+}
+
+func (q *Queries) GetLFGProposalMembers(ctx context.Context, proposalID int32) ([]GetLFGProposalMembersRow, error) {
+	rows, err := q.db.Query(ctx, getLFGProposalMembers, proposalID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetLFGProposalMembersRow
+	for rows.Next() {
+		var i GetLFGProposalMembersRow
+		if err := rows.Scan(
+			&i.ProposalID,
+			&i.UserID,
+			&i.EntryID,
+			&i.Role,
+			&i.Response,
+			&i.Username,
+			&i.Timezone,
+			&i.CharacterID,
+			&i.CharacterName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLatestReadyCheck = `-- name: GetLatestReadyCheck :one
+SELECT id, run_id, started_by, status, expires_at, finished_at, created_at, updated_at FROM ready_checks
+WHERE run_id = $1
+ORDER BY id DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLatestReadyCheck(ctx context.Context, runID int32) (ReadyCheck, error) {
+	row := q.db.QueryRow(ctx, getLatestReadyCheck, runID)
+	var i ReadyCheck
+	err := row.Scan(
+		&i.ID,
+		&i.RunID,
+		&i.StartedBy,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.FinishedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getLeaderboardSnapshot = `-- name: GetLeaderboardSnapshot :many
+SELECT season_id, guild_id, user_id, rank, username, rating, elapsed_seconds, best_runs, scoring, created_at FROM leaderboard_snapshots
+WHERE season_id = $1 AND guild_id = $2
+ORDER BY rank
+`
+
+type GetLeaderboardSnapshotParams struct {
+	SeasonID int32
+	GuildID  int32
+}
+
+func (q *Queries) GetLeaderboardSnapshot(ctx context.Context, arg GetLeaderboardSnapshotParams) ([]LeaderboardSnapshot, error) {
+	rows, err := q.db.Query(ctx, getLeaderboardSnapshot, arg.SeasonID, arg.GuildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LeaderboardSnapshot
+	for rows.Next() {
+		var i LeaderboardSnapshot
+		if err := rows.Scan(
+			&i.SeasonID,
+			&i.GuildID,
+			&i.UserID,
+			&i.Rank,
+			&i.Username,
+			&i.Rating,
+			&i.ElapsedSeconds,
+			&i.BestRuns,
+			&i.Scoring,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getNotificationPreference = `-- name: GetNotificationPreference :one
+SELECT channels FROM notification_preferences
+WHERE user_id = $1 AND type = $2 LIMIT 1
+`
+
+type GetNotificationPreferenceParams struct {
+	UserID int32
+	Type   string
+}
+
+func (q *Queries) GetNotificationPreference(ctx context.Context, arg GetNotificationPreferenceParams) ([]string, error) {
+	row := q.db.QueryRow(ctx, getNotificationPreference, arg.UserID, arg.Type)
+	var channels []string
+	err := row.Scan(&channels)
+	return channels, err
+}
+
+const getNotificationPreferences = `-- name: GetNotificationPreferences :many
+SELECT user_id, type, channels FROM notification_preferences
+WHERE user_id = $1
+ORDER BY type
+`
+
+func (q *Queries) GetNotificationPreferences(ctx context.Context, userID int32) ([]NotificationPreference, error) {
+	rows, err := q.db.Query(ctx, getNotificationPreferences, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NotificationPreference
+	for rows.Next() {
+		var i NotificationPreference
+		if err := rows.Scan(&i.UserID, &i.Type, &i.Channels); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getNotificationRecipient = `-- name: GetNotificationRecipient :one
+SELECT users.id, users.email, users.timezone, notification_settings.quiet_start_minute,
+    notification_settings.quiet_end_minute, COALESCE(notification_settings.webhook_url, '')::text AS webhook_url
+FROM users
+LEFT JOIN notification_settings ON notification_settings.user_id = users.id
+WHERE users.id = $1 LIMIT 1
+`
+
+type GetNotificationRecipientRow struct {
+	ID               int32
+	Email            string
+	Timezone         string
+	QuietStartMinute pgtype.Int4
+	QuietEndMinute   pgtype.Int4
+	WebhookUrl       string
+}
+
+func (q *Queries) GetNotificationRecipient(ctx context.Context, id int32) (GetNotificationRecipientRow, error) {
+	row := q.db.QueryRow(ctx, getNotificationRecipient, id)
+	var i GetNotificationRecipientRow
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Timezone,
+		&i.QuietStartMinute,
+		&i.QuietEndMinute,
+		&i.WebhookUrl,
+	)
+	return i, err
+}
+
+const getNotificationSettings = `-- name: GetNotificationSettings :one
+SELECT user_id, quiet_start_minute, quiet_end_minute, webhook_url, created_at, updated_at FROM notification_settings
+WHERE user_id = $1 LIMIT 1
+`
+
+func (q *Queries) GetNotificationSettings(ctx context.Context, userID int32) (NotificationSetting, error) {
+	row := q.db.QueryRow(ctx, getNotificationSettings, userID)
+	var i NotificationSetting
+	err := row.Scan(
+		&i.UserID,
+		&i.QuietStartMinute,
+		&i.QuietEndMinute,
+		&i.WebhookUrl,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getOpenSeason = `-- name: GetOpenSeason :one
+SELECT id, code, name, starts_at, ends_at, closed_at, created_at, updated_at FROM seasons
+WHERE closed_at IS NULL LIMIT 1
+`
+
+func (q *Queries) GetOpenSeason(ctx context.Context) (Season, error) {
+	row := q.db.QueryRow(ctx, getOpenSeason)
+	var i Season
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.Name,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.ClosedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getReadyCheck = `-- name: GetReadyCheck :one
+SELECT id, run_id, started_by, status, expires_at, finished_at, created_at, updated_at FROM ready_checks
+WHERE id = $1
+`
+
+func (q *Queries) GetReadyCheck(ctx context.Context, id int32) (ReadyCheck, error) {
+	row := q.db.QueryRow(ctx, getReadyCheck, id)
+	var i ReadyCheck
+	err := row.Scan(
+		&i.ID,
+		&i.RunID,
+		&i.StartedBy,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.FinishedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getReadyCheckResponses = `-- name: GetReadyCheckResponses :many
+SELECT ready_check_id, user_id, ready, responded_at FROM ready_check_responses
+WHERE ready_check_id = $1
+ORDER BY responded_at, user_id
+`
+
+func (q *Queries) GetReadyCheckResponses(ctx context.Context, readyCheckID int32) ([]ReadyCheckResponse, error) {
+	rows, err := q.db.Query(ctx, getReadyCheckResponses, readyCheckID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadyCheckResponse
+	for rows.Next() {
+		var i ReadyCheckResponse
+		if err := rows.Scan(
+			&i.ReadyCheckID,
+			&i.UserID,
+			&i.Ready,
+			&i.RespondedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRunAttendance = `-- name: GetRunAttendance :many
+SELECT
+    run_signups.user_id,
+    users.username,
+    run_signups.role,
+    run_signups.status,
+    run_signups.attendance,
+    run_signups.withdrawn_at,
+    runs.starts_at
+FROM run_signups
+JOIN users ON users.id = run_signups.user_id
+JOIN runs ON runs.id = run_signups.run_id
+WHERE run_signups.run_id = $1 AND (
+    run_signups.status = 'confirmed'
+    OR (run_signups.status = 'withdrawn' AND NOT EXISTS (
+        SELECT 1 FROM run_signups AS active
+        WHERE active.run_id = run_signups.run_id AND active.user_id = run_signups.user_id
+            AND active.status <> 'withdrawn'
+    ))
+)
+ORDER BY run_signups.status, users.username, run_signups.withdrawn_at DESC
+`
+
+type GetRunAttendanceRow struct {
+	UserID      int32
+	Username    string
+	Role        string
+	Status      string
+	Attendance  pgtype.Text
+	WithdrawnAt pgtype.Timestamptz
+	StartsAt    pgtype.Timestamptz
+}
+
+// The confirmed signups of a run and those withdrawn by users that did not sign
+// up again, with how long before the start they withdrew.
+func (q *Queries) GetRunAttendance(ctx context.Context, runID int32) ([]GetRunAttendanceRow, error) {
+	rows, err := q.db.Query(ctx, getRunAttendance, runID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRunAttendanceRow
+	for rows.Next() {
+		var i GetRunAttendanceRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Username,
+			&i.Role,
+			&i.Status,
+			&i.Attendance,
+			&i.WithdrawnAt,
+			&i.StartsAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRunByID = `-- name: GetRunByID :one
+SELECT runs.id, runs.dungeon_id, runs.difficulty, runs.key_level, runs.organizer_id, runs.starts_at, runs.timezone, runs.duration_minutes, runs.notes, runs.status, runs.created_at, runs.updated_at, runs.tank_slots, runs.healer_slots, runs.dps_slots, runs.series_id, runs.occurrence_at, runs.sequence, runs.guild_id, runs.started_at, runs.finished_at, runs.upgrade_level, runs.affixes, dungeons.id, dungeons.code, dungeons.name, dungeons.expansion, dungeons.season, dungeons.par_seconds, dungeons.boss_count, dungeons.difficulties, dungeons.active, dungeons.created_at, dungeons.updated_at FROM runs
+JOIN dungeons ON dungeons.id = runs.dungeon_id
+WHERE runs.id = $1 LIMIT 1
+`
+
+type GetRunByIDRow struct {
+	Run     Run
+	Dungeon Dungeon
+}
+
+func (q *Queries) GetRunByID(ctx context.Context, id int32) (GetRunByIDRow, error) {
+	row := q.db.QueryRow(ctx, getRunByID, id)
+	var i GetRunByIDRow
+	err := row.Scan(
+		&i.Run.ID,
+		&i.Run.DungeonID,
+		&i.Run.Difficulty,
+		&i.Run.KeyLevel,
+		&i.Run.OrganizerID,
+		&i.Run.StartsAt,
+		&i.Run.Timezone,
+		&i.Run.DurationMinutes,
+		&i.Run.Notes,
+		&i.Run.Status,
+		&i.Run.CreatedAt,
+		&i.Run.UpdatedAt,
+		&i.Run.TankSlots,
+		&i.Run.HealerSlots,
+		&i.Run.DpsSlots,
+		&i.Run.SeriesID,
+		&i.Run.OccurrenceAt,
+		&i.Run.Sequence,
+		&i.Run.GuildID,
+		&i.Run.StartedAt,
+		&i.Run.FinishedAt,
+		&i.Run.UpgradeLevel,
+		&i.Run.Affixes,
+		&i.Dungeon.ID,
+		&i.Dungeon.Code,
+		&i.Dungeon.Name,
+		&i.Dungeon.Expansion,
+		&i.Dungeon.Season,
+		&i.Dungeon.ParSeconds,
+		&i.Dungeon.BossCount,
+		&i.Dungeon.Difficulties,
+		&i.Dungeon.Active,
+		&i.Dungeon.CreatedAt,
+		&i.Dungeon.UpdatedAt,
+	)
+	return i, err
+}
+
+const getRunEvent = `-- name: GetRunEvent :one
+SELECT id, run_id, type, user_id, payload, created_at FROM run_events
+WHERE id = $1
+`
+
+func (q *Queries) GetRunEvent(ctx context.Context, id int64) (RunEvent, error) {
+	row := q.db.QueryRow(ctx, getRunEvent, id)
+	var i RunEvent
+	err := row.Scan(
+		&i.ID,
+		&i.RunID,
+		&i.Type,
+		&i.UserID,
+		&i.Payload,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getRunEventsAfter = `-- name: GetRunEventsAfter :many
+SELECT id, run_id, type, user_id, payload, created_at FROM run_events
+WHERE run_id = $1 AND id > $2
+ORDER BY id
+LIMIT $3
+`
+
+type GetRunEventsAfterParams struct {
+	RunID     int32
+	AfterID   int64
+	MaxEvents int32
+}
+
+func (q *Queries) GetRunEventsAfter(ctx context.Context, arg GetRunEventsAfterParams) ([]RunEvent, error) {
+	rows, err := q.db.Query(ctx, getRunEventsAfter, arg.RunID, arg.AfterID, arg.MaxEvents)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RunEvent
+	for rows.Next() {
+		var i RunEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.RunID,
+			&i.Type,
+			&i.UserID,
+			&i.Payload,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRunReminderOffsets = `-- name: GetRunReminderOffsets :many
+SELECT offset_minutes FROM run_reminders
+WHERE run_id = $1
+ORDER BY offset_minutes
+`
+
+func (q *Queries) GetRunReminderOffsets(ctx context.Context, runID int32) ([]int32, error) {
+	rows, err := q.db.Query(ctx, getRunReminderOffsets, runID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var offset_minutes int32
+		if err := rows.Scan(&offset_minutes); err != nil {
+			return nil, err
+		}
+		items = append(items, offset_minutes)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRunSignups = `-- name: GetRunSignups :many
+SELECT run_signups.id, run_signups.run_id, run_signups.user_id, run_signups.role, run_signups.status, run_signups.withdrawn_at, run_signups.created_at, run_signups.updated_at, run_signups.waitlist_position, run_signups.attendance, run_signups.character_id, users.username, characters.name AS character_name FROM run_signups
+JOIN users ON users.id = run_signups.user_id
+LEFT JOIN characters ON characters.id = run_signups.character_id
+WHERE run_signups.run_id = $1 AND run_signups.status <> 'withdrawn'
+ORDER BY run_signups.waitlist_position NULLS FIRST, run_signups.created_at, run_signups.id
+`
+
+type GetRunSignupsRow struct {
+	ID               int32
+	RunID            int32
+	UserID           int32
+	Role             string
+	Status           string
+	WithdrawnAt      pgtype.Timestamptz
+	CreatedAt        pgtype.Timestamptz
+	UpdatedAt        pgtype.Timestamptz
+	WaitlistPosition pgtype.Int4
+	Attendance       pgtype.Text
+	CharacterID      pgtype.Int4
+	Username         string
+	CharacterName    pgtype.Text
+}
+
+func (q *Queries) GetRunSignups(ctx context.Context, runID int32) ([]GetRunSignupsRow, error) {
+	rows, err := q.db.Query(ctx, getRunSignups, runID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRunSignupsRow
+	for rows.Next() {
+		var i GetRunSignupsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.RunID,
+			&i.UserID,
+			&i.Role,
+			&i.Status,
+			&i.WithdrawnAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.WaitlistPosition,
+			&i.Attendance,
+			&i.CharacterID,
+			&i.Username,
+			&i.CharacterName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRuns = `-- name: GetRuns :many
+SELECT runs.id, runs.dungeon_id, runs.difficulty, runs.key_level, runs.organizer_id, runs.starts_at, runs.timezone, runs.duration_minutes, runs.notes, runs.status, runs.created_at, runs.updated_at, runs.tank_slots, runs.healer_slots, runs.dps_slots, runs.series_id, runs.occurrence_at, runs.sequence, runs.guild_id, runs.started_at, runs.finished_at, runs.upgrade_level, runs.affixes, dungeons.id, dungeons.code, dungeons.name, dungeons.expansion, dungeons.season, dungeons.par_seconds, dungeons.boss_count, dungeons.difficulties, dungeons.active, dungeons.created_at, dungeons.updated_at FROM runs
+JOIN dungeons ON dungeons.id = runs.dungeon_id
+WHERE runs.starts_at >= $1 AND runs.starts_at < $2
+    AND runs.status <> 'cancelled'
+ORDER BY runs.starts_at, runs.id
+`
+
+type GetRunsParams struct {
+	StartsAfter  pgtype.Timestamptz
+	StartsBefore pgtype.Timestamptz
+}
+
+type GetRunsRow struct {
+	Run     Run
+	Dungeon Dungeon
+}
+
+func (q *Queries) GetRuns(ctx context.Context, arg GetRunsParams) ([]GetRunsRow, error) {
+	rows, err := q.db.Query(ctx, getRuns, arg.StartsAfter, arg.StartsBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRunsRow
+	for rows.Next() {
+		var i GetRunsRow
+		if err := rows.Scan(
+			&i.Run.ID,
+			&i.Run.DungeonID,
+			&i.Run.Difficulty,
+			&i.Run.KeyLevel,
+			&i.Run.OrganizerID,
+			&i.Run.StartsAt,
+			&i.Run.Timezone,
+			&i.Run.DurationMinutes,
+			&i.Run.Notes,
+			&i.Run.Status,
+			&i.Run.CreatedAt,
+			&i.Run.UpdatedAt,
+			&i.Run.TankSlots,
+			&i.Run.HealerSlots,
+			&i.Run.DpsSlots,
+			&i.Run.SeriesID,
+			&i.Run.OccurrenceAt,
+			&i.Run.Sequence,
+			&i.Run.GuildID,
+			&i.Run.StartedAt,
+			&i.Run.FinishedAt,
+			&i.Run.UpgradeLevel,
+			&i.Run.Affixes,
+			&i.Dungeon.ID,
+			&i.Dungeon.Code,
+			&i.Dungeon.Name,
+			&i.Dungeon.Expansion,
+			&i.Dungeon.Season,
+			&i.Dungeon.ParSeconds,
+			&i.Dungeon.BossCount,
+			&i.Dungeon.Difficulties,
+			&i.Dungeon.Active,
+			&i.Dungeon.CreatedAt,
+			&i.Dungeon.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSeasonAffixes = `-- name: GetSeasonAffixes :many
+SELECT season_id, week, affixes FROM season_affixes
+WHERE season_id = $1
+ORDER BY week
+`
+
+func (q *Queries) GetSeasonAffixes(ctx context.Context, seasonID int32) ([]SeasonAffix, error) {
+	rows, err := q.db.Query(ctx, getSeasonAffixes, seasonID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SeasonAffix
+	for rows.Next() {
+		var i SeasonAffix
+		if err := rows.Scan(&i.SeasonID, &i.Week, &i.Affixes); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSeasonByCode = `-- name: GetSeasonByCode :one
+SELECT id, code, name, starts_at, ends_at, closed_at, created_at, updated_at FROM seasons
+WHERE code = $1 LIMIT 1
+`
+
+func (q *Queries) GetSeasonByCode(ctx context.Context, code string) (Season, error) {
+	row := q.db.QueryRow(ctx, getSeasonByCode, code)
+	var i Season
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.Name,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.ClosedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getSeasonTimedRuns = `-- name: GetSeasonTimedRuns :many
+SELECT
+    run_participants.user_id,
+    users.username,
+    runs.id AS run_id,
+    dungeons.code AS dungeon_code,
+    COALESCE(runs.key_level, 0)::int AS key_level,
+    COALESCE(runs.upgrade_level, 0)::int AS upgrade_level,
+    EXTRACT(EPOCH FROM runs.finished_at - runs.started_at)::int AS elapsed_seconds,
+    dungeons.par_seconds
+FROM guild_members
+JOIN run_participants ON run_participants.user_id = guild_members.user_id
+JOIN runs ON runs.id = run_participants.run_id
+JOIN dungeons ON dungeons.id = runs.dungeon_id
+JOIN users ON users.id = guild_members.user_id
+WHERE guild_members.guild_id = $1
+    AND runs.status = 'completed' AND runs.upgrade_level > 0
+    AND runs.finished_at >= $2
+    AND ($3::timestamptz IS NULL OR runs.finished_at < $3)
+    AND ($4::text = '' OR $4 = ANY(runs.affixes))
+ORDER BY run_participants.user_id, runs.id
+`
+
+type GetSeasonTimedRunsParams struct {
+	GuildID  int32
+	StartsAt pgtype.Timestamptz
+	EndsAt   pgtype.Timestamptz
+	Affix    string
+}
+
+type GetSeasonTimedRunsRow struct {
+	UserID         int32
+	Username       string
+	RunID          int32
+	DungeonCode    string
+	KeyLevel       int32
+	UpgradeLevel   int32
+	ElapsedSeconds int32
+	ParSeconds     int32
+}
+
+func (q *Queries) GetSeasonTimedRuns(ctx context.Context, arg GetSeasonTimedRunsParams) ([]GetSeasonTimedRunsRow, error) {
+	rows, err := q.db.Query(ctx, getSeasonTimedRuns,
+		arg.GuildID,
+		arg.StartsAt,
+		arg.EndsAt,
+		arg.Affix,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSeasonTimedRunsRow
+	for rows.Next() {
+		var i GetSeasonTimedRunsRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Username,
+			&i.RunID,
+			&i.DungeonCode,
+			&i.KeyLevel,
+			&i.UpgradeLevel,
+			&i.ElapsedSeconds,
+			&i.ParSeconds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSeasons = `-- name: GetSeasons :many
+SELECT id, code, name, starts_at, ends_at, closed_at, created_at, updated_at FROM seasons
+ORDER BY starts_at DESC
+`
+
+func (q *Queries) GetSeasons(ctx context.Context) ([]Season, error) {
+	rows, err := q.db.Query(ctx, getSeasons)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Season
+	for rows.Next() {
+		var i Season
+		if err := rows.Scan(
+			&i.ID,
+			&i.Code,
+			&i.Name,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.ClosedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSeriesByID = `-- name: GetSeriesByID :one
+SELECT run_series.id, run_series.organizer_id, run_series.dungeon_id, run_series.difficulty, run_series.key_level, run_series.rrule, run_series.timezone, run_series.starts_at, run_series.until_at, run_series.duration_minutes, run_series.notes, run_series.tank_slots, run_series.healer_slots, run_series.dps_slots, run_series.created_at, run_series.updated_at, run_series.guild_id, dungeons.id, dungeons.code, dungeons.name, dungeons.expansion, dungeons.season, dungeons.par_seconds, dungeons.boss_count, dungeons.difficulties, dungeons.active, dungeons.created_at, dungeons.updated_at FROM run_series
+JOIN dungeons ON dungeons.id = run_series.dungeon_id
+WHERE run_series.id = $1 LIMIT 1
+`
+
+type GetSeriesByIDRow struct {
+	RunSeries RunSeries
+	Dungeon   Dungeon
+}
+
+func (q *Queries) GetSeriesByID(ctx context.Context, id int32) (GetSeriesByIDRow, error) {
+	row := q.db.QueryRow(ctx, getSeriesByID, id)
+	var i GetSeriesByIDRow
+	err := row.Scan(
+		&i.RunSeries.ID,
+		&i.RunSeries.OrganizerID,
+		&i.RunSeries.DungeonID,
+		&i.RunSeries.Difficulty,
+		&i.RunSeries.KeyLevel,
+		&i.RunSeries.Rrule,
+		&i.RunSeries.Timezone,
+		&i.RunSeries.StartsAt,
+		&i.RunSeries.UntilAt,
+		&i.RunSeries.DurationMinutes,
+		&i.RunSeries.Notes,
+		&i.RunSeries.TankSlots,
+		&i.RunSeries.HealerSlots,
+		&i.RunSeries.DpsSlots,
+		&i.RunSeries.CreatedAt,
+		&i.RunSeries.UpdatedAt,
+		&i.RunSeries.GuildID,
+		&i.Dungeon.ID,
+		&i.Dungeon.Code,
+		&i.Dungeon.Name,
+		&i.Dungeon.Expansion,
+		&i.Dungeon.Season,
+		&i.Dungeon.ParSeconds,
+		&i.Dungeon.BossCount,
+		&i.Dungeon.Difficulties,
+		&i.Dungeon.Active,
+		&i.Dungeon.CreatedAt,
+		&i.Dungeon.UpdatedAt,
+	)
+	return i, err
+}
+
+const getSeriesRun = `-- name: GetSeriesRun :one
+SELECT id, dungeon_id, difficulty, key_level, organizer_id, starts_at, timezone, duration_minutes, notes, status, created_at, updated_at, tank_slots, healer_slots, dps_slots, series_id, occurrence_at, sequence, guild_id, started_at, finished_at, upgrade_level, affixes FROM runs
+WHERE series_id = $1 AND occurrence_at = $2
+LIMIT 1
+`
+
+type GetSeriesRunParams struct {
+	SeriesID     pgtype.Int4
+	OccurrenceAt pgtype.Timestamptz
+}
+
+func (q *Queries) GetSeriesRun(ctx context.Context, arg GetSeriesRunParams) (Run, error) {
+	row := q.db.QueryRow(ctx, getSeriesRun, arg.SeriesID, arg.OccurrenceAt)
+	var i Run
+	err := row.Scan(
+		&i.ID,
+		&i.DungeonID,
+		&i.Difficulty,
+		&i.KeyLevel,
+		&i.OrganizerID,
+		&i.StartsAt,
+		&i.Timezone,
+		&i.DurationMinutes,
+		&i.Notes,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TankSlots,
+		&i.HealerSlots,
+		&i.DpsSlots,
+		&i.SeriesID,
+		&i.OccurrenceAt,
+		&i.Sequence,
+		&i.GuildID,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.UpgradeLevel,
+		&i.Affixes,
+	)
+	return i, err
+}
+
+const getSeriesRuns = `-- name: GetSeriesRuns :many
+SELECT runs.id, runs.dungeon_id, runs.difficulty, runs.key_level, runs.organizer_id, runs.starts_at, runs.timezone, runs.duration_minutes, runs.notes, runs.status, runs.created_at, runs.updated_at, runs.tank_slots, runs.healer_slots, runs.dps_slots, runs.series_id, runs.occurrence_at, runs.sequence, runs.guild_id, runs.started_at, runs.finished_at, runs.upgrade_level, runs.affixes, dungeons.id, dungeons.code, dungeons.name, dungeons.expansion, dungeons.season, dungeons.par_seconds, dungeons.boss_count, dungeons.difficulties, dungeons.active, dungeons.created_at, dungeons.updated_at FROM runs
+JOIN dungeons ON dungeons.id = runs.dungeon_id
+WHERE runs.series_id = $1 AND runs.occurrence_at >= $2 AND runs.occurrence_at < $3
+    AND runs.status <> 'cancelled'
+ORDER BY runs.starts_at, runs.id
+`
+
+type GetSeriesRunsParams struct {
+	SeriesID     pgtype.Int4
+	StartsAfter  pgtype.Timestamptz
+	StartsBefore pgtype.Timestamptz
+}
+
+type GetSeriesRunsRow struct {
+	Run     Run
+	Dungeon Dungeon
+}
+
+func (q *Queries) GetSeriesRuns(ctx context.Context, arg GetSeriesRunsParams) ([]GetSeriesRunsRow, error) {
+	rows, err := q.db.Query(ctx, getSeriesRuns, arg.SeriesID, arg.StartsAfter, arg.StartsBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSeriesRunsRow
+	for rows.Next() {
+		var i GetSeriesRunsRow
+		if err := rows.Scan(
+			&i.Run.ID,
+			&i.Run.DungeonID,
+			&i.Run.Difficulty,
+			&i.Run.KeyLevel,
+			&i.Run.OrganizerID,
+			&i.Run.StartsAt,
+			&i.Run.Timezone,
+			&i.Run.DurationMinutes,
+			&i.Run.Notes,
+			&i.Run.Status,
+			&i.Run.CreatedAt,
+			&i.Run.UpdatedAt,
+			&i.Run.TankSlots,
+			&i.Run.HealerSlots,
+			&i.Run.DpsSlots,
+			&i.Run.SeriesID,
+			&i.Run.OccurrenceAt,
+			&i.Run.Sequence,
+			&i.Run.GuildID,
+			&i.Run.StartedAt,
+			&i.Run.FinishedAt,
+			&i.Run.UpgradeLevel,
+			&i.Run.Affixes,
+			&i.Dungeon.ID,
+			&i.Dungeon.Code,
+			&i.Dungeon.Name,
+			&i.Dungeon.Expansion,
+			&i.Dungeon.Season,
+			&i.Dungeon.ParSeconds,
+			&i.Dungeon.BossCount,
+			&i.Dungeon.Difficulties,
+			&i.Dungeon.Active,
+			&i.Dungeon.CreatedAt,
+			&i.Dungeon.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSeriesRunsFrom = `-- name: GetSeriesRunsFrom :many
+SELECT runs.id, runs.dungeon_id, runs.difficulty, runs.key_level, runs.organizer_id, runs.starts_at, runs.timezone, runs.duration_minutes, runs.notes, runs.status, runs.created_at, runs.updated_at, runs.tank_slots, runs.healer_slots, runs.dps_slots, runs.series_id, runs.occurrence_at, runs.sequence, runs.guild_id, runs.started_at, runs.finished_at, runs.upgrade_level, runs.affixes, dungeons.id, dungeons.code, dungeons.name, dungeons.expansion, dungeons.season, dungeons.par_seconds, dungeons.boss_count, dungeons.difficulties, dungeons.active, dungeons.created_at, dungeons.updated_at, EXISTS (
+        SELECT 1 FROM run_series_exceptions
+        WHERE run_series_exceptions.series_id = runs.series_id
+            AND run_series_exceptions.occurrence_at = runs.occurrence_at
+            AND run_series_exceptions.kind = 'override'
+    ) AS overridden
+FROM runs
+JOIN dungeons ON dungeons.id = runs.dungeon_id
+WHERE runs.series_id = $1 AND runs.occurrence_at >= $2
+ORDER BY runs.occurrence_at
+FOR UPDATE OF runs
+`
+
+type GetSeriesRunsFromParams struct {
+	SeriesID     pgtype.Int4
+	OccurrenceAt pgtype.Timestamptz
+}
+
+type GetSeriesRunsFromRow struct {
+	Run        Run
+	Dungeon    Dungeon
+	Overridden bool
+}
+
+func (q *Queries) GetSeriesRunsFrom(ctx context.Context, arg GetSeriesRunsFromParams) ([]GetSeriesRunsFromRow, error) {
+	rows, err := q.db.Query(ctx, getSeriesRunsFrom, arg.SeriesID, arg.OccurrenceAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSeriesRunsFromRow
+	for rows.Next() {
+		var i GetSeriesRunsFromRow
+		if err := rows.Scan(
+			&i.Run.ID,
+			&i.Run.DungeonID,
+			&i.Run.Difficulty,
+			&i.Run.KeyLevel,
+			&i.Run.OrganizerID,
+			&i.Run.StartsAt,
+			&i.Run.Timezone,
+			&i.Run.DurationMinutes,
+			&i.Run.Notes,
+			&i.Run.Status,
+			&i.Run.CreatedAt,
+			&i.Run.UpdatedAt,
+			&i.Run.TankSlots,
+			&i.Run.HealerSlots,
+			&i.Run.DpsSlots,
+			&i.Run.SeriesID,
+			&i.Run.OccurrenceAt,
+			&i.Run.Sequence,
+			&i.Run.GuildID,
+			&i.Run.StartedAt,
+			&i.Run.FinishedAt,
+			&i.Run.UpgradeLevel,
+			&i.Run.Affixes,
+			&i.Dungeon.ID,
+			&i.Dungeon.Code,
+			&i.Dungeon.Name,
+			&i.Dungeon.Expansion,
+			&i.Dungeon.Season,
+			&i.Dungeon.ParSeconds,
+			&i.Dungeon.BossCount,
+			&i.Dungeon.Difficulties,
+			&i.Dungeon.Active,
+			&i.Dungeon.CreatedAt,
+			&i.Dungeon.UpdatedAt,
+			&i.Overridden,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSeriesSkipsFrom = `-- name: GetSeriesSkipsFrom :many
+SELECT occurrence_at FROM run_series_exceptions
+WHERE series_id = $1 AND occurrence_at >= $2 AND kind = 'skip'
+ORDER BY occurrence_at
+`
+
+type GetSeriesSkipsFromParams struct {
+	SeriesID     int32
+	OccurrenceAt pgtype.Timestamptz
+}
+
+func (q *Queries) GetSeriesSkipsFrom(ctx context.Context, arg GetSeriesSkipsFromParams) ([]pgtype.Timestamptz, error) {
+	rows, err := q.db.Query(ctx, getSeriesSkipsFrom, arg.SeriesID, arg.OccurrenceAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.Timestamptz
+	for rows.Next() {
+		var occurrence_at pgtype.Timestamptz
+		if err := rows.Scan(&occurrence_at); err != nil {
+			return nil, err
+		}
+		items = append(items, occurrence_at)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSubscribedWebhookEndpoints = `-- name: GetSubscribedWebhookEndpoints :many
+SELECT id, guild_id, url, secret, events, active, consecutive_failures, disabled_at, created_at, updated_at FROM webhook_endpoints
+WHERE active AND $1::text = ANY(events)
+    AND (guild_id IS NULL OR guild_id = $2)
+ORDER BY id
+`
+
+type GetSubscribedWebhookEndpointsParams struct {
+	Event   string
+	GuildID pgtype.Int4
+}
+
+func (q *Queries) GetSubscribedWebhookEndpoints(ctx context.Context, arg GetSubscribedWebhookEndpointsParams) ([]WebhookEndpoint, error) {
+	rows, err := q.db.Query(ctx, getSubscribedWebhookEndpoints, arg.Event, arg.GuildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookEndpoint
+	for rows.Next() {
+		var i WebhookEndpoint
+		if err := rows.Scan(
+			&i.ID,
+			&i.GuildID,
+			&i.Url,
+			&i.Secret,
+			&i.Events,
+			&i.Active,
+			&i.ConsecutiveFailures,
+			&i.DisabledAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserAffixRunStats = `-- name: GetUserAffixRunStats :many
+SELECT
+    run_participants.user_id,
+    runs.dungeon_id,
+    run_participants.role,
+    count(*)::int AS completed,
+    count(*) FILTER (WHERE runs.status = 'completed' AND runs.upgrade_level > 0)::int AS timed,
+    COALESCE(max(runs.key_level) FILTER (WHERE runs.status = 'completed' AND runs.upgrade_level > 0), 0)::int
+        AS best_timed_level,
+    COALESCE(sum(EXTRACT(EPOCH FROM runs.finished_at - runs.started_at)), 0)::bigint AS elapsed_seconds,
+    sum(dungeons.par_seconds)::bigint AS par_seconds,
+    dungeons.id, dungeons.code, dungeons.name, dungeons.expansion, dungeons.season, dungeons.par_seconds, dungeons.boss_count, dungeons.difficulties, dungeons.active, dungeons.created_at, dungeons.updated_at
+FROM run_participants
+JOIN runs ON runs.id = run_participants.run_id
+JOIN dungeons ON dungeons.id = runs.dungeon_id
+WHERE run_participants.user_id = $1 AND runs.status IN ('completed', 'depleted')
+    AND $2::text = ANY(runs.affixes)
+GROUP BY run_participants.user_id, runs.dungeon_id, run_participants.role, dungeons.id
+ORDER BY dungeons.name, run_participants.role
+`
+
+type GetUserAffixRunStatsParams struct {
+	UserID int32
+	Affix  string
+}
+
+type GetUserAffixRunStatsRow struct {
+	UserID         int32
+	DungeonID      int32
+	Role           string
+	Completed      int32
+	Timed          int32
+	BestTimedLevel int32
+	ElapsedSeconds int64
+	ParSeconds     int64
+	Dungeon        Dungeon
+}
+
+// user_run_stats of a user, counting only the runs started while the affix was active.
+func (q *Queries) GetUserAffixRunStats(ctx context.Context, arg GetUserAffixRunStatsParams) ([]GetUserAffixRunStatsRow, error) {
+	rows, err := q.db.Query(ctx, getUserAffixRunStats, arg.UserID, arg.Affix)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserAffixRunStatsRow
+	for rows.Next() {
+		var i GetUserAffixRunStatsRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.DungeonID,
+			&i.Role,
+			&i.Completed,
+			&i.Timed,
+			&i.BestTimedLevel,
+			&i.ElapsedSeconds,
+			&i.ParSeconds,
+			&i.Dungeon.ID,
+			&i.Dungeon.Code,
+			&i.Dungeon.Name,
+			&i.Dungeon.Expansion,
+			&i.Dungeon.Season,
+			&i.Dungeon.ParSeconds,
+			&i.Dungeon.BossCount,
+			&i.Dungeon.Difficulties,
+			&i.Dungeon.Active,
+			&i.Dungeon.CreatedAt,
+			&i.Dungeon.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserAttendance = `-- name: GetUserAttendance :many
+SELECT run_signups.status, run_signups.attendance, run_signups.withdrawn_at, runs.starts_at
+FROM run_signups
+JOIN runs ON runs.id = run_signups.run_id
+WHERE run_signups.user_id = $1 AND runs.status <> 'cancelled' AND runs.starts_at > $2
+    AND (run_signups.attendance IS NOT NULL OR run_signups.status = 'withdrawn')
+ORDER BY runs.starts_at
+`
+
+type GetUserAttendanceParams struct {
+	UserID int32
+	Since  pgtype.Timestamptz
+}
+
+type GetUserAttendanceRow struct {
+	Status      string
+	Attendance  pgtype.Text
+	WithdrawnAt pgtype.Timestamptz
+	StartsAt    pgtype.Timestamptz
+}
+
+// The marked signups and withdrawals of a user in runs that were not cancelled,
+// starting after since.
+func (q *Queries) GetUserAttendance(ctx context.Context, arg GetUserAttendanceParams) ([]GetUserAttendanceRow, error) {
+	rows, err := q.db.Query(ctx, getUserAttendance, arg.UserID, arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserAttendanceRow
+	for rows.Next() {
+		var i GetUserAttendanceRow
+		if err := rows.Scan(
+			&i.Status,
+			&i.Attendance,
+			&i.WithdrawnAt,
+			&i.StartsAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserByEmail = `-- name: GetUserByEmail :one
+SELECT id, username, email, roles, timezone FROM users
+WHERE email = $1 LIMIT 1
+`
+
+type GetUserByEmailRow struct {
+	ID       int32
+	Username string
+	Email    string
+	Roles    []string
+	Timezone string
+}
+
+func (q *Queries) GetUserByEmail(ctx context.Context, email string) (GetUserByEmailRow, error) {
+	row := q.db.QueryRow(ctx, getUserByEmail, email)
+	var i GetUserByEmailRow
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Email,
+		&i.Roles,
+		&i.Timezone,
+	)
+	return i, err
+}
+
+const getUserByID = `-- name: GetUserByID :one
+SELECT id, username, email, roles, timezone FROM users
+WHERE id = $1 LIMIT 1
+`
+
+type GetUserByIDRow struct {
+	ID       int32
+	Username string
+	Email    string
+	Roles    []string
+	Timezone string
+}
+
+func (q *Queries) GetUserByID(ctx context.Context, id int32) (GetUserByIDRow, error) {
+	row := q.db.QueryRow(ctx, getUserByID, id)
+	var i GetUserByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Email,
+		&i.Roles,
+		&i.Timezone,
+	)
+	return i, err
+}
+
+const getUserByUsername = `-- name: GetUserByUsername :one
+SELECT id, username, email, roles, timezone FROM users
+WHERE username = $1 LIMIT 1
+`
+
+type GetUserByUsernameRow struct {
+	ID       int32
+	Username string
+	Email    string
+	Roles    []string
+	Timezone string
+}
+
+func (q *Queries) GetUserByUsername(ctx context.Context, username string) (GetUserByUsernameRow, error) {
+	row := q.db.QueryRow(ctx, getUserByUsername, username)
+	var i GetUserByUsernameRow
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Email,
+		&i.Roles,
+		&i.Timezone,
+	)
+	return i, err
+}
+
+const getUserCalendarRuns = `-- name: GetUserCalendarRuns :many
+SELECT runs.id, runs.dungeon_id, runs.difficulty, runs.key_level, runs.organizer_id, runs.starts_at, runs.timezone, runs.duration_minutes, runs.notes, runs.status, runs.created_at, runs.updated_at, runs.tank_slots, runs.healer_slots, runs.dps_slots, runs.series_id, runs.occurrence_at, runs.sequence, runs.guild_id, runs.started_at, runs.finished_at, runs.upgrade_level, runs.affixes, dungeons.id, dungeons.code, dungeons.name, dungeons.expansion, dungeons.season, dungeons.par_seconds, dungeons.boss_count, dungeons.difficulties, dungeons.active, dungeons.created_at, dungeons.updated_at FROM runs
+JOIN dungeons ON dungeons.id = runs.dungeon_id
+WHERE runs.starts_at >= $1
+    AND (runs.organizer_id = $2 OR EXISTS (
+        SELECT 1 FROM run_signups
+        WHERE run_signups.run_id = runs.id AND run_signups.user_id = $2
+            AND run_signups.status <> 'withdrawn'
+    ))
+ORDER BY runs.starts_at, runs.id
+`
+
+type GetUserCalendarRunsParams struct {
+	StartsAfter pgtype.Timestamptz
+	UserID      int32
+}
+
+type GetUserCalendarRunsRow struct {
+	Run     Run
+	Dungeon Dungeon
+}
+
+func (q *Queries) GetUserCalendarRuns(ctx context.Context, arg GetUserCalendarRunsParams) ([]GetUserCalendarRunsRow, error) {
+	rows, err := q.db.Query(ctx, getUserCalendarRuns, arg.StartsAfter, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserCalendarRunsRow
+	for rows.Next() {
+		var i GetUserCalendarRunsRow
+		if err := rows.Scan(
+			&i.Run.ID,
+			&i.Run.DungeonID,
+			&i.Run.Difficulty,
+			&i.Run.KeyLevel,
+			&i.Run.OrganizerID,
+			&i.Run.StartsAt,
+			&i.Run.Timezone,
+			&i.Run.DurationMinutes,
+			&i.Run.Notes,
+			&i.Run.Status,
+			&i.Run.CreatedAt,
+			&i.Run.UpdatedAt,
+			&i.Run.TankSlots,
+			&i.Run.HealerSlots,
+			&i.Run.DpsSlots,
+			&i.Run.SeriesID,
+			&i.Run.OccurrenceAt,
+			&i.Run.Sequence,
+			&i.Run.GuildID,
+			&i.Run.StartedAt,
+			&i.Run.FinishedAt,
+			&i.Run.UpgradeLevel,
+			&i.Run.Affixes,
+			&i.Dungeon.ID,
+			&i.Dungeon.Code,
+			&i.Dungeon.Name,
+			&i.Dungeon.Expansion,
+			&i.Dungeon.Season,
+			&i.Dungeon.ParSeconds,
+			&i.Dungeon.BossCount,
+			&i.Dungeon.Difficulties,
+			&i.Dungeon.Active,
+			&i.Dungeon.CreatedAt,
+			&i.Dungeon.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserCalendarToken = `-- name: GetUserCalendarToken :one
+SELECT calendar_token FROM users
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetUserCalendarToken(ctx context.Context, id int32) (pgtype.Text, error) {
+	row := q.db.QueryRow(ctx, getUserCalendarToken, id)
+	var calendar_token pgtype.Text
+	err := row.Scan(&calendar_token)
+	return calendar_token, err
+}
+
+const getUserCharacters = `-- name: GetUserCharacters :many
+SELECT characters.id, characters.user_id, characters.name, characters.realm, characters.class, characters.spec, characters.item_level, characters.roles, characters.created_at, characters.updated_at, characters.imported_at, dungeons.code AS key_dungeon, keystones.level AS key_level,
+    keystones.recorded_at AS key_recorded_at
+FROM characters
+LEFT JOIN keystones ON keystones.character_id = characters.id
+LEFT JOIN dungeons ON dungeons.id = keystones.dungeon_id
+WHERE characters.user_id = $1
+ORDER BY lower(characters.realm), lower(characters.name)
+`
+
+type GetUserCharactersRow struct {
+	Character     Character
+	KeyDungeon    pgtype.Text
+	KeyLevel      pgtype.Int4
+	KeyRecordedAt pgtype.Timestamptz
+}
+
+func (q *Queries) GetUserCharacters(ctx context.Context, userID int32) ([]GetUserCharactersRow, error) {
+	rows, err := q.db.Query(ctx, getUserCharacters, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserCharactersRow
+	for rows.Next() {
+		var i GetUserCharactersRow
+		if err := rows.Scan(
+			&i.Character.ID,
+			&i.Character.UserID,
+			&i.Character.Name,
+			&i.Character.Realm,
+			&i.Character.Class,
+			&i.Character.Spec,
+			&i.Character.ItemLevel,
+			&i.Character.Roles,
+			&i.Character.CreatedAt,
+			&i.Character.UpdatedAt,
+			&i.Character.ImportedAt,
+			&i.KeyDungeon,
+			&i.KeyLevel,
+			&i.KeyRecordedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserFullByEmail = `-- name: GetUserFullByEmail :one
+SELECT id, username, email, password_hash, timezone, created_at, updated_at, roles, calendar_token FROM users
+WHERE email = $1 LIMIT 1
+`
+
+func (q *Queries) GetUserFullByEmail(ctx context.Context, email string) (User, error) {
+	row := q.db.QueryRow(ctx, getUserFullByEmail, email)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Email,
+		&i.PasswordHash,
+		&i.Timezone,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Roles,
+		&i.CalendarToken,
+	)
+	return i, err
+}
+
+const getUserKeystones = `-- name: GetUserKeystones :many
+SELECT keystones.id, keystones.user_id, keystones.character_id, keystones.dungeon_id, keystones.level, keystones.recorded_at, keystones.created_at, keystones.updated_at, dungeons.id, dungeons.code, dungeons.name, dungeons.expansion, dungeons.season, dungeons.par_seconds, dungeons.boss_count, dungeons.difficulties, dungeons.active, dungeons.created_at, dungeons.updated_at, users.username, characters.name AS character_name,
+    COALESCE(characters.roles, users.roles)::text[] AS holder_roles
+FROM keystones
+JOIN dungeons ON dungeons.id = keystones.dungeon_id
+JOIN users ON users.id = keystones.user_id
+LEFT JOIN characters ON characters.id = keystones.character_id
+WHERE keystones.user_id = $1 AND keystones.recorded_at >= $2
+ORDER BY keystones.level DESC, keystones.id
+`
+
+type GetUserKeystonesParams struct {
+	UserID        int32
+	RecordedAfter pgtype.Timestamptz
+}
+
+type GetUserKeystonesRow struct {
+	Keystone      Keystone
+	Dungeon       Dungeon
+	Username      string
+	CharacterName pgtype.Text
+	HolderRoles   []string
+}
+
+func (q *Queries) GetUserKeystones(ctx context.Context, arg GetUserKeystonesParams) ([]GetUserKeystonesRow, error) {
+	rows, err := q.db.Query(ctx, getUserKeystones, arg.UserID, arg.RecordedAfter)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserKeystonesRow
+	for rows.Next() {
+		var i GetUserKeystonesRow
+		if err := rows.Scan(
+			&i.Keystone.ID,
+			&i.Keystone.UserID,
+			&i.Keystone.CharacterID,
+			&i.Keystone.DungeonID,
+			&i.Keystone.Level,
+			&i.Keystone.RecordedAt,
+			&i.Keystone.CreatedAt,
+			&i.Keystone.UpdatedAt,
+			&i.Dungeon.ID,
+			&i.Dungeon.Code,
+			&i.Dungeon.Name,
+			&i.Dungeon.Expansion,
+			&i.Dungeon.Season,
+			&i.Dungeon.ParSeconds,
+			&i.Dungeon.BossCount,
+			&i.Dungeon.Difficulties,
+			&i.Dungeon.Active,
+			&i.Dungeon.CreatedAt,
+			&i.Dungeon.UpdatedAt,
+			&i.Username,
+			&i.CharacterName,
+			&i.HolderRoles,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserRunStats = `-- name: GetUserRunStats :many
+SELECT user_run_stats.user_id, user_run_stats.dungeon_id, user_run_stats.role, user_run_stats.completed, user_run_stats.timed, user_run_stats.best_timed_level, user_run_stats.elapsed_seconds, user_run_stats.par_seconds, dungeons.id, dungeons.code, dungeons.name, dungeons.expansion, dungeons.season, dungeons.par_seconds, dungeons.boss_count, dungeons.difficulties, dungeons.active, dungeons.created_at, dungeons.updated_at FROM user_run_stats
+JOIN dungeons ON dungeons.id = user_run_stats.dungeon_id
+WHERE user_run_stats.user_id = $1
+ORDER BY dungeons.name, user_run_stats.role
+`
+
+type GetUserRunStatsRow struct {
+	UserRunStat UserRunStat
+	Dungeon     Dungeon
+}
+
+func (q *Queries) GetUserRunStats(ctx context.Context, userID int32) ([]GetUserRunStatsRow, error) {
+	rows, err := q.db.Query(ctx, getUserRunStats, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserRunStatsRow
+	for rows.Next() {
+		var i GetUserRunStatsRow
+		if err := rows.Scan(
+			&i.UserRunStat.UserID,
+			&i.UserRunStat.DungeonID,
+			&i.UserRunStat.Role,
+			&i.UserRunStat.Completed,
+			&i.UserRunStat.Timed,
+			&i.UserRunStat.BestTimedLevel,
+			&i.UserRunStat.ElapsedSeconds,
+			&i.UserRunStat.ParSeconds,
+			&i.Dungeon.ID,
+			&i.Dungeon.Code,
+			&i.Dungeon.Name,
+			&i.Dungeon.Expansion,
+			&i.Dungeon.Season,
+			&i.Dungeon.ParSeconds,
+			&i.Dungeon.BossCount,
+			&i.Dungeon.Difficulties,
+			&i.Dungeon.Active,
+			&i.Dungeon.CreatedAt,
+			&i.Dungeon.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserRunStatsSummary = `-- name: GetUserRunStatsSummary :many
+SELECT user_run_stats_summary.user_id, user_run_stats_summary.dungeon_id, user_run_stats_summary.role, user_run_stats_summary.completed, user_run_stats_summary.timed, user_run_stats_summary.best_timed_level, user_run_stats_summary.elapsed_seconds, user_run_stats_summary.par_seconds, dungeons.id, dungeons.code, dungeons.name, dungeons.expansion, dungeons.season, dungeons.par_seconds, dungeons.boss_count, dungeons.difficulties, dungeons.active, dungeons.created_at, dungeons.updated_at FROM user_run_stats_summary
+JOIN dungeons ON dungeons.id = user_run_stats_summary.dungeon_id
+WHERE user_run_stats_summary.user_id = $1
+ORDER BY dungeons.name, user_run_stats_summary.role
+`
+
+type GetUserRunStatsSummaryRow struct {
+	UserRunStatsSummary UserRunStatsSummary
+	Dungeon             Dungeon
+}
+
+func (q *Queries) GetUserRunStatsSummary(ctx context.Context, userID int32) ([]GetUserRunStatsSummaryRow, error) {
+	rows, err := q.db.Query(ctx, getUserRunStatsSummary, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserRunStatsSummaryRow
+	for rows.Next() {
+		var i GetUserRunStatsSummaryRow
+		if err := rows.Scan(
+			&i.UserRunStatsSummary.UserID,
+			&i.UserRunStatsSummary.DungeonID,
+			&i.UserRunStatsSummary.Role,
+			&i.UserRunStatsSummary.Completed,
+			&i.UserRunStatsSummary.Timed,
+			&i.UserRunStatsSummary.BestTimedLevel,
+			&i.UserRunStatsSummary.ElapsedSeconds,
+			&i.UserRunStatsSummary.ParSeconds,
+			&i.Dungeon.ID,
+			&i.Dungeon.Code,
+			&i.Dungeon.Name,
+			&i.Dungeon.Expansion,
+			&i.Dungeon.Season,
+			&i.Dungeon.ParSeconds,
+			&i.Dungeon.BossCount,
+			&i.Dungeon.Difficulties,
+			&i.Dungeon.Active,
+			&i.Dungeon.CreatedAt,
+			&i.Dungeon.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserSignupStats = `-- name: GetUserSignupStats :one
+SELECT
+    count(*) FILTER (WHERE run_signups.attendance = 'no_show')::int AS no_shows,
+    count(*) FILTER (
+        WHERE run_signups.status = 'withdrawn' AND runs.status <> 'cancelled'
+            AND run_signups.withdrawn_at > runs.starts_at - make_interval(secs => $1::int)
+    )::int AS late_withdrawals
+FROM run_signups
+JOIN runs ON runs.id = run_signups.run_id
+WHERE run_signups.user_id = $2
+`
+
+type GetUserSignupStatsParams struct {
+	LateWithdrawalSeconds int32
+	UserID                int32
+}
+
+type GetUserSignupStatsRow struct {
+	NoShows         int32
+	LateWithdrawals int32
+}
+
+func (q *Queries) GetUserSignupStats(ctx context.Context, arg GetUserSignupStatsParams) (GetUserSignupStatsRow, error) {
+	row := q.db.QueryRow(ctx, getUserSignupStats, arg.LateWithdrawalSeconds, arg.UserID)
+	var i GetUserSignupStatsRow
+	err := row.Scan(&i.NoShows, &i.LateWithdrawals)
+	return i, err
+}
+
+const getUsers = `-- name: GetUsers :many
+SELECT id, username, email, roles, timezone FROM users
+`
+
+type GetUsersRow struct {
+	ID       int32
+	Username string
+	Email    string
+	Roles    []string
+	Timezone string
+}
+
+func (q *Queries) GetUsers(ctx context.Context) ([]GetUsersRow, error) {
+	rows, err := q.db.Query(ctx, getUsers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUsersRow
+	for rows.Next() {
+		var i GetUsersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.Email,
+			&i.Roles,
+			&i.Timezone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUsersByIDs = `-- name: GetUsersByIDs :many
+SELECT id, username, roles, timezone FROM users
+WHERE id = ANY($1::int[])
+ORDER BY id
+`
+
+type GetUsersByIDsRow struct {
+	ID       int32
+	Username string
+	Roles    []string
+	Timezone string
+}
+
+func (q *Queries) GetUsersByIDs(ctx context.Context, ids []int32) ([]GetUsersByIDsRow, error) {
+	rows, err := q.db.Query(ctx, getUsersByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUsersByIDsRow
+	for rows.Next() {
+		var i GetUsersByIDsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Username,
+			&i.Roles,
+			&i.Timezone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWaitlist = `-- name: GetWaitlist :many
+SELECT run_signups.id, run_signups.run_id, run_signups.user_id, run_signups.role, run_signups.status, run_signups.withdrawn_at, run_signups.created_at, run_signups.updated_at, run_signups.waitlist_position, run_signups.attendance, run_signups.character_id, COALESCE(characters.roles, users.roles)::text[] AS user_roles FROM run_signups
+JOIN users ON users.id = run_signups.user_id
+LEFT JOIN characters ON characters.id = run_signups.character_id
+WHERE run_signups.run_id = $1 AND run_signups.role = $2 AND run_signups.status = 'waitlisted'
+ORDER BY run_signups.waitlist_position, run_signups.created_at, run_signups.id
+`
+
+type GetWaitlistParams struct {
+	RunID int32
+	Role  string
+}
+
+type GetWaitlistRow struct {
+	ID               int32
+	RunID            int32
+	UserID           int32
+	Role             string
+	Status           string
+	WithdrawnAt      pgtype.Timestamptz
+	CreatedAt        pgtype.Timestamptz
+	UpdatedAt        pgtype.Timestamptz
+	WaitlistPosition pgtype.Int4
+	Attendance       pgtype.Text
+	CharacterID      pgtype.Int4
+	UserRoles        []string
+}
+
+// user_roles are the roles of the character signed up, or of the user for
+// signups without one.
+func (q *Queries) GetWaitlist(ctx context.Context, arg GetWaitlistParams) ([]GetWaitlistRow, error) {
+	rows, err := q.db.Query(ctx, getWaitlist, arg.RunID, arg.Role)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetWaitlistRow
+	for rows.Next() {
+		var i GetWaitlistRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.RunID,
+			&i.UserID,
+			&i.Role,
+			&i.Status,
+			&i.WithdrawnAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.WaitlistPosition,
+			&i.Attendance,
+			&i.CharacterID,
+			&i.UserRoles,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWebhookDeliveries = `-- name: GetWebhookDeliveries :many
+SELECT id, endpoint_id, event_id, event, payload, status, attempts, response_status, last_error, delivered_at, created_at, updated_at FROM webhook_deliveries
+WHERE endpoint_id = $1
+ORDER BY id DESC
+LIMIT $2
+`
+
+type GetWebhookDeliveriesParams struct {
+	EndpointID    int32
+	MaxDeliveries int32
+}
+
+func (q *Queries) GetWebhookDeliveries(ctx context.Context, arg GetWebhookDeliveriesParams) ([]WebhookDelivery, error) {
+	rows, err := q.db.Query(ctx, getWebhookDeliveries, arg.EndpointID, arg.MaxDeliveries)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookDelivery
+	for rows.Next() {
+		var i WebhookDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.EndpointID,
+			&i.EventID,
+			&i.Event,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.ResponseStatus,
+			&i.LastError,
+			&i.DeliveredAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWebhookDelivery = `-- name: GetWebhookDelivery :one
+SELECT id, endpoint_id, event_id, event, payload, status, attempts, response_status, last_error, delivered_at, created_at, updated_at FROM webhook_deliveries
+WHERE id = $1
+`
+
+func (q *Queries) GetWebhookDelivery(ctx context.Context, id int64) (WebhookDelivery, error) {
+	row := q.db.QueryRow(ctx, getWebhookDelivery, id)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.EndpointID,
+		&i.EventID,
+		&i.Event,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.ResponseStatus,
+		&i.LastError,
+		&i.DeliveredAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getWebhookEndpoint = `-- name: GetWebhookEndpoint :one
+SELECT id, guild_id, url, secret, events, active, consecutive_failures, disabled_at, created_at, updated_at FROM webhook_endpoints
+WHERE id = $1
+`
+
+func (q *Queries) GetWebhookEndpoint(ctx context.Context, id int32) (WebhookEndpoint, error) {
+	row := q.db.QueryRow(ctx, getWebhookEndpoint, id)
+	var i WebhookEndpoint
+	err := row.Scan(
+		&i.ID,
+		&i.GuildID,
+		&i.Url,
+		&i.Secret,
+		&i.Events,
+		&i.Active,
+		&i.ConsecutiveFailures,
+		&i.DisabledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getWebhookEndpoints = `-- name: GetWebhookEndpoints :many
+SELECT id, guild_id, url, secret, events, active, consecutive_failures, disabled_at, created_at, updated_at FROM webhook_endpoints
+WHERE guild_id IS NOT DISTINCT FROM $1
+ORDER BY id
+`
+
+func (q *Queries) GetWebhookEndpoints(ctx context.Context, guildID pgtype.Int4) ([]WebhookEndpoint, error) {
+	rows, err := q.db.Query(ctx, getWebhookEndpoints, guildID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookEndpoint
+	for rows.Next() {
+		var i WebhookEndpoint
+		if err := rows.Scan(
+			&i.ID,
+			&i.GuildID,
+			&i.Url,
+			&i.Secret,
+			&i.Events,
+			&i.Active,
+			&i.ConsecutiveFailures,
+			&i.DisabledAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWeeklyTimedRuns = `-- name: GetWeeklyTimedRuns :many
+SELECT run_participants.user_id, COALESCE(runs.key_level, 0)::int AS key_level
+FROM run_participants
+JOIN runs ON runs.id = run_participants.run_id
+WHERE run_participants.user_id = ANY($1::int[])
+    AND runs.difficulty = 'Mythic+' AND runs.status = 'completed' AND runs.upgrade_level > 0
+    AND runs.finished_at >= $2 AND runs.finished_at < $3
+    AND COALESCE(runs.key_level, 0) >= $4::int
+ORDER BY run_participants.user_id, key_level DESC, runs.id
+`
+
+type GetWeeklyTimedRunsParams struct {
+	UserIds  []int32
+	StartsAt pgtype.Timestamptz
+	EndsAt   pgtype.Timestamptz
+	MinLevel int32
+}
+
+type GetWeeklyTimedRunsRow struct {
+	UserID   int32
+	KeyLevel int32
+}
+
+// The key levels of the Mythic+ runs each of the users timed in a week, at
+// min_level or above, highest first.
+func (q *Queries) GetWeeklyTimedRuns(ctx context.Context, arg GetWeeklyTimedRunsParams) ([]GetWeeklyTimedRunsRow, error) {
+	rows, err := q.db.Query(ctx, getWeeklyTimedRuns,
+		arg.UserIds,
+		arg.StartsAt,
+		arg.EndsAt,
+		arg.MinLevel,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetWeeklyTimedRunsRow
+	for rows.Next() {
+		var i GetWeeklyTimedRunsRow
+		if err := rows.Scan(&i.UserID, &i.KeyLevel); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockActiveLFGEntry = `-- name: LockActiveLFGEntry :one
+SELECT id, user_id, dungeon_id, min_key_level, max_key_level, roles, available_from, available_until, status, proposal_id, created_at, updated_at, character_id FROM lfg_entries
+WHERE user_id = $1 AND status IN ('queued', 'proposed')
+FOR UPDATE
+`
+
+func (q *Queries) LockActiveLFGEntry(ctx context.Context, userID int32) (LfgEntry, error) {
+	row := q.db.QueryRow(ctx, lockActiveLFGEntry, userID)
+	var i LfgEntry
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.DungeonID,
+		&i.MinKeyLevel,
+		&i.MaxKeyLevel,
+		&i.Roles,
+		&i.AvailableFrom,
+		&i.AvailableUntil,
+		&i.Status,
+		&i.ProposalID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CharacterID,
+	)
+	return i, err
+}
+
+const lockCatalog = `-- name: LockCatalog :exec
+SELECT pg_advisory_xact_lock(hashtext($1::text))
+`
+
+func (q *Queries) LockCatalog(ctx context.Context, catalog string) error {
+	_, err := q.db.Exec(ctx, lockCatalog, catalog)
+	return err
+}
+
+const lockExpiredLFGProposals = `-- name: LockExpiredLFGProposals :many
+SELECT id, dungeon_id, key_level, starts_at, score, seed, status, expires_at, run_id, created_at, updated_at FROM lfg_proposals
+WHERE status = 'pending' AND expires_at <= $1
+ORDER BY id
+FOR UPDATE SKIP LOCKED
+`
+
+func (q *Queries) LockExpiredLFGProposals(ctx context.Context, now pgtype.Timestamptz) ([]LfgProposal, error) {
+	rows, err := q.db.Query(ctx, lockExpiredLFGProposals, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LfgProposal
+	for rows.Next() {
+		var i LfgProposal
+		if err := rows.Scan(
+			&i.ID,
+			&i.DungeonID,
+			&i.KeyLevel,
+			&i.StartsAt,
+			&i.Score,
+			&i.Seed,
+			&i.Status,
+			&i.ExpiresAt,
+			&i.RunID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockLFGProposal = `-- name: LockLFGProposal :one
+SELECT id, dungeon_id, key_level, starts_at, score, seed, status, expires_at, run_id, created_at, updated_at FROM lfg_proposals
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) LockLFGProposal(ctx context.Context, id int32) (LfgProposal, error) {
+	row := q.db.QueryRow(ctx, lockLFGProposal, id)
+	var i LfgProposal
+	err := row.Scan(
+		&i.ID,
+		&i.DungeonID,
+		&i.KeyLevel,
+		&i.StartsAt,
+		&i.Score,
+		&i.Seed,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.RunID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const lockPendingReadyCheck = `-- name: LockPendingReadyCheck :one
+SELECT id, run_id, started_by, status, expires_at, finished_at, created_at, updated_at FROM ready_checks
+WHERE run_id = $1 AND status = 'pending'
+FOR UPDATE
+`
+
+func (q *Queries) LockPendingReadyCheck(ctx context.Context, runID int32) (ReadyCheck, error) {
+	row := q.db.QueryRow(ctx, lockPendingReadyCheck, runID)
+	var i ReadyCheck
+	err := row.Scan(
+		&i.ID,
+		&i.RunID,
+		&i.StartedBy,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.FinishedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const lockQueuedLFGEntries = `-- name: LockQueuedLFGEntries :many
+SELECT lfg_entries.id, lfg_entries.user_id, lfg_entries.dungeon_id, lfg_entries.min_key_level, lfg_entries.max_key_level, lfg_entries.roles, lfg_entries.available_from, lfg_entries.available_until, lfg_entries.status, lfg_entries.proposal_id, lfg_entries.created_at, lfg_entries.updated_at, lfg_entries.character_id, dungeons.id, dungeons.code, dungeons.name, dungeons.expansion, dungeons.season, dungeons.par_seconds, dungeons.boss_count, dungeons.difficulties, dungeons.active, dungeons.created_at, dungeons.updated_at FROM lfg_entries
+JOIN dungeons ON dungeons.id = lfg_entries.dungeon_id
+WHERE lfg_entries.status = 'queued' AND lfg_entries.available_until > $1
+ORDER BY lfg_entries.created_at, lfg_entries.id
+FOR UPDATE OF lfg_entries SKIP LOCKED
+`
+
+type LockQueuedLFGEntriesRow struct {
+	LfgEntry LfgEntry
+	Dungeon  Dungeon
+}
+
+// Entries of users that are still available, oldest first. Entries another
+// matcher holds are skipped.
+func (q *Queries) LockQueuedLFGEntries(ctx context.Context, now pgtype.Timestamptz) ([]LockQueuedLFGEntriesRow, error) {
+	rows, err := q.db.Query(ctx, lockQueuedLFGEntries, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LockQueuedLFGEntriesRow
+	for rows.Next() {
+		var i LockQueuedLFGEntriesRow
+		if err := rows.Scan(
+			&i.LfgEntry.ID,
+			&i.LfgEntry.UserID,
+			&i.LfgEntry.DungeonID,
+			&i.LfgEntry.MinKeyLevel,
+			&i.LfgEntry.MaxKeyLevel,
+			&i.LfgEntry.Roles,
+			&i.LfgEntry.AvailableFrom,
+			&i.LfgEntry.AvailableUntil,
+			&i.LfgEntry.Status,
+			&i.LfgEntry.ProposalID,
+			&i.LfgEntry.CreatedAt,
+			&i.LfgEntry.UpdatedAt,
+			&i.LfgEntry.CharacterID,
+			&i.Dungeon.ID,
+			&i.Dungeon.Code,
+			&i.Dungeon.Name,
+			&i.Dungeon.Expansion,
+			&i.Dungeon.Season,
+			&i.Dungeon.ParSeconds,
+			&i.Dungeon.BossCount,
+			&i.Dungeon.Difficulties,
+			&i.Dungeon.Active,
+			&i.Dungeon.CreatedAt,
+			&i.Dungeon.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockReadyCheck = `-- name: LockReadyCheck :one
+SELECT id, run_id, started_by, status, expires_at, finished_at, created_at, updated_at FROM ready_checks
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) LockReadyCheck(ctx context.Context, id int32) (ReadyCheck, error) {
+	row := q.db.QueryRow(ctx, lockReadyCheck, id)
+	var i ReadyCheck
+	err := row.Scan(
+		&i.ID,
+		&i.RunID,
+		&i.StartedBy,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.FinishedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const lockRun = `-- name: LockRun :one
+SELECT id, dungeon_id, difficulty, key_level, organizer_id, starts_at, timezone, duration_minutes, notes, status, created_at, updated_at, tank_slots, healer_slots, dps_slots, series_id, occurrence_at, sequence, guild_id, started_at, finished_at, upgrade_level, affixes FROM runs
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) LockRun(ctx context.Context, id int32) (Run, error) {
+	row := q.db.QueryRow(ctx, lockRun, id)
+	var i Run
+	err := row.Scan(
+		&i.ID,
+		&i.DungeonID,
+		&i.Difficulty,
+		&i.KeyLevel,
+		&i.OrganizerID,
+		&i.StartsAt,
+		&i.Timezone,
+		&i.DurationMinutes,
+		&i.Notes,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TankSlots,
+		&i.HealerSlots,
+		&i.DpsSlots,
+		&i.SeriesID,
+		&i.OccurrenceAt,
+		&i.Sequence,
+		&i.GuildID,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.UpgradeLevel,
+		&i.Affixes,
+	)
+	return i, err
+}
+
+const lockSeriesRun = `-- name: LockSeriesRun :one
+SELECT id, dungeon_id, difficulty, key_level, organizer_id, starts_at, timezone, duration_minutes, notes, status, created_at, updated_at, tank_slots, healer_slots, dps_slots, series_id, occurrence_at, sequence, guild_id, started_at, finished_at, upgrade_level, affixes FROM runs
+WHERE series_id = $1 AND occurrence_at = $2
+FOR UPDATE
+`
+
+type LockSeriesRunParams struct {
+	SeriesID     pgtype.Int4
+	OccurrenceAt pgtype.Timestamptz
+}
+
+func (q *Queries) LockSeriesRun(ctx context.Context, arg LockSeriesRunParams) (Run, error) {
+	row := q.db.QueryRow(ctx, lockSeriesRun, arg.SeriesID, arg.OccurrenceAt)
+	var i Run
+	err := row.Scan(
+		&i.ID,
+		&i.DungeonID,
+		&i.Difficulty,
+		&i.KeyLevel,
+		&i.OrganizerID,
+		&i.StartsAt,
+		&i.Timezone,
+		&i.DurationMinutes,
+		&i.Notes,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TankSlots,
+		&i.HealerSlots,
+		&i.DpsSlots,
+		&i.SeriesID,
+		&i.OccurrenceAt,
+		&i.Sequence,
+		&i.GuildID,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.UpgradeLevel,
+		&i.Affixes,
+	)
+	return i, err
+}
+
+const markAttendance = `-- name: MarkAttendance :execrows
+UPDATE run_signups SET attendance = $3
+WHERE run_id = $1 AND user_id = $2 AND status = 'confirmed'
+`
+
+type MarkAttendanceParams struct {
+	RunID      int32
+	UserID     int32
+	Attendance pgtype.Text
+}
+
+func (q *Queries) MarkAttendance(ctx context.Context, arg MarkAttendanceParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markAttendance, arg.RunID, arg.UserID, arg.Attendance)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const markInboxNotificationRead = `-- name: MarkInboxNotificationRead :execrows
+UPDATE inbox_notifications SET read_at = COALESCE(read_at, $1)
+WHERE id = $2 AND user_id = $3
+`
+
+type MarkInboxNotificationReadParams struct {
+	ReadAt pgtype.Timestamptz
+	ID     int64
+	UserID int32
+}
+
+func (q *Queries) MarkInboxNotificationRead(ctx context.Context, arg MarkInboxNotificationReadParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markInboxNotificationRead, arg.ReadAt, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const materializeSeriesRun = `-- name: MaterializeSeriesRun :one
+INSERT INTO runs (dungeon_id, difficulty, key_level, organizer_id, starts_at, timezone, duration_minutes, notes,
+    tank_slots, healer_slots, dps_slots, series_id, occurrence_at, guild_id)
+SELECT dungeon_id, difficulty, key_level, organizer_id, $1::timestamptz, timezone, duration_minutes, notes,
+    tank_slots, healer_slots, dps_slots, id, $1::timestamptz, guild_id
+FROM run_series
+WHERE run_series.id = $2
+    AND (run_series.until_at IS NULL OR $1::timestamptz < run_series.until_at)
+    AND NOT EXISTS (
+        SELECT 1 FROM run_series_exceptions
+        WHERE run_series_exceptions.series_id = $2
+            AND run_series_exceptions.occurrence_at = $1::timestamptz
+            AND run_series_exceptions.kind = 'skip'
+    )
+ON CONFLICT (series_id, occurrence_at) DO NOTHING
+RETURNING id, dungeon_id, difficulty, key_level, organizer_id, starts_at, timezone, duration_minutes, notes, status, created_at, updated_at, tank_slots, healer_slots, dps_slots, series_id, occurrence_at, sequence, guild_id, started_at, finished_at, upgrade_level, affixes
+`
+
+type MaterializeSeriesRunParams struct {
+	OccurrenceAt pgtype.Timestamptz
+	SeriesID     int32
+}
+
+func (q *Queries) MaterializeSeriesRun(ctx context.Context, arg MaterializeSeriesRunParams) (Run, error) {
+	row := q.db.QueryRow(ctx, materializeSeriesRun, arg.OccurrenceAt, arg.SeriesID)
+	var i Run
+	err := row.Scan(
+		&i.ID,
+		&i.DungeonID,
+		&i.Difficulty,
+		&i.KeyLevel,
+		&i.OrganizerID,
+		&i.StartsAt,
+		&i.Timezone,
+		&i.DurationMinutes,
+		&i.Notes,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TankSlots,
+		&i.HealerSlots,
+		&i.DpsSlots,
+		&i.SeriesID,
+		&i.OccurrenceAt,
+		&i.Sequence,
+		&i.GuildID,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.UpgradeLevel,
+		&i.Affixes,
+	)
+	return i, err
+}
+
+const moveSeriesRun = `-- name: MoveSeriesRun :exec
+UPDATE runs SET series_id = $1, occurrence_at = $2
+WHERE id = $3
+`
+
+type MoveSeriesRunParams struct {
+	ToSeriesID   pgtype.Int4
+	OccurrenceAt pgtype.Timestamptz
+	ID           int32
+}
+
+func (q *Queries) MoveSeriesRun(ctx context.Context, arg MoveSeriesRunParams) error {
+	_, err := q.db.Exec(ctx, moveSeriesRun, arg.ToSeriesID, arg.OccurrenceAt, arg.ID)
+	return err
+}
+
+const nextWaitlistPosition = `-- name: NextWaitlistPosition :one
+SELECT (COALESCE(MAX(waitlist_position), 0) + 1)::int FROM run_signups
+WHERE run_id = $1 AND role = $2 AND status = 'waitlisted'
+`
+
+type NextWaitlistPositionParams struct {
+	RunID int32
+	Role  string
+}
+
+func (q *Queries) NextWaitlistPosition(ctx context.Context, arg NextWaitlistPositionParams) (int32, error) {
+	row := q.db.QueryRow(ctx, nextWaitlistPosition, arg.RunID, arg.Role)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const promoteSignup = `-- name: PromoteSignup :one
+UPDATE run_signups SET status = 'confirmed', waitlist_position = NULL
+WHERE id = $1
+RETURNING id, run_id, user_id, role, status, withdrawn_at, created_at, updated_at, waitlist_position, attendance, character_id
+`
+
+func (q *Queries) PromoteSignup(ctx context.Context, id int32) (RunSignup, error) {
+	row := q.db.QueryRow(ctx, promoteSignup, id)
+	var i RunSignup
+	err := row.Scan(
+		&i.ID,
+		&i.RunID,
+		&i.UserID,
+		&i.Role,
+		&i.Status,
+		&i.WithdrawnAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.WaitlistPosition,
+		&i.Attendance,
+		&i.CharacterID,
+	)
+	return i, err
+}
+
+const recordWebhookFailure = `-- name: RecordWebhookFailure :one
+UPDATE webhook_endpoints SET
+    consecutive_failures = consecutive_failures + 1,
+    active = active AND consecutive_failures + 1 < $1,
+    disabled_at = CASE
+        WHEN active AND consecutive_failures + 1 >= $1 THEN CURRENT_TIMESTAMP
+        ELSE disabled_at
+    END
+WHERE id = $2
+RETURNING id, guild_id, url, secret, events, active, consecutive_failures, disabled_at, created_at, updated_at
+`
+
+type RecordWebhookFailureParams struct {
+	MaxFailures int32
+	ID          int32
+}
+
+func (q *Queries) RecordWebhookFailure(ctx context.Context, arg RecordWebhookFailureParams) (WebhookEndpoint, error) {
+	row := q.db.QueryRow(ctx, recordWebhookFailure, arg.MaxFailures, arg.ID)
+	var i WebhookEndpoint
+	err := row.Scan(
+		&i.ID,
+		&i.GuildID,
+		&i.Url,
+		&i.Secret,
+		&i.Events,
+		&i.Active,
+		&i.ConsecutiveFailures,
+		&i.DisabledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const refreshUserRunStatsSummary = `-- name: RefreshUserRunStatsSummary :exec
+REFRESH MATERIALIZED VIEW CONCURRENTLY user_run_stats_summary
+`
+
+func (q *Queries) RefreshUserRunStatsSummary(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, refreshUserRunStatsSummary)
+	return err
+}
+
+const releaseJob = `-- name: ReleaseJob :exec
+UPDATE jobs SET status = 'pending', locked_until = NULL
+WHERE id = $1 AND status = 'running' AND locked_until = $2
+`
+
+type ReleaseJobParams struct {
+	ID          int64
+	LockedUntil pgtype.Timestamptz
+}
+
+func (q *Queries) ReleaseJob(ctx context.Context, arg ReleaseJobParams) error {
+	_, err := q.db.Exec(ctx, releaseJob, arg.ID, arg.LockedUntil)
+	return err
+}
+
+const removeGuildMember = `-- name: RemoveGuildMember :exec
+DELETE FROM guild_members
+WHERE guild_id = $1 AND user_id = $2
+`
+
+type RemoveGuildMemberParams struct {
+	GuildID int32
+	UserID  int32
+}
+
+func (q *Queries) RemoveGuildMember(ctx context.Context, arg RemoveGuildMemberParams) error {
+	_, err := q.db.Exec(ctx, removeGuildMember, arg.GuildID, arg.UserID)
+	return err
+}
+
+const resetWebhookFailures = `-- name: ResetWebhookFailures :exec
+UPDATE webhook_endpoints SET consecutive_failures = 0
+WHERE id = $1 AND consecutive_failures > 0
+`
+
+func (q *Queries) ResetWebhookFailures(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, resetWebhookFailures, id)
+	return err
+}
+
+const retryJob = `-- name: RetryJob :one
+UPDATE jobs SET status = 'pending', attempts = 0, last_error = '', run_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND status = 'dead'
+RETURNING id, queue, kind, args, unique_key, status, attempts, max_attempts, last_error, run_at, created_at, updated_at, locked_until
+`
+
+func (q *Queries) RetryJob(ctx context.Context, id int64) (Job, error) {
+	row := q.db.QueryRow(ctx, retryJob, id)
+	var i Job
+	err := row.Scan(
+		&i.ID,
+		&i.Queue,
+		&i.Kind,
+		&i.Args,
+		&i.UniqueKey,
+		&i.Status,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.LastError,
+		&i.RunAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LockedUntil,
+	)
+	return i, err
+}
+
+const setCatalogVersion = `-- name: SetCatalogVersion :exec
+INSERT INTO catalog_versions (catalog, version)
+VALUES ($1, $2)
+ON CONFLICT (catalog) DO UPDATE SET
+    version = EXCLUDED.version,
+    applied_at = CURRENT_TIMESTAMP
+`
+
+type SetCatalogVersionParams struct {
+	Catalog string
+	Version int32
+}
+
+func (q *Queries) SetCatalogVersion(ctx context.Context, arg SetCatalogVersionParams) error {
+	_, err := q.db.Exec(ctx, setCatalogVersion, arg.Catalog, arg.Version)
+	return err
+}
+
+const setCharacterArmory = `-- name: SetCharacterArmory :exec
+UPDATE characters
+SET class = $1, spec = $2, item_level = $3, roles = $4, imported_at = $5
+WHERE id = $6
+`
+
+type SetCharacterArmoryParams struct {
+	Class      string
+	Spec       string
+	ItemLevel  int32
+	Roles      []string
+	ImportedAt pgtype.Timestamptz
+	ID         int32
+}
+
+func (q *Queries) SetCharacterArmory(ctx context.Context, arg SetCharacterArmoryParams) error {
+	_, err := q.db.Exec(ctx, setCharacterArmory,
+		arg.Class,
+		arg.Spec,
+		arg.ItemLevel,
+		arg.Roles,
+		arg.ImportedAt,
+		arg.ID,
+	)
+	return err
+}
+
+const setGuildDiscordWebhook = `-- name: SetGuildDiscordWebhook :exec
+UPDATE guilds SET discord_webhook_url = $2
+WHERE id = $1
+`
+
+type SetGuildDiscordWebhookParams struct {
+	ID                int32
+	DiscordWebhookUrl string
+}
 
-const createUser = `-- name: CreateUser :one
-INSERT INTO users (username, email, password_hash, roles, timezone)
-VALUES ($1, $2, $3, $4, $5)
-RETURNING id, username, email, password_hash, timezone, created_at, updated_at, roles
+func (q *Queries) SetGuildDiscordWebhook(ctx context.Context, arg SetGuildDiscordWebhookParams) error {
+	_, err := q.db.Exec(ctx, setGuildDiscordWebhook, arg.ID, arg.DiscordWebhookUrl)
+	return err
+}
+
+const setGuildLeaderboardScoring = `-- name: SetGuildLeaderboardScoring :exec
+UPDATE guilds SET leaderboard_scoring = $2
+WHERE id = $1
 `
 
-type CreateUserParams struct {
-	Username     string
-	Email        string
-	PasswordHash string
-	Roles        []string
-	Timezone     string
+type SetGuildLeaderboardScoringParams struct {
+	ID                 int32
+	LeaderboardScoring string
 }
 
-func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
-	row := q.db.QueryRow(ctx, createUser,
-		arg.Username,
-		arg.Email,
-		arg.PasswordHash,
-		arg.Roles,
-		arg.Timezone,
+func (q *Queries) SetGuildLeaderboardScoring(ctx context.Context, arg SetGuildLeaderboardScoringParams) error {
+	_, err := q.db.Exec(ctx, setGuildLeaderboardScoring, arg.ID, arg.LeaderboardScoring)
+	return err
+}
+
+const setGuildSignupPolicy = `-- name: SetGuildSignupPolicy :exec
+UPDATE guilds SET waitlist_below_reliability = $2
+WHERE id = $1
+`
+
+type SetGuildSignupPolicyParams struct {
+	ID                       int32
+	WaitlistBelowReliability pgtype.Int4
+}
+
+func (q *Queries) SetGuildSignupPolicy(ctx context.Context, arg SetGuildSignupPolicyParams) error {
+	_, err := q.db.Exec(ctx, setGuildSignupPolicy, arg.ID, arg.WaitlistBelowReliability)
+	return err
+}
+
+const setLFGEntryStatus = `-- name: SetLFGEntryStatus :exec
+UPDATE lfg_entries SET status = $1, proposal_id = $2
+WHERE id = $3
+`
+
+type SetLFGEntryStatusParams struct {
+	Status     string
+	ProposalID pgtype.Int4
+	ID         int32
+}
+
+func (q *Queries) SetLFGEntryStatus(ctx context.Context, arg SetLFGEntryStatusParams) error {
+	_, err := q.db.Exec(ctx, setLFGEntryStatus, arg.Status, arg.ProposalID, arg.ID)
+	return err
+}
+
+const setLFGProposalResponse = `-- name: SetLFGProposalResponse :execrows
+UPDATE lfg_proposal_members SET response = $3
+WHERE proposal_id = $1 AND user_id = $2 AND response = 'pending'
+`
+
+type SetLFGProposalResponseParams struct {
+	ProposalID int32
+	UserID     int32
+	Response   string
+}
+
+func (q *Queries) SetLFGProposalResponse(ctx context.Context, arg SetLFGProposalResponseParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setLFGProposalResponse, arg.ProposalID, arg.UserID, arg.Response)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setLFGProposalStatus = `-- name: SetLFGProposalStatus :one
+UPDATE lfg_proposals SET status = $1, run_id = $2
+WHERE id = $3
+RETURNING id, dungeon_id, key_level, starts_at, score, seed, status, expires_at, run_id, created_at, updated_at
+`
+
+type SetLFGProposalStatusParams struct {
+	Status string
+	RunID  pgtype.Int4
+	ID     int32
+}
+
+func (q *Queries) SetLFGProposalStatus(ctx context.Context, arg SetLFGProposalStatusParams) (LfgProposal, error) {
+	row := q.db.QueryRow(ctx, setLFGProposalStatus, arg.Status, arg.RunID, arg.ID)
+	var i LfgProposal
+	err := row.Scan(
+		&i.ID,
+		&i.DungeonID,
+		&i.KeyLevel,
+		&i.StartsAt,
+		&i.Score,
+		&i.Seed,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.RunID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
 	)
-	var i User
+	return i, err
+}
+
+const setRunStatus = `-- name: SetRunStatus :one
+UPDATE runs SET status = $2, sequence = sequence + 1
+WHERE id = $1
+RETURNING id, dungeon_id, difficulty, key_level, organizer_id, starts_at, timezone, duration_minutes, notes, status, created_at, updated_at, tank_slots, healer_slots, dps_slots, series_id, occurrence_at, sequence, guild_id, started_at, finished_at, upgrade_level, affixes
+`
+
+type SetRunStatusParams struct {
+	ID     int32
+	Status string
+}
+
+func (q *Queries) SetRunStatus(ctx context.Context, arg SetRunStatusParams) (Run, error) {
+	row := q.db.QueryRow(ctx, setRunStatus, arg.ID, arg.Status)
+	var i Run
 	err := row.Scan(
 		&i.ID,
-		&i.Username,
-		&i.Email,
-		&i.PasswordHash,
+		&i.DungeonID,
+		&i.Difficulty,
+		&i.KeyLevel,
+		&i.OrganizerID,
+		&i.StartsAt,
 		&i.Timezone,
+		&i.DurationMinutes,
+		&i.Notes,
+		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
-		&i.Roles,
+		&i.TankSlots,
+		&i.HealerSlots,
+		&i.DpsSlots,
+		&i.SeriesID,
+		&i.OccurrenceAt,
+		&i.Sequence,
+		&i.GuildID,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.UpgradeLevel,
+		&i.Affixes,
 	)
 	return i, err
 }
 
-const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, username, email, roles, timezone FROM users
-WHERE email = $1 LIMIT 1
+const setUserCalendarToken = `-- name: SetUserCalendarToken :exec
+UPDATE users SET calendar_token = $2
+WHERE id = $1
 `
 
-type GetUserByEmailRow struct {
-	ID       int32
-	Username string
-	Email    string
-	Roles    []string
-	Timezone string
+type SetUserCalendarTokenParams struct {
+	ID            int32
+	CalendarToken pgtype.Text
 }
 
-func (q *Queries) GetUserByEmail(ctx context.Context, email string) (GetUserByEmailRow, error) {
-	row := q.db.QueryRow(ctx, getUserByEmail, email)
-	var i GetUserByEmailRow
+func (q *Queries) SetUserCalendarToken(ctx context.Context, arg SetUserCalendarTokenParams) error {
+	_, err := q.db.Exec(ctx, setUserCalendarToken, arg.ID, arg.CalendarToken)
+	return err
+}
+
+const setWaitlistPosition = `-- name: SetWaitlistPosition :exec
+UPDATE run_signups SET waitlist_position = $2
+WHERE id = $1
+`
+
+type SetWaitlistPositionParams struct {
+	ID               int32
+	WaitlistPosition pgtype.Int4
+}
+
+func (q *Queries) SetWaitlistPosition(ctx context.Context, arg SetWaitlistPositionParams) error {
+	_, err := q.db.Exec(ctx, setWaitlistPosition, arg.ID, arg.WaitlistPosition)
+	return err
+}
+
+const transitionRun = `-- name: TransitionRun :one
+UPDATE runs SET status = $2, started_at = $3, finished_at = $4, upgrade_level = $5,
+    affixes = COALESCE($6::text[], affixes), sequence = sequence + 1
+WHERE id = $1
+RETURNING id, dungeon_id, difficulty, key_level, organizer_id, starts_at, timezone, duration_minutes, notes, status, created_at, updated_at, tank_slots, healer_slots, dps_slots, series_id, occurrence_at, sequence, guild_id, started_at, finished_at, upgrade_level, affixes
+`
+
+type TransitionRunParams struct {
+	ID           int32
+	Status       string
+	StartedAt    pgtype.Timestamptz
+	FinishedAt   pgtype.Timestamptz
+	UpgradeLevel pgtype.Int4
+	Affixes      []string
+}
+
+func (q *Queries) TransitionRun(ctx context.Context, arg TransitionRunParams) (Run, error) {
+	row := q.db.QueryRow(ctx, transitionRun,
+		arg.ID,
+		arg.Status,
+		arg.StartedAt,
+		arg.FinishedAt,
+		arg.UpgradeLevel,
+		arg.Affixes,
+	)
+	var i Run
 	err := row.Scan(
 		&i.ID,
-		&i.Username,
-		&i.Email,
-		&i.Roles,
+		&i.DungeonID,
+		&i.Difficulty,
+		&i.KeyLevel,
+		&i.OrganizerID,
+		&i.StartsAt,
 		&i.Timezone,
+		&i.DurationMinutes,
+		&i.Notes,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TankSlots,
+		&i.HealerSlots,
+		&i.DpsSlots,
+		&i.SeriesID,
+		&i.OccurrenceAt,
+		&i.Sequence,
+		&i.GuildID,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.UpgradeLevel,
+		&i.Affixes,
 	)
 	return i, err
 }
 
-const getUserByID = `-- name: GetUserByID :one
-SELECT id, username, email, roles, timezone FROM users
-WHERE id = $1 LIMIT 1
+const updateCharacter = `-- name: UpdateCharacter :one
+UPDATE characters SET name = $2, realm = $3, class = $4, spec = $5, item_level = $6, roles = $7
+WHERE id = $1
+RETURNING id, user_id, name, realm, class, spec, item_level, roles, created_at, updated_at, imported_at
 `
 
-type GetUserByIDRow struct {
-	ID       int32
-	Username string
-	Email    string
-	Roles    []string
-	Timezone string
+type UpdateCharacterParams struct {
+	ID        int32
+	Name      string
+	Realm     string
+	Class     string
+	Spec      string
+	ItemLevel int32
+	Roles     []string
 }
 
-func (q *Queries) GetUserByID(ctx context.Context, id int32) (GetUserByIDRow, error) {
-	row := q.db.QueryRow(ctx, getUserByID, id)
-	var i GetUserByIDRow
+func (q *Queries) UpdateCharacter(ctx context.Context, arg UpdateCharacterParams) (Character, error) {
+	row := q.db.QueryRow(ctx, updateCharacter,
+		arg.ID,
+		arg.Name,
+		arg.Realm,
+		arg.Class,
+		arg.Spec,
+		arg.ItemLevel,
+		arg.Roles,
+	)
+	var i Character
 	err := row.Scan(
 		&i.ID,
-		&i.Username,
-		&i.Email,
+		&i.UserID,
+		&i.Name,
+		&i.Realm,
+		&i.Class,
+		&i.Spec,
+		&i.ItemLevel,
 		&i.Roles,
-		&i.Timezone,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ImportedAt,
 	)
 	return i, err
 }
 
-const getUserByUsername = `-- name: GetUserByUsername :one
-SELECT id, username, email, roles, timezone FROM users
-WHERE username = $1 LIMIT 1
+const updateGuildAnnouncementDelivery = `-- name: UpdateGuildAnnouncementDelivery :execrows
+UPDATE guild_announcements SET status = $1, attempts = $2, last_error = $3,
+    deliver_after = $4, delivered_at = $5
+WHERE id = $6 AND status = 'pending' AND deliver_after = $7
 `
 
-type GetUserByUsernameRow struct {
-	ID       int32
-	Username string
-	Email    string
-	Roles    []string
-	Timezone string
+type UpdateGuildAnnouncementDeliveryParams struct {
+	Status       string
+	Attempts     int32
+	LastError    string
+	DeliverAfter pgtype.Timestamptz
+	DeliveredAt  pgtype.Timestamptz
+	ID           int64
+	LeasedUntil  pgtype.Timestamptz
 }
 
-func (q *Queries) GetUserByUsername(ctx context.Context, username string) (GetUserByUsernameRow, error) {
-	row := q.db.QueryRow(ctx, getUserByUsername, username)
-	var i GetUserByUsernameRow
+func (q *Queries) UpdateGuildAnnouncementDelivery(ctx context.Context, arg UpdateGuildAnnouncementDeliveryParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateGuildAnnouncementDelivery,
+		arg.Status,
+		arg.Attempts,
+		arg.LastError,
+		arg.DeliverAfter,
+		arg.DeliveredAt,
+		arg.ID,
+		arg.LeasedUntil,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateJobFailure = `-- name: UpdateJobFailure :execrows
+UPDATE jobs SET status = $2, attempts = $3, last_error = $4, run_at = $5, locked_until = NULL
+WHERE id = $1 AND locked_until = $6
+`
+
+type UpdateJobFailureParams struct {
+	ID          int64
+	Status      string
+	Attempts    int32
+	LastError   string
+	RunAt       pgtype.Timestamptz
+	LockedUntil pgtype.Timestamptz
+}
+
+func (q *Queries) UpdateJobFailure(ctx context.Context, arg UpdateJobFailureParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateJobFailure,
+		arg.ID,
+		arg.Status,
+		arg.Attempts,
+		arg.LastError,
+		arg.RunAt,
+		arg.LockedUntil,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateNotificationDelivery = `-- name: UpdateNotificationDelivery :execrows
+UPDATE notifications SET status = $1, attempts = $2, delivered_channels = $3,
+    last_error = $4, deliver_after = $5, delivered_at = $6
+WHERE id = $7 AND status = 'pending' AND deliver_after = $8
+`
+
+type UpdateNotificationDeliveryParams struct {
+	Status            string
+	Attempts          int32
+	DeliveredChannels []string
+	LastError         string
+	DeliverAfter      pgtype.Timestamptz
+	DeliveredAt       pgtype.Timestamptz
+	ID                int64
+	LeasedUntil       pgtype.Timestamptz
+}
+
+func (q *Queries) UpdateNotificationDelivery(ctx context.Context, arg UpdateNotificationDeliveryParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateNotificationDelivery,
+		arg.Status,
+		arg.Attempts,
+		arg.DeliveredChannels,
+		arg.LastError,
+		arg.DeliverAfter,
+		arg.DeliveredAt,
+		arg.ID,
+		arg.LeasedUntil,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateRun = `-- name: UpdateRun :one
+UPDATE runs SET
+    dungeon_id = $2,
+    difficulty = $3,
+    key_level = $4,
+    starts_at = $5,
+    timezone = $6,
+    duration_minutes = $7,
+    notes = $8,
+    tank_slots = $9,
+    healer_slots = $10,
+    dps_slots = $11,
+    guild_id = $12,
+    sequence = sequence + 1
+WHERE id = $1
+RETURNING id, dungeon_id, difficulty, key_level, organizer_id, starts_at, timezone, duration_minutes, notes, status, created_at, updated_at, tank_slots, healer_slots, dps_slots, series_id, occurrence_at, sequence, guild_id, started_at, finished_at, upgrade_level, affixes
+`
+
+type UpdateRunParams struct {
+	ID              int32
+	DungeonID       int32
+	Difficulty      string
+	KeyLevel        pgtype.Int4
+	StartsAt        pgtype.Timestamptz
+	Timezone        string
+	DurationMinutes int32
+	Notes           string
+	TankSlots       int32
+	HealerSlots     int32
+	DpsSlots        int32
+	GuildID         pgtype.Int4
+}
+
+func (q *Queries) UpdateRun(ctx context.Context, arg UpdateRunParams) (Run, error) {
+	row := q.db.QueryRow(ctx, updateRun,
+		arg.ID,
+		arg.DungeonID,
+		arg.Difficulty,
+		arg.KeyLevel,
+		arg.StartsAt,
+		arg.Timezone,
+		arg.DurationMinutes,
+		arg.Notes,
+		arg.TankSlots,
+		arg.HealerSlots,
+		arg.DpsSlots,
+		arg.GuildID,
+	)
+	var i Run
 	err := row.Scan(
 		&i.ID,
-		&i.Username,
-		&i.Email,
-		&i.Roles,
+		&i.DungeonID,
+		&i.Difficulty,
+		&i.KeyLevel,
+		&i.OrganizerID,
+		&i.StartsAt,
 		&i.Timezone,
+		&i.DurationMinutes,
+		&i.Notes,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TankSlots,
+		&i.HealerSlots,
+		&i.DpsSlots,
+		&i.SeriesID,
+		&i.OccurrenceAt,
+		&i.Sequence,
+		&i.GuildID,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.UpgradeLevel,
+		&i.Affixes,
 	)
 	return i, err
 }
 
-const getUserFullByEmail = `-- name: GetUserFullByEmail :one
-SELECT id, username, email, password_hash, timezone, created_at, updated_at, roles FROM users
-WHERE email = $1 LIMIT 1
+const updateWebhookDelivery = `-- name: UpdateWebhookDelivery :exec
+UPDATE webhook_deliveries SET status = $2, attempts = $3, response_status = $4, last_error = $5,
+    delivered_at = $6
+WHERE id = $1
 `
 
-func (q *Queries) GetUserFullByEmail(ctx context.Context, email string) (User, error) {
-	row := q.db.QueryRow(ctx, getUserFullByEmail, email)
-	var i User
+type UpdateWebhookDeliveryParams struct {
+	ID             int64
+	Status         string
+	Attempts       int32
+	ResponseStatus pgtype.Int4
+	LastError      string
+	DeliveredAt    pgtype.Timestamptz
+}
+
+func (q *Queries) UpdateWebhookDelivery(ctx context.Context, arg UpdateWebhookDeliveryParams) error {
+	_, err := q.db.Exec(ctx, updateWebhookDelivery,
+		arg.ID,
+		arg.Status,
+		arg.Attempts,
+		arg.ResponseStatus,
+		arg.LastError,
+		arg.DeliveredAt,
+	)
+	return err
+}
+
+const updateWebhookEndpoint = `-- name: UpdateWebhookEndpoint :one
+UPDATE webhook_endpoints SET url = $2, events = $3, active = $4, consecutive_failures = $5, disabled_at = $6
+WHERE id = $1
+RETURNING id, guild_id, url, secret, events, active, consecutive_failures, disabled_at, created_at, updated_at
+`
+
+type UpdateWebhookEndpointParams struct {
+	ID                  int32
+	Url                 string
+	Events              []string
+	Active              bool
+	ConsecutiveFailures int32
+	DisabledAt          pgtype.Timestamptz
+}
+
+func (q *Queries) UpdateWebhookEndpoint(ctx context.Context, arg UpdateWebhookEndpointParams) (WebhookEndpoint, error) {
+	row := q.db.QueryRow(ctx, updateWebhookEndpoint,
+		arg.ID,
+		arg.Url,
+		arg.Events,
+		arg.Active,
+		arg.ConsecutiveFailures,
+		arg.DisabledAt,
+	)
+	var i WebhookEndpoint
 	err := row.Scan(
 		&i.ID,
-		&i.Username,
-		&i.Email,
-		&i.PasswordHash,
-		&i.Timezone,
+		&i.GuildID,
+		&i.Url,
+		&i.Secret,
+		&i.Events,
+		&i.Active,
+		&i.ConsecutiveFailures,
+		&i.DisabledAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
-		&i.Roles,
 	)
 	return i, err
 }
 
-const getUsers = `-- name: GetUsers :many
-SELECT id, username, email, roles, timezone FROM users
+const upsertAffix = `-- name: UpsertAffix :one
+INSERT INTO affixes (code, name, description, active)
+VALUES ($1, $2, $3, TRUE)
+ON CONFLICT (code) DO UPDATE SET
+    name = EXCLUDED.name,
+    description = EXCLUDED.description,
+    active = TRUE
+RETURNING id, code, name, description, active, created_at, updated_at
 `
 
-type GetUsersRow struct {
-	ID       int32
-	Username string
-	Email    string
-	Roles    []string
-	Timezone string
+type UpsertAffixParams struct {
+	Code        string
+	Name        string
+	Description string
 }
 
-func (q *Queries) GetUsers(ctx context.Context) ([]GetUsersRow, error) {
-	rows, err := q.db.Query(ctx, getUsers)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []GetUsersRow
-	for rows.Next() {
-		var i GetUsersRow
-		if err := rows.Scan(
-			&i.ID,
-			&i.Username,
-			&i.Email,
-			&i.Roles,
-			&i.Timezone,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+func (q *Queries) UpsertAffix(ctx context.Context, arg UpsertAffixParams) (Affix, error) {
+	row := q.db.QueryRow(ctx, upsertAffix, arg.Code, arg.Name, arg.Description)
+	var i Affix
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.Name,
+		&i.Description,
+		&i.Active,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertDungeon = `-- name: UpsertDungeon :one
+INSERT INTO dungeons (code, name, expansion, season, par_seconds, boss_count, difficulties, active)
+VALUES ($1, $2, $3, $4, $5, $6, $7, TRUE)
+ON CONFLICT (code) DO UPDATE SET
+    name = EXCLUDED.name,
+    expansion = EXCLUDED.expansion,
+    season = EXCLUDED.season,
+    par_seconds = EXCLUDED.par_seconds,
+    boss_count = EXCLUDED.boss_count,
+    difficulties = EXCLUDED.difficulties,
+    active = TRUE
+RETURNING id, code, name, expansion, season, par_seconds, boss_count, difficulties, active, created_at, updated_at
+`
+
+type UpsertDungeonParams struct {
+	Code         string
+	Name         string
+	Expansion    string
+	Season       string
+	ParSeconds   int32
+	BossCount    int32
+	Difficulties []string
+}
+
+func (q *Queries) UpsertDungeon(ctx context.Context, arg UpsertDungeonParams) (Dungeon, error) {
+	row := q.db.QueryRow(ctx, upsertDungeon,
+		arg.Code,
+		arg.Name,
+		arg.Expansion,
+		arg.Season,
+		arg.ParSeconds,
+		arg.BossCount,
+		arg.Difficulties,
+	)
+	var i Dungeon
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.Name,
+		&i.Expansion,
+		&i.Season,
+		&i.ParSeconds,
+		&i.BossCount,
+		&i.Difficulties,
+		&i.Active,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertNotificationSettings = `-- name: UpsertNotificationSettings :exec
+INSERT INTO notification_settings (user_id, quiet_start_minute, quiet_end_minute, webhook_url)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id) DO UPDATE
+SET quiet_start_minute = EXCLUDED.quiet_start_minute, quiet_end_minute = EXCLUDED.quiet_end_minute,
+    webhook_url = EXCLUDED.webhook_url
+`
+
+type UpsertNotificationSettingsParams struct {
+	UserID           int32
+	QuietStartMinute pgtype.Int4
+	QuietEndMinute   pgtype.Int4
+	WebhookUrl       string
+}
+
+func (q *Queries) UpsertNotificationSettings(ctx context.Context, arg UpsertNotificationSettingsParams) error {
+	_, err := q.db.Exec(ctx, upsertNotificationSettings,
+		arg.UserID,
+		arg.QuietStartMinute,
+		arg.QuietEndMinute,
+		arg.WebhookUrl,
+	)
+	return err
+}
+
+const upsertReadyCheckResponse = `-- name: UpsertReadyCheckResponse :exec
+INSERT INTO ready_check_responses (ready_check_id, user_id, ready, responded_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (ready_check_id, user_id) DO UPDATE SET ready = EXCLUDED.ready, responded_at = EXCLUDED.responded_at
+`
+
+type UpsertReadyCheckResponseParams struct {
+	ReadyCheckID int32
+	UserID       int32
+	Ready        bool
+	RespondedAt  pgtype.Timestamptz
+}
+
+func (q *Queries) UpsertReadyCheckResponse(ctx context.Context, arg UpsertReadyCheckResponseParams) error {
+	_, err := q.db.Exec(ctx, upsertReadyCheckResponse,
+		arg.ReadyCheckID,
+		arg.UserID,
+		arg.Ready,
+		arg.RespondedAt,
+	)
+	return err
+}
+
+const upsertSeriesException = `-- name: UpsertSeriesException :exec
+INSERT INTO run_series_exceptions (series_id, occurrence_at, kind)
+VALUES ($1, $2, $3)
+ON CONFLICT (series_id, occurrence_at) DO UPDATE SET kind = EXCLUDED.kind
+`
+
+type UpsertSeriesExceptionParams struct {
+	SeriesID     int32
+	OccurrenceAt pgtype.Timestamptz
+	Kind         string
+}
+
+func (q *Queries) UpsertSeriesException(ctx context.Context, arg UpsertSeriesExceptionParams) error {
+	_, err := q.db.Exec(ctx, upsertSeriesException, arg.SeriesID, arg.OccurrenceAt, arg.Kind)
+	return err
+}
+
+const withdrawSignup = `-- name: WithdrawSignup :one
+UPDATE run_signups SET status = 'withdrawn', withdrawn_at = CURRENT_TIMESTAMP, waitlist_position = NULL
+WHERE id = $1
+RETURNING id, run_id, user_id, role, status, withdrawn_at, created_at, updated_at, waitlist_position, attendance, character_id
+`
+
+func (q *Queries) WithdrawSignup(ctx context.Context, id int32) (RunSignup, error) {
+	row := q.db.QueryRow(ctx, withdrawSignup, id)
+	var i RunSignup
+	err := row.Scan(
+		&i.ID,
+		&i.RunID,
+		&i.UserID,
+		&i.Role,
+		&i.Status,
+		&i.WithdrawnAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.WaitlistPosition,
+		&i.Attendance,
+		&i.CharacterID,
+	)
+	return i, err
 }
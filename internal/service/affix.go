@@ -0,0 +1,349 @@
+package service
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// affixCatalogName is the key the affix catalog version is stored under in
+// the catalog_versions table.
+const affixCatalogName = "affixes"
+
+// The affix catalog and season rotations shipped with the binary. Bump the
+// version in the file whenever its contents change so that existing databases
+// pick it up.
+//
+//go:embed seed/affixes.json
+var affixSeed []byte
+
+// Affix is a dungeon modifier from the catalog, active during the weeks of a
+// season's rotation that list its code.
+type Affix struct {
+	ID          int32  `json:"id"`
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// AffixRotation lists the affix codes of each week of a season's rotation.
+type AffixRotation struct {
+	Season string     `json:"season"`
+	Weeks  [][]string `json:"weeks"`
+}
+
+// AffixCatalog is the versioned affix catalog with the rotations of seasons.
+// It is the format of the embedded seed file.
+type AffixCatalog struct {
+	Version   int32           `json:"version"`
+	Affixes   []Affix         `json:"affixes"`
+	Rotations []AffixRotation `json:"rotations"`
+}
+
+// AffixService is the interface for the affix catalog.
+type AffixService interface {
+	GetAffixes(context.Context) ([]*Affix, error)
+	SeedCatalog(context.Context) error
+}
+
+// affixService is the implementation of AffixService.
+type affixService struct {
+	dbPool    *pgxpool.Pool
+	affixRepo repo.Querier
+}
+
+// NewAffixService creates a new affixService with the provided database connection pool.
+// It returns a pointer to the affixService.
+func NewAffixService(dbPool *pgxpool.Pool) *affixService {
+	return &affixService{
+		dbPool:    dbPool,
+		affixRepo: repo.New(dbPool),
+	}
+}
+
+// GetAffixes returns every affix in the active catalog, ordered by name.
+func (s *affixService) GetAffixes(ctx context.Context) ([]*Affix, error) {
+	rows, err := s.affixRepo.GetAffixes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	affixes := make([]*Affix, 0, len(rows))
+	for _, row := range rows {
+		affixes = append(affixes, mapAffix(row))
+	}
+	return affixes, nil
+}
+
+// SeedCatalog imports the affix catalog embedded in the binary, along with the
+// rotations of the seasons in it that were already opened. Seasons opened
+// later take their rotation from the seed as they open. It is safe to call on
+// every startup, the import is skipped when the database already holds the
+// same or a newer version.
+func (s *affixService) SeedCatalog(ctx context.Context) error {
+	catalog, err := parseAffixCatalog(affixSeed)
+	if err != nil {
+		return fmt.Errorf("embedded affix catalog: %w", err)
+	}
+	if err := isValidAffixCatalog(catalog); err != nil {
+		return fmt.Errorf("embedded affix catalog: %w", err)
+	}
+
+	return inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		return importAffixCatalog(ctx, q, catalog)
+	})
+}
+
+// importAffixCatalog replaces the affix catalog with a valid catalog, unless
+// the same or a newer version was imported already. Affixes are matched on
+// their code, those missing from the catalog are deactivated.
+func importAffixCatalog(ctx context.Context, q repo.Querier, catalog *AffixCatalog) error {
+	if err := q.LockCatalog(ctx, affixCatalogName); err != nil {
+		return err
+	}
+
+	current, err := q.GetCatalogVersion(ctx, affixCatalogName)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+	if catalog.Version <= current {
+		return nil
+	}
+
+	if err := q.DeactivateAffixes(ctx); err != nil {
+		return err
+	}
+	for _, a := range catalog.Affixes {
+		_, err := q.UpsertAffix(ctx, repo.UpsertAffixParams{
+			Code:        a.Code,
+			Name:        a.Name,
+			Description: a.Description,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, rotation := range catalog.Rotations {
+		season, err := loadSeason(ctx, q, rotation.Season)
+		if errors.Is(err, ErrSeasonNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := replaceAffixRotation(ctx, q, season.ID, rotation.Weeks); err != nil {
+			return err
+		}
+	}
+
+	return q.SetCatalogVersion(ctx, repo.SetCatalogVersionParams{
+		Catalog: affixCatalogName,
+		Version: catalog.Version,
+	})
+}
+
+// seedAffixRotation returns the rotation of the season with code in the
+// embedded seed, if it has one.
+func seedAffixRotation(code string) ([][]string, error) {
+	catalog, err := parseAffixCatalog(affixSeed)
+	if err != nil {
+		return nil, fmt.Errorf("embedded affix catalog: %w", err)
+	}
+	for _, rotation := range catalog.Rotations {
+		if rotation.Season == code {
+			return rotation.Weeks, nil
+		}
+	}
+	return nil, nil
+}
+
+// activeAffixes returns the codes of the affixes active at t, those of the
+// week of the open season's rotation that t is in. It is empty outside of the
+// season and while the season has no rotation.
+func activeAffixes(ctx context.Context, q repo.Querier, reset WeeklyReset, t time.Time) ([]string, error) {
+	season, err := loadSeason(ctx, q, "")
+	if errors.Is(err, ErrSeasonNotFound) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if t.Before(season.StartsAt) || (season.EndsAt != nil && !t.Before(*season.EndsAt)) {
+		return []string{}, nil
+	}
+
+	rotation, err := loadAffixRotation(ctx, q, season.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(rotation) == 0 {
+		return []string{}, nil
+	}
+	return rotationWeek(rotation, seasonWeekNumber(season, reset, t)), nil
+}
+
+// loadAffixRotation loads the rotation of the season with seasonID.
+func loadAffixRotation(ctx context.Context, q repo.Querier, seasonID int32) ([][]string, error) {
+	rows, err := q.GetSeasonAffixes(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+	rotation := make([][]string, 0, len(rows))
+	for _, row := range rows {
+		rotation = append(rotation, row.Affixes)
+	}
+	return rotation, nil
+}
+
+// rotationWeek returns the affixes of week n of a season, the rotation
+// starting over once every week of it was played.
+func rotationWeek(rotation [][]string, n int32) []string {
+	if len(rotation) == 0 {
+		return nil
+	}
+	return rotation[int(n-1)%len(rotation)]
+}
+
+// createAffixRotation stores the rotation of the season with seasonID.
+func createAffixRotation(ctx context.Context, q repo.Querier, seasonID int32, rotation [][]string) error {
+	for i, affixes := range rotation {
+		if err := q.CreateSeasonAffixes(ctx, repo.CreateSeasonAffixesParams{
+			SeasonID: seasonID,
+			Week:     int32(i + 1),
+			Affixes:  affixes,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replaceAffixRotation replaces the rotation of the season with seasonID.
+func replaceAffixRotation(ctx context.Context, q repo.Querier, seasonID int32, rotation [][]string) error {
+	if err := q.DeleteSeasonAffixes(ctx, seasonID); err != nil {
+		return err
+	}
+	return createAffixRotation(ctx, q, seasonID, rotation)
+}
+
+// checkAffixRotation returns ErrInvalidAffixRotation unless rotation only
+// lists affixes of the active catalog.
+func checkAffixRotation(ctx context.Context, q repo.Querier, rotation [][]string) error {
+	rows, err := q.GetAffixes(ctx)
+	if err != nil {
+		return err
+	}
+	known := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		known[row.Code] = true
+	}
+
+	for _, affixes := range rotation {
+		for _, code := range affixes {
+			if !known[code] {
+				return fmt.Errorf("%w: unknown affix %s", ErrInvalidAffixRotation, code)
+			}
+		}
+	}
+	return nil
+}
+
+// loadAffix loads the affix with code, which is matched case insensitively.
+func loadAffix(ctx context.Context, q repo.Querier, code string) (*Affix, error) {
+	affix, err := q.GetAffixByCode(ctx, strings.ToUpper(code))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrAffixNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return mapAffix(affix), nil
+}
+
+// parseAffixCatalog decodes a JSON affix catalog. It does not validate the contents.
+func parseAffixCatalog(data []byte) (*AffixCatalog, error) {
+	var catalog AffixCatalog
+	if err := json.Unmarshal(data, &catalog); err != nil {
+		return nil, err
+	}
+	return &catalog, nil
+}
+
+func mapAffix(a repo.Affix) *Affix {
+	return &Affix{
+		ID:          a.ID,
+		Code:        a.Code,
+		Name:        a.Name,
+		Description: a.Description,
+	}
+}
+
+var affixCodeRegex = regexp.MustCompile(`^[A-Z][A-Z_]{1,31}$`)
+
+func isValidAffixCatalog(catalog *AffixCatalog) error {
+	if catalog.Version < 1 || len(catalog.Affixes) == 0 {
+		return ErrInvalidCatalog
+	}
+
+	codes := make(map[string]bool)
+	for _, a := range catalog.Affixes {
+		if !affixCodeRegex.MatchString(a.Code) || strings.TrimSpace(a.Name) == "" {
+			return fmt.Errorf("%w: affix %s", ErrInvalidCatalog, a.Code)
+		}
+		if codes[a.Code] {
+			return fmt.Errorf("%w: duplicate affix %s", ErrInvalidCatalog, a.Code)
+		}
+		codes[a.Code] = true
+	}
+
+	seasons := make(map[string]bool)
+	for _, rotation := range catalog.Rotations {
+		if !seasonCodeRegex.MatchString(rotation.Season) || seasons[rotation.Season] {
+			return fmt.Errorf("%w: rotation of %s", ErrInvalidCatalog, rotation.Season)
+		}
+		seasons[rotation.Season] = true
+		if err := isValidAffixRotation(rotation.Weeks); err != nil {
+			return fmt.Errorf("%w: rotation of %s", err, rotation.Season)
+		}
+		for _, affixes := range rotation.Weeks {
+			for _, code := range affixes {
+				if !codes[code] {
+					return fmt.Errorf("%w: unknown affix %s in rotation of %s", ErrInvalidCatalog, code,
+						rotation.Season)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// isValidAffixRotation checks the shape of a rotation: up to a season of
+// weeks, each with a few distinct affix codes.
+func isValidAffixRotation(rotation [][]string) error {
+	if len(rotation) > maxSeasonWeeks {
+		return ErrInvalidAffixRotation
+	}
+	for _, affixes := range rotation {
+		if len(affixes) == 0 || len(affixes) > maxWeekAffixes {
+			return ErrInvalidAffixRotation
+		}
+		seen := make(map[string]bool, len(affixes))
+		for _, code := range affixes {
+			if !affixCodeRegex.MatchString(code) || seen[code] {
+				return ErrInvalidAffixRotation
+			}
+			seen[code] = true
+		}
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+func Test_isValidAffixCatalog(t *testing.T) {
+	seed, err := parseAffixCatalog(affixSeed)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	affixes := []Affix{{Code: "FORTIFIED", Name: "Fortified"}, {Code: "TYRANNICAL", Name: "Tyrannical"}}
+	tests := []struct {
+		name    string
+		catalog *AffixCatalog
+		wantErr error
+	}{
+		{"Embedded Seed", seed, nil},
+		{"Valid", &AffixCatalog{Version: 2, Affixes: affixes, Rotations: []AffixRotation{
+			{Season: "TWW-S2", Weeks: [][]string{{"FORTIFIED"}, {"TYRANNICAL"}}},
+		}}, nil},
+		{"No Version", &AffixCatalog{Affixes: affixes}, ErrInvalidCatalog},
+		{"Lower Case Code", &AffixCatalog{Version: 2, Affixes: []Affix{{Code: "fortified", Name: "Fortified"}}},
+			ErrInvalidCatalog},
+		{"Duplicate Affix", &AffixCatalog{Version: 2, Affixes: append(affixes, affixes[0])}, ErrInvalidCatalog},
+		{"Unknown Affix In Rotation", &AffixCatalog{Version: 2, Affixes: affixes, Rotations: []AffixRotation{
+			{Season: "TWW-S2", Weeks: [][]string{{"BURSTING"}}},
+		}}, ErrInvalidCatalog},
+		{"Duplicate Rotation", &AffixCatalog{Version: 2, Affixes: affixes, Rotations: []AffixRotation{
+			{Season: "TWW-S2", Weeks: [][]string{{"FORTIFIED"}}},
+			{Season: "TWW-S2", Weeks: [][]string{{"TYRANNICAL"}}},
+		}}, ErrInvalidCatalog},
+		{"Empty Week", &AffixCatalog{Version: 2, Affixes: affixes, Rotations: []AffixRotation{
+			{Season: "TWW-S2", Weeks: [][]string{{}}},
+		}}, ErrInvalidAffixRotation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, isValidAffixCatalog(tt.catalog), tt.wantErr)
+		})
+	}
+}
+
+func Test_importAffixCatalog(t *testing.T) {
+	ctx := context.Background()
+	catalog := &AffixCatalog{
+		Version: 2,
+		Affixes: []Affix{{Code: "FORTIFIED", Name: "Fortified"}, {Code: "TYRANNICAL", Name: "Tyrannical"}},
+		Rotations: []AffixRotation{
+			{Season: "TWW-S1", Weeks: [][]string{{"TYRANNICAL"}, {"FORTIFIED"}}},
+			{Season: "TWW-S2", Weeks: [][]string{{"FORTIFIED"}}},
+		},
+	}
+
+	t.Run("Imported", func(t *testing.T) {
+		mockq := repo.NewMockQuerier(t)
+		mockq.EXPECT().LockCatalog(ctx, "affixes").Return(nil)
+		mockq.EXPECT().GetCatalogVersion(ctx, "affixes").Return(1, nil)
+		mockq.EXPECT().DeactivateAffixes(ctx).Return(nil)
+		for _, a := range catalog.Affixes {
+			mockq.EXPECT().UpsertAffix(ctx, repo.UpsertAffixParams{Code: a.Code, Name: a.Name}).Return(repo.Affix{}, nil)
+		}
+		// Only seasons that were opened take their rotation.
+		mockq.EXPECT().GetSeasonByCode(ctx, "TWW-S1").Return(repo.Season{ID: 1, Code: "TWW-S1"}, nil)
+		mockq.EXPECT().GetSeasonByCode(ctx, "TWW-S2").Return(repo.Season{}, pgx.ErrNoRows)
+		mockq.EXPECT().DeleteSeasonAffixes(ctx, int32(1)).Return(nil)
+		mockq.EXPECT().CreateSeasonAffixes(ctx, repo.CreateSeasonAffixesParams{SeasonID: 1, Week: 1,
+			Affixes: []string{"TYRANNICAL"}}).Return(nil)
+		mockq.EXPECT().CreateSeasonAffixes(ctx, repo.CreateSeasonAffixesParams{SeasonID: 1, Week: 2,
+			Affixes: []string{"FORTIFIED"}}).Return(nil)
+		mockq.EXPECT().SetCatalogVersion(ctx, repo.SetCatalogVersionParams{Catalog: "affixes", Version: 2}).Return(nil)
+
+		assert.NoError(t, importAffixCatalog(ctx, mockq, catalog))
+	})
+
+	t.Run("Already Imported", func(t *testing.T) {
+		mockq := repo.NewMockQuerier(t)
+		mockq.EXPECT().LockCatalog(ctx, "affixes").Return(nil)
+		mockq.EXPECT().GetCatalogVersion(ctx, "affixes").Return(2, nil)
+
+		assert.NoError(t, importAffixCatalog(ctx, mockq, catalog))
+	})
+}
+
+func Test_activeAffixes(t *testing.T) {
+	ctx := context.Background()
+	endsAt := utc(2026, 12, 15, 16, 0)
+	season := repo.Season{ID: 3, Code: "MN-S1", StartsAt: pgTimestamptz(utc(2026, 9, 15, 15, 0)),
+		EndsAt: pgTimestamptz(endsAt)}
+	rotation := []repo.SeasonAffix{
+		{SeasonID: 3, Week: 1, Affixes: []string{"ASCENDANT", "TYRANNICAL"}},
+		{SeasonID: 3, Week: 2, Affixes: []string{"OBLIVION", "FORTIFIED"}},
+		{SeasonID: 3, Week: 3, Affixes: []string{"VOIDBOUND", "TYRANNICAL"}},
+	}
+
+	tests := []struct {
+		name     string
+		at       time.Time
+		noSeason bool
+		rotation []repo.SeasonAffix
+		want     []string
+	}{
+		{"First Week", utc(2026, 9, 15, 20, 0), false, rotation, []string{"ASCENDANT", "TYRANNICAL"}},
+		{"Third Week", utc(2026, 9, 29, 15, 0), false, rotation, []string{"VOIDBOUND", "TYRANNICAL"}},
+		// Week 6 of the season is the third of its second rotation.
+		{"Rotation Wraps", utc(2026, 10, 20, 15, 0), false, rotation, []string{"VOIDBOUND", "TYRANNICAL"}},
+		{"Before Season", utc(2026, 9, 15, 14, 0), false, nil, []string{}},
+		{"After Season", endsAt, false, nil, []string{}},
+		{"No Rotation", utc(2026, 9, 15, 20, 0), false, []repo.SeasonAffix{}, []string{}},
+		{"No Open Season", utc(2026, 9, 15, 20, 0), true, nil, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockq := repo.NewMockQuerier(t)
+			if tt.noSeason {
+				mockq.EXPECT().GetOpenSeason(ctx).Return(repo.Season{}, pgx.ErrNoRows)
+			} else {
+				mockq.EXPECT().GetOpenSeason(ctx).Return(season, nil)
+			}
+			if tt.rotation != nil {
+				mockq.EXPECT().GetSeasonAffixes(ctx, int32(3)).Return(tt.rotation, nil)
+			}
+
+			got, err := activeAffixes(ctx, mockq, regionResets[RegionUS], tt.at)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
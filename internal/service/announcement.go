@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tmaffia/dungeon-time-api/internal/discord"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// AnnouncementKind is the change to a run that is announced to its guild.
+type AnnouncementKind string
+
+const (
+	AnnouncementRunCreated   = AnnouncementKind("run.created")
+	AnnouncementRunFilled    = AnnouncementKind("run.filled")
+	AnnouncementRunUpdated   = AnnouncementKind("run.updated")
+	AnnouncementRunCancelled = AnnouncementKind("run.cancelled")
+)
+
+// Announcement statuses.
+const (
+	announcementPending   = "pending"
+	announcementDelivered = "delivered"
+	announcementFailed    = "failed"
+)
+
+// Delivery limits for announcements, see the notification limits.
+const (
+	announcementBatchSize   = 50
+	maxAnnouncementAttempts = 5
+	announcementRetryDelay  = 30 * time.Second
+	announcementLease       = 5 * time.Minute
+	// maxEmbedNotes keeps run notes well under Discord's embed description limit.
+	maxEmbedNotes = 1000
+)
+
+// discordWebhookHosts are the hosts Discord serves webhooks from.
+var discordWebhookHosts = []string{"discord.com", "discordapp.com", "canary.discord.com", "ptb.discord.com"}
+
+// AnnouncementService is the interface for delivering queued announcements of
+// runs to guild Discord channels.
+type AnnouncementService interface {
+	DeliverPending(context.Context) (int, error)
+}
+
+// announcementService is the implementation of AnnouncementService.
+type announcementService struct {
+	announcementRepo repo.Querier
+	discord          *discord.Client
+	now              func() time.Time
+}
+
+// NewAnnouncementService creates a new announcementService with the provided database
+// connection pool, posting to Discord with client.
+// It returns a pointer to the announcementService.
+func NewAnnouncementService(dbPool *pgxpool.Pool, client *discord.Client) *announcementService {
+	return &announcementService{
+		announcementRepo: repo.New(dbPool),
+		discord:          client,
+		now:              time.Now,
+	}
+}
+
+// DeliverPending posts announcements that are due, up to announcementBatchSize.
+// Each announcement is leased like a notification before it is posted, so no
+// transaction is held open while Discord answers. It returns the number of
+// announcements handled.
+func (s *announcementService) DeliverPending(ctx context.Context) (int, error) {
+	q := s.announcementRepo
+	handled := 0
+	for handled < announcementBatchSize {
+		now := s.now()
+		rows, err := q.ClaimGuildAnnouncements(ctx, repo.ClaimGuildAnnouncementsParams{
+			LeasedUntil: pgTimestamptz(now.Add(announcementLease)),
+			Now:         pgTimestamptz(now),
+			BatchSize:   1,
+		})
+		if err != nil {
+			return handled, err
+		}
+		if len(rows) == 0 {
+			break
+		}
+		if err := s.deliver(ctx, q, rows[0]); errors.Is(err, errLeaseLost) {
+			log.Printf("delivering announcement %d: %v", rows[0].ID, err)
+		} else if err != nil {
+			return handled, err
+		}
+		handled++
+	}
+	return handled, nil
+}
+
+// Work posts pending announcements every interval until ctx is done.
+func (s *announcementService) Work(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.DeliverPending(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("delivering announcements: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// deliver posts a claimed announcement with the run as it is now. When Discord
+// rate limits the webhook, every pending announcement for the guild waits
+// until the limit resets, without counting as a failed attempt. Other failures
+// are retried with backoff unless Discord rejected the request outright. The
+// outcome is only recorded while a is still leased, otherwise errLeaseLost is returned.
+func (s *announcementService) deliver(ctx context.Context, q repo.Querier, a repo.GuildAnnouncement) error {
+	now := s.now()
+	params := repo.UpdateGuildAnnouncementDeliveryParams{
+		ID:           a.ID,
+		Status:       announcementDelivered,
+		Attempts:     a.Attempts + 1,
+		DeliverAfter: a.DeliverAfter,
+		DeliveredAt:  pgTimestamptz(now),
+		LeasedUntil:  a.DeliverAfter,
+	}
+
+	msg, webhookURL, err := s.render(ctx, q, a)
+	if err != nil {
+		return err
+	}
+	if webhookURL == "" {
+		params.Status = announcementFailed
+		params.DeliveredAt = pgtype.Timestamptz{}
+		params.LastError = "guild has no discord webhook"
+		return leaseHeld(q.UpdateGuildAnnouncementDelivery(ctx, params))
+	}
+
+	err = s.discord.ExecuteWebhook(ctx, webhookURL, msg)
+	if err == nil {
+		return leaseHeld(q.UpdateGuildAnnouncementDelivery(ctx, params))
+	}
+
+	// Only a and the guild's announcements that are due wait, the ones other
+	// workers have leased are deferred by them when they are rate limited too.
+	var rateLimit *discord.RateLimitError
+	if errors.As(err, &rateLimit) {
+		return q.DeferGuildAnnouncements(ctx, repo.DeferGuildAnnouncementsParams{
+			DeliverAfter: pgTimestamptz(now.Add(rateLimit.RetryAfter)),
+			GuildID:      a.GuildID,
+			Now:          pgTimestamptz(now),
+			ID:           a.ID,
+			LeasedUntil:  a.DeliverAfter,
+		})
+	}
+
+	params.LastError = err.Error()
+	params.DeliveredAt = pgtype.Timestamptz{}
+	params.Status = announcementPending
+	params.DeliverAfter = pgTimestamptz(now.Add(announcementRetryDelay << (params.Attempts - 1)))
+
+	var status *discord.StatusError
+	if (errors.As(err, &status) && !status.Temporary()) || params.Attempts >= maxAnnouncementAttempts {
+		params.Status = announcementFailed
+	}
+	return leaseHeld(q.UpdateGuildAnnouncementDelivery(ctx, params))
+}
+
+// render builds the message for an announcement and returns it with the
+// webhook URL of the guild.
+func (s *announcementService) render(ctx context.Context, q repo.Querier,
+	a repo.GuildAnnouncement) (*discord.WebhookMessage, string, error) {
+	guild, err := q.GetGuildByID(ctx, a.GuildID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	row, err := q.GetRunByID(ctx, a.RunID)
+	if err != nil {
+		return nil, "", err
+	}
+	run := mapRun(row.Run, row.Dungeon)
+
+	var roster *Roster
+	if AnnouncementKind(a.Kind) != AnnouncementRunCancelled {
+		if roster, err = loadRoster(ctx, q, row.Run); err != nil {
+			return nil, "", err
+		}
+	}
+
+	return &discord.WebhookMessage{
+		Embeds:          []discord.Embed{runEmbed(AnnouncementKind(a.Kind), run, roster)},
+		AllowedMentions: &discord.AllowedMentions{Parse: []string{}},
+	}, guild.DiscordWebhookUrl, nil
+}
+
+// runEmbed builds the embed announcing a change to run. The open slots are
+// left out when roster is nil.
+func runEmbed(kind AnnouncementKind, run *Run, roster *Roster) discord.Embed {
+	var title string
+	var color int
+	switch kind {
+	case AnnouncementRunCreated:
+		title, color = "New run", discord.ColorBlurple
+	case AnnouncementRunFilled:
+		title, color = "Run full", discord.ColorGreen
+	case AnnouncementRunUpdated:
+		title, color = "Run updated", discord.ColorYellow
+	case AnnouncementRunCancelled:
+		title, color = "Run cancelled", discord.ColorRed
+	}
+
+	difficulty := string(run.Difficulty)
+	if run.KeyLevel != nil {
+		difficulty = fmt.Sprintf("%s %d", run.Difficulty, *run.KeyLevel)
+	}
+
+	embed := discord.Embed{
+		Title:       fmt.Sprintf("%s: %s", title, runTitle(run)),
+		Description: truncate(run.Notes, maxEmbedNotes),
+		Color:       color,
+		Fields: []discord.EmbedField{
+			{Name: "Dungeon", Value: run.Dungeon.Name, Inline: true},
+			{Name: "Difficulty", Value: difficulty, Inline: true},
+			{Name: "Duration", Value: fmt.Sprintf("%d minutes", run.DurationMinutes), Inline: true},
+			{Name: "Starts", Value: fmt.Sprintf("%s (%s)",
+				discord.Timestamp(run.StartsAt, "F"), discord.Timestamp(run.StartsAt, "R"))},
+		},
+		Footer:    &discord.EmbedFooter{Text: fmt.Sprintf("Run #%d", run.ID)},
+		Timestamp: run.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+	if roster != nil {
+		for _, slots := range roster.Slots {
+			embed.Fields = append(embed.Fields, discord.EmbedField{
+				Name:   string(slots.Role),
+				Value:  fmt.Sprintf("%d of %d open", slots.Open, slots.Total),
+				Inline: true,
+			})
+		}
+	}
+	return embed
+}
+
+// announceRun queues an announcement of a change to the run with runID for
+// its guild. Nothing is queued for runs without a guild or for guilds without
+// a Discord webhook. Call it with the Querier of the transaction making the change.
+func announceRun(ctx context.Context, q repo.Querier, runID int32, guildID *int32, kind AnnouncementKind) error {
+	if guildID == nil {
+		return nil
+	}
+	return q.CreateGuildAnnouncement(ctx, repo.CreateGuildAnnouncementParams{
+		RunID:   runID,
+		Kind:    string(kind),
+		GuildID: *guildID,
+	})
+}
+
+// isValidDiscordWebhook reports whether rawURL is a Discord webhook URL.
+// Only Discord's own hosts are allowed so guild admins can not make the
+// server post to arbitrary addresses.
+func isValidDiscordWebhook(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != "https" || u.User != nil || u.Port() != "" {
+		return false
+	}
+	for _, host := range discordWebhookHosts {
+		if u.Host == host {
+			return strings.HasPrefix(u.Path, "/api/webhooks/")
+		}
+	}
+	return false
+}
+
+// truncate shortens s to at most n runes, ending with an ellipsis when cut.
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-1]) + "…"
+}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// Attendance is whether a member who signed up for a run showed up for it.
+type Attendance string
+
+const (
+	AttendanceAttended = Attendance("attended")
+	AttendanceLate     = Attendance("late")
+	AttendanceNoShow   = Attendance("no_show")
+	// AttendanceWithdrew is never marked, it is given to signups withdrawn
+	// before the run.
+	AttendanceWithdrew = Attendance("withdrew")
+)
+
+// markableAttendance is the attendance a leader can mark confirmed signups with.
+var markableAttendance = []Attendance{AttendanceAttended, AttendanceLate, AttendanceNoShow}
+
+// finishedStatuses are the statuses of runs whose attendance can be marked.
+var finishedStatuses = []RunStatus{RunStatusCompleted, RunStatusDepleted, RunStatusAbandoned}
+
+// AttendanceMark is a leader's record of whether a member showed up.
+type AttendanceMark struct {
+	UserID     int32      `json:"user_id"`
+	Attendance Attendance `json:"attendance"`
+}
+
+// AttendanceRecord is the attendance of a member that signed up for a run.
+// Attendance is nil until the leader marks it. NoticeHours is how long before
+// the start a member withdrew.
+type AttendanceRecord struct {
+	UserID      int32       `json:"user_id"`
+	Username    string      `json:"username"`
+	Role        UserRole    `json:"role"`
+	Attendance  *Attendance `json:"attendance"`
+	NoticeHours *float64    `json:"notice_hours,omitempty"`
+}
+
+// GetAttendance returns the attendance of everyone that held a slot in a run
+// or withdrew from it.
+func (s *runService) GetAttendance(ctx context.Context, runID int32) ([]*AttendanceRecord, error) {
+	if _, err := s.runRepo.GetRunByID(ctx, runID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrRunNotFound
+		}
+		return nil, err
+	}
+	return loadAttendance(ctx, s.runRepo, runID)
+}
+
+// MarkAttendance corrects the attendance of a finished run. Leaders usually
+// mark it as they close the run, see TransitionRun. Only the organizer can mark
+// attendance and only for members holding a slot.
+func (s *runService) MarkAttendance(ctx context.Context, actorID, runID int32,
+	marks []AttendanceMark) ([]*AttendanceRecord, error) {
+	if err := checkAttendanceMarks(marks); err != nil {
+		return nil, err
+	}
+
+	var records []*AttendanceRecord
+	err := inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		run, err := q.LockRun(ctx, runID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrRunNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if run.OrganizerID != actorID {
+			return ErrForbidden
+		}
+		if !slices.Contains(finishedStatuses, RunStatus(run.Status)) {
+			return ErrRunNotFinished
+		}
+
+		if err := markAttendance(ctx, q, runID, marks); err != nil {
+			return err
+		}
+		records, err = loadAttendance(ctx, q, runID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// markAttendance stores marks for the run with runID. Returns
+// ErrSignupNotFound if a marked member does not hold a slot in the run.
+func markAttendance(ctx context.Context, q repo.Querier, runID int32, marks []AttendanceMark) error {
+	for _, mark := range marks {
+		n, err := q.MarkAttendance(ctx, repo.MarkAttendanceParams{
+			RunID:      runID,
+			UserID:     mark.UserID,
+			Attendance: pgtype.Text{String: string(mark.Attendance), Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return fmt.Errorf("%w: user %d", ErrSignupNotFound, mark.UserID)
+		}
+	}
+	return nil
+}
+
+// checkAttendanceMarks returns ErrInvalidAttendance unless every mark is one a
+// leader can make, for a different member.
+func checkAttendanceMarks(marks []AttendanceMark) error {
+	seen := make(map[int32]bool, len(marks))
+	for _, mark := range marks {
+		if !slices.Contains(markableAttendance, mark.Attendance) {
+			return fmt.Errorf("%w: %q", ErrInvalidAttendance, mark.Attendance)
+		}
+		if seen[mark.UserID] {
+			return fmt.Errorf("%w: user %d marked twice", ErrInvalidAttendance, mark.UserID)
+		}
+		seen[mark.UserID] = true
+	}
+	return nil
+}
+
+func loadAttendance(ctx context.Context, q repo.Querier, runID int32) ([]*AttendanceRecord, error) {
+	rows, err := q.GetRunAttendance(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*AttendanceRecord, 0, len(rows))
+	withdrawn := make(map[int32]bool)
+	for _, row := range rows {
+		record := &AttendanceRecord{
+			UserID:   row.UserID,
+			Username: row.Username,
+			Role:     UserRole(row.Role),
+		}
+		if SignupStatus(row.Status) == SignupStatusWithdrawn {
+			// Users can sign up and withdraw again, keep their latest withdrawal.
+			if withdrawn[row.UserID] {
+				continue
+			}
+			withdrawn[row.UserID] = true
+			withdrew := AttendanceWithdrew
+			hours := math.Round(noticeBefore(row.WithdrawnAt, row.StartsAt).Hours()*10) / 10
+			record.Attendance = &withdrew
+			record.NoticeHours = &hours
+		} else if row.Attendance.Valid {
+			attendance := Attendance(row.Attendance.String)
+			record.Attendance = &attendance
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// noticeBefore returns how long before startsAt a signup was withdrawn at
+// withdrawnAt, 0 for withdrawals after the start.
+func noticeBefore(withdrawnAt, startsAt pgtype.Timestamptz) time.Duration {
+	return max(startsAt.Time.Sub(withdrawnAt.Time), 0)
+}
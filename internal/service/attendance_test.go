@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+func Test_checkAttendanceMarks(t *testing.T) {
+	tests := []struct {
+		name    string
+		marks   []AttendanceMark
+		wantErr error
+	}{
+		{"None", nil, nil},
+		{"Marked", []AttendanceMark{{5, AttendanceAttended}, {6, AttendanceLate}, {7, AttendanceNoShow}}, nil},
+		{"Withdrew Is Not Marked", []AttendanceMark{{5, AttendanceWithdrew}}, ErrInvalidAttendance},
+		{"Unknown", []AttendanceMark{{5, "afk"}}, ErrInvalidAttendance},
+		{"Marked Twice", []AttendanceMark{{5, AttendanceAttended}, {5, AttendanceLate}}, ErrInvalidAttendance},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, checkAttendanceMarks(tt.marks), tt.wantErr)
+		})
+	}
+}
+
+func Test_markAttendance(t *testing.T) {
+	ctx := context.Background()
+	mockq := repo.NewMockQuerier(t)
+	mockq.EXPECT().MarkAttendance(ctx, repo.MarkAttendanceParams{RunID: 1, UserID: 5,
+		Attendance: pgtype.Text{String: "attended", Valid: true}}).Return(1, nil)
+	mockq.EXPECT().MarkAttendance(ctx, repo.MarkAttendanceParams{RunID: 1, UserID: 6,
+		Attendance: pgtype.Text{String: "no_show", Valid: true}}).Return(0, nil)
+
+	err := markAttendance(ctx, mockq, 1, []AttendanceMark{{5, AttendanceAttended}, {6, AttendanceNoShow}})
+	assert.ErrorIs(t, err, ErrSignupNotFound)
+}
+
+func Test_loadAttendance(t *testing.T) {
+	ctx := context.Background()
+	startsAt := pgTimestamptz(utc(2025, 3, 5, 20, 0))
+	mockq := repo.NewMockQuerier(t)
+	mockq.EXPECT().GetRunAttendance(ctx, int32(1)).Return([]repo.GetRunAttendanceRow{
+		{UserID: 5, Username: "jaina", Role: "Healer", Status: "confirmed", StartsAt: startsAt,
+			Attendance: pgtype.Text{String: "late", Valid: true}},
+		{UserID: 6, Username: "thrall", Role: "Tank", Status: "confirmed", StartsAt: startsAt},
+		{UserID: 7, Username: "varian", Role: "DPS", Status: "withdrawn", StartsAt: startsAt,
+			WithdrawnAt: pgTimestamptz(utc(2025, 3, 5, 14, 30))},
+		{UserID: 7, Username: "varian", Role: "Tank", Status: "withdrawn", StartsAt: startsAt,
+			WithdrawnAt: pgTimestamptz(utc(2025, 3, 4, 20, 0))},
+	}, nil)
+
+	late, withdrew := AttendanceLate, AttendanceWithdrew
+	notice := 5.5
+	records, err := loadAttendance(ctx, mockq, 1)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []*AttendanceRecord{
+			{UserID: 5, Username: "jaina", Role: RoleHealer, Attendance: &late},
+			{UserID: 6, Username: "thrall", Role: RoleTank},
+			{UserID: 7, Username: "varian", Role: RoleDPS, Attendance: &withdrew, NoticeHours: &notice},
+		}, records)
+	}
+}
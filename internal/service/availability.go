@@ -0,0 +1,670 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// Limits on best time searches. Candidate start times are every candidateStep,
+// which lines up with every timezone offset in use.
+const (
+	maxBestTimeRange = 14 * 24 * time.Hour
+	candidateStep    = 15 * time.Minute
+	maxCandidates    = 10
+	minutesPerDay    = 24 * 60
+)
+
+// clockLayout is the layout of the start and end of a weekly window.
+const clockLayout = "15:04"
+
+// WeeklyWindow is a time a user is usually free every week, as a wall clock range
+// in the user's timezone. End may be "24:00" for a window that lasts until midnight.
+// Windows can not cross midnight, split them into two windows instead.
+type WeeklyWindow struct {
+	Weekday string `json:"weekday"`
+	Start   string `json:"start"`
+	End     string `json:"end"`
+}
+
+// AvailabilityException is a one-off change to a user's availability. Available
+// is false for time the user is busy and true for extra time outside their weekly windows.
+type AvailabilityException struct {
+	ID        int32     `json:"id"`
+	StartsAt  time.Time `json:"starts_at"`
+	EndsAt    time.Time `json:"ends_at"`
+	Available bool      `json:"available"`
+}
+
+// AvailabilityExceptionInput holds the client provided fields used to add an exception.
+// StartsAt and EndsAt are parsed like run start times, in the user's timezone.
+type AvailabilityExceptionInput struct {
+	StartsAt  string `json:"starts_at"`
+	EndsAt    string `json:"ends_at"`
+	Available bool   `json:"available"`
+}
+
+// Availability is when a user is free to play. Exceptions only include
+// those that have not ended yet.
+type Availability struct {
+	UserID     int32                    `json:"user_id"`
+	Timezone   string                   `json:"timezone"`
+	Windows    []WeeklyWindow           `json:"windows"`
+	Exceptions []*AvailabilityException `json:"exceptions"`
+}
+
+// BestTimeQuery asks for start times where a group can be formed from either
+// the members of a guild or a set of users.
+type BestTimeQuery struct {
+	GuildID         *int32
+	UserIDs         []int32
+	Composition     Composition
+	From            time.Time
+	To              time.Time
+	DurationMinutes int32
+}
+
+// RoleAssignment is a user filling a role in a proposed group.
+type RoleAssignment struct {
+	UserID int32    `json:"user_id"`
+	Role   UserRole `json:"role"`
+}
+
+// CandidateTime is a start time where enough players are free for the whole run.
+// Available lists everyone free by the role they can play, Group is one way
+// to fill the composition from them.
+type CandidateTime struct {
+	StartsAt       time.Time            `json:"starts_at"`
+	EndsAt         time.Time            `json:"ends_at"`
+	AvailableCount int                  `json:"available_count"`
+	Available      map[UserRole][]int32 `json:"available"`
+	Group          []RoleAssignment     `json:"group"`
+}
+
+// AvailabilityService is the interface for member availability and finding
+// times that suit a group.
+type AvailabilityService interface {
+	GetAvailability(context.Context, int32) (*Availability, error)
+	SetWeeklyWindows(context.Context, int32, int32, []WeeklyWindow) (*Availability, error)
+	AddException(context.Context, int32, int32, *AvailabilityExceptionInput) (*AvailabilityException, error)
+	DeleteException(context.Context, int32, int32, int32) error
+	FindBestTimes(context.Context, *BestTimeQuery) ([]*CandidateTime, error)
+}
+
+// availabilityService is the implementation of AvailabilityService.
+type availabilityService struct {
+	dbPool           *pgxpool.Pool
+	availabilityRepo repo.Querier
+	now              func() time.Time
+}
+
+// NewAvailabilityService creates a new availabilityService with the provided database connection pool.
+// It returns a pointer to the availabilityService.
+func NewAvailabilityService(dbPool *pgxpool.Pool) *availabilityService {
+	return &availabilityService{
+		dbPool:           dbPool,
+		availabilityRepo: repo.New(dbPool),
+		now:              time.Now,
+	}
+}
+
+// GetAvailability returns a user's weekly windows and upcoming exceptions.
+func (s *availabilityService) GetAvailability(ctx context.Context, userID int32) (*Availability, error) {
+	user, err := s.availabilityRepo.GetUserByID(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	windows, err := s.availabilityRepo.GetAvailabilityWindows(ctx, []int32{userID})
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	exceptions, err := s.availabilityRepo.GetAvailabilityExceptions(ctx, repo.GetAvailabilityExceptionsParams{
+		UserIds:      []int32{userID},
+		StartsAfter:  pgTimestamptz(now),
+		StartsBefore: pgTimestamptz(now.AddDate(1, 0, 0)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	availability := &Availability{
+		UserID:     userID,
+		Timezone:   user.Timezone,
+		Windows:    make([]WeeklyWindow, 0, len(windows)),
+		Exceptions: make([]*AvailabilityException, 0, len(exceptions)),
+	}
+	for _, w := range windows {
+		availability.Windows = append(availability.Windows, mapWeeklyWindow(w))
+	}
+	for _, e := range exceptions {
+		availability.Exceptions = append(availability.Exceptions, mapAvailabilityException(e))
+	}
+	return availability, nil
+}
+
+// SetWeeklyWindows replaces all of a user's weekly windows. Users can only
+// change their own availability.
+func (s *availabilityService) SetWeeklyWindows(ctx context.Context, actorID, userID int32,
+	windows []WeeklyWindow) (*Availability, error) {
+	if actorID != userID {
+		return nil, ErrForbidden
+	}
+
+	params := make([]repo.CreateAvailabilityWindowParams, 0, len(windows))
+	for _, w := range windows {
+		p, err := parseWeeklyWindow(w)
+		if err != nil {
+			return nil, err
+		}
+		p.UserID = userID
+		params = append(params, p)
+	}
+
+	err := inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		if err := q.DeleteAvailabilityWindows(ctx, userID); err != nil {
+			return err
+		}
+		for _, p := range params {
+			if _, err := q.CreateAvailabilityWindow(ctx, p); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetAvailability(ctx, userID)
+}
+
+// AddException adds a one-off change to a user's availability. Users can only
+// change their own availability.
+func (s *availabilityService) AddException(ctx context.Context, actorID, userID int32,
+	input *AvailabilityExceptionInput) (*AvailabilityException, error) {
+	if actorID != userID {
+		return nil, ErrForbidden
+	}
+
+	user, err := s.availabilityRepo.GetUserByID(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := time.LoadLocation(user.Timezone)
+	if err != nil {
+		return nil, ErrInvalidTimezone
+	}
+
+	startsAt, err := parseStartTime(input.StartsAt, loc)
+	if err != nil {
+		return nil, err
+	}
+	endsAt, err := parseStartTime(input.EndsAt, loc)
+	if err != nil {
+		return nil, err
+	}
+	if !endsAt.After(startsAt) || !endsAt.After(s.now()) {
+		return nil, ErrInvalidAvailability
+	}
+
+	e, err := s.availabilityRepo.CreateAvailabilityException(ctx, repo.CreateAvailabilityExceptionParams{
+		UserID:    userID,
+		StartsAt:  pgTimestamptz(startsAt),
+		EndsAt:    pgTimestamptz(endsAt),
+		Available: input.Available,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mapAvailabilityException(e), nil
+}
+
+// DeleteException removes one of a user's exceptions. Users can only change
+// their own availability.
+func (s *availabilityService) DeleteException(ctx context.Context, actorID, userID, exceptionID int32) error {
+	if actorID != userID {
+		return ErrForbidden
+	}
+
+	deleted, err := s.availabilityRepo.DeleteAvailabilityException(ctx, repo.DeleteAvailabilityExceptionParams{
+		ID:     exceptionID,
+		UserID: userID,
+	})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrAvailabilityNotFound
+	}
+	return nil
+}
+
+// FindBestTimes returns the start times in [From, To) where enough of the
+// players are free for the whole run to fill the composition. Times with the
+// most players free come first, so there is room for someone dropping out,
+// then earlier times first.
+func (s *availabilityService) FindBestTimes(ctx context.Context, query *BestTimeQuery) ([]*CandidateTime, error) {
+	if !query.To.After(query.From) || query.To.Sub(query.From) > maxBestTimeRange {
+		return nil, ErrInvalidTimeRange
+	}
+	if !isValidRunDuration(query.DurationMinutes) {
+		return nil, ErrInvalidDuration
+	}
+	if !isValidComposition(query.Composition) {
+		return nil, ErrInvalidComposition
+	}
+
+	players, err := loadPlayers(ctx, s.availabilityRepo, query)
+	if err != nil {
+		return nil, err
+	}
+	if err := loadFreeTime(ctx, s.availabilityRepo, players, query.From, query.To); err != nil {
+		return nil, err
+	}
+
+	duration := time.Duration(query.DurationMinutes) * time.Minute
+	return bestTimes(players, query.Composition, query.From, query.To, duration), nil
+}
+
+// player is someone considered by a best time search.
+type player struct {
+	userID int32
+	roles  []UserRole
+	loc    *time.Location
+	free   []interval
+}
+
+// loadPlayers loads the guild members or users a query is about.
+func loadPlayers(ctx context.Context, q repo.Querier, query *BestTimeQuery) ([]*player, error) {
+	var players []*player
+	if query.GuildID != nil {
+		if _, err := q.GetGuildByID(ctx, *query.GuildID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, ErrGuildNotFound
+			}
+			return nil, err
+		}
+
+		members, err := q.GetGuildMembers(ctx, *query.GuildID)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range members {
+			players = append(players, newPlayer(m.UserID, m.UserRoles, m.Timezone))
+		}
+		return players, nil
+	}
+
+	if len(query.UserIDs) == 0 {
+		return nil, fmt.Errorf("%w: a guild or users are required", ErrInvalidUser)
+	}
+
+	users, err := q.GetUsersByIDs(ctx, query.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range query.UserIDs {
+		if !slices.ContainsFunc(users, func(u repo.GetUsersByIDsRow) bool { return u.ID == id }) {
+			return nil, fmt.Errorf("%w: %d", ErrUserNotFound, id)
+		}
+	}
+	for _, u := range users {
+		players = append(players, newPlayer(u.ID, u.Roles, u.Timezone))
+	}
+	return players, nil
+}
+
+// loadFreeTime loads when each of players is free between from and to.
+func loadFreeTime(ctx context.Context, q repo.Querier, players []*player, from, to time.Time) error {
+	// Load one day more on each side so windows around the edges of the range
+	// are complete in every timezone.
+	from, to = from.Add(-24*time.Hour), to.Add(24*time.Hour)
+	ids := make([]int32, 0, len(players))
+	for _, p := range players {
+		ids = append(ids, p.userID)
+	}
+
+	windows, err := q.GetAvailabilityWindows(ctx, ids)
+	if err != nil {
+		return err
+	}
+	exceptions, err := q.GetAvailabilityExceptions(ctx, repo.GetAvailabilityExceptionsParams{
+		UserIds:      ids,
+		StartsAfter:  pgTimestamptz(from),
+		StartsBefore: pgTimestamptz(to),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, p := range players {
+		p.free = freeIntervals(
+			filterByUser(windows, p.userID, func(w repo.AvailabilityWindow) int32 { return w.UserID }),
+			filterByUser(exceptions, p.userID, func(e repo.AvailabilityException) int32 { return e.UserID }),
+			p.loc, from, to)
+	}
+	return nil
+}
+
+func newPlayer(userID int32, roles []string, timezone string) *player {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	return &player{userID: userID, roles: playerRoles(roles), loc: loc}
+}
+
+// playerRoles returns the combat roles in roles.
+func playerRoles(roles []string) []UserRole {
+	var combat []UserRole
+	for _, role := range roles {
+		if isCombatRole(UserRole(role)) {
+			combat = append(combat, UserRole(role))
+		}
+	}
+	return combat
+}
+
+// interval is a half open range of time [start, end).
+type interval struct {
+	start time.Time
+	end   time.Time
+}
+
+// freeIntervals returns when a user is free between from and to, in order and
+// without overlaps. Weekly windows are placed in loc one local day at a time,
+// so they keep their wall clock times across DST changes.
+func freeIntervals(windows []repo.AvailabilityWindow, exceptions []repo.AvailabilityException,
+	loc *time.Location, from, to time.Time) []interval {
+	var free []interval
+	last := floating(to, loc)
+	for day := floating(from, loc).Truncate(24 * time.Hour); !day.After(last); day = day.AddDate(0, 0, 1) {
+		for _, w := range windows {
+			if time.Weekday(w.Weekday) != day.Weekday() {
+				continue
+			}
+			free = append(free, interval{
+				start: localize(day.Add(time.Duration(w.StartMinute)*time.Minute), loc),
+				end:   localize(day.Add(time.Duration(w.EndMinute)*time.Minute), loc),
+			})
+		}
+	}
+
+	var busy []interval
+	for _, e := range exceptions {
+		i := interval{start: e.StartsAt.Time, end: e.EndsAt.Time}
+		if e.Available {
+			free = append(free, i)
+		} else {
+			busy = append(busy, i)
+		}
+	}
+
+	free = mergeIntervals(free)
+	for _, b := range busy {
+		free = subtractInterval(free, b)
+	}
+	return free
+}
+
+// mergeIntervals sorts intervals and joins those that overlap or touch.
+func mergeIntervals(intervals []interval) []interval {
+	slices.SortFunc(intervals, func(a, b interval) int { return a.start.Compare(b.start) })
+
+	var merged []interval
+	for _, i := range intervals {
+		if n := len(merged); n > 0 && !i.start.After(merged[n-1].end) {
+			if i.end.After(merged[n-1].end) {
+				merged[n-1].end = i.end
+			}
+			continue
+		}
+		merged = append(merged, i)
+	}
+	return merged
+}
+
+// subtractInterval removes b from every interval.
+func subtractInterval(intervals []interval, b interval) []interval {
+	var result []interval
+	for _, i := range intervals {
+		if !b.start.Before(i.end) || !b.end.After(i.start) {
+			result = append(result, i)
+			continue
+		}
+		if i.start.Before(b.start) {
+			result = append(result, interval{start: i.start, end: b.start})
+		}
+		if b.end.Before(i.end) {
+			result = append(result, interval{start: b.end, end: i.end})
+		}
+	}
+	return result
+}
+
+// isFree reports whether p is free for the whole of [start, end).
+func (p *player) isFree(start, end time.Time) bool {
+	for _, i := range p.free {
+		if !i.start.After(start) && !i.end.Before(end) {
+			return true
+		}
+	}
+	return false
+}
+
+// bestTimes checks every candidate start time between from and to and returns
+// the best ones, see FindBestTimes for the ranking.
+func bestTimes(players []*player, composition Composition, from, to time.Time,
+	duration time.Duration) []*CandidateTime {
+	slices.SortFunc(players, func(a, b *player) int { return cmp.Compare(a.userID, b.userID) })
+
+	var candidates []*CandidateTime
+	start := from.Truncate(candidateStep)
+	if start.Before(from) {
+		start = start.Add(candidateStep)
+	}
+	for ; !start.Add(duration).After(to); start = start.Add(candidateStep) {
+		end := start.Add(duration)
+
+		var free []*player
+		for _, p := range players {
+			if len(p.roles) > 0 && p.isFree(start, end) {
+				free = append(free, p)
+			}
+		}
+
+		group, ok := fillComposition(free, composition)
+		if !ok {
+			continue
+		}
+
+		available := make(map[UserRole][]int32)
+		for _, role := range combatRoles {
+			available[role] = []int32{}
+		}
+		for _, p := range free {
+			for _, role := range p.roles {
+				available[role] = append(available[role], p.userID)
+			}
+		}
+
+		candidates = append(candidates, &CandidateTime{
+			StartsAt:       start,
+			EndsAt:         end,
+			AvailableCount: len(free),
+			Available:      available,
+			Group:          group,
+		})
+	}
+
+	slices.SortStableFunc(candidates, func(a, b *CandidateTime) int {
+		return cmp.Compare(b.AvailableCount, a.AvailableCount)
+	})
+	if len(candidates) > maxCandidates {
+		candidates = candidates[:maxCandidates]
+	}
+	return candidates
+}
+
+// fillComposition assigns players to every slot of the composition, each player
+// to at most one slot they can play. It is a bipartite matching found with
+// augmenting paths, players can hold several roles so a greedy pass is not enough.
+func fillComposition(players []*player, composition Composition) ([]RoleAssignment, bool) {
+	var slots []UserRole
+	for _, role := range combatRoles {
+		for range composition.Slots(role) {
+			slots = append(slots, role)
+		}
+	}
+	if len(players) < len(slots) {
+		return nil, false
+	}
+
+	// slotPlayer[i] is the index of the player in slot i, or -1.
+	slotPlayer := make([]int, len(slots))
+	for i := range slotPlayer {
+		slotPlayer[i] = -1
+	}
+
+	var assign func(p int, seen []bool) bool
+	assign = func(p int, seen []bool) bool {
+		for i, role := range slots {
+			if seen[i] || !slices.Contains(players[p].roles, role) {
+				continue
+			}
+			seen[i] = true
+			if slotPlayer[i] == -1 || assign(slotPlayer[i], seen) {
+				slotPlayer[i] = p
+				return true
+			}
+		}
+		return false
+	}
+
+	filled := 0
+	for p := range players {
+		if assign(p, make([]bool, len(slots))) {
+			filled++
+		}
+	}
+	if filled < len(slots) {
+		return nil, false
+	}
+
+	group := make([]RoleAssignment, 0, len(slots))
+	for i, role := range slots {
+		group = append(group, RoleAssignment{UserID: players[slotPlayer[i]].userID, Role: role})
+	}
+	sortGroup(group)
+	return group, true
+}
+
+// sortGroup sorts a group by role, in the order of combatRoles, then by user.
+func sortGroup(group []RoleAssignment) {
+	slices.SortFunc(group, func(a, b RoleAssignment) int {
+		return cmp.Or(
+			cmp.Compare(slices.Index(combatRoles, a.Role), slices.Index(combatRoles, b.Role)),
+			cmp.Compare(a.UserID, b.UserID),
+		)
+	})
+}
+
+// parseWeeklyWindow validates a window and converts it to database fields.
+func parseWeeklyWindow(w WeeklyWindow) (repo.CreateAvailabilityWindowParams, error) {
+	weekday, ok := parseWeekday(w.Weekday)
+	if !ok {
+		return repo.CreateAvailabilityWindowParams{}, fmt.Errorf("%w: unknown weekday %q", ErrInvalidAvailability, w.Weekday)
+	}
+
+	start, err := parseClock(w.Start)
+	if err != nil {
+		return repo.CreateAvailabilityWindowParams{}, err
+	}
+	end, err := parseClock(w.End)
+	if err != nil {
+		return repo.CreateAvailabilityWindowParams{}, err
+	}
+	if start >= end || start == minutesPerDay {
+		return repo.CreateAvailabilityWindowParams{}, fmt.Errorf("%w: window must end after it starts on the same day",
+			ErrInvalidAvailability)
+	}
+
+	return repo.CreateAvailabilityWindowParams{
+		Weekday:     int32(weekday),
+		StartMinute: start,
+		EndMinute:   end,
+	}, nil
+}
+
+// parseWeekday parses an English weekday name in any case.
+func parseWeekday(value string) (time.Weekday, bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(d.String(), value) {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// parseClock parses a wall clock time such as "20:30" into minutes since midnight.
+// "24:00" is accepted as the end of the day.
+func parseClock(value string) (int32, error) {
+	if value == "24:00" {
+		return minutesPerDay, nil
+	}
+
+	t, err := time.Parse(clockLayout, value)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid time %q", ErrInvalidAvailability, value)
+	}
+	return int32(t.Hour()*60 + t.Minute()), nil
+}
+
+func formatClock(minutes int32) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+func filterByUser[T any](items []T, userID int32, user func(T) int32) []T {
+	var filtered []T
+	for _, item := range items {
+		if user(item) == userID {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+func mapWeeklyWindow(w repo.AvailabilityWindow) WeeklyWindow {
+	return WeeklyWindow{
+		Weekday: time.Weekday(w.Weekday).String(),
+		Start:   formatClock(w.StartMinute),
+		End:     formatClock(w.EndMinute),
+	}
+}
+
+func mapAvailabilityException(e repo.AvailabilityException) *AvailabilityException {
+	return &AvailabilityException{
+		ID:        e.ID,
+		StartsAt:  e.StartsAt.Time.UTC(),
+		EndsAt:    e.EndsAt.Time.UTC(),
+		Available: e.Available,
+	}
+}
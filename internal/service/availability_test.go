@@ -0,0 +1,200 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+func Test_parseWeeklyWindow(t *testing.T) {
+	tests := []struct {
+		name    string
+		window  WeeklyWindow
+		want    repo.CreateAvailabilityWindowParams
+		wantErr error
+	}{
+		{"Evening", WeeklyWindow{"Tuesday", "20:00", "23:30"},
+			repo.CreateAvailabilityWindowParams{Weekday: 2, StartMinute: 1200, EndMinute: 1410}, nil},
+		{"Until Midnight", WeeklyWindow{"sunday", "18:00", "24:00"},
+			repo.CreateAvailabilityWindowParams{Weekday: 0, StartMinute: 1080, EndMinute: 1440}, nil},
+		{"Crosses Midnight", WeeklyWindow{"Friday", "22:00", "01:00"}, repo.CreateAvailabilityWindowParams{},
+			ErrInvalidAvailability},
+		{"Empty Window", WeeklyWindow{"Friday", "22:00", "22:00"}, repo.CreateAvailabilityWindowParams{},
+			ErrInvalidAvailability},
+		{"Unknown Weekday", WeeklyWindow{"Caturday", "20:00", "22:00"}, repo.CreateAvailabilityWindowParams{},
+			ErrInvalidAvailability},
+		{"Invalid Time", WeeklyWindow{"Monday", "8pm", "22:00"}, repo.CreateAvailabilityWindowParams{},
+			ErrInvalidAvailability},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseWeeklyWindow(tt.window)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_freeIntervals(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+	tuesdayEvening := repo.AvailabilityWindow{Weekday: 2, StartMinute: 20 * 60, EndMinute: 23 * 60}
+	mondayLate := repo.AvailabilityWindow{Weekday: 1, StartMinute: 22 * 60, EndMinute: 24 * 60}
+	tuesdayEarly := repo.AvailabilityWindow{Weekday: 2, StartMinute: 0, EndMinute: 60}
+
+	tests := []struct {
+		name       string
+		windows    []repo.AvailabilityWindow
+		exceptions []repo.AvailabilityException
+		from       time.Time
+		to         time.Time
+		want       []interval
+	}{
+		{
+			"Keeps Wall Clock Across Spring Forward",
+			[]repo.AvailabilityWindow{tuesdayEvening}, nil,
+			utc(2025, 3, 4, 0, 0), utc(2025, 3, 13, 0, 0),
+			[]interval{
+				{utc(2025, 3, 5, 1, 0), utc(2025, 3, 5, 4, 0)},
+				{utc(2025, 3, 12, 0, 0), utc(2025, 3, 12, 3, 0)},
+			},
+		},
+		{
+			"Windows Across Midnight Merge",
+			[]repo.AvailabilityWindow{mondayLate, tuesdayEarly}, nil,
+			utc(2025, 1, 6, 12, 0), utc(2025, 1, 7, 12, 0),
+			[]interval{{utc(2025, 1, 7, 3, 0), utc(2025, 1, 7, 6, 0)}},
+		},
+		{
+			"Busy Exception Splits Window",
+			[]repo.AvailabilityWindow{tuesdayEvening},
+			[]repo.AvailabilityException{{StartsAt: pgTimestamptz(utc(2025, 1, 8, 2, 0)),
+				EndsAt: pgTimestamptz(utc(2025, 1, 8, 3, 0)), Available: false}},
+			utc(2025, 1, 7, 0, 0), utc(2025, 1, 8, 12, 0),
+			[]interval{
+				{utc(2025, 1, 8, 1, 0), utc(2025, 1, 8, 2, 0)},
+				{utc(2025, 1, 8, 3, 0), utc(2025, 1, 8, 4, 0)},
+			},
+		},
+		{
+			"Available Exception Extends Window",
+			[]repo.AvailabilityWindow{tuesdayEvening},
+			[]repo.AvailabilityException{{StartsAt: pgTimestamptz(utc(2025, 1, 7, 23, 0)),
+				EndsAt: pgTimestamptz(utc(2025, 1, 8, 1, 30)), Available: true}},
+			utc(2025, 1, 7, 0, 0), utc(2025, 1, 8, 12, 0),
+			[]interval{{utc(2025, 1, 7, 23, 0), utc(2025, 1, 8, 4, 0)}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, freeIntervals(tt.windows, tt.exceptions, newYork, tt.from, tt.to))
+		})
+	}
+}
+
+func Test_fillComposition(t *testing.T) {
+	tank := &player{userID: 1, roles: []UserRole{RoleTank}}
+	flex := &player{userID: 2, roles: []UserRole{RoleTank, RoleHealer}}
+	healer := &player{userID: 3, roles: []UserRole{RoleHealer}}
+	dps := func(id int32) *player { return &player{userID: id, roles: []UserRole{RoleDPS}} }
+
+	tests := []struct {
+		name        string
+		players     []*player
+		composition Composition
+		want        []RoleAssignment
+		wantOK      bool
+	}{
+		{
+			"Standard Group",
+			[]*player{tank, healer, dps(4), dps(5), dps(6)}, DefaultComposition,
+			[]RoleAssignment{{1, RoleTank}, {3, RoleHealer}, {4, RoleDPS}, {5, RoleDPS}, {6, RoleDPS}},
+			true,
+		},
+		{
+			"Flexible Player Moves To Free A Slot",
+			[]*player{flex, tank}, Composition{Tanks: 1, Healers: 1},
+			[]RoleAssignment{{1, RoleTank}, {2, RoleHealer}},
+			true,
+		},
+		{
+			"Missing Healer",
+			[]*player{tank, dps(4), dps(5), dps(6), dps(7)}, DefaultComposition,
+			nil,
+			false,
+		},
+		{
+			"Not Enough Players",
+			[]*player{tank, healer}, DefaultComposition,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := fillComposition(tt.players, tt.composition)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_bestTimes(t *testing.T) {
+	evening := []interval{{utc(2025, 1, 8, 1, 0), utc(2025, 1, 8, 4, 0)}}
+	lateEvening := []interval{{utc(2025, 1, 8, 2, 0), utc(2025, 1, 8, 4, 0)}}
+	players := []*player{
+		{userID: 1, roles: []UserRole{RoleTank}, free: evening},
+		{userID: 2, roles: []UserRole{RoleHealer}, free: evening},
+		{userID: 3, roles: []UserRole{RoleDPS}, free: lateEvening},
+		{userID: 4, roles: []UserRole{RoleDPS, RoleHealer}, free: evening},
+		{userID: 5, roles: nil, free: evening},
+	}
+	composition := Composition{Tanks: 1, Healers: 1, DPS: 1}
+
+	got := bestTimes(players, composition, utc(2025, 1, 8, 0, 50), utc(2025, 1, 8, 4, 0), time.Hour)
+
+	if assert.Len(t, got, 9) {
+		// Everyone with a role is free from 02:00, so those times rank first.
+		assert.Equal(t, utc(2025, 1, 8, 2, 0), got[0].StartsAt)
+		assert.Equal(t, 4, got[0].AvailableCount)
+		assert.Equal(t, []int32{3, 4}, got[0].Available[RoleDPS])
+		// Earlier times with only three players free follow, earliest first.
+		assert.Equal(t, utc(2025, 1, 8, 1, 0), got[5].StartsAt)
+		assert.Equal(t, 3, got[5].AvailableCount)
+		assert.Equal(t, []RoleAssignment{{1, RoleTank}, {2, RoleHealer}, {4, RoleDPS}}, got[5].Group)
+	}
+}
+
+func Test_bestTimes_Limit(t *testing.T) {
+	allDay := []interval{{utc(2025, 1, 1, 0, 0), utc(2025, 1, 3, 0, 0)}}
+	players := []*player{
+		{userID: 1, roles: []UserRole{RoleTank}, free: allDay},
+	}
+
+	got := bestTimes(players, Composition{Tanks: 1}, utc(2025, 1, 1, 0, 0), utc(2025, 1, 2, 0, 0), time.Hour)
+	assert.Len(t, got, maxCandidates)
+	assert.Equal(t, utc(2025, 1, 1, 0, 0), got[0].StartsAt)
+}
+
+func Test_subtractInterval(t *testing.T) {
+	base := []interval{{utc(2025, 1, 1, 10, 0), utc(2025, 1, 1, 12, 0)}}
+
+	tests := []struct {
+		name string
+		busy interval
+		want []interval
+	}{
+		{"Before", interval{utc(2025, 1, 1, 8, 0), utc(2025, 1, 1, 10, 0)}, base},
+		{"Covers", interval{utc(2025, 1, 1, 9, 0), utc(2025, 1, 1, 13, 0)}, nil},
+		{"Start", interval{utc(2025, 1, 1, 9, 0), utc(2025, 1, 1, 11, 0)},
+			[]interval{{utc(2025, 1, 1, 11, 0), utc(2025, 1, 1, 12, 0)}}},
+		{"End", interval{utc(2025, 1, 1, 11, 0), utc(2025, 1, 1, 12, 0)},
+			[]interval{{utc(2025, 1, 1, 10, 0), utc(2025, 1, 1, 11, 0)}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, subtractInterval(base, tt.busy))
+		})
+	}
+}
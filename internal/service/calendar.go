@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// calendarFeedHistory is how far back calendar feeds list runs, so recent
+// runs stay in people's calendars after they happen.
+const calendarFeedHistory = 30 * 24 * time.Hour
+
+// calendarTokenBytes is the number of random bytes in a calendar token.
+const calendarTokenBytes = 24
+
+// CalendarService is the interface for the calendar feeds of users.
+// Calendar apps can not send headers, so feeds are protected by a per-user
+// token in the feed URL instead of the acting user.
+type CalendarService interface {
+	GetCalendarToken(context.Context, int32, int32) (string, error)
+	RotateCalendarToken(context.Context, int32, int32) (string, error)
+	GetCalendarRuns(context.Context, int32, string) ([]*Run, error)
+}
+
+// calendarService is the implementation of CalendarService.
+type calendarService struct {
+	dbPool       *pgxpool.Pool
+	calendarRepo repo.Querier
+	now          func() time.Time
+}
+
+// NewCalendarService creates a new calendarService with the provided database connection pool.
+// It returns a pointer to the calendarService.
+func NewCalendarService(dbPool *pgxpool.Pool) *calendarService {
+	return &calendarService{
+		dbPool:       dbPool,
+		calendarRepo: repo.New(dbPool),
+		now:          time.Now,
+	}
+}
+
+// GetCalendarToken returns the user's calendar token, creating one the first time.
+// Users can only see their own token.
+func (s *calendarService) GetCalendarToken(ctx context.Context, actorID, userID int32) (string, error) {
+	if actorID != userID {
+		return "", ErrForbidden
+	}
+
+	token, err := s.calendarRepo.GetUserCalendarToken(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", ErrUserNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+	if token.Valid {
+		return token.String, nil
+	}
+	return s.RotateCalendarToken(ctx, actorID, userID)
+}
+
+// RotateCalendarToken replaces the user's calendar token, so feed URLs with the
+// old token stop working. Users can only rotate their own token.
+func (s *calendarService) RotateCalendarToken(ctx context.Context, actorID, userID int32) (string, error) {
+	if actorID != userID {
+		return "", ErrForbidden
+	}
+
+	if _, err := s.calendarRepo.GetUserByID(ctx, userID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ErrUserNotFound
+		}
+		return "", err
+	}
+
+	b := make([]byte, calendarTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	err := s.calendarRepo.SetUserCalendarToken(ctx, repo.SetUserCalendarTokenParams{
+		ID:            userID,
+		CalendarToken: pgtype.Text{String: token, Valid: true},
+	})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// GetCalendarRuns returns the runs in a user's calendar feed: runs they organize
+// or are signed up for, from the last 30 days on. Cancelled runs are included
+// so calendar apps can show the cancellation.
+// Returns ErrForbidden unless token is the user's calendar token.
+func (s *calendarService) GetCalendarRuns(ctx context.Context, userID int32, token string) ([]*Run, error) {
+	stored, err := s.calendarRepo.GetUserCalendarToken(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrForbidden
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !stored.Valid || subtle.ConstantTimeCompare([]byte(stored.String), []byte(token)) != 1 {
+		return nil, ErrForbidden
+	}
+
+	rows, err := s.calendarRepo.GetUserCalendarRuns(ctx, repo.GetUserCalendarRunsParams{
+		UserID:      userID,
+		StartsAfter: pgTimestamptz(s.now().Add(-calendarFeedHistory)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]*Run, 0, len(rows))
+	for _, row := range rows {
+		runs = append(runs, mapRun(row.Run, row.Dungeon))
+	}
+	return runs, nil
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+func Test_calendarService_GetCalendarRuns(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	rows := []repo.GetUserCalendarRunsRow{
+		{Run: repo.Run{ID: 1, Status: "scheduled"}, Dungeon: repo.Dungeon{Code: "ARAK"}},
+		{Run: repo.Run{ID: 2, Status: "cancelled", Sequence: 3}, Dungeon: repo.Dungeon{Code: "ARAK"}},
+	}
+
+	tests := []struct {
+		name     string
+		stored   pgtype.Text
+		storeErr error
+		token    string
+		wantErr  error
+	}{
+		{"Valid Token", pgtype.Text{String: "secret", Valid: true}, nil, "secret", nil},
+		{"Wrong Token", pgtype.Text{String: "secret", Valid: true}, nil, "guess", ErrForbidden},
+		{"Empty Token", pgtype.Text{String: "secret", Valid: true}, nil, "", ErrForbidden},
+		{"No Token Yet", pgtype.Text{}, nil, "", ErrForbidden},
+		{"Unknown User", pgtype.Text{}, pgx.ErrNoRows, "secret", ErrForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			mockq.EXPECT().GetUserCalendarToken(ctx, int32(7)).Return(tt.stored, tt.storeErr)
+			if tt.wantErr == nil {
+				mockq.EXPECT().GetUserCalendarRuns(ctx, repo.GetUserCalendarRunsParams{
+					UserID:      7,
+					StartsAfter: pgTimestamptz(now.Add(-calendarFeedHistory)),
+				}).Return(rows, nil)
+			}
+			s := &calendarService{calendarRepo: mockq, now: func() time.Time { return now }}
+
+			runs, err := s.GetCalendarRuns(ctx, 7, tt.token)
+			if !assert.ErrorIs(t, err, tt.wantErr) || tt.wantErr != nil {
+				return
+			}
+			if assert.Len(t, runs, 2) {
+				assert.Equal(t, RunStatusCancelled, runs[1].Status)
+				assert.Equal(t, int32(3), runs[1].Sequence)
+			}
+		})
+	}
+}
+
+func Test_calendarService_GetCalendarToken(t *testing.T) {
+	t.Run("Existing Token", func(t *testing.T) {
+		ctx := context.Background()
+		mockq := repo.NewMockQuerier(t)
+		mockq.EXPECT().GetUserCalendarToken(ctx, int32(7)).Return(pgtype.Text{String: "secret", Valid: true}, nil)
+		s := &calendarService{calendarRepo: mockq, now: time.Now}
+
+		token, err := s.GetCalendarToken(ctx, 7, 7)
+		assert.NoError(t, err)
+		assert.Equal(t, "secret", token)
+	})
+
+	t.Run("Creates Token", func(t *testing.T) {
+		ctx := context.Background()
+		mockq := repo.NewMockQuerier(t)
+		mockq.EXPECT().GetUserCalendarToken(ctx, int32(7)).Return(pgtype.Text{}, nil)
+		mockq.EXPECT().GetUserByID(ctx, int32(7)).Return(repo.GetUserByIDRow{ID: 7}, nil)
+		var stored string
+		mockq.EXPECT().SetUserCalendarToken(ctx, mock.Anything).
+			Run(func(_ context.Context, arg repo.SetUserCalendarTokenParams) { stored = arg.CalendarToken.String }).
+			Return(nil)
+		s := &calendarService{calendarRepo: mockq, now: time.Now}
+
+		token, err := s.GetCalendarToken(ctx, 7, 7)
+		assert.NoError(t, err)
+		assert.Len(t, token, 32)
+		assert.Equal(t, stored, token)
+	})
+
+	t.Run("Other User", func(t *testing.T) {
+		s := &calendarService{calendarRepo: repo.NewMockQuerier(t), now: time.Now}
+
+		_, err := s.GetCalendarToken(context.Background(), 8, 7)
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
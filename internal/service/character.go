@@ -0,0 +1,323 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tmaffia/dungeon-time-api/internal/armory"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// characterClasses are the playable classes a character can have.
+var characterClasses = []string{
+	"Death Knight", "Demon Hunter", "Druid", "Evoker", "Hunter", "Mage", "Monk",
+	"Paladin", "Priest", "Rogue", "Shaman", "Warlock", "Warrior",
+}
+
+// Limits of character fields. Character names are a single word of letters,
+// realm names may have spaces, apostrophes and dashes inside the name.
+const (
+	maxItemLevel  = 1000
+	maxSpecLength = 32
+)
+
+var (
+	characterNameRegex = regexp.MustCompile(`^\p{L}{2,12}$`)
+	realmNameRegex     = regexp.MustCompile(`^[\p{L}\p{N}][\p{L}\p{N} '-]{0,30}[\p{L}\p{N}]$`)
+)
+
+// Character is an in-game character of a user, with the roles it can fill.
+// Signups and the looking for group queue can name a character to play as,
+// its roles are then used instead of the roles of the user.
+type Character struct {
+	ID         int32      `json:"id"`
+	UserID     int32      `json:"user_id"`
+	Name       string     `json:"name"`
+	Realm      string     `json:"realm"`
+	Class      string     `json:"class"`
+	Spec       string     `json:"spec"`
+	ItemLevel  int32      `json:"item_level"`
+	Roles      []UserRole `json:"roles"`
+	Keystone   *Keystone  `json:"keystone,omitempty"`
+	ImportedAt *time.Time `json:"imported_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// Keystone is the Mythic+ key a character holds this week, for the dungeon
+// with the code Dungeon.
+type Keystone struct {
+	Dungeon string `json:"dungeon"`
+	Level   int32  `json:"level"`
+}
+
+// String describes k, like "ARAK +12", or returns "" if k is nil.
+func (k *Keystone) String() string {
+	if k == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s +%d", k.Dungeon, k.Level)
+}
+
+// CharacterInput holds the fields used to create or update a character.
+type CharacterInput struct {
+	Name      string     `json:"name"`
+	Realm     string     `json:"realm"`
+	Class     string     `json:"class"`
+	Spec      string     `json:"spec"`
+	ItemLevel int32      `json:"item_level"`
+	Roles     []UserRole `json:"roles"`
+}
+
+// CharacterService is the interface for managing the characters of users.
+type CharacterService interface {
+	GetCharacters(ctx context.Context, userID int32) ([]*Character, error)
+	GetCharacter(ctx context.Context, userID, id int32) (*Character, error)
+	CreateCharacter(ctx context.Context, actorID, userID int32, input *CharacterInput) (*Character, error)
+	UpdateCharacter(ctx context.Context, actorID, userID, id int32, input *CharacterInput) (*Character, error)
+	DeleteCharacter(ctx context.Context, actorID, userID, id int32) error
+	ImportCharacters(ctx context.Context, target ImportTarget, source armory.Source, dryRun bool) (*ImportReport, error)
+}
+
+// characterService is the implementation of CharacterService.
+type characterService struct {
+	dbPool        *pgxpool.Pool
+	characterRepo repo.Querier
+	reset         WeeklyReset
+	now           func() time.Time
+}
+
+// NewCharacterService creates a new characterService with the provided database connection pool.
+// Keys held by characters expire at reset. It returns a pointer to the characterService.
+func NewCharacterService(dbPool *pgxpool.Pool, reset WeeklyReset) *characterService {
+	return &characterService{
+		dbPool:        dbPool,
+		characterRepo: repo.New(dbPool),
+		reset:         reset,
+		now:           time.Now,
+	}
+}
+
+// GetCharacters returns the characters of the user with userID, by realm and name.
+func (s *characterService) GetCharacters(ctx context.Context, userID int32) ([]*Character, error) {
+	if _, err := s.characterRepo.GetUserByID(ctx, userID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	rows, err := s.characterRepo.GetUserCharacters(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	weekStart := s.reset.CurrentWeek(s.now()).StartsAt
+	characters := make([]*Character, 0, len(rows))
+	for _, row := range rows {
+		key := currentKeystone(row.KeyDungeon, row.KeyLevel, row.KeyRecordedAt, weekStart)
+		characters = append(characters, mapCharacter(row.Character, key))
+	}
+	return characters, nil
+}
+
+// GetCharacter returns the character with id of the user with userID.
+// Returns ErrCharacterNotFound if the user has no such character.
+func (s *characterService) GetCharacter(ctx context.Context, userID, id int32) (*Character, error) {
+	row, err := loadCharacter(ctx, s.characterRepo, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	key := currentKeystone(row.KeyDungeon, row.KeyLevel, row.KeyRecordedAt, s.reset.CurrentWeek(s.now()).StartsAt)
+	return mapCharacter(row.Character, key), nil
+}
+
+// CreateCharacter adds a character to the user with userID. Users can only
+// add their own characters. Returns ErrCharacterExists if the realm already
+// has a character with the name.
+func (s *characterService) CreateCharacter(ctx context.Context, actorID, userID int32,
+	input *CharacterInput) (*Character, error) {
+	if actorID != userID {
+		return nil, ErrForbidden
+	}
+	if err := isValidCharacter(input); err != nil {
+		return nil, err
+	}
+
+	c, err := s.characterRepo.CreateCharacter(ctx, repo.CreateCharacterParams{
+		UserID:    userID,
+		Name:      input.Name,
+		Realm:     strings.TrimSpace(input.Realm),
+		Class:     input.Class,
+		Spec:      strings.TrimSpace(input.Spec),
+		ItemLevel: input.ItemLevel,
+		Roles:     roleStrings(input.Roles),
+	})
+	if err := characterWriteErr(err); err != nil {
+		return nil, err
+	}
+	return mapCharacter(c, nil), nil
+}
+
+// UpdateCharacter replaces the fields of a character of the user with userID.
+// Users can only change their own characters. Signups already made as the
+// character keep their role even if the character can no longer fill it.
+func (s *characterService) UpdateCharacter(ctx context.Context, actorID, userID, id int32,
+	input *CharacterInput) (*Character, error) {
+	if actorID != userID {
+		return nil, ErrForbidden
+	}
+	if err := isValidCharacter(input); err != nil {
+		return nil, err
+	}
+	row, err := loadCharacter(ctx, s.characterRepo, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := s.characterRepo.UpdateCharacter(ctx, repo.UpdateCharacterParams{
+		ID:        id,
+		Name:      input.Name,
+		Realm:     strings.TrimSpace(input.Realm),
+		Class:     input.Class,
+		Spec:      strings.TrimSpace(input.Spec),
+		ItemLevel: input.ItemLevel,
+		Roles:     roleStrings(input.Roles),
+	})
+	if err := characterWriteErr(err); err != nil {
+		return nil, err
+	}
+	key := currentKeystone(row.KeyDungeon, row.KeyLevel, row.KeyRecordedAt, s.reset.CurrentWeek(s.now()).StartsAt)
+	return mapCharacter(c, key), nil
+}
+
+// DeleteCharacter removes a character of the user with userID. Users can only
+// remove their own characters. Signups made as the character stay, with the
+// roles of the user.
+func (s *characterService) DeleteCharacter(ctx context.Context, actorID, userID, id int32) error {
+	if actorID != userID {
+		return ErrForbidden
+	}
+
+	deleted, err := s.characterRepo.DeleteCharacter(ctx, repo.DeleteCharacterParams{ID: id, UserID: userID})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrCharacterNotFound
+	}
+	return nil
+}
+
+// playableRoles returns the roles the user with userID can play as the
+// character with characterID, or as themselves if characterID is nil.
+func playableRoles(ctx context.Context, q repo.Querier, userID int32, characterID *int32) ([]UserRole, error) {
+	if characterID != nil {
+		row, err := loadCharacter(ctx, q, userID, *characterID)
+		if err != nil {
+			return nil, err
+		}
+		return mapRoles(row.Character.Roles)
+	}
+
+	user, err := q.GetUserByID(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return mapRoles(user.Roles)
+}
+
+// loadCharacter returns the character with id, or ErrCharacterNotFound unless
+// it belongs to the user with userID.
+func loadCharacter(ctx context.Context, q repo.Querier, userID, id int32) (repo.GetCharacterByIDRow, error) {
+	row, err := q.GetCharacterByID(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && row.Character.UserID != userID) {
+		return repo.GetCharacterByIDRow{}, ErrCharacterNotFound
+	}
+	return row, err
+}
+
+// characterWriteErr maps the error of writing a character, nil if there is none.
+func characterWriteErr(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return ErrCharacterExists
+	}
+	return err
+}
+
+// isValidCharacter returns ErrInvalidCharacter describing the first problem
+// with input, or ErrInvalidRole if it lists a role that is not a UserRole.
+func isValidCharacter(input *CharacterInput) error {
+	if !characterNameRegex.MatchString(input.Name) {
+		return fmt.Errorf("%w: name must be 2 to 12 letters", ErrInvalidCharacter)
+	}
+	if !realmNameRegex.MatchString(strings.TrimSpace(input.Realm)) {
+		return fmt.Errorf("%w: invalid realm", ErrInvalidCharacter)
+	}
+	if !slices.Contains(characterClasses, input.Class) {
+		return fmt.Errorf("%w: unknown class %q", ErrInvalidCharacter, input.Class)
+	}
+	if utf8.RuneCountInString(strings.TrimSpace(input.Spec)) > maxSpecLength {
+		return fmt.Errorf("%w: spec is longer than %d characters", ErrInvalidCharacter, maxSpecLength)
+	}
+	if input.ItemLevel < 0 || input.ItemLevel > maxItemLevel {
+		return fmt.Errorf("%w: item level must be between 0 and %d", ErrInvalidCharacter, maxItemLevel)
+	}
+	if len(input.Roles) == 0 {
+		return fmt.Errorf("%w: at least one role is required", ErrInvalidCharacter)
+	}
+	if !isValidRoles(input.Roles...) {
+		return ErrInvalidRole
+	}
+	for i, role := range input.Roles {
+		if slices.Contains(input.Roles[:i], role) {
+			return fmt.Errorf("%w: %s listed twice", ErrInvalidCharacter, role)
+		}
+	}
+	return nil
+}
+
+// currentKeystone returns the key for the dungeon with the code dungeon at
+// level, or nil if there is none or it was recorded before weekStart.
+func currentKeystone(dungeon pgtype.Text, level pgtype.Int4, recordedAt pgtype.Timestamptz,
+	weekStart time.Time) *Keystone {
+	if !dungeon.Valid || !level.Valid || recordedAt.Time.Before(weekStart) {
+		return nil
+	}
+	return &Keystone{Dungeon: dungeon.String, Level: level.Int32}
+}
+
+// mapCharacter maps c, which holds keystone.
+func mapCharacter(c repo.Character, keystone *Keystone) *Character {
+	roles := make([]UserRole, 0, len(c.Roles))
+	for _, role := range c.Roles {
+		roles = append(roles, UserRole(role))
+	}
+	return &Character{
+		ID:         c.ID,
+		UserID:     c.UserID,
+		Name:       c.Name,
+		Realm:      c.Realm,
+		Class:      c.Class,
+		Spec:       c.Spec,
+		ItemLevel:  c.ItemLevel,
+		Roles:      roles,
+		Keystone:   keystone,
+		ImportedAt: timestamptzPtr(c.ImportedAt),
+		CreatedAt:  c.CreatedAt.Time,
+		UpdatedAt:  c.UpdatedAt.Time,
+	}
+}
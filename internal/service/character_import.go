@@ -0,0 +1,386 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/tmaffia/dungeon-time-api/internal/armory"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// ImportAction is what an import did, or would do, with a character.
+type ImportAction string
+
+const (
+	ImportCreate    = ImportAction("create")
+	ImportUpdate    = ImportAction("update")
+	ImportUnchanged = ImportAction("unchanged")
+	ImportSkip      = ImportAction("skip")
+)
+
+// specRoles are the specs that tank or heal, every other spec is DPS.
+var specRoles = map[string]UserRole{
+	"Blood":        RoleTank,
+	"Brewmaster":   RoleTank,
+	"Guardian":     RoleTank,
+	"Protection":   RoleTank,
+	"Vengeance":    RoleTank,
+	"Discipline":   RoleHealer,
+	"Holy":         RoleHealer,
+	"Mistweaver":   RoleHealer,
+	"Preservation": RoleHealer,
+	"Restoration":  RoleHealer,
+}
+
+// ImportTarget is whose characters an import covers, the user with UserID or
+// the members of the guild with GuildID. ActorID must be the user or an admin
+// of the guild, unless Admin is set because the caller checked access itself,
+// like the admin endpoint and the import command do.
+type ImportTarget struct {
+	UserID  *int32
+	GuildID *int32
+	ActorID int32
+	Admin   bool
+}
+
+// FieldChange is a field an import changes on a character.
+type FieldChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+// CharacterChange is what an import did with one profile. Reason says why a
+// profile was skipped.
+type CharacterChange struct {
+	Name        string        `json:"name"`
+	Realm       string        `json:"realm"`
+	UserID      int32         `json:"user_id,omitempty"`
+	CharacterID *int32        `json:"character_id,omitempty"`
+	Action      ImportAction  `json:"action"`
+	Changes     []FieldChange `json:"changes,omitempty"`
+	Reason      string        `json:"reason,omitempty"`
+}
+
+// ImportReport sums up an import. Dry runs report the changes an import would
+// make without making them.
+type ImportReport struct {
+	DryRun     bool               `json:"dry_run"`
+	Created    int                `json:"created"`
+	Updated    int                `json:"updated"`
+	Unchanged  int                `json:"unchanged"`
+	Skipped    int                `json:"skipped"`
+	Characters []*CharacterChange `json:"characters"`
+}
+
+// importScope is who an import can give characters to, the user with userID
+// or the guild members in members, by lowercase username.
+type importScope struct {
+	userID  int32
+	members map[string]int32
+}
+
+// ImportCharacters creates and updates characters from the profiles of
+// source. Characters are matched by realm and name. User imports give new
+// characters to the user, guild imports to the member the profile names as its
+// owner. Characters of anyone else are skipped, as are profiles that are not
+// valid characters. New characters can fill the role of their spec, existing
+// ones gain it. Keys in the export replace the key the character holds, which
+// is kept when the export has none. Every change is made in one transaction,
+// or none with dryRun.
+func (s *characterService) ImportCharacters(ctx context.Context, target ImportTarget, source armory.Source,
+	dryRun bool) (*ImportReport, error) {
+	scope, err := loadImportScope(ctx, s.characterRepo, target)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles, err := source.Profiles(ctx)
+	if errors.Is(err, armory.ErrInvalidExport) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidImport, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	weekStart := s.reset.CurrentWeek(now).StartsAt
+	if dryRun {
+		return importProfiles(ctx, s.characterRepo, scope, profiles, true, now, weekStart)
+	}
+	var report *ImportReport
+	err = inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		var err error
+		report, err = importProfiles(ctx, q, scope, profiles, false, now, weekStart)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// loadImportScope checks the actor of target may import and returns who the
+// import can give characters to.
+func loadImportScope(ctx context.Context, q repo.Querier, target ImportTarget) (*importScope, error) {
+	if (target.UserID == nil) == (target.GuildID == nil) {
+		return nil, fmt.Errorf("%w: import for either a user or a guild", ErrInvalidImport)
+	}
+
+	if target.UserID != nil {
+		if !target.Admin && target.ActorID != *target.UserID {
+			return nil, ErrForbidden
+		}
+		if _, err := q.GetUserByID(ctx, *target.UserID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, ErrUserNotFound
+			}
+			return nil, err
+		}
+		return &importScope{userID: *target.UserID}, nil
+	}
+
+	guild, err := loadGuild(ctx, q, *target.GuildID)
+	if err != nil {
+		return nil, err
+	}
+	if !target.Admin && !guild.IsAdmin(target.ActorID) {
+		return nil, ErrForbidden
+	}
+	scope := &importScope{members: make(map[string]int32, len(guild.Members))}
+	for _, m := range guild.Members {
+		scope.members[strings.ToLower(m.Username)] = m.UserID
+	}
+	return scope, nil
+}
+
+// importProfiles imports every profile in turn at now, in the week that started
+// at weekStart, see ImportCharacters.
+func importProfiles(ctx context.Context, q repo.Querier, scope *importScope, profiles []armory.Profile,
+	dryRun bool, now, weekStart time.Time) (*ImportReport, error) {
+	rows, err := q.GetDungeons(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dungeons := make(map[string]repo.Dungeon, 2*len(rows))
+	for _, d := range rows {
+		dungeons[strings.ToLower(d.Code)] = d
+		dungeons[strings.ToLower(d.Name)] = d
+	}
+
+	report := &ImportReport{DryRun: dryRun, Characters: make([]*CharacterChange, 0, len(profiles))}
+	seen := make(map[string]bool, len(profiles))
+	for _, profile := range profiles {
+		key := strings.ToLower(profile.Realm) + "/" + strings.ToLower(profile.Name)
+		var change *CharacterChange
+		if seen[key] {
+			change = skipProfile(profile, "listed more than once")
+		} else {
+			seen[key] = true
+			change, err = importProfile(ctx, q, scope, profile, dungeons, dryRun, now, weekStart)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		switch change.Action {
+		case ImportCreate:
+			report.Created++
+		case ImportUpdate:
+			report.Updated++
+		case ImportUnchanged:
+			report.Unchanged++
+		case ImportSkip:
+			report.Skipped++
+		}
+		report.Characters = append(report.Characters, change)
+	}
+	return report, nil
+}
+
+// importProfile creates or updates the character of profile, unless dryRun.
+// dungeons are the active dungeons by lowercase code and name.
+func importProfile(ctx context.Context, q repo.Querier, scope *importScope, profile armory.Profile,
+	dungeons map[string]repo.Dungeon, dryRun bool, now, weekStart time.Time) (*CharacterChange, error) {
+	role := specRole(profile.Spec)
+	input := &CharacterInput{
+		Name:      profile.Name,
+		Realm:     profile.Realm,
+		Class:     profile.Class,
+		Spec:      profile.Spec,
+		ItemLevel: profile.ItemLevel,
+		Roles:     []UserRole{role},
+	}
+	if err := isValidCharacter(input); err != nil {
+		return skipProfile(profile, err.Error()), nil
+	}
+
+	params := repo.SetCharacterArmoryParams{
+		Class:      profile.Class,
+		Spec:       profile.Spec,
+		ItemLevel:  profile.ItemLevel,
+		ImportedAt: pgTimestamptz(now),
+	}
+	var keystone *repo.CreateKeystoneParams
+	var newKey *Keystone
+	if k := profile.Keystone; k != nil {
+		dungeon, ok := dungeons[strings.ToLower(k.Dungeon)]
+		if !ok {
+			return skipProfile(profile, fmt.Sprintf("unknown dungeon %q", k.Dungeon)), nil
+		}
+		if k.Level < minKeyLevel || k.Level > maxKeyLevel {
+			return skipProfile(profile, fmt.Sprintf("invalid key level %d", k.Level)), nil
+		}
+		keystone = &repo.CreateKeystoneParams{DungeonID: dungeon.ID, Level: k.Level, RecordedAt: pgTimestamptz(now)}
+		newKey = &Keystone{Dungeon: dungeon.Code, Level: k.Level}
+	}
+
+	existing, err := q.GetCharacterByName(ctx, repo.GetCharacterByNameParams{Realm: profile.Realm, Name: profile.Name})
+	if errors.Is(err, pgx.ErrNoRows) {
+		ownerID, reason := scope.newOwner(profile)
+		if reason != "" {
+			return skipProfile(profile, reason), nil
+		}
+		change := &CharacterChange{Name: profile.Name, Realm: profile.Realm, UserID: ownerID, Action: ImportCreate,
+			Changes: diffCharacter(repo.Character{}, nil, params, []UserRole{role}, newKey)}
+		if dryRun {
+			return change, nil
+		}
+
+		c, err := q.CreateCharacter(ctx, repo.CreateCharacterParams{
+			UserID:    ownerID,
+			Name:      profile.Name,
+			Realm:     profile.Realm,
+			Class:     profile.Class,
+			Spec:      profile.Spec,
+			ItemLevel: profile.ItemLevel,
+			Roles:     roleStrings(input.Roles),
+		})
+		if err != nil {
+			return nil, err
+		}
+		params.ID, params.Roles = c.ID, c.Roles
+		change.CharacterID = &c.ID
+		return change, saveImport(ctx, q, params, c.UserID, keystone)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c := existing.Character
+	if reason := scope.checkOwner(c.UserID); reason != "" {
+		return skipProfile(profile, reason), nil
+	}
+	roles := make([]UserRole, 0, len(c.Roles)+1)
+	for _, r := range c.Roles {
+		roles = append(roles, UserRole(r))
+	}
+	if !slices.Contains(roles, role) {
+		roles = append(roles, role)
+	}
+	oldKey := currentKeystone(existing.KeyDungeon, existing.KeyLevel, existing.KeyRecordedAt, weekStart)
+	if newKey == nil {
+		newKey = oldKey
+	}
+
+	change := &CharacterChange{Name: c.Name, Realm: c.Realm, UserID: c.UserID, CharacterID: &c.ID,
+		Action: ImportUnchanged, Changes: diffCharacter(c, oldKey, params, roles, newKey)}
+	if len(change.Changes) > 0 {
+		change.Action = ImportUpdate
+	}
+	if dryRun {
+		return change, nil
+	}
+	params.ID, params.Roles = c.ID, roleStrings(roles)
+	return change, saveImport(ctx, q, params, c.UserID, keystone)
+}
+
+// saveImport writes the imported fields of params to the character of the user
+// with userID, and replaces the key it holds with keystone unless that is nil.
+func saveImport(ctx context.Context, q repo.Querier, params repo.SetCharacterArmoryParams, userID int32,
+	keystone *repo.CreateKeystoneParams) error {
+	if err := q.SetCharacterArmory(ctx, params); err != nil {
+		return err
+	}
+	if keystone == nil {
+		return nil
+	}
+	keystone.UserID, keystone.CharacterID = userID, pgInt4(&params.ID)
+	_, err := replaceKeystone(ctx, q, *keystone)
+	return err
+}
+
+// newOwner returns who a new character of profile goes to, or why it can not
+// be imported.
+func (s *importScope) newOwner(profile armory.Profile) (int32, string) {
+	if s.members == nil {
+		return s.userID, ""
+	}
+	if profile.Owner == "" {
+		return 0, "no owner for a new character"
+	}
+	id, ok := s.members[strings.ToLower(profile.Owner)]
+	if !ok {
+		return 0, fmt.Sprintf("owner %q is not a member of the guild", profile.Owner)
+	}
+	return id, ""
+}
+
+// checkOwner returns why a character of the user with userID can not be
+// imported, or "" if it can.
+func (s *importScope) checkOwner(userID int32) string {
+	if s.members == nil {
+		if userID != s.userID {
+			return "belongs to another user"
+		}
+		return ""
+	}
+	for _, id := range s.members {
+		if id == userID {
+			return ""
+		}
+	}
+	return "belongs to a player outside the guild"
+}
+
+// diffCharacter lists the changes from c, holding the key oldKey, to the
+// imported fields of params with roles and the key newKey.
+func diffCharacter(c repo.Character, oldKey *Keystone, params repo.SetCharacterArmoryParams, roles []UserRole,
+	newKey *Keystone) []FieldChange {
+	var changes []FieldChange
+	add := func(field, old, new string) {
+		if old != new {
+			changes = append(changes, FieldChange{Field: field, Old: old, New: new})
+		}
+	}
+	oldLevel := ""
+	if c.ID != 0 {
+		oldLevel = strconv.Itoa(int(c.ItemLevel))
+	}
+
+	add("class", c.Class, params.Class)
+	add("spec", c.Spec, params.Spec)
+	add("item_level", oldLevel, strconv.Itoa(int(params.ItemLevel)))
+	add("roles", strings.Join(c.Roles, ","), strings.Join(roleStrings(roles), ","))
+	add("keystone", oldKey.String(), newKey.String())
+	return changes
+}
+
+// specRole returns the role a character plays in spec.
+func specRole(spec string) UserRole {
+	if role, ok := specRoles[spec]; ok {
+		return role
+	}
+	return RoleDPS
+}
+
+// skipProfile returns the change of a profile skipped for reason.
+func skipProfile(profile armory.Profile, reason string) *CharacterChange {
+	return &CharacterChange{Name: profile.Name, Realm: profile.Realm, Action: ImportSkip, Reason: reason}
+}
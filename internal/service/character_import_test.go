@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/tmaffia/dungeon-time-api/internal/armory"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+func Test_characterService_ImportCharacters_user(t *testing.T) {
+	ctx := context.Background()
+	mockq := repo.NewMockQuerier(t)
+	mockq.EXPECT().GetUserByID(ctx, int32(1)).Return(repo.GetUserByIDRow{ID: 1}, nil)
+	mockq.EXPECT().GetDungeons(ctx).Return([]repo.Dungeon{{ID: 7, Code: "ARAK", Name: "Ara-Kara, City of Echoes"}}, nil)
+	mockq.EXPECT().GetCharacterByName(ctx, repo.GetCharacterByNameParams{Realm: "Area 52", Name: "Thrall"}).
+		Return(repo.GetCharacterByNameRow{Character: repo.Character{ID: 3, UserID: 1, Name: "Thrall", Realm: "Area 52",
+			Class: "Shaman", Spec: "Enhancement", ItemLevel: 610, Roles: []string{"DPS"}}}, nil)
+	mockq.EXPECT().GetCharacterByName(ctx, repo.GetCharacterByNameParams{Realm: "Area 52", Name: "Rexxar"}).
+		Return(repo.GetCharacterByNameRow{Character: repo.Character{ID: 4, UserID: 1, Name: "Rexxar", Realm: "Area 52",
+			Class: "Hunter", Spec: "Beast Mastery", ItemLevel: 615, Roles: []string{"DPS"}},
+			KeyDungeon: pgtype.Text{String: "ARAK", Valid: true}, KeyLevel: pgtype.Int4{Int32: 10, Valid: true},
+			KeyRecordedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true}}, nil)
+	mockq.EXPECT().GetCharacterByName(ctx, repo.GetCharacterByNameParams{Realm: "Kel'Thuzad", Name: "Jaina"}).
+		Return(repo.GetCharacterByNameRow{}, pgx.ErrNoRows)
+	mockq.EXPECT().GetCharacterByName(ctx, repo.GetCharacterByNameParams{Realm: "Area 52", Name: "Garrosh"}).
+		Return(repo.GetCharacterByNameRow{Character: repo.Character{ID: 5, UserID: 2}}, nil)
+	s := &characterService{characterRepo: mockq, reset: DefaultWeeklyReset, now: time.Now}
+
+	source := armory.Static{
+		{Name: "Thrall", Realm: "Area 52", Class: "Shaman", Spec: "Restoration", ItemLevel: 623,
+			Keystone: &armory.Keystone{Dungeon: "Ara-Kara, City of Echoes", Level: 12}},
+		{Name: "Rexxar", Realm: "Area 52", Class: "Hunter", Spec: "Beast Mastery", ItemLevel: 615},
+		{Name: "Jaina", Realm: "Kel'Thuzad", Class: "Mage", Spec: "Frost", ItemLevel: 618},
+		{Name: "Garrosh", Realm: "Area 52", Class: "Warrior", Spec: "Arms", ItemLevel: 620},
+		{Name: "Thrall", Realm: "area 52", Class: "Shaman", Spec: "Restoration", ItemLevel: 623},
+		{Name: "Medivh", Realm: "Area 52", Class: "Bard", ItemLevel: 600},
+		{Name: "Khadgar", Realm: "Area 52", Class: "Mage", ItemLevel: 600,
+			Keystone: &armory.Keystone{Dungeon: "Karazhan", Level: 10}},
+	}
+	report, err := s.ImportCharacters(ctx, ImportTarget{UserID: int32Ptr(1), ActorID: 1}, source, true)
+	assert.NoError(t, err)
+
+	assert.True(t, report.DryRun)
+	assert.Equal(t, 1, report.Created)
+	assert.Equal(t, 1, report.Updated)
+	assert.Equal(t, 1, report.Unchanged)
+	assert.Equal(t, 4, report.Skipped)
+	assert.Equal(t, []FieldChange{
+		{Field: "spec", Old: "Enhancement", New: "Restoration"},
+		{Field: "item_level", Old: "610", New: "623"},
+		{Field: "roles", Old: "DPS", New: "DPS,Healer"},
+		{Field: "keystone", Old: "", New: "ARAK +12"},
+	}, report.Characters[0].Changes)
+	assert.Equal(t, ImportUnchanged, report.Characters[1].Action, "the held key is kept")
+	assert.Empty(t, report.Characters[1].Changes)
+	assert.Equal(t, ImportCreate, report.Characters[2].Action)
+	assert.Equal(t, int32(1), report.Characters[2].UserID)
+	assert.Equal(t, "belongs to another user", report.Characters[3].Reason)
+	assert.Equal(t, "listed more than once", report.Characters[4].Reason)
+	assert.Contains(t, report.Characters[5].Reason, `unknown class "Bard"`)
+	assert.Equal(t, `unknown dungeon "Karazhan"`, report.Characters[6].Reason)
+}
+
+func Test_characterService_ImportCharacters_guild(t *testing.T) {
+	tests := []struct {
+		name       string
+		actorID    int32
+		owner      string
+		wantAction ImportAction
+		wantUserID int32
+		wantReason string
+		wantErr    error
+	}{
+		{"Owner Is Member", 1, "Warchief", ImportCreate, 2, "", nil},
+		{"Owner Not Member", 1, "Varian", ImportSkip, 0, `owner "Varian" is not a member of the guild`, nil},
+		{"No Owner", 1, "", ImportSkip, 0, "no owner for a new character", nil},
+		{"Not Guild Admin", 2, "Warchief", "", 0, "", ErrForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			mockq.EXPECT().GetGuildByID(ctx, int32(9)).Return(repo.Guild{ID: 9}, nil)
+			mockq.EXPECT().GetGuildMembers(ctx, int32(9)).Return([]repo.GetGuildMembersRow{
+				{GuildID: 9, UserID: 1, Username: "thrall", Role: "admin"},
+				{GuildID: 9, UserID: 2, Username: "warchief", Role: "member"},
+			}, nil)
+			if tt.wantErr == nil {
+				mockq.EXPECT().GetDungeons(ctx).Return(nil, nil)
+				mockq.EXPECT().GetCharacterByName(ctx, mock.Anything).Return(repo.GetCharacterByNameRow{}, pgx.ErrNoRows)
+			}
+			s := &characterService{characterRepo: mockq, reset: DefaultWeeklyReset, now: time.Now}
+
+			source := armory.Static{{Name: "Garrosh", Realm: "Area 52", Class: "Warrior", Spec: "Protection",
+				ItemLevel: 620, Owner: tt.owner}}
+			report, err := s.ImportCharacters(ctx, ImportTarget{GuildID: int32Ptr(9), ActorID: tt.actorID}, source, true)
+			assert.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			change := report.Characters[0]
+			assert.Equal(t, tt.wantAction, change.Action)
+			assert.Equal(t, tt.wantUserID, change.UserID)
+			assert.Equal(t, tt.wantReason, change.Reason)
+		})
+	}
+}
+
+func Test_characterService_ImportCharacters_target(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  ImportTarget
+		wantErr error
+	}{
+		{"No Target", ImportTarget{ActorID: 1}, ErrInvalidImport},
+		{"User And Guild", ImportTarget{UserID: int32Ptr(1), GuildID: int32Ptr(9), ActorID: 1}, ErrInvalidImport},
+		{"Someone Else", ImportTarget{UserID: int32Ptr(2), ActorID: 1}, ErrForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &characterService{characterRepo: repo.NewMockQuerier(t), reset: DefaultWeeklyReset, now: time.Now}
+
+			_, err := s.ImportCharacters(context.Background(), tt.target, armory.Static{}, true)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_specRole(t *testing.T) {
+	assert.Equal(t, RoleTank, specRole("Vengeance"))
+	assert.Equal(t, RoleHealer, specRole("Mistweaver"))
+	assert.Equal(t, RoleDPS, specRole("Frost"))
+	assert.Equal(t, RoleDPS, specRole(""))
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+func Test_isValidCharacter(t *testing.T) {
+	valid := func(change func(*CharacterInput)) *CharacterInput {
+		input := &CharacterInput{Name: "Thrall", Realm: "Area 52", Class: "Shaman", Spec: "Restoration",
+			ItemLevel: 620, Roles: []UserRole{RoleHealer, RoleDPS}}
+		change(input)
+		return input
+	}
+
+	tests := []struct {
+		name    string
+		input   *CharacterInput
+		wantErr error
+	}{
+		{"Valid", valid(func(*CharacterInput) {}), nil},
+		{"Accented Name", valid(func(c *CharacterInput) { c.Name = "Jaína" }), nil},
+		{"Apostrophe Realm", valid(func(c *CharacterInput) { c.Realm = "Kel'Thuzad" }), nil},
+		{"No Spec", valid(func(c *CharacterInput) { c.Spec = "" }), nil},
+		{"Name With Space", valid(func(c *CharacterInput) { c.Name = "Go El" }), ErrInvalidCharacter},
+		{"Name Too Long", valid(func(c *CharacterInput) { c.Name = "Thrallthrallthrall" }), ErrInvalidCharacter},
+		{"No Realm", valid(func(c *CharacterInput) { c.Realm = " " }), ErrInvalidCharacter},
+		{"Unknown Class", valid(func(c *CharacterInput) { c.Class = "Bard" }), ErrInvalidCharacter},
+		{"Item Level Too High", valid(func(c *CharacterInput) { c.ItemLevel = 5000 }), ErrInvalidCharacter},
+		{"No Roles", valid(func(c *CharacterInput) { c.Roles = nil }), ErrInvalidCharacter},
+		{"Unknown Role", valid(func(c *CharacterInput) { c.Roles = []UserRole{"Bard"} }), ErrInvalidRole},
+		{"Role Twice", valid(func(c *CharacterInput) { c.Roles = []UserRole{RoleDPS, RoleDPS} }),
+			ErrInvalidCharacter},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, isValidCharacter(tt.input), tt.wantErr)
+		})
+	}
+}
+
+func Test_characterService_CreateCharacter(t *testing.T) {
+	input := &CharacterInput{Name: "Thrall", Realm: " Area 52 ", Class: "Shaman", ItemLevel: 620,
+		Roles: []UserRole{RoleHealer}}
+
+	tests := []struct {
+		name    string
+		actorID int32
+		dbErr   error
+		wantErr error
+	}{
+		{"CreateCharacter Success", 1, nil, nil},
+		{"CreateCharacter Someone Else", 2, nil, ErrForbidden},
+		{"CreateCharacter Taken", 1, &pgconn.PgError{Code: uniqueViolation}, ErrCharacterExists},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			if tt.wantErr != ErrForbidden {
+				mockq.EXPECT().CreateCharacter(ctx, repo.CreateCharacterParams{UserID: 1, Name: "Thrall",
+					Realm: "Area 52", Class: "Shaman", ItemLevel: 620, Roles: []string{"Healer"}}).
+					Return(repo.Character{ID: 3, UserID: 1, Roles: []string{"Healer"}}, tt.dbErr)
+			}
+			s := &characterService{characterRepo: mockq}
+
+			_, err := s.CreateCharacter(ctx, tt.actorID, 1, input)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_playableRoles(t *testing.T) {
+	tests := []struct {
+		name        string
+		characterID *int32
+		want        []UserRole
+		wantErr     error
+	}{
+		{"User", nil, []UserRole{RoleTank, RoleDPS}, nil},
+		{"Character", int32Ptr(3), []UserRole{RoleHealer}, nil},
+		{"Unknown Character", int32Ptr(4), nil, ErrCharacterNotFound},
+		{"Someone Elses Character", int32Ptr(5), nil, ErrCharacterNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			mockq.EXPECT().GetUserByID(ctx, int32(1)).
+				Return(repo.GetUserByIDRow{ID: 1, Roles: []string{"Tank", "DPS"}}, nil).Maybe()
+			mockq.EXPECT().GetCharacterByID(ctx, int32(3)).
+				Return(repo.GetCharacterByIDRow{Character: repo.Character{ID: 3, UserID: 1, Roles: []string{"Healer"}}}, nil).
+				Maybe()
+			mockq.EXPECT().GetCharacterByID(ctx, int32(4)).Return(repo.GetCharacterByIDRow{}, pgx.ErrNoRows).Maybe()
+			mockq.EXPECT().GetCharacterByID(ctx, int32(5)).
+				Return(repo.GetCharacterByIDRow{Character: repo.Character{ID: 5, UserID: 2, Roles: []string{"Tank"}}}, nil).
+				Maybe()
+
+			got, err := playableRoles(ctx, mockq, 1, tt.characterID)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package service
+
+// maxPartySize is the largest group a dungeon can be run with.
+const maxPartySize = 5
+
+// Composition is the number of slots a group has for each combat role.
+type Composition struct {
+	Tanks   int32 `json:"tanks"`
+	Healers int32 `json:"healers"`
+	DPS     int32 `json:"dps"`
+}
+
+// DefaultComposition is the standard dungeon group of 1 tank, 1 healer and 3 DPS.
+var DefaultComposition = Composition{Tanks: 1, Healers: 1, DPS: 3}
+
+// combatRoles are the roles that fill slots in a group, in the order they are listed.
+var combatRoles = []UserRole{RoleTank, RoleHealer, RoleDPS}
+
+// Slots returns the number of slots for role. Non combat roles have no slots.
+func (c Composition) Slots(role UserRole) int32 {
+	switch role {
+	case RoleTank:
+		return c.Tanks
+	case RoleHealer:
+		return c.Healers
+	case RoleDPS:
+		return c.DPS
+	default:
+		return 0
+	}
+}
+
+// without returns c with one slot of role less.
+func (c Composition) without(role UserRole) Composition {
+	switch role {
+	case RoleTank:
+		c.Tanks--
+	case RoleHealer:
+		c.Healers--
+	case RoleDPS:
+		c.DPS--
+	}
+	return c
+}
+
+// Size returns the total number of slots in the composition
+func (c Composition) Size() int32 {
+	return c.Tanks + c.Healers + c.DPS
+}
+
+func isValidComposition(c Composition) bool {
+	return c.Tanks >= 0 && c.Healers >= 0 && c.DPS >= 0 &&
+		c.Size() >= 1 && c.Size() <= maxPartySize
+}
+
+func isCombatRole(role UserRole) bool {
+	return role == RoleTank || role == RoleHealer || role == RoleDPS
+}
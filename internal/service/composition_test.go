@@ -0,0 +1,45 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComposition_Slots(t *testing.T) {
+	tests := []struct {
+		name string
+		role UserRole
+		want int32
+	}{
+		{"Tank", RoleTank, 1},
+		{"Healer", RoleHealer, 1},
+		{"DPS", RoleDPS, 3},
+		{"Leader Has No Slots", RoleLeader, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DefaultComposition.Slots(tt.role))
+		})
+	}
+}
+
+func Test_isValidComposition(t *testing.T) {
+	tests := []struct {
+		name        string
+		composition Composition
+		want        bool
+	}{
+		{"Default", DefaultComposition, true},
+		{"Two Healers", Composition{Tanks: 1, Healers: 2, DPS: 2}, true},
+		{"Duo", Composition{Tanks: 1, Healers: 1}, true},
+		{"Empty", Composition{}, false},
+		{"Negative", Composition{Tanks: -1, Healers: 1, DPS: 3}, false},
+		{"Too Large", Composition{Tanks: 2, Healers: 2, DPS: 3}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isValidComposition(tt.composition))
+		})
+	}
+}
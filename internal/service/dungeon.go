@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// dungeonCatalogName is the key the dungeon catalog version is stored under
+// in the catalog_versions table.
+const dungeonCatalogName = "dungeons"
+
+// The dungeon catalog shipped with the binary. Bump the version in the file
+// whenever its contents change so that existing databases pick it up.
+//
+//go:embed seed/dungeons.json
+var dungeonSeed []byte
+
+// Dungeon represents a single dungeon from the catalog.
+// ParSeconds is the timer a run has to beat to count as timed.
+type Dungeon struct {
+	ID           int32        `json:"id"`
+	Code         string       `json:"code"`
+	Name         string       `json:"name"`
+	Expansion    string       `json:"expansion"`
+	Season       string       `json:"season"`
+	ParSeconds   int32        `json:"par_seconds"`
+	BossCount    int32        `json:"boss_count"`
+	Difficulties []Difficulty `json:"difficulties"`
+}
+
+// ParTimer returns the dungeon's par timer as a time.Duration
+func (d *Dungeon) ParTimer() time.Duration {
+	return time.Duration(d.ParSeconds) * time.Second
+}
+
+// SupportsDifficulty reports whether the dungeon can be run at the given difficulty
+func (d *Dungeon) SupportsDifficulty(difficulty Difficulty) bool {
+	return slices.Contains(d.Difficulties, difficulty)
+}
+
+// DungeonCatalog is the versioned set of dungeons available for the current season.
+// It is the format of both the embedded seed file and the admin import endpoint.
+type DungeonCatalog struct {
+	Version  int32     `json:"version"`
+	Dungeons []Dungeon `json:"dungeons"`
+}
+
+// Difficulty represents a difficulty level a dungeon can be run at
+type Difficulty string
+
+const (
+	DifficultyNormal     = Difficulty("Normal")
+	DifficultyHeroic     = Difficulty("Heroic")
+	DifficultyMythic     = Difficulty("Mythic")
+	DifficultyMythicPlus = Difficulty("Mythic+")
+)
+
+// Pre defined difficulties, these are the only valid difficulties for a dungeon.
+var difficulties = []Difficulty{DifficultyNormal, DifficultyHeroic,
+	DifficultyMythic, DifficultyMythicPlus}
+
+// DungeonService is the interface for dungeon catalog operations.
+type DungeonService interface {
+	GetDungeons(context.Context) ([]*Dungeon, error)
+	GetDungeonByCode(context.Context, string) (*Dungeon, error)
+	ImportCatalog(context.Context, *DungeonCatalog) error
+	SeedCatalog(context.Context) error
+}
+
+// dungeonService is the implementation of DungeonService.
+type dungeonService struct {
+	dbPool      *pgxpool.Pool
+	dungeonRepo repo.Querier
+}
+
+// NewDungeonService creates a new dungeonService with the provided database connection pool.
+// It returns a pointer to the dungeonService.
+func NewDungeonService(dbPool *pgxpool.Pool) *dungeonService {
+	return &dungeonService{
+		dbPool:      dbPool,
+		dungeonRepo: repo.New(dbPool),
+	}
+}
+
+// GetDungeons returns every dungeon in the active catalog, ordered by name.
+func (s *dungeonService) GetDungeons(ctx context.Context) ([]*Dungeon, error) {
+	var dungeons []*Dungeon
+	d, err := s.dungeonRepo.GetDungeons(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, dungeon := range d {
+		dungeons = append(dungeons, mapDungeon(dungeon))
+	}
+	return dungeons, nil
+}
+
+// GetDungeonByCode returns a dungeon by its short code, such as "ARAK".
+// Returns ErrDungeonNotFound if no dungeon has the code.
+func (s *dungeonService) GetDungeonByCode(ctx context.Context, code string) (*Dungeon, error) {
+	d, err := s.dungeonRepo.GetDungeonByCode(ctx, code)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrDungeonNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return mapDungeon(d), nil
+}
+
+// ImportCatalog replaces the active dungeon catalog with the provided one.
+// Dungeons are matched on their code, so ids stay stable across seasons, and
+// dungeons missing from the catalog are deactivated rather than deleted.
+// Returns ErrStaleCatalog if the catalog version is not newer than the one
+// already applied.
+func (s *dungeonService) ImportCatalog(ctx context.Context, catalog *DungeonCatalog) error {
+	if err := isValidCatalog(catalog); err != nil {
+		return err
+	}
+
+	return inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		if err := q.LockCatalog(ctx, dungeonCatalogName); err != nil {
+			return err
+		}
+
+		current, err := q.GetCatalogVersion(ctx, dungeonCatalogName)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+		if catalog.Version <= current {
+			return ErrStaleCatalog
+		}
+
+		if err := q.DeactivateDungeons(ctx); err != nil {
+			return err
+		}
+
+		for _, d := range catalog.Dungeons {
+			_, err := q.UpsertDungeon(ctx, repo.UpsertDungeonParams{
+				Code:         d.Code,
+				Name:         d.Name,
+				Expansion:    d.Expansion,
+				Season:       d.Season,
+				ParSeconds:   d.ParSeconds,
+				BossCount:    d.BossCount,
+				Difficulties: difficultyStrings(d.Difficulties),
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return q.SetCatalogVersion(ctx, repo.SetCatalogVersionParams{
+			Catalog: dungeonCatalogName,
+			Version: catalog.Version,
+		})
+	})
+}
+
+// SeedCatalog imports the dungeon catalog embedded in the binary.
+// It is safe to call on every startup, the import is skipped when the
+// database already holds the same or a newer version.
+func (s *dungeonService) SeedCatalog(ctx context.Context) error {
+	catalog, err := parseCatalog(dungeonSeed)
+	if err != nil {
+		return fmt.Errorf("embedded dungeon catalog: %w", err)
+	}
+
+	err = s.ImportCatalog(ctx, catalog)
+	if errors.Is(err, ErrStaleCatalog) {
+		return nil
+	}
+	return err
+}
+
+// parseCatalog decodes a JSON dungeon catalog. It does not validate the contents.
+func parseCatalog(data []byte) (*DungeonCatalog, error) {
+	var catalog DungeonCatalog
+	if err := json.Unmarshal(data, &catalog); err != nil {
+		return nil, err
+	}
+	return &catalog, nil
+}
+
+func mapDungeon(d repo.Dungeon) *Dungeon {
+	var diffs []Difficulty
+	for _, diff := range d.Difficulties {
+		diffs = append(diffs, Difficulty(diff))
+	}
+
+	return &Dungeon{
+		ID:           d.ID,
+		Code:         d.Code,
+		Name:         d.Name,
+		Expansion:    d.Expansion,
+		Season:       d.Season,
+		ParSeconds:   d.ParSeconds,
+		BossCount:    d.BossCount,
+		Difficulties: diffs,
+	}
+}
+
+func difficultyStrings(diffs []Difficulty) []string {
+	strs := make([]string, 0, len(diffs))
+	for _, d := range diffs {
+		strs = append(strs, string(d))
+	}
+	return strs
+}
+
+func isValidCatalog(catalog *DungeonCatalog) error {
+	if catalog.Version < 1 || len(catalog.Dungeons) == 0 {
+		return ErrInvalidCatalog
+	}
+
+	seen := make(map[string]bool)
+	for i := range catalog.Dungeons {
+		d := &catalog.Dungeons[i]
+		if err := isValidDungeon(d); err != nil {
+			return fmt.Errorf("%w: %s", err, d.Code)
+		}
+		if seen[d.Code] {
+			return fmt.Errorf("%w: duplicate code %s", ErrInvalidCatalog, d.Code)
+		}
+		seen[d.Code] = true
+	}
+	return nil
+}
+
+var dungeonCodeRegex = regexp.MustCompile(`^[A-Z0-9]{2,8}$`)
+
+func isValidDungeon(d *Dungeon) error {
+	if !dungeonCodeRegex.MatchString(d.Code) || d.Name == "" ||
+		d.Expansion == "" || d.Season == "" ||
+		d.ParSeconds <= 0 || d.BossCount <= 0 {
+		return ErrInvalidDungeon
+	}
+
+	if len(d.Difficulties) == 0 || !isValidDifficulties(d.Difficulties...) {
+		return ErrInvalidDifficulty
+	}
+	return nil
+}
+
+func isValidDifficulties(diffs ...Difficulty) bool {
+	for _, d := range diffs {
+		if !slices.Contains(difficulties, d) {
+			return false
+		}
+	}
+	return true
+}
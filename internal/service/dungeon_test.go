@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+func validCatalogDungeon() Dungeon {
+	return Dungeon{
+		Code:         "ARAK",
+		Name:         "Ara-Kara, City of Echoes",
+		Expansion:    "The War Within",
+		Season:       "TWW-S1",
+		ParSeconds:   1800,
+		BossCount:    3,
+		Difficulties: []Difficulty{DifficultyMythic, DifficultyMythicPlus},
+	}
+}
+
+func Test_parseCatalog_embeddedSeed(t *testing.T) {
+	catalog, err := parseCatalog(dungeonSeed)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.GreaterOrEqual(t, catalog.Version, int32(1))
+	assert.NotEmpty(t, catalog.Dungeons)
+	assert.NoError(t, isValidCatalog(catalog))
+}
+
+func Test_isValidCatalog(t *testing.T) {
+	badCode := validCatalogDungeon()
+	badCode.Code = "ara-kara"
+	noPar := validCatalogDungeon()
+	noPar.ParSeconds = 0
+	badDifficulty := validCatalogDungeon()
+	badDifficulty.Difficulties = []Difficulty{"Legendary"}
+	noDifficulty := validCatalogDungeon()
+	noDifficulty.Difficulties = nil
+
+	tests := []struct {
+		name    string
+		catalog *DungeonCatalog
+		wantErr error
+	}{
+		{"Valid Catalog", &DungeonCatalog{Version: 1, Dungeons: []Dungeon{validCatalogDungeon()}}, nil},
+		{"Missing Version", &DungeonCatalog{Dungeons: []Dungeon{validCatalogDungeon()}}, ErrInvalidCatalog},
+		{"No Dungeons", &DungeonCatalog{Version: 1}, ErrInvalidCatalog},
+		{"Duplicate Code", &DungeonCatalog{Version: 1, Dungeons: []Dungeon{validCatalogDungeon(), validCatalogDungeon()}}, ErrInvalidCatalog},
+		{"Invalid Code", &DungeonCatalog{Version: 1, Dungeons: []Dungeon{badCode}}, ErrInvalidDungeon},
+		{"Missing Par Timer", &DungeonCatalog{Version: 1, Dungeons: []Dungeon{noPar}}, ErrInvalidDungeon},
+		{"Unknown Difficulty", &DungeonCatalog{Version: 1, Dungeons: []Dungeon{badDifficulty}}, ErrInvalidDifficulty},
+		{"No Difficulties", &DungeonCatalog{Version: 1, Dungeons: []Dungeon{noDifficulty}}, ErrInvalidDifficulty},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := isValidCatalog(tt.catalog)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_dungeonService_GetDungeonByCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    string
+		row     repo.Dungeon
+		rowErr  error
+		want    *Dungeon
+		wantErr error
+	}{
+		{
+			"GetDungeonByCode Success",
+			"ARAK",
+			repo.Dungeon{ID: 1, Code: "ARAK", Name: "Ara-Kara, City of Echoes", ParSeconds: 1800,
+				BossCount: 3, Difficulties: []string{"Mythic", "Mythic+"}},
+			nil,
+			&Dungeon{ID: 1, Code: "ARAK", Name: "Ara-Kara, City of Echoes", ParSeconds: 1800,
+				BossCount: 3, Difficulties: []Difficulty{DifficultyMythic, DifficultyMythicPlus}},
+			nil,
+		},
+		{
+			"GetDungeonByCode Not Found",
+			"NOPE",
+			repo.Dungeon{},
+			pgx.ErrNoRows,
+			nil,
+			ErrDungeonNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			mockq.EXPECT().GetDungeonByCode(ctx, tt.code).Return(tt.row, tt.rowErr)
+			s := &dungeonService{dungeonRepo: mockq}
+
+			got, err := s.GetDungeonByCode(ctx, tt.code)
+			if !assert.ErrorIs(t, err, tt.wantErr) {
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDungeon_SupportsDifficulty(t *testing.T) {
+	d := validCatalogDungeon()
+	assert.True(t, d.SupportsDifficulty(DifficultyMythicPlus))
+	assert.False(t, d.SupportsDifficulty(DifficultyNormal))
+}
@@ -1,15 +1,125 @@
 package service
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
-	ErrUserNotFound      = errors.New("user not found")
-	ErrUserExists        = errors.New("user already exists")
-	ErrIncorrectPassword = errors.New("incorrect password")
-	ErrInvalidUser       = errors.New("invalid user")
-	ErrInvalidRole       = errors.New("invalid role")
-	ErrInvalidTimezone   = errors.New("invalid timezone")
-	ErrInvalidPassword   = errors.New("invalid password")
-	ErrInvalidEmail      = errors.New("invalid email")
-	ErrInvalidUsername   = errors.New("invalid username")
+	ErrUserNotFound                   = errors.New("user not found")
+	ErrUserExists                     = errors.New("user already exists")
+	ErrIncorrectPassword              = errors.New("incorrect password")
+	ErrInvalidUser                    = errors.New("invalid user")
+	ErrInvalidRole                    = errors.New("invalid role")
+	ErrInvalidTimezone                = errors.New("invalid timezone")
+	ErrInvalidPassword                = errors.New("invalid password")
+	ErrInvalidEmail                   = errors.New("invalid email")
+	ErrInvalidUsername                = errors.New("invalid username")
+	ErrDungeonNotFound                = errors.New("dungeon not found")
+	ErrInvalidDungeon                 = errors.New("invalid dungeon")
+	ErrInvalidDifficulty              = errors.New("invalid difficulty")
+	ErrInvalidCatalog                 = errors.New("invalid catalog")
+	ErrStaleCatalog                   = errors.New("catalog version is not newer than the current catalog")
+	ErrForbidden                      = errors.New("forbidden")
+	ErrRunNotFound                    = errors.New("run not found")
+	ErrRunCancelled                   = errors.New("run is cancelled")
+	ErrRunInPast                      = errors.New("run must start in the future")
+	ErrRunStarted                     = errors.New("run has already started")
+	ErrInvalidRunStatus               = errors.New("invalid run status")
+	ErrInvalidTransition              = errors.New("invalid run status transition")
+	ErrInvalidStartTime               = errors.New("invalid start time")
+	ErrInvalidDuration                = errors.New("invalid duration")
+	ErrInvalidNotes                   = errors.New("invalid notes")
+	ErrInvalidKeyLevel                = errors.New("invalid key level")
+	ErrInvalidTimeRange               = errors.New("invalid time range")
+	ErrInvalidComposition             = errors.New("invalid composition")
+	ErrRoleNotPlayable                = errors.New("user can not play this role")
+	ErrAlreadySignedUp                = errors.New("user is already signed up for this run")
+	ErrSignupNotFound                 = errors.New("signup not found")
+	ErrInvalidWaitlistOrder           = errors.New("waitlist order must list every waitlisted user once")
+	ErrSeriesNotFound                 = errors.New("series not found")
+	ErrOccurrenceNotFound             = errors.New("occurrence not found")
+	ErrInvalidRRule                   = errors.New("invalid recurrence rule")
+	ErrGuildNotFound                  = errors.New("guild not found")
+	ErrGuildExists                    = errors.New("guild already exists")
+	ErrInvalidGuild                   = errors.New("invalid guild")
+	ErrAlreadyGuildMember             = errors.New("user is already a member of this guild")
+	ErrGuildMemberNotFound            = errors.New("guild member not found")
+	ErrInvalidAvailability            = errors.New("invalid availability")
+	ErrAvailabilityNotFound           = errors.New("availability exception not found")
+	ErrInvalidNotificationPreferences = errors.New("invalid notification preferences")
+	ErrInvalidDiscordWebhook          = errors.New("invalid discord webhook")
+	ErrNotificationNotFound           = errors.New("notification not found")
+	ErrJobNotFound                    = errors.New("job not found")
+	ErrInvalidJobStatus               = errors.New("invalid job status")
+	ErrJobNotDead                     = errors.New("only dead jobs can be retried")
+	ErrJobPending                     = errors.New("an identical job is already pending")
+	ErrInvalidWebhook                 = errors.New("invalid webhook")
+	ErrWebhookNotFound                = errors.New("webhook not found")
+	ErrWebhookDeliveryNotFound        = errors.New("webhook delivery not found")
+	ErrWebhookDisabled                = errors.New("webhook is disabled")
+	ErrInvalidReadyCheck              = errors.New("invalid ready check")
+	ErrReadyCheckNotFound             = errors.New("ready check not found")
+	ErrReadyCheckInProgress           = errors.New("a ready check is already in progress")
+	ErrReadyCheckNotPending           = errors.New("no ready check is in progress")
+	ErrInvalidSeason                  = errors.New("invalid season")
+	ErrSeasonNotFound                 = errors.New("season not found")
+	ErrSeasonExists                   = errors.New("season already exists")
+	ErrSeasonOpen                     = errors.New("another season is still open")
+	ErrSeasonClosed                   = errors.New("season is closed")
+	ErrInvalidScoring                 = errors.New("invalid leaderboard scoring")
+	ErrInvalidPage                    = errors.New("invalid page")
+	ErrInvalidAttendance              = errors.New("invalid attendance")
+	ErrRunNotFinished                 = errors.New("run has not finished")
+	ErrInvalidSignupPolicy            = errors.New("invalid signup policy")
+	ErrInvalidLFGEntry                = errors.New("invalid looking for group entry")
+	ErrAlreadyQueued                  = errors.New("user is already looking for a group")
+	ErrNotQueued                      = errors.New("user is not looking for a group")
+	ErrProposalNotFound               = errors.New("group proposal not found")
+	ErrProposalClosed                 = errors.New("group proposal is no longer open")
+	ErrCharacterNotFound              = errors.New("character not found")
+	ErrCharacterExists                = errors.New("character already exists on this realm")
+	ErrInvalidCharacter               = errors.New("invalid character")
+	ErrInvalidImport                  = errors.New("invalid character import")
+	ErrKeystoneNotFound               = errors.New("keystone not found")
+	ErrInvalidKeystone                = errors.New("keys can only be held for active dungeons")
+	ErrInvalidRegion                  = errors.New("invalid region")
+	ErrAffixNotFound                  = errors.New("affix not found")
+	ErrInvalidAffixRotation           = errors.New("invalid affix rotation")
+	ErrInvalidVaultQuery              = errors.New("invalid vault query")
+	ErrNoVaultPush                    = errors.New("no group of members short of their vault can run a key before the reset")
 )
+
+// TransitionError is returned when a run can not move from its status to
+// another. It wraps ErrInvalidTransition and carries a problem code clients
+// can rely on instead of the message.
+type TransitionError struct {
+	From RunStatus
+	To   RunStatus
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("%s: %s run can not become %s", ErrInvalidTransition, e.From, e.To)
+}
+
+func (e *TransitionError) Unwrap() error {
+	return ErrInvalidTransition
+}
+
+// ProblemCode returns why the transition is not allowed: the run is
+// "run_cancelled", "run_finished", "run_not_started" or "run_in_progress",
+// or "invalid_transition" when none of those apply.
+func (e *TransitionError) ProblemCode() string {
+	switch {
+	case e.From == RunStatusCancelled:
+		return "run_cancelled"
+	case e.From == RunStatusCompleted, e.From == RunStatusDepleted, e.From == RunStatusAbandoned:
+		return "run_finished"
+	case e.From.Open() && (e.To == RunStatusCompleted || e.To == RunStatusAbandoned):
+		return "run_not_started"
+	case e.From == RunStatusInProgress:
+		return "run_in_progress"
+	default:
+		return "invalid_transition"
+	}
+}
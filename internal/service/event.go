@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// RunEventType identifies what changed in a run.
+type RunEventType string
+
+const (
+	EventSignupConfirmed    = RunEventType("signup.confirmed")
+	EventSignupWaitlisted   = RunEventType("signup.waitlisted")
+	EventSignupWithdrawn    = RunEventType("signup.withdrawn")
+	EventSignupPromoted     = RunEventType("signup.promoted")
+	EventWaitlistReordered  = RunEventType("waitlist.reordered")
+	EventRunUpdated         = RunEventType("run.updated")
+	EventRunCancelled       = RunEventType("run.cancelled")
+	EventRunStatusChanged   = RunEventType("run.status_changed")
+	EventReadyCheckStarted  = RunEventType("ready_check.started")
+	EventReadyCheckAnswered = RunEventType("ready_check.answered")
+	EventReadyCheckFinished = RunEventType("ready_check.finished")
+)
+
+// RunEvent is a change to a run, recorded in the same transaction as the change
+// itself so that anything reacting to events never sees a change that was rolled back.
+// UserID is the user the event is about, if any.
+type RunEvent struct {
+	ID        int64           `json:"id"`
+	RunID     int32           `json:"run_id"`
+	Type      RunEventType    `json:"type"`
+	UserID    *int32          `json:"user_id,omitempty"`
+	Payload   json.RawMessage `json:"payload"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// signupEventPayload is the payload of every signup.* event.
+type signupEventPayload struct {
+	SignupID int32        `json:"signup_id"`
+	Role     UserRole     `json:"role"`
+	Status   SignupStatus `json:"status"`
+}
+
+// recordRunEvent stores an event for a run. Call it with the Querier of the
+// transaction making the change, holding the lock on the run or having created
+// it, so the events of a run commit in the order of their ids. Streams skip
+// events with ids below the last one they sent.
+func recordRunEvent(ctx context.Context, q repo.Querier, runID int32, eventType RunEventType,
+	userID *int32, payload any) (*RunEvent, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := q.CreateRunEvent(ctx, repo.CreateRunEventParams{
+		RunID:   runID,
+		Type:    string(eventType),
+		UserID:  pgInt4(userID),
+		Payload: body,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mapRunEvent(e), nil
+}
+
+// recordSignupEvent stores a signup.* event for the signup.
+func recordSignupEvent(ctx context.Context, q repo.Querier, eventType RunEventType, signup repo.RunSignup) error {
+	_, err := recordRunEvent(ctx, q, signup.RunID, eventType, &signup.UserID, signupEventPayload{
+		SignupID: signup.ID,
+		Role:     UserRole(signup.Role),
+		Status:   SignupStatus(signup.Status),
+	})
+	return err
+}
+
+func mapRunEvent(e repo.RunEvent) *RunEvent {
+	return &RunEvent{
+		ID:        e.ID,
+		RunID:     e.RunID,
+		Type:      RunEventType(e.Type),
+		UserID:    int4Ptr(e.UserID),
+		Payload:   json.RawMessage(e.Payload),
+		CreatedAt: e.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,27 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+func Test_recordSignupEvent(t *testing.T) {
+	ctx := context.Background()
+	signup := repo.RunSignup{ID: 9, RunID: 2, UserID: 5, Role: "Healer", Status: "confirmed"}
+	params := repo.CreateRunEventParams{
+		RunID:   2,
+		Type:    "signup.promoted",
+		UserID:  pgInt4(&signup.UserID),
+		Payload: []byte(`{"signup_id":9,"role":"Healer","status":"confirmed"}`),
+	}
+
+	mockq := repo.NewMockQuerier(t)
+	mockq.EXPECT().CreateRunEvent(ctx, params).Return(repo.RunEvent{
+		ID: 1, RunID: params.RunID, Type: params.Type, UserID: params.UserID, Payload: params.Payload,
+	}, nil)
+
+	assert.NoError(t, recordSignupEvent(ctx, mockq, EventSignupPromoted, signup))
+}
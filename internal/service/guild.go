@@ -0,0 +1,328 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// GuildRole is what a member is allowed to do in a guild.
+type GuildRole string
+
+const (
+	GuildRoleAdmin  = GuildRole("admin")
+	GuildRoleMember = GuildRole("member")
+)
+
+// Guild is a group of users that play together. The Discord webhook URL is
+// a secret, so only whether there is one is shown. LeaderboardScoring is how
+// members are rated on the guild's leaderboard.
+type Guild struct {
+	ID                 int32              `json:"id"`
+	Name               string             `json:"name"`
+	Members            []*GuildMember     `json:"members"`
+	DiscordWebhook     bool               `json:"discord_webhook"`
+	LeaderboardScoring LeaderboardScoring `json:"leaderboard_scoring"`
+	SignupPolicy       SignupPolicy       `json:"signup_policy"`
+	CreatedAt          time.Time          `json:"created_at"`
+	UpdatedAt          time.Time          `json:"updated_at"`
+}
+
+// GuildMember is a user's membership of a guild.
+type GuildMember struct {
+	UserID   int32     `json:"user_id"`
+	Username string    `json:"username"`
+	Role     GuildRole `json:"role"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+// IsAdmin reports whether the user with userID administers the guild.
+func (g *Guild) IsAdmin(userID int32) bool {
+	for _, m := range g.Members {
+		if m.UserID == userID {
+			return m.Role == GuildRoleAdmin
+		}
+	}
+	return false
+}
+
+// GuildService is the interface for managing guilds and their members.
+type GuildService interface {
+	CreateGuild(context.Context, int32, string) (*Guild, error)
+	GetGuildByID(context.Context, int32) (*Guild, error)
+	AddMember(context.Context, int32, int32, int32) (*Guild, error)
+	RemoveMember(context.Context, int32, int32, int32) (*Guild, error)
+	SetDiscordWebhook(context.Context, int32, int32, string) (*Guild, error)
+	SetLeaderboardScoring(context.Context, int32, int32, LeaderboardScoring) (*Guild, error)
+	SetSignupPolicy(context.Context, int32, int32, *SignupPolicy) (*Guild, error)
+}
+
+// guildService is the implementation of GuildService.
+type guildService struct {
+	dbPool    *pgxpool.Pool
+	guildRepo repo.Querier
+}
+
+// NewGuildService creates a new guildService with the provided database connection pool.
+// It returns a pointer to the guildService.
+func NewGuildService(dbPool *pgxpool.Pool) *guildService {
+	return &guildService{
+		dbPool:    dbPool,
+		guildRepo: repo.New(dbPool),
+	}
+}
+
+// CreateGuild creates a guild with the user with ownerID as its first admin.
+func (s *guildService) CreateGuild(ctx context.Context, ownerID int32, name string) (*Guild, error) {
+	if !isValidGuildName(name) {
+		return nil, ErrInvalidGuild
+	}
+
+	if _, err := s.guildRepo.GetUserByID(ctx, ownerID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	if _, err := s.guildRepo.GetGuildByName(ctx, name); err == nil {
+		return nil, ErrGuildExists
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	var id int32
+	err := inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		g, err := q.CreateGuild(ctx, name)
+		if err != nil {
+			return err
+		}
+		id = g.ID
+		return addGuildMember(ctx, q, g.ID, ownerID, GuildRoleAdmin)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetGuildByID(ctx, id)
+}
+
+// GetGuildByID returns a guild and its members. Returns ErrGuildNotFound if it does not exist.
+func (s *guildService) GetGuildByID(ctx context.Context, id int32) (*Guild, error) {
+	return loadGuild(ctx, s.guildRepo, id)
+}
+
+// AddMember adds the user with userID to a guild as a member.
+// Only guild admins can add members.
+func (s *guildService) AddMember(ctx context.Context, actorID, guildID, userID int32) (*Guild, error) {
+	guild, err := s.GetGuildByID(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+	if !guild.IsAdmin(actorID) {
+		return nil, ErrForbidden
+	}
+
+	if _, err := s.guildRepo.GetUserByID(ctx, userID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	for _, m := range guild.Members {
+		if m.UserID == userID {
+			return nil, ErrAlreadyGuildMember
+		}
+	}
+
+	err = inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		return addGuildMember(ctx, q, guildID, userID, GuildRoleMember)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetGuildByID(ctx, guildID)
+}
+
+// RemoveMember removes the user with userID from a guild. Admins can remove
+// anyone and members can remove themselves.
+func (s *guildService) RemoveMember(ctx context.Context, actorID, guildID, userID int32) (*Guild, error) {
+	guild, err := s.GetGuildByID(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+	if actorID != userID && !guild.IsAdmin(actorID) {
+		return nil, ErrForbidden
+	}
+
+	member, err := s.guildRepo.GetGuildMember(ctx, repo.GetGuildMemberParams{GuildID: guildID, UserID: userID})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrGuildMemberNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		err := q.RemoveGuildMember(ctx, repo.RemoveGuildMemberParams{GuildID: guildID, UserID: userID})
+		if err != nil {
+			return err
+		}
+		return emitWebhookEvent(ctx, q, WebhookGuildMemberRemoved, &guildID, guildMemberWebhookData{
+			GuildID: guildID,
+			UserID:  userID,
+			Role:    GuildRole(member.Role),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetGuildByID(ctx, guildID)
+}
+
+// SetDiscordWebhook sets the Discord webhook that runs for the guild are
+// announced to. An empty URL stops announcements. Only guild admins can set it.
+func (s *guildService) SetDiscordWebhook(ctx context.Context, actorID, guildID int32, webhookURL string) (*Guild, error) {
+	if webhookURL != "" && !isValidDiscordWebhook(webhookURL) {
+		return nil, ErrInvalidDiscordWebhook
+	}
+
+	guild, err := s.GetGuildByID(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+	if !guild.IsAdmin(actorID) {
+		return nil, ErrForbidden
+	}
+
+	err = s.guildRepo.SetGuildDiscordWebhook(ctx, repo.SetGuildDiscordWebhookParams{
+		ID:                guildID,
+		DiscordWebhookUrl: webhookURL,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetGuildByID(ctx, guildID)
+}
+
+// SetLeaderboardScoring sets how members are rated on the guild's leaderboard.
+// Leaderboards of closed seasons keep the scoring they were frozen with. Only
+// guild admins can set it.
+func (s *guildService) SetLeaderboardScoring(ctx context.Context, actorID, guildID int32,
+	scoring LeaderboardScoring) (*Guild, error) {
+	if _, ok := scoringFuncs[scoring]; !ok {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidScoring, scoring)
+	}
+
+	guild, err := s.GetGuildByID(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+	if !guild.IsAdmin(actorID) {
+		return nil, ErrForbidden
+	}
+
+	err = s.guildRepo.SetGuildLeaderboardScoring(ctx, repo.SetGuildLeaderboardScoringParams{
+		ID:                 guildID,
+		LeaderboardScoring: string(scoring),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetGuildByID(ctx, guildID)
+}
+
+// SetSignupPolicy sets the rules applied to signups for the guild's runs. A
+// nil WaitlistBelowReliability lets everyone take open slots. Only guild admins
+// can set it.
+func (s *guildService) SetSignupPolicy(ctx context.Context, actorID, guildID int32,
+	policy *SignupPolicy) (*Guild, error) {
+	if t := policy.WaitlistBelowReliability; t != nil && (*t < 0 || *t > 100) {
+		return nil, fmt.Errorf("%w: reliability goes from 0 to 100", ErrInvalidSignupPolicy)
+	}
+
+	guild, err := s.GetGuildByID(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+	if !guild.IsAdmin(actorID) {
+		return nil, ErrForbidden
+	}
+
+	err = s.guildRepo.SetGuildSignupPolicy(ctx, repo.SetGuildSignupPolicyParams{
+		ID:                       guildID,
+		WaitlistBelowReliability: pgInt4(policy.WaitlistBelowReliability),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetGuildByID(ctx, guildID)
+}
+
+// addGuildMember adds the user with userID to a guild with role and emits the
+// guild.member_added webhook event.
+func addGuildMember(ctx context.Context, q repo.Querier, guildID, userID int32, role GuildRole) error {
+	_, err := q.AddGuildMember(ctx, repo.AddGuildMemberParams{
+		GuildID: guildID,
+		UserID:  userID,
+		Role:    string(role),
+	})
+	if err != nil {
+		return err
+	}
+	return emitWebhookEvent(ctx, q, WebhookGuildMemberAdded, &guildID, guildMemberWebhookData{
+		GuildID: guildID,
+		UserID:  userID,
+		Role:    role,
+	})
+}
+
+// loadGuild loads a guild and its members with q.
+func loadGuild(ctx context.Context, q repo.Querier, id int32) (*Guild, error) {
+	g, err := q.GetGuildByID(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrGuildNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.GetGuildMembers(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	guild := &Guild{
+		ID:                 g.ID,
+		Name:               g.Name,
+		Members:            make([]*GuildMember, 0, len(rows)),
+		DiscordWebhook:     g.DiscordWebhookUrl != "",
+		LeaderboardScoring: guildScoring(g),
+		SignupPolicy:       SignupPolicy{WaitlistBelowReliability: int4Ptr(g.WaitlistBelowReliability)},
+		CreatedAt:          g.CreatedAt.Time,
+		UpdatedAt:          g.UpdatedAt.Time,
+	}
+	for _, row := range rows {
+		guild.Members = append(guild.Members, &GuildMember{
+			UserID:   row.UserID,
+			Username: row.Username,
+			Role:     GuildRole(row.Role),
+			JoinedAt: row.CreatedAt.Time,
+		})
+	}
+	return guild, nil
+}
+
+// guildNameRegex allows names like in game guild names: 2 to 32 letters and digits,
+// with spaces, apostrophes and dashes inside the name.
+var guildNameRegex = regexp.MustCompile(`^[\p{L}\p{N}][\p{L}\p{N} '-]{0,30}[\p{L}\p{N}]$`)
+
+func isValidGuildName(name string) bool {
+	return guildNameRegex.MatchString(name)
+}
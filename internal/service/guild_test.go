@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+func Test_isValidGuildName(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  bool
+	}{
+		{"Simple", "Rebellion", true},
+		{"With Spaces", "Method Raid Team", true},
+		{"Apostrophe", "Knight's Watch", true},
+		{"Accented", "Ordre Éternel", true},
+		{"Too Short", "A", false},
+		{"Leading Space", " Rebellion", false},
+		{"Symbols", "<Rebellion>", false},
+		{"Too Long", "The Extremely Long Guild Name Of Doom", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isValidGuildName(tt.value))
+		})
+	}
+}
+
+func Test_guildService_AddMember(t *testing.T) {
+	guild := repo.Guild{ID: 1, Name: "Rebellion"}
+	members := []repo.GetGuildMembersRow{
+		{GuildID: 1, UserID: 7, Role: "admin", Username: "leader"},
+		{GuildID: 1, UserID: 8, Role: "member", Username: "member"},
+	}
+
+	tests := []struct {
+		name       string
+		actorID    int32
+		userID     int32
+		userErr    error
+		wantLookup bool
+		wantErr    error
+	}{
+		{"AddMember Not Admin", 8, 9, nil, false, ErrForbidden},
+		{"AddMember Already Member", 7, 8, nil, true, ErrAlreadyGuildMember},
+		{"AddMember Unknown User", 7, 10, pgx.ErrNoRows, true, ErrUserNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			mockq.EXPECT().GetGuildByID(ctx, int32(1)).Return(guild, nil)
+			mockq.EXPECT().GetGuildMembers(ctx, int32(1)).Return(members, nil)
+			if tt.wantLookup {
+				mockq.EXPECT().GetUserByID(ctx, tt.userID).Return(repo.GetUserByIDRow{ID: tt.userID}, tt.userErr)
+			}
+			s := &guildService{guildRepo: mockq}
+
+			_, err := s.AddMember(ctx, tt.actorID, 1, tt.userID)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_addGuildMember(t *testing.T) {
+	ctx := context.Background()
+	mockq := repo.NewMockQuerier(t)
+	mockq.EXPECT().AddGuildMember(ctx, repo.AddGuildMemberParams{GuildID: 1, UserID: 9, Role: "member"}).
+		Return(repo.GuildMember{GuildID: 1, UserID: 9, Role: "member"}, nil)
+	mockq.EXPECT().GetSubscribedWebhookEndpoints(ctx, repo.GetSubscribedWebhookEndpointsParams{
+		Event: "guild.member_added", GuildID: pgtype.Int4{Int32: 1, Valid: true},
+	}).Return(nil, nil)
+
+	assert.NoError(t, addGuildMember(ctx, mockq, 1, 9, GuildRoleMember))
+}
+
+func Test_guildService_SetDiscordWebhook(t *testing.T) {
+	guild := repo.Guild{ID: 1, Name: "Rebellion"}
+	members := []repo.GetGuildMembersRow{
+		{GuildID: 1, UserID: 7, Role: "admin", Username: "leader"},
+		{GuildID: 1, UserID: 8, Role: "member", Username: "member"},
+	}
+
+	tests := []struct {
+		name    string
+		actorID int32
+		url     string
+		wantErr error
+	}{
+		{"SetDiscordWebhook Success", 7, "https://discord.com/api/webhooks/123/abc", nil},
+		{"SetDiscordWebhook Remove", 7, "", nil},
+		{"SetDiscordWebhook Not Admin", 8, "https://discord.com/api/webhooks/123/abc", ErrForbidden},
+		{"SetDiscordWebhook Not Discord", 7, "https://example.com/hook", ErrInvalidDiscordWebhook},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			if tt.wantErr != ErrInvalidDiscordWebhook {
+				mockq.EXPECT().GetGuildByID(ctx, int32(1)).Return(guild, nil)
+				mockq.EXPECT().GetGuildMembers(ctx, int32(1)).Return(members, nil)
+			}
+			if tt.wantErr == nil {
+				mockq.EXPECT().SetGuildDiscordWebhook(ctx, repo.SetGuildDiscordWebhookParams{ID: 1,
+					DiscordWebhookUrl: tt.url}).Return(nil)
+			}
+			s := &guildService{guildRepo: mockq}
+
+			_, err := s.SetDiscordWebhook(ctx, tt.actorID, 1, tt.url)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_guildService_SetLeaderboardScoring(t *testing.T) {
+	guild := repo.Guild{ID: 1, Name: "Rebellion"}
+	members := []repo.GetGuildMembersRow{
+		{GuildID: 1, UserID: 7, Role: "admin", Username: "leader"},
+		{GuildID: 1, UserID: 8, Role: "member", Username: "member"},
+	}
+
+	tests := []struct {
+		name    string
+		actorID int32
+		scoring LeaderboardScoring
+		wantErr error
+	}{
+		{"SetLeaderboardScoring Success", 7, ScoringKeyLevel, nil},
+		{"SetLeaderboardScoring Not Admin", 8, ScoringKeyLevel, ErrForbidden},
+		{"SetLeaderboardScoring Unknown", 7, "io", ErrInvalidScoring},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			if tt.wantErr != ErrInvalidScoring {
+				mockq.EXPECT().GetGuildByID(ctx, int32(1)).Return(guild, nil)
+				mockq.EXPECT().GetGuildMembers(ctx, int32(1)).Return(members, nil)
+			}
+			if tt.wantErr == nil {
+				mockq.EXPECT().SetGuildLeaderboardScoring(ctx, repo.SetGuildLeaderboardScoringParams{ID: 1,
+					LeaderboardScoring: string(tt.scoring)}).Return(nil)
+			}
+			s := &guildService{guildRepo: mockq}
+
+			_, err := s.SetLeaderboardScoring(ctx, tt.actorID, 1, tt.scoring)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_guildService_SetSignupPolicy(t *testing.T) {
+	guild := repo.Guild{ID: 1, Name: "Rebellion"}
+	members := []repo.GetGuildMembersRow{
+		{GuildID: 1, UserID: 7, Role: "admin", Username: "leader"},
+		{GuildID: 1, UserID: 8, Role: "member", Username: "member"},
+	}
+
+	tests := []struct {
+		name      string
+		actorID   int32
+		threshold *int32
+		wantErr   error
+	}{
+		{"SetSignupPolicy Success", 7, int32Ptr(70), nil},
+		{"SetSignupPolicy Remove", 7, nil, nil},
+		{"SetSignupPolicy Not Admin", 8, int32Ptr(70), ErrForbidden},
+		{"SetSignupPolicy Out Of Range", 7, int32Ptr(101), ErrInvalidSignupPolicy},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			if tt.wantErr != ErrInvalidSignupPolicy {
+				mockq.EXPECT().GetGuildByID(ctx, int32(1)).Return(guild, nil)
+				mockq.EXPECT().GetGuildMembers(ctx, int32(1)).Return(members, nil)
+			}
+			if tt.wantErr == nil {
+				mockq.EXPECT().SetGuildSignupPolicy(ctx, repo.SetGuildSignupPolicyParams{ID: 1,
+					WaitlistBelowReliability: pgInt4(tt.threshold)}).Return(nil)
+			}
+			s := &guildService{guildRepo: mockq}
+
+			_, err := s.SetSignupPolicy(ctx, tt.actorID, 1, &SignupPolicy{WaitlistBelowReliability: tt.threshold})
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestGuild_IsAdmin(t *testing.T) {
+	g := &Guild{Members: []*GuildMember{
+		{UserID: 7, Role: GuildRoleAdmin},
+		{UserID: 8, Role: GuildRoleMember},
+	}}
+
+	assert.True(t, g.IsAdmin(7))
+	assert.False(t, g.IsAdmin(8))
+	assert.False(t, g.IsAdmin(9))
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tmaffia/dungeon-time-api/internal/jobs"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// maxJobsListed is the most jobs returned when listing jobs.
+const maxJobsListed = 100
+
+// uniqueViolation is the Postgres error code for a unique constraint violation.
+const uniqueViolation = "23505"
+
+// Job is a background job as shown to admins.
+type Job struct {
+	ID          int64           `json:"id"`
+	Queue       string          `json:"queue"`
+	Kind        string          `json:"kind"`
+	Args        json.RawMessage `json:"args"`
+	UniqueKey   string          `json:"unique_key,omitempty"`
+	Status      string          `json:"status"`
+	Attempts    int32           `json:"attempts"`
+	MaxAttempts int32           `json:"max_attempts"`
+	LastError   string          `json:"last_error,omitempty"`
+	RunAt       time.Time       `json:"run_at"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
+
+// JobService is the interface for inspecting background jobs and retrying
+// dead ones. It is only exposed to admins.
+type JobService interface {
+	GetJobs(context.Context, string, string) ([]*Job, error)
+	RetryJob(context.Context, int64) (*Job, error)
+}
+
+// jobService is the implementation of JobService.
+type jobService struct {
+	dbPool  *pgxpool.Pool
+	jobRepo repo.Querier
+}
+
+// NewJobService creates a new jobService with the provided database connection pool.
+// It returns a pointer to the jobService.
+func NewJobService(dbPool *pgxpool.Pool) *jobService {
+	return &jobService{
+		dbPool:  dbPool,
+		jobRepo: repo.New(dbPool),
+	}
+}
+
+// GetJobs returns the most recent jobs, optionally only those with status and
+// on queue. Jobs that succeeded are not kept, so only pending, running and
+// dead jobs can be listed.
+func (s *jobService) GetJobs(ctx context.Context, status, queue string) ([]*Job, error) {
+	if status != "" && status != jobs.StatusPending && status != jobs.StatusRunning && status != jobs.StatusDead {
+		return nil, ErrInvalidJobStatus
+	}
+
+	rows, err := s.jobRepo.GetJobs(ctx, repo.GetJobsParams{
+		Status:  pgtype.Text{String: status, Valid: status != ""},
+		Queue:   pgtype.Text{String: queue, Valid: queue != ""},
+		MaxJobs: maxJobsListed,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*Job, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, mapJob(row))
+	}
+	return result, nil
+}
+
+// RetryJob queues a dead job to run again now with its attempts reset.
+func (s *jobService) RetryJob(ctx context.Context, id int64) (*Job, error) {
+	row, err := s.jobRepo.RetryJob(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		if _, err := s.jobRepo.GetJobByID(ctx, id); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, ErrJobNotFound
+			}
+			return nil, err
+		}
+		return nil, ErrJobNotDead
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return nil, ErrJobPending
+	}
+	if err != nil {
+		return nil, err
+	}
+	return mapJob(row), nil
+}
+
+func mapJob(j repo.Job) *Job {
+	return &Job{
+		ID:          j.ID,
+		Queue:       j.Queue,
+		Kind:        j.Kind,
+		Args:        j.Args,
+		UniqueKey:   j.UniqueKey.String,
+		Status:      j.Status,
+		Attempts:    j.Attempts,
+		MaxAttempts: j.MaxAttempts,
+		LastError:   j.LastError,
+		RunAt:       j.RunAt.Time,
+		CreatedAt:   j.CreatedAt.Time,
+	}
+}
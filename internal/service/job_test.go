@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+func Test_jobService_GetJobs(t *testing.T) {
+	ctx := context.Background()
+	mockq := repo.NewMockQuerier(t)
+	mockq.EXPECT().GetJobs(ctx, repo.GetJobsParams{
+		Status: pgtype.Text{String: "dead", Valid: true}, MaxJobs: 100,
+	}).Return([]repo.Job{{ID: 3, Queue: "default", Kind: "greet", Args: []byte(`{}`), Status: "dead",
+		Attempts: 10, MaxAttempts: 10, LastError: "no answer"}}, nil)
+	s := &jobService{jobRepo: mockq}
+
+	got, err := s.GetJobs(ctx, "dead", "")
+	assert.NoError(t, err)
+	if assert.Len(t, got, 1) {
+		assert.Equal(t, int64(3), got[0].ID)
+		assert.Equal(t, "no answer", got[0].LastError)
+	}
+
+	_, err = s.GetJobs(ctx, "completed", "")
+	assert.ErrorIs(t, err, ErrInvalidJobStatus)
+}
+
+func Test_jobService_RetryJob(t *testing.T) {
+	tests := []struct {
+		name     string
+		retryErr error
+		getErr   error
+		wantErr  error
+	}{
+		{"Retried", nil, nil, nil},
+		{"Not Found", pgx.ErrNoRows, pgx.ErrNoRows, ErrJobNotFound},
+		{"Not Dead", pgx.ErrNoRows, nil, ErrJobNotDead},
+		{"Already Pending", &pgconn.PgError{Code: "23505"}, nil, ErrJobPending},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			mockq.EXPECT().RetryJob(ctx, int64(3)).Return(repo.Job{ID: 3, Status: "pending"}, tt.retryErr)
+			if tt.retryErr == pgx.ErrNoRows {
+				mockq.EXPECT().GetJobByID(ctx, int64(3)).Return(repo.Job{ID: 3, Status: "pending"}, tt.getErr)
+			}
+			s := &jobService{jobRepo: mockq}
+
+			job, err := s.RetryJob(ctx, 3)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "pending", job.Status)
+		})
+	}
+}
@@ -0,0 +1,379 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// HeldKeystone is a Mythic+ key held this week by a user, or by one of their
+// characters if CharacterID is set. Dungeon is the code of the dungeon.
+type HeldKeystone struct {
+	ID            int32     `json:"id"`
+	UserID        int32     `json:"user_id"`
+	Username      string    `json:"username"`
+	CharacterID   *int32    `json:"character_id,omitempty"`
+	CharacterName *string   `json:"character_name,omitempty"`
+	Dungeon       string    `json:"dungeon"`
+	DungeonName   string    `json:"dungeon_name"`
+	Level         int32     `json:"level"`
+	RecordedAt    time.Time `json:"recorded_at"`
+}
+
+// KeystoneInput holds the fields used to record a held key. Dungeon is the
+// code of an active dungeon.
+type KeystoneInput struct {
+	Dungeon string `json:"dungeon"`
+	Level   int32  `json:"level"`
+}
+
+// KeystoneInventory is the keys held in the week from WeekStart until ResetsAt,
+// highest first.
+type KeystoneInventory struct {
+	WeekStart time.Time       `json:"week_start"`
+	ResetsAt  time.Time       `json:"resets_at"`
+	Keystones []*HeldKeystone `json:"keystones"`
+}
+
+// KeyPlanQuery asks for a plan of the keys held in a guild, each run for
+// DurationMinutes by a group of Composition.
+type KeyPlanQuery struct {
+	Composition     Composition
+	DurationMinutes int32
+}
+
+// PlannedKey is a time to run a held key and a group to run it with. The
+// holder of the key is always in the group.
+type PlannedKey struct {
+	Keystone *HeldKeystone    `json:"keystone"`
+	StartsAt time.Time        `json:"starts_at"`
+	EndsAt   time.Time        `json:"ends_at"`
+	Group    []RoleAssignment `json:"group"`
+}
+
+// KeyPlan proposes which keys a guild runs before the week resets at ResetsAt.
+// Unplanned lists the keys no group could be found for.
+type KeyPlan struct {
+	WeekStart time.Time       `json:"week_start"`
+	ResetsAt  time.Time       `json:"resets_at"`
+	Planned   []*PlannedKey   `json:"planned"`
+	Unplanned []*HeldKeystone `json:"unplanned"`
+}
+
+// KeystoneService is the interface for the keys players hold and planning
+// which of them to run.
+type KeystoneService interface {
+	GetKeystones(ctx context.Context, userID int32) (*KeystoneInventory, error)
+	SetKeystone(ctx context.Context, actorID, userID int32, characterID *int32, input *KeystoneInput) (*HeldKeystone, error)
+	DeleteKeystone(ctx context.Context, actorID, userID int32, characterID *int32) error
+	GetGuildKeystones(ctx context.Context, guildID int32) (*KeystoneInventory, error)
+	PlanWeek(ctx context.Context, guildID int32, query *KeyPlanQuery) (*KeyPlan, error)
+}
+
+// keystoneService is the implementation of KeystoneService.
+type keystoneService struct {
+	dbPool       *pgxpool.Pool
+	keystoneRepo repo.Querier
+	reset        WeeklyReset
+	now          func() time.Time
+}
+
+// NewKeystoneService creates a new keystoneService with the provided database connection pool.
+// Held keys expire at reset. It returns a pointer to the keystoneService.
+func NewKeystoneService(dbPool *pgxpool.Pool, reset WeeklyReset) *keystoneService {
+	return &keystoneService{
+		dbPool:       dbPool,
+		keystoneRepo: repo.New(dbPool),
+		reset:        reset,
+		now:          time.Now,
+	}
+}
+
+// GetKeystones returns the keys the user with userID and their characters hold
+// this week.
+func (s *keystoneService) GetKeystones(ctx context.Context, userID int32) (*KeystoneInventory, error) {
+	if _, err := s.keystoneRepo.GetUserByID(ctx, userID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	inventory := s.newInventory()
+	rows, err := s.keystoneRepo.GetUserKeystones(ctx, repo.GetUserKeystonesParams{
+		UserID:        userID,
+		RecordedAfter: pgTimestamptz(inventory.WeekStart),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		inventory.Keystones = append(inventory.Keystones,
+			mapHeldKeystone(row.Keystone, row.Dungeon, row.Username, row.CharacterName))
+	}
+	return inventory, nil
+}
+
+// SetKeystone records the key the user with userID holds, or their character
+// with characterID, replacing the key it held before. Users can only record
+// their own keys.
+func (s *keystoneService) SetKeystone(ctx context.Context, actorID, userID int32, characterID *int32,
+	input *KeystoneInput) (*HeldKeystone, error) {
+	if actorID != userID {
+		return nil, ErrForbidden
+	}
+	if input.Level < minKeyLevel || input.Level > maxKeyLevel {
+		return nil, ErrInvalidKeyLevel
+	}
+
+	dungeon, err := s.keystoneRepo.GetDungeonByCode(ctx, input.Dungeon)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrDungeonNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !dungeon.Active {
+		return nil, ErrInvalidKeystone
+	}
+
+	user, err := s.keystoneRepo.GetUserByID(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	var characterName pgtype.Text
+	if characterID != nil {
+		row, err := loadCharacter(ctx, s.keystoneRepo, userID, *characterID)
+		if err != nil {
+			return nil, err
+		}
+		characterName = pgtype.Text{String: row.Character.Name, Valid: true}
+	}
+
+	var keystone repo.Keystone
+	err = inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		var err error
+		keystone, err = replaceKeystone(ctx, q, repo.CreateKeystoneParams{
+			UserID:      userID,
+			CharacterID: pgInt4(characterID),
+			DungeonID:   dungeon.ID,
+			Level:       input.Level,
+			RecordedAt:  pgTimestamptz(s.now()),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mapHeldKeystone(keystone, dungeon, user.Username, characterName), nil
+}
+
+// DeleteKeystone clears the key held by the user with userID, or their
+// character with characterID. Users can only clear their own keys.
+func (s *keystoneService) DeleteKeystone(ctx context.Context, actorID, userID int32, characterID *int32) error {
+	if actorID != userID {
+		return ErrForbidden
+	}
+
+	deleted, err := s.keystoneRepo.DeleteKeystone(ctx, repo.DeleteKeystoneParams{
+		UserID:      userID,
+		CharacterID: pgInt4(characterID),
+	})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrKeystoneNotFound
+	}
+	return nil
+}
+
+// GetGuildKeystones returns the keys the members of the guild with guildID and
+// their characters hold this week.
+func (s *keystoneService) GetGuildKeystones(ctx context.Context, guildID int32) (*KeystoneInventory, error) {
+	if _, err := loadGuild(ctx, s.keystoneRepo, guildID); err != nil {
+		return nil, err
+	}
+	inventory, _, err := s.guildInventory(ctx, guildID)
+	return inventory, err
+}
+
+// PlanWeek proposes a time and group for the keys held in the guild with
+// guildID, between now and the reset. Members are only planned in while they
+// are available and for roles they can play, the holder of a key with the
+// roles of the character holding it. The highest keys are planned first, each
+// at the earliest time a group can be found for it, and nobody is planned
+// into two keys at once.
+func (s *keystoneService) PlanWeek(ctx context.Context, guildID int32, query *KeyPlanQuery) (*KeyPlan, error) {
+	if !isValidRunDuration(query.DurationMinutes) {
+		return nil, ErrInvalidDuration
+	}
+	if !isValidComposition(query.Composition) {
+		return nil, ErrInvalidComposition
+	}
+
+	players, err := loadPlayers(ctx, s.keystoneRepo, &BestTimeQuery{GuildID: &guildID})
+	if err != nil {
+		return nil, err
+	}
+	inventory, rows, err := s.guildInventory(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	from := s.now()
+	if err := loadFreeTime(ctx, s.keystoneRepo, players, from, inventory.ResetsAt); err != nil {
+		return nil, err
+	}
+
+	keys := make([]heldKey, 0, len(rows))
+	for i, row := range rows {
+		keys = append(keys, heldKey{keystone: inventory.Keystones[i], roles: playerRoles(row.HolderRoles)})
+	}
+
+	duration := time.Duration(query.DurationMinutes) * time.Minute
+	planned, unplanned := planKeys(keys, players, query.Composition, from, inventory.ResetsAt, duration)
+	return &KeyPlan{
+		WeekStart: inventory.WeekStart,
+		ResetsAt:  inventory.ResetsAt,
+		Planned:   planned,
+		Unplanned: unplanned,
+	}, nil
+}
+
+// guildInventory loads the keys held in the guild with guildID this week, and
+// the rows they were mapped from in the same order.
+func (s *keystoneService) guildInventory(ctx context.Context,
+	guildID int32) (*KeystoneInventory, []repo.GetGuildKeystonesRow, error) {
+	inventory := s.newInventory()
+	rows, err := s.keystoneRepo.GetGuildKeystones(ctx, repo.GetGuildKeystonesParams{
+		GuildID:       guildID,
+		RecordedAfter: pgTimestamptz(inventory.WeekStart),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, row := range rows {
+		inventory.Keystones = append(inventory.Keystones,
+			mapHeldKeystone(row.Keystone, row.Dungeon, row.Username, row.CharacterName))
+	}
+	return inventory, rows, nil
+}
+
+// newInventory returns an empty inventory of the current week.
+func (s *keystoneService) newInventory() *KeystoneInventory {
+	week := s.reset.CurrentWeek(s.now())
+	return &KeystoneInventory{
+		WeekStart: week.StartsAt,
+		ResetsAt:  week.EndsAt,
+		Keystones: []*HeldKeystone{},
+	}
+}
+
+// heldKey is a key being planned and the roles its holder can play with it.
+type heldKey struct {
+	keystone *HeldKeystone
+	roles    []UserRole
+}
+
+// planKeys plans keys, highest first, into the time between from and to, see
+// PlanWeek. Players are booked by taking the planned runs out of their free
+// time.
+func planKeys(keys []heldKey, players []*player, composition Composition, from, to time.Time,
+	duration time.Duration) ([]*PlannedKey, []*HeldKeystone) {
+	byID := make(map[int32]*player, len(players))
+	for _, p := range players {
+		byID[p.userID] = p
+	}
+
+	start := from.Truncate(candidateStep)
+	if start.Before(from) {
+		start = start.Add(candidateStep)
+	}
+
+	planned, unplanned := []*PlannedKey{}, []*HeldKeystone{}
+	for _, key := range keys {
+		holder := byID[key.keystone.UserID]
+		var plan *PlannedKey
+		for t := start; holder != nil && plan == nil && !t.Add(duration).After(to); t = t.Add(candidateStep) {
+			plan = planKey(key, holder, players, composition, t, t.Add(duration))
+		}
+		if plan == nil {
+			unplanned = append(unplanned, key.keystone)
+			continue
+		}
+
+		booked := interval{start: plan.StartsAt, end: plan.EndsAt}
+		for _, member := range plan.Group {
+			p := byID[member.UserID]
+			p.free = subtractInterval(p.free, booked)
+		}
+		planned = append(planned, plan)
+	}
+	return planned, unplanned
+}
+
+// planKey returns a group for key from start to end with holder in it, or nil
+// if there is none.
+func planKey(key heldKey, holder *player, players []*player, composition Composition,
+	start, end time.Time) *PlannedKey {
+	if !holder.isFree(start, end) {
+		return nil
+	}
+
+	var free []*player
+	for _, p := range players {
+		if p != holder && len(p.roles) > 0 && p.isFree(start, end) {
+			free = append(free, p)
+		}
+	}
+	for _, role := range combatRoles {
+		if composition.Slots(role) == 0 || !slices.Contains(key.roles, role) {
+			continue
+		}
+		group, ok := fillComposition(free, composition.without(role))
+		if !ok {
+			continue
+		}
+		group = append(group, RoleAssignment{UserID: holder.userID, Role: role})
+		sortGroup(group)
+		return &PlannedKey{Keystone: key.keystone, StartsAt: start, EndsAt: end, Group: group}
+	}
+	return nil
+}
+
+// replaceKeystone records the key in params, replacing the key held by the
+// same user or character.
+func replaceKeystone(ctx context.Context, q repo.Querier, params repo.CreateKeystoneParams) (repo.Keystone, error) {
+	_, err := q.DeleteKeystone(ctx, repo.DeleteKeystoneParams{UserID: params.UserID, CharacterID: params.CharacterID})
+	if err != nil {
+		return repo.Keystone{}, err
+	}
+	return q.CreateKeystone(ctx, params)
+}
+
+func mapHeldKeystone(k repo.Keystone, d repo.Dungeon, username string, characterName pgtype.Text) *HeldKeystone {
+	held := &HeldKeystone{
+		ID:          k.ID,
+		UserID:      k.UserID,
+		Username:    username,
+		CharacterID: int4Ptr(k.CharacterID),
+		Dungeon:     d.Code,
+		DungeonName: d.Name,
+		Level:       k.Level,
+		RecordedAt:  k.RecordedAt.Time,
+	}
+	if characterName.Valid {
+		held.CharacterName = &characterName.String
+	}
+	return held
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+func Test_planKeys(t *testing.T) {
+	from := utc(2026, 10, 20, 18, 0)
+	to := from.Add(4 * time.Hour)
+	allWeek := []interval{{start: from, end: to}}
+	newPlayers := func() []*player {
+		return []*player{
+			{userID: 1, roles: []UserRole{RoleTank, RoleDPS}, free: allWeek},
+			{userID: 2, roles: []UserRole{RoleHealer}, free: allWeek},
+			{userID: 3, roles: []UserRole{RoleDPS}, free: allWeek},
+			{userID: 4, roles: []UserRole{RoleDPS}, free: allWeek},
+			{userID: 5, roles: []UserRole{RoleDPS}, free: allWeek},
+			{userID: 6, roles: []UserRole{RoleDPS}, free: allWeek},
+			{userID: 7, roles: []UserRole{RoleDPS}},
+		}
+	}
+	key := func(userID, level int32, roles ...UserRole) heldKey {
+		return heldKey{keystone: &HeldKeystone{UserID: userID, Level: level}, roles: roles}
+	}
+
+	tests := []struct {
+		name          string
+		keys          []heldKey
+		wantStarts    []time.Time
+		wantGroups    [][]int32
+		wantUnplanned int
+	}{
+		{"Holder Tanks", []heldKey{key(1, 15, RoleTank)}, []time.Time{from}, [][]int32{{1, 2, 3, 4, 5}}, 0},
+		{"Members Booked", []heldKey{key(1, 15, RoleTank), key(6, 10, RoleDPS)},
+			[]time.Time{from, from.Add(time.Hour)}, [][]int32{{1, 2, 3, 4, 5}, {1, 2, 3, 4, 6}}, 0},
+		{"Holder Never Free", []heldKey{key(7, 20, RoleDPS)}, nil, nil, 1},
+		{"Holder Fills The Only Healer Slot", []heldKey{key(2, 12, RoleHealer, RoleTank)}, []time.Time{from},
+			[][]int32{{1, 2, 3, 4, 5}}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			planned, unplanned := planKeys(tt.keys, newPlayers(), DefaultComposition, from, to, time.Hour)
+
+			assert.Len(t, unplanned, tt.wantUnplanned)
+			assert.Len(t, planned, len(tt.wantStarts))
+			for i, plan := range planned {
+				assert.Equal(t, tt.wantStarts[i], plan.StartsAt)
+				assert.Equal(t, tt.wantStarts[i].Add(time.Hour), plan.EndsAt)
+				ids := make([]int32, 0, len(plan.Group))
+				for _, member := range plan.Group {
+					ids = append(ids, member.UserID)
+				}
+				assert.Equal(t, tt.wantGroups[i], ids)
+			}
+		})
+	}
+}
+
+func Test_keystoneService_SetKeystone_validation(t *testing.T) {
+	tests := []struct {
+		name       string
+		actorID    int32
+		input      *KeystoneInput
+		dungeon    repo.Dungeon
+		dungeonErr error
+		wantErr    error
+	}{
+		{"Someone Else", 2, &KeystoneInput{Dungeon: "ARAK", Level: 12}, repo.Dungeon{}, nil, ErrForbidden},
+		{"Level Too Low", 1, &KeystoneInput{Dungeon: "ARAK", Level: 1}, repo.Dungeon{}, nil, ErrInvalidKeyLevel},
+		{"Level Too High", 1, &KeystoneInput{Dungeon: "ARAK", Level: 41}, repo.Dungeon{}, nil, ErrInvalidKeyLevel},
+		{"Unknown Dungeon", 1, &KeystoneInput{Dungeon: "KARA", Level: 12}, repo.Dungeon{}, pgx.ErrNoRows,
+			ErrDungeonNotFound},
+		{"Inactive Dungeon", 1, &KeystoneInput{Dungeon: "SBG", Level: 12}, repo.Dungeon{ID: 3, Code: "SBG"}, nil,
+			ErrInvalidKeystone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			if tt.dungeon.ID != 0 || tt.dungeonErr != nil {
+				mockq.EXPECT().GetDungeonByCode(ctx, tt.input.Dungeon).Return(tt.dungeon, tt.dungeonErr)
+			}
+			s := &keystoneService{keystoneRepo: mockq, reset: DefaultWeeklyReset, now: time.Now}
+
+			_, err := s.SetKeystone(ctx, tt.actorID, 1, nil, tt.input)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_keystoneService_DeleteKeystone(t *testing.T) {
+	tests := []struct {
+		name        string
+		characterID *int32
+		deleted     int64
+		wantErr     error
+	}{
+		{"Own Key", nil, 1, nil},
+		{"Character Key", int32Ptr(3), 1, nil},
+		{"No Key", nil, 0, ErrKeystoneNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			mockq.EXPECT().DeleteKeystone(ctx, repo.DeleteKeystoneParams{UserID: 1, CharacterID: pgInt4(tt.characterID)}).
+				Return(tt.deleted, nil)
+			s := &keystoneService{keystoneRepo: mockq, reset: DefaultWeeklyReset, now: time.Now}
+
+			assert.ErrorIs(t, s.DeleteKeystone(ctx, 1, 1, tt.characterID), tt.wantErr)
+		})
+	}
+}
+
+func Test_keystoneService_GetKeystones(t *testing.T) {
+	ctx := context.Background()
+	now := utc(2026, 10, 21, 12, 0)
+	mockq := repo.NewMockQuerier(t)
+	mockq.EXPECT().GetUserByID(ctx, int32(1)).Return(repo.GetUserByIDRow{ID: 1}, nil)
+	mockq.EXPECT().GetUserKeystones(ctx, repo.GetUserKeystonesParams{
+		UserID:        1,
+		RecordedAfter: pgTimestamptz(utc(2026, 10, 20, 15, 0)),
+	}).Return([]repo.GetUserKeystonesRow{{
+		Keystone:      repo.Keystone{ID: 9, UserID: 1, CharacterID: pgInt4(int32Ptr(3)), Level: 12},
+		Dungeon:       repo.Dungeon{Code: "ARAK", Name: "Ara-Kara, City of Echoes"},
+		Username:      "thrall",
+		CharacterName: pgtype.Text{String: "Thrall", Valid: true},
+	}}, nil)
+	s := &keystoneService{keystoneRepo: mockq, reset: DefaultWeeklyReset, now: func() time.Time { return now }}
+
+	inventory, err := s.GetKeystones(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, utc(2026, 10, 27, 15, 0), inventory.ResetsAt)
+	assert.Len(t, inventory.Keystones, 1)
+	assert.Equal(t, "ARAK", inventory.Keystones[0].Dungeon)
+	assert.Equal(t, "Thrall", *inventory.Keystones[0].CharacterName)
+}
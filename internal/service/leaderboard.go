@@ -0,0 +1,325 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// Page sizes of leaderboards.
+const (
+	defaultLeaderboardLimit = 25
+	maxLeaderboardLimit     = 100
+)
+
+// LeaderboardScoring names a way of rating members on a leaderboard.
+type LeaderboardScoring string
+
+const (
+	ScoringMythicPlus = LeaderboardScoring("mythic_plus")
+	ScoringKeyLevel   = LeaderboardScoring("key_level")
+)
+
+// DefaultLeaderboardScoring is the scoring of guilds that did not pick one.
+const DefaultLeaderboardScoring = ScoringMythicPlus
+
+// ScoringFunc rates a timed run. A member's rating adds up the best rated run
+// they timed in each dungeon.
+type ScoringFunc func(run *BestRun) float64
+
+// scoringFuncs are the scorings guilds can rank their leaderboard by.
+var scoringFuncs = map[LeaderboardScoring]ScoringFunc{
+	ScoringMythicPlus: mythicPlusScore,
+	ScoringKeyLevel:   keyLevelScore,
+}
+
+// BestRun is the best rated run a member timed in a dungeon during a season.
+type BestRun struct {
+	RunID          int32   `json:"run_id"`
+	DungeonCode    string  `json:"dungeon"`
+	KeyLevel       int32   `json:"key_level"`
+	UpgradeLevel   int32   `json:"upgrade_level"`
+	ElapsedSeconds int32   `json:"elapsed_seconds"`
+	ParSeconds     int32   `json:"par_seconds"`
+	Score          float64 `json:"score"`
+}
+
+// LeaderboardEntry is the standing of a member. ElapsedSeconds is the time
+// spent in their best runs, the faster member ranks first on equal ratings.
+type LeaderboardEntry struct {
+	Rank           int32      `json:"rank"`
+	UserID         int32      `json:"user_id"`
+	Username       string     `json:"username"`
+	Rating         float64    `json:"rating"`
+	ElapsedSeconds int32      `json:"elapsed_seconds"`
+	BestRuns       []*BestRun `json:"best_runs"`
+}
+
+// Leaderboard is a page of the members of a guild that timed runs in a
+// season, ranked by rating. Leaderboards of closed seasons are Frozen as they
+// stood when the season closed. Leaderboards filtered by an Affix only rate
+// the runs started while it was active, and are ranked from the runs even once
+// the season closed.
+type Leaderboard struct {
+	GuildID int32               `json:"guild_id"`
+	Season  *Season             `json:"season"`
+	Affix   string              `json:"affix,omitempty"`
+	Scoring LeaderboardScoring  `json:"scoring"`
+	Frozen  bool                `json:"frozen"`
+	Total   int                 `json:"total"`
+	Limit   int                 `json:"limit"`
+	Offset  int                 `json:"offset"`
+	Entries []*LeaderboardEntry `json:"entries"`
+}
+
+// LeaderboardService is the interface for guild leaderboards.
+type LeaderboardService interface {
+	GetLeaderboard(ctx context.Context, guildID int32, seasonCode, affix string, limit, offset int) (*Leaderboard,
+		error)
+}
+
+// leaderboardService is the implementation of LeaderboardService.
+type leaderboardService struct {
+	dbPool          *pgxpool.Pool
+	leaderboardRepo repo.Querier
+}
+
+// NewLeaderboardService creates a new leaderboardService with the provided database connection pool.
+// It returns a pointer to the leaderboardService.
+func NewLeaderboardService(dbPool *pgxpool.Pool) *leaderboardService {
+	return &leaderboardService{
+		dbPool:          dbPool,
+		leaderboardRepo: repo.New(dbPool),
+	}
+}
+
+// GetLeaderboard returns a page of the leaderboard of a guild for the season
+// with seasonCode, or for the open season if it is empty, filtered by the affix
+// with code affix unless it is empty. A limit of 0 gives the default page size.
+// Returns ErrAffixNotFound for an unknown affix.
+func (s *leaderboardService) GetLeaderboard(ctx context.Context, guildID int32, seasonCode, affix string,
+	limit, offset int) (*Leaderboard, error) {
+	if limit == 0 {
+		limit = defaultLeaderboardLimit
+	}
+	if limit < 0 || limit > maxLeaderboardLimit || offset < 0 {
+		return nil, ErrInvalidPage
+	}
+
+	guild, err := s.leaderboardRepo.GetGuildByID(ctx, guildID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrGuildNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	season, err := loadSeason(ctx, s.leaderboardRepo, seasonCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if affix != "" {
+		a, err := loadAffix(ctx, s.leaderboardRepo, affix)
+		if err != nil {
+			return nil, err
+		}
+		affix = a.Code
+	}
+
+	board := &Leaderboard{GuildID: guildID, Season: season, Affix: affix, Limit: limit, Offset: offset}
+	var entries []*LeaderboardEntry
+	if season.Closed() && affix == "" {
+		board.Frozen = true
+		board.Scoring, entries, err = frozenLeaderboard(ctx, s.leaderboardRepo, season.ID, guildID)
+	} else {
+		board.Scoring = guildScoring(guild)
+		entries, err = seasonLeaderboard(ctx, s.leaderboardRepo, season, guildID, board.Scoring, affix)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	board.Total = len(entries)
+	board.Entries = entries[min(offset, len(entries)):min(offset+limit, len(entries))]
+	return board, nil
+}
+
+// seasonLeaderboard ranks the members of a guild by the runs they timed in
+// season so far, only those started with the affix with code affix active
+// unless it is empty.
+func seasonLeaderboard(ctx context.Context, q repo.Querier, season *Season, guildID int32,
+	scoring LeaderboardScoring, affix string) ([]*LeaderboardEntry, error) {
+	rows, err := q.GetSeasonTimedRuns(ctx, repo.GetSeasonTimedRunsParams{
+		GuildID:  guildID,
+		StartsAt: pgTimestamptz(season.StartsAt),
+		EndsAt:   pgTimestamptzPtr(season.EndsAt),
+		Affix:    affix,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rankLeaderboard(rows, scoringFuncs[scoring]), nil
+}
+
+// frozenLeaderboard returns the leaderboard of a guild stored when the season
+// with seasonID closed, and the scoring it was ranked by.
+func frozenLeaderboard(ctx context.Context, q repo.Querier, seasonID, guildID int32) (LeaderboardScoring,
+	[]*LeaderboardEntry, error) {
+	rows, err := q.GetLeaderboardSnapshot(ctx, repo.GetLeaderboardSnapshotParams{
+		SeasonID: seasonID,
+		GuildID:  guildID,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	scoring := DefaultLeaderboardScoring
+	entries := make([]*LeaderboardEntry, 0, len(rows))
+	for _, row := range rows {
+		scoring = LeaderboardScoring(row.Scoring)
+		entry := &LeaderboardEntry{
+			Rank:           row.Rank,
+			UserID:         row.UserID,
+			Username:       row.Username,
+			Rating:         row.Rating,
+			ElapsedSeconds: row.ElapsedSeconds,
+		}
+		if err := json.Unmarshal(row.BestRuns, &entry.BestRuns); err != nil {
+			return "", nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return scoring, entries, nil
+}
+
+// freezeLeaderboards stores the leaderboard of every guild for season, which
+// has just closed.
+func freezeLeaderboards(ctx context.Context, q repo.Querier, season *Season) error {
+	guilds, err := q.GetGuilds(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, guild := range guilds {
+		scoring := guildScoring(guild)
+		entries, err := seasonLeaderboard(ctx, q, season, guild.ID, scoring, "")
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			bestRuns, err := json.Marshal(entry.BestRuns)
+			if err != nil {
+				return err
+			}
+			err = q.CreateLeaderboardSnapshot(ctx, repo.CreateLeaderboardSnapshotParams{
+				SeasonID:       season.ID,
+				GuildID:        guild.ID,
+				UserID:         entry.UserID,
+				Rank:           entry.Rank,
+				Username:       entry.Username,
+				Rating:         entry.Rating,
+				ElapsedSeconds: entry.ElapsedSeconds,
+				BestRuns:       bestRuns,
+				Scoring:        string(scoring),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// rankLeaderboard picks the best run of each member in each dungeon by score
+// and ranks members by the sum of those scores. Ties go to the member who spent
+// less time in their best runs, then to the lower user id so ranks are stable.
+func rankLeaderboard(rows []repo.GetSeasonTimedRunsRow, score ScoringFunc) []*LeaderboardEntry {
+	byUser := make(map[int32]*LeaderboardEntry)
+	best := make(map[int32]map[string]*BestRun)
+	var entries []*LeaderboardEntry
+	for _, row := range rows {
+		entry, ok := byUser[row.UserID]
+		if !ok {
+			entry = &LeaderboardEntry{UserID: row.UserID, Username: row.Username}
+			byUser[row.UserID] = entry
+			best[row.UserID] = make(map[string]*BestRun)
+			entries = append(entries, entry)
+		}
+
+		run := &BestRun{
+			RunID:          row.RunID,
+			DungeonCode:    row.DungeonCode,
+			KeyLevel:       row.KeyLevel,
+			UpgradeLevel:   row.UpgradeLevel,
+			ElapsedSeconds: row.ElapsedSeconds,
+			ParSeconds:     row.ParSeconds,
+		}
+		run.Score = score(run)
+		current := best[row.UserID][row.DungeonCode]
+		if current == nil || run.Score > current.Score ||
+			(run.Score == current.Score && run.ElapsedSeconds < current.ElapsedSeconds) {
+			best[row.UserID][row.DungeonCode] = run
+		}
+	}
+
+	for _, entry := range entries {
+		entry.BestRuns = make([]*BestRun, 0, len(best[entry.UserID]))
+		for _, run := range best[entry.UserID] {
+			entry.Rating += run.Score
+			entry.ElapsedSeconds += run.ElapsedSeconds
+			entry.BestRuns = append(entry.BestRuns, run)
+		}
+		sort.Slice(entry.BestRuns, func(i, j int) bool {
+			return entry.BestRuns[i].DungeonCode < entry.BestRuns[j].DungeonCode
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.Rating != b.Rating {
+			return a.Rating > b.Rating
+		}
+		if a.ElapsedSeconds != b.ElapsedSeconds {
+			return a.ElapsedSeconds < b.ElapsedSeconds
+		}
+		return a.UserID < b.UserID
+	})
+	for i, entry := range entries {
+		entry.Rank = int32(i + 1)
+	}
+	if entries == nil {
+		entries = []*LeaderboardEntry{}
+	}
+	return entries
+}
+
+// guildScoring returns the scoring a guild ranks its leaderboard by, falling
+// back to the default for scorings that no longer exist.
+func guildScoring(guild repo.Guild) LeaderboardScoring {
+	scoring := LeaderboardScoring(guild.LeaderboardScoring)
+	if _, ok := scoringFuncs[scoring]; !ok {
+		return DefaultLeaderboardScoring
+	}
+	return scoring
+}
+
+// mythicPlusScore rates a run like the in-game Mythic+ rating: 10 points per
+// key level, plus up to 5 for the share of the timer that was left.
+func mythicPlusScore(run *BestRun) float64 {
+	score := float64(run.KeyLevel) * 10
+	if run.ParSeconds > 0 {
+		left := 1 - float64(run.ElapsedSeconds)/float64(run.ParSeconds)
+		score += 5 * max(0, min(left, 1))
+	}
+	return score
+}
+
+// keyLevelScore rates a run by its key level alone.
+func keyLevelScore(run *BestRun) float64 {
+	return float64(run.KeyLevel)
+}
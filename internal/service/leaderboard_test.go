@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+func Test_rankLeaderboard(t *testing.T) {
+	timed := func(userID, runID int32, dungeon string, keyLevel, elapsed int32) repo.GetSeasonTimedRunsRow {
+		return repo.GetSeasonTimedRunsRow{UserID: userID, Username: "player", RunID: runID, DungeonCode: dungeon,
+			KeyLevel: keyLevel, UpgradeLevel: 1, ElapsedSeconds: elapsed, ParSeconds: 1800}
+	}
+
+	tests := []struct {
+		name      string
+		rows      []repo.GetSeasonTimedRunsRow
+		wantUsers []int32
+		wantRuns  [][]int32
+	}{
+		{"No Runs", nil, []int32{}, [][]int32{}},
+		{
+			"Best Run Per Dungeon",
+			[]repo.GetSeasonTimedRunsRow{
+				timed(5, 1, "ARAK", 10, 1700), timed(5, 2, "ARAK", 12, 1750), timed(5, 3, "SV", 8, 1700),
+				timed(6, 4, "ARAK", 19, 1700),
+			},
+			[]int32{5, 6}, [][]int32{{2, 3}, {4}},
+		},
+		{
+			"Equal Level Keeps The Faster Run",
+			[]repo.GetSeasonTimedRunsRow{timed(5, 1, "ARAK", 12, 1750), timed(5, 2, "ARAK", 12, 1700)},
+			[]int32{5}, [][]int32{{2}},
+		},
+		{
+			"Tie Goes To The Faster Member",
+			[]repo.GetSeasonTimedRunsRow{timed(5, 1, "ARAK", 12, 1750), timed(6, 2, "SV", 12, 1500),
+				timed(7, 3, "COT", 12, 1500)},
+			[]int32{6, 7, 5}, [][]int32{{2}, {3}, {1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := rankLeaderboard(tt.rows, keyLevelScore)
+			users := []int32{}
+			runs := [][]int32{}
+			for i, entry := range entries {
+				assert.Equal(t, int32(i+1), entry.Rank)
+				users = append(users, entry.UserID)
+				var ids []int32
+				for _, run := range entry.BestRuns {
+					ids = append(ids, run.RunID)
+				}
+				runs = append(runs, ids)
+			}
+			assert.Equal(t, tt.wantUsers, users)
+			assert.Equal(t, tt.wantRuns, runs)
+		})
+	}
+}
+
+func Test_mythicPlusScore(t *testing.T) {
+	tests := []struct {
+		name string
+		run  BestRun
+		want float64
+	}{
+		{"Just In Time", BestRun{KeyLevel: 10, ElapsedSeconds: 1800, ParSeconds: 1800}, 100},
+		{"Half The Timer Left", BestRun{KeyLevel: 10, ElapsedSeconds: 900, ParSeconds: 1800}, 102.5},
+		{"No Par", BestRun{KeyLevel: 2}, 20},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, mythicPlusScore(&tt.run))
+		})
+	}
+}
+
+func Test_leaderboardService_GetLeaderboard(t *testing.T) {
+	ctx := context.Background()
+	open := repo.Season{ID: 1, Code: "TWW-S2", StartsAt: pgTimestamptz(utc(2025, 3, 4, 15, 0))}
+	closed := repo.Season{ID: 2, Code: "TWW-S1", StartsAt: pgTimestamptz(utc(2024, 9, 10, 15, 0)),
+		EndsAt: open.StartsAt, ClosedAt: open.StartsAt}
+	guild := repo.Guild{ID: 4, LeaderboardScoring: "key_level"}
+
+	tests := []struct {
+		name        string
+		season      string
+		affix       string
+		limit       int
+		offset      int
+		wantFrozen  bool
+		wantUsers   []int32
+		wantScoring LeaderboardScoring
+		wantErr     error
+	}{
+		{"Open Season Page", "", "", 1, 1, false, []int32{5}, ScoringKeyLevel, nil},
+		{"Past The End", "", "", 0, 5, false, []int32{}, ScoringKeyLevel, nil},
+		{"Frozen Season", "TWW-S1", "", 0, 0, true, []int32{7}, ScoringMythicPlus, nil},
+		{"Affix Filter", "", "fortified", 0, 0, false, []int32{6, 5}, ScoringKeyLevel, nil},
+		{"Affix Filter On Closed Season", "TWW-S1", "FORTIFIED", 0, 0, false, []int32{6, 5}, ScoringKeyLevel, nil},
+		{"Unknown Affix", "", "BURSTING", 0, 0, false, nil, "", ErrAffixNotFound},
+		{"Limit Too High", "", "", 101, 0, false, nil, "", ErrInvalidPage},
+		{"Negative Offset", "", "", 10, -1, false, nil, "", ErrInvalidPage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockq := repo.NewMockQuerier(t)
+			season := open
+			if tt.season != "" {
+				season = closed
+			}
+			if tt.wantErr != ErrInvalidPage {
+				mockq.EXPECT().GetGuildByID(ctx, int32(4)).Return(guild, nil)
+				if tt.season != "" {
+					mockq.EXPECT().GetSeasonByCode(ctx, tt.season).Return(closed, nil)
+				} else {
+					mockq.EXPECT().GetOpenSeason(ctx).Return(open, nil)
+				}
+			}
+			switch {
+			case tt.wantErr == ErrAffixNotFound:
+				mockq.EXPECT().GetAffixByCode(ctx, "BURSTING").Return(repo.Affix{}, pgx.ErrNoRows)
+			case tt.wantErr != nil:
+			case tt.affix != "":
+				mockq.EXPECT().GetAffixByCode(ctx, "FORTIFIED").Return(repo.Affix{ID: 1, Code: "FORTIFIED"}, nil)
+				mockq.EXPECT().GetSeasonTimedRuns(ctx, repo.GetSeasonTimedRunsParams{GuildID: 4,
+					StartsAt: season.StartsAt, EndsAt: season.EndsAt, Affix: "FORTIFIED"}).Return(
+					[]repo.GetSeasonTimedRunsRow{
+						{UserID: 5, DungeonCode: "ARAK", KeyLevel: 10, UpgradeLevel: 1, ElapsedSeconds: 1700},
+						{UserID: 6, DungeonCode: "ARAK", KeyLevel: 14, UpgradeLevel: 1, ElapsedSeconds: 1700},
+					}, nil)
+			case tt.wantFrozen:
+				mockq.EXPECT().GetLeaderboardSnapshot(ctx, repo.GetLeaderboardSnapshotParams{SeasonID: 2,
+					GuildID: 4}).Return([]repo.LeaderboardSnapshot{{SeasonID: 2, GuildID: 4, UserID: 7, Rank: 1,
+					Rating: 120, BestRuns: []byte(`[{"run_id":3,"dungeon":"ARAK","key_level":12}]`),
+					Scoring: "mythic_plus"}}, nil)
+			default:
+				mockq.EXPECT().GetSeasonTimedRuns(ctx, repo.GetSeasonTimedRunsParams{GuildID: 4,
+					StartsAt: open.StartsAt, EndsAt: pgtype.Timestamptz{}}).Return([]repo.GetSeasonTimedRunsRow{
+					{UserID: 5, DungeonCode: "ARAK", KeyLevel: 10, UpgradeLevel: 1, ElapsedSeconds: 1700},
+					{UserID: 6, DungeonCode: "ARAK", KeyLevel: 14, UpgradeLevel: 1, ElapsedSeconds: 1700},
+				}, nil)
+			}
+			s := &leaderboardService{leaderboardRepo: mockq}
+
+			board, err := s.GetLeaderboard(ctx, 4, tt.season, tt.affix, tt.limit, tt.offset)
+			if !assert.ErrorIs(t, err, tt.wantErr) || err != nil {
+				return
+			}
+			assert.Equal(t, tt.wantFrozen, board.Frozen)
+			assert.Equal(t, tt.wantScoring, board.Scoring)
+			users := []int32{}
+			for _, entry := range board.Entries {
+				users = append(users, entry.UserID)
+			}
+			assert.Equal(t, tt.wantUsers, users)
+		})
+	}
+}
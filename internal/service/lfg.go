@@ -0,0 +1,688 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// LFGEntryStatus is where a player is in the looking for group queue.
+type LFGEntryStatus string
+
+const (
+	LFGEntryQueued   = LFGEntryStatus("queued")
+	LFGEntryProposed = LFGEntryStatus("proposed")
+	LFGEntryMatched  = LFGEntryStatus("matched")
+	LFGEntryLeft     = LFGEntryStatus("left")
+)
+
+// ProposalStatus is the state of a group the matcher proposed.
+type ProposalStatus string
+
+const (
+	ProposalPending  = ProposalStatus("pending")
+	ProposalAccepted = ProposalStatus("accepted")
+	ProposalDeclined = ProposalStatus("declined")
+	ProposalExpired  = ProposalStatus("expired")
+)
+
+// ProposalResponse is a member's answer to a proposed group.
+type ProposalResponse string
+
+const (
+	ResponsePending  = ProposalResponse("pending")
+	ResponseAccepted = ProposalResponse("accepted")
+	ResponseDeclined = ProposalResponse("declined")
+)
+
+// Limits of the queue. Members have lfgProposalTTL to accept a proposed group.
+// Runs formed from the queue are booked for the par timer and lfgRunPadding
+// for forming up.
+const (
+	maxLFGWindow   = 24 * time.Hour
+	lfgProposalTTL = 15 * time.Minute
+	lfgRunPadding  = 15 * time.Minute
+)
+
+// LFGEntry is a player looking for a group for a dungeon, for keys between
+// MinKeyLevel and MaxKeyLevel, as any of Roles while available.
+type LFGEntry struct {
+	ID             int32          `json:"id"`
+	UserID         int32          `json:"user_id"`
+	CharacterID    *int32         `json:"character_id,omitempty"`
+	Dungeon        *Dungeon       `json:"dungeon"`
+	MinKeyLevel    int32          `json:"min_key_level"`
+	MaxKeyLevel    int32          `json:"max_key_level"`
+	Roles          []UserRole     `json:"roles"`
+	AvailableFrom  time.Time      `json:"available_from"`
+	AvailableUntil time.Time      `json:"available_until"`
+	Status         LFGEntryStatus `json:"status"`
+	ProposalID     *int32         `json:"proposal_id,omitempty"`
+	CreatedAt      time.Time      `json:"created_at"`
+}
+
+// LFGInput holds the fields used to join the queue. Roles defaults to every
+// combat role the user plays, or the character with CharacterID if set.
+type LFGInput struct {
+	CharacterID    *int32     `json:"character_id"`
+	DungeonCode    string     `json:"dungeon"`
+	MinKeyLevel    int32      `json:"min_key_level"`
+	MaxKeyLevel    int32      `json:"max_key_level"`
+	Roles          []UserRole `json:"roles"`
+	AvailableFrom  time.Time  `json:"available_from"`
+	AvailableUntil time.Time  `json:"available_until"`
+}
+
+// LFGProposal is a group the matcher put together from the queue. Once every
+// member accepts, a run is created with them signed up, see RunID.
+type LFGProposal struct {
+	ID        int32             `json:"id"`
+	Dungeon   *Dungeon          `json:"dungeon"`
+	KeyLevel  int32             `json:"key_level"`
+	StartsAt  time.Time         `json:"starts_at"`
+	Score     float64           `json:"score"`
+	Seed      int64             `json:"seed"`
+	Status    ProposalStatus    `json:"status"`
+	ExpiresAt time.Time         `json:"expires_at"`
+	RunID     *int32            `json:"run_id,omitempty"`
+	Members   []*ProposalMember `json:"members"`
+}
+
+// ProposalMember is a player in a proposed group.
+type ProposalMember struct {
+	UserID        int32            `json:"user_id"`
+	Username      string           `json:"username"`
+	CharacterID   *int32           `json:"character_id,omitempty"`
+	CharacterName string           `json:"character_name,omitempty"`
+	Role          UserRole         `json:"role"`
+	Response      ProposalResponse `json:"response"`
+}
+
+// LFGService is the interface for the looking for group queue.
+type LFGService interface {
+	JoinQueue(ctx context.Context, userID int32, input *LFGInput) (*LFGEntry, error)
+	GetQueueEntry(ctx context.Context, userID int32) (*LFGEntry, error)
+	LeaveQueue(ctx context.Context, userID int32) error
+	GetProposal(ctx context.Context, id int32) (*LFGProposal, error)
+	RespondToProposal(ctx context.Context, userID, id int32, accept bool) (*LFGProposal, error)
+	Match(ctx context.Context, seed int64) ([]*LFGProposal, error)
+}
+
+// lfgService is the implementation of LFGService.
+type lfgService struct {
+	dbPool  *pgxpool.Pool
+	lfgRepo repo.Querier
+	now     func() time.Time
+}
+
+// NewLFGService creates a new lfgService with the provided database connection pool.
+// It returns a pointer to the lfgService.
+func NewLFGService(dbPool *pgxpool.Pool) *lfgService {
+	return &lfgService{
+		dbPool:  dbPool,
+		lfgRepo: repo.New(dbPool),
+		now:     time.Now,
+	}
+}
+
+// JoinQueue puts the user in the queue. The dungeon must have Mythic+ keys and
+// the user must be available for at least its par timer. Returns
+// ErrAlreadyQueued if the user is queued already.
+func (s *lfgService) JoinQueue(ctx context.Context, userID int32, input *LFGInput) (*LFGEntry, error) {
+	playable, err := playableRoles(ctx, s.lfgRepo, userID, input.CharacterID)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := queueRoles(playable, input.Roles)
+	if err != nil {
+		return nil, err
+	}
+
+	dungeon, err := s.lfgRepo.GetDungeonByCode(ctx, input.DungeonCode)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && !dungeon.Active) {
+		return nil, ErrDungeonNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := isValidLFGInput(input, mapDungeon(dungeon), s.now()); err != nil {
+		return nil, err
+	}
+
+	entry, err := s.lfgRepo.CreateLFGEntry(ctx, repo.CreateLFGEntryParams{
+		UserID:         userID,
+		DungeonID:      dungeon.ID,
+		MinKeyLevel:    input.MinKeyLevel,
+		MaxKeyLevel:    input.MaxKeyLevel,
+		Roles:          roleStrings(roles),
+		AvailableFrom:  pgTimestamptz(input.AvailableFrom),
+		AvailableUntil: pgTimestamptz(input.AvailableUntil),
+		CharacterID:    pgInt4(input.CharacterID),
+	})
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return nil, ErrAlreadyQueued
+	}
+	if err != nil {
+		return nil, err
+	}
+	return mapLFGEntry(entry, dungeon), nil
+}
+
+// GetQueueEntry returns the entry of the user in the queue. Returns
+// ErrNotQueued if they are not in it.
+func (s *lfgService) GetQueueEntry(ctx context.Context, userID int32) (*LFGEntry, error) {
+	row, err := s.lfgRepo.GetActiveLFGEntry(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotQueued
+	}
+	if err != nil {
+		return nil, err
+	}
+	return mapLFGEntry(row.LfgEntry, row.Dungeon), nil
+}
+
+// LeaveQueue takes the user out of the queue. Leaving while in a proposed
+// group declines it, the rest of the group goes back to the queue.
+func (s *lfgService) LeaveQueue(ctx context.Context, userID int32) error {
+	return inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		entry, err := q.LockActiveLFGEntry(ctx, userID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotQueued
+		}
+		if err != nil {
+			return err
+		}
+
+		if LFGEntryStatus(entry.Status) == LFGEntryProposed && entry.ProposalID.Valid {
+			if _, err := q.LockLFGProposal(ctx, entry.ProposalID.Int32); err != nil {
+				return err
+			}
+			members, err := q.GetLFGProposalMembers(ctx, entry.ProposalID.Int32)
+			if err != nil {
+				return err
+			}
+			if err := setProposalResponse(ctx, q, entry.ProposalID.Int32, userID, ResponseDeclined); err != nil {
+				return err
+			}
+			return closeProposal(ctx, q, entry.ProposalID.Int32, ProposalDeclined, members, userID)
+		}
+		return q.SetLFGEntryStatus(ctx, repo.SetLFGEntryStatusParams{ID: entry.ID, Status: string(LFGEntryLeft)})
+	})
+}
+
+// GetProposal returns a proposed group. Returns ErrProposalNotFound if it does
+// not exist.
+func (s *lfgService) GetProposal(ctx context.Context, id int32) (*LFGProposal, error) {
+	return loadProposal(ctx, s.lfgRepo, id)
+}
+
+// RespondToProposal records whether the user accepts the proposed group. The
+// run is created as soon as the last member accepts. A member declining breaks
+// the group up, they leave the queue and everyone else goes back into it.
+// Returns ErrProposalClosed once the group is no longer waiting on answers.
+func (s *lfgService) RespondToProposal(ctx context.Context, userID, id int32, accept bool) (*LFGProposal, error) {
+	var proposal *LFGProposal
+	err := inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		var err error
+		proposal, err = respondToProposal(ctx, q, userID, id, accept, s.now())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return proposal, nil
+}
+
+// respondToProposal records the answer of the user with userID to the
+// proposal with id at now, see RespondToProposal.
+func respondToProposal(ctx context.Context, q repo.Querier, userID, id int32, accept bool,
+	now time.Time) (*LFGProposal, error) {
+	locked, err := q.LockLFGProposal(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrProposalNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if ProposalStatus(locked.Status) != ProposalPending || !locked.ExpiresAt.Time.After(now) {
+		return nil, ErrProposalClosed
+	}
+
+	members, err := q.GetLFGProposalMembers(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	i := slices.IndexFunc(members, func(m repo.GetLFGProposalMembersRow) bool { return m.UserID == userID })
+	if i < 0 {
+		return nil, ErrForbidden
+	}
+	if ProposalResponse(members[i].Response) != ResponsePending {
+		return nil, ErrProposalClosed
+	}
+
+	if !accept {
+		if err := setProposalResponse(ctx, q, id, userID, ResponseDeclined); err != nil {
+			return nil, err
+		}
+		if err := closeProposal(ctx, q, id, ProposalDeclined, members, userID); err != nil {
+			return nil, err
+		}
+		return loadProposal(ctx, q, id)
+	}
+
+	if err := setProposalResponse(ctx, q, id, userID, ResponseAccepted); err != nil {
+		return nil, err
+	}
+	members[i].Response = string(ResponseAccepted)
+	if slices.ContainsFunc(members, func(m repo.GetLFGProposalMembersRow) bool {
+		return ProposalResponse(m.Response) != ResponseAccepted
+	}) {
+		return loadProposal(ctx, q, id)
+	}
+
+	row, err := q.GetLFGProposal(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	run, err := createProposedRun(ctx, q, row, members, now)
+	if err != nil {
+		return nil, err
+	}
+	_, err = q.SetLFGProposalStatus(ctx, repo.SetLFGProposalStatusParams{
+		ID:     id,
+		Status: string(ProposalAccepted),
+		RunID:  pgInt4(&run.ID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		err := q.SetLFGEntryStatus(ctx, repo.SetLFGEntryStatusParams{
+			ID:         m.EntryID,
+			Status:     string(LFGEntryMatched),
+			ProposalID: pgInt4(&id),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return loadProposal(ctx, q, id)
+}
+
+// Match runs the matcher over the queue with seed, see matchGroups, and
+// proposes the groups it forms to their members. Proposals nobody finished
+// answering in time lapse first, members that did not answer leave the queue.
+// The matched entries are locked while matching, so matchers can run beside
+// each other.
+func (s *lfgService) Match(ctx context.Context, seed int64) ([]*LFGProposal, error) {
+	var ids []int32
+	err := inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		var err error
+		ids, err = matchQueue(ctx, q, seed, s.now())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	proposals := make([]*LFGProposal, 0, len(ids))
+	for _, id := range ids {
+		proposal, err := loadProposal(ctx, s.lfgRepo, id)
+		if err != nil {
+			return nil, err
+		}
+		proposals = append(proposals, proposal)
+	}
+	return proposals, nil
+}
+
+// Work runs the matcher every interval until ctx is done, with a new seed
+// every pass.
+func (s *lfgService) Work(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.Match(ctx, s.now().UnixNano()); err != nil && ctx.Err() == nil {
+			log.Printf("lfg: matching: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// matchQueue lapses expired proposals and proposes the groups the matcher
+// forms from the queue at now. It returns the ids of the new proposals.
+func matchQueue(ctx context.Context, q repo.Querier, seed int64, now time.Time) ([]int32, error) {
+	expired, err := q.LockExpiredLFGProposals(ctx, pgTimestamptz(now))
+	if err != nil {
+		return nil, err
+	}
+	for _, proposal := range expired {
+		members, err := q.GetLFGProposalMembers(ctx, proposal.ID)
+		if err != nil {
+			return nil, err
+		}
+		if err := closeProposal(ctx, q, proposal.ID, ProposalExpired, members, 0); err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err := q.LockQueuedLFGEntries(ctx, pgTimestamptz(now))
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]*matchCandidate, 0, len(rows))
+	for _, row := range rows {
+		reliability, err := loadReliability(ctx, q, row.LfgEntry.UserID, now)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, newMatchCandidate(row.LfgEntry, row.Dungeon, reliability.Score))
+	}
+
+	var ids []int32
+	for _, group := range matchGroups(candidates, now, seed) {
+		id, err := proposeGroup(ctx, q, group, seed, now)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// proposeGroup stores group as a proposal, takes its members off the queue
+// while they answer and lets them know.
+func proposeGroup(ctx context.Context, q repo.Querier, group *groupMatch, seed int64, now time.Time) (int32, error) {
+	proposal, err := q.CreateLFGProposal(ctx, repo.CreateLFGProposalParams{
+		DungeonID: group.DungeonID,
+		KeyLevel:  group.KeyLevel,
+		StartsAt:  pgTimestamptz(group.StartsAt),
+		Score:     group.Score,
+		Seed:      seed,
+		ExpiresAt: pgTimestamptz(now.Add(lfgProposalTTL)),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	row, err := q.GetLFGProposal(ctx, proposal.ID)
+	if err != nil {
+		return 0, err
+	}
+	title := fmt.Sprintf("%s +%d", row.Dungeon.Name, group.KeyLevel)
+	for _, m := range group.Members {
+		err := q.AddLFGProposalMember(ctx, repo.AddLFGProposalMemberParams{
+			ProposalID: proposal.ID,
+			UserID:     m.Candidate.UserID,
+			EntryID:    m.Candidate.EntryID,
+			Role:       string(m.Role),
+		})
+		if err != nil {
+			return 0, err
+		}
+		err = q.SetLFGEntryStatus(ctx, repo.SetLFGEntryStatusParams{
+			ID:         m.Candidate.EntryID,
+			Status:     string(LFGEntryProposed),
+			ProposalID: pgInt4(&proposal.ID),
+		})
+		if err != nil {
+			return 0, err
+		}
+		if err := enqueueProposalNotification(ctx, q, m.Candidate.UserID, proposal, title, m.Role); err != nil {
+			return 0, err
+		}
+	}
+	return proposal.ID, nil
+}
+
+// closeProposal ends the proposal with id as status. Members that accepted go
+// back to the queue, everyone else leaves it, except on a decline where only
+// the member with declinedBy leaves.
+func closeProposal(ctx context.Context, q repo.Querier, id int32, status ProposalStatus,
+	members []repo.GetLFGProposalMembersRow, declinedBy int32) error {
+	_, err := q.SetLFGProposalStatus(ctx, repo.SetLFGProposalStatusParams{ID: id, Status: string(status)})
+	if err != nil {
+		return err
+	}
+
+	for _, m := range members {
+		requeue := ProposalResponse(m.Response) == ResponseAccepted
+		if status == ProposalDeclined {
+			requeue = m.UserID != declinedBy
+		}
+		params := repo.SetLFGEntryStatusParams{ID: m.EntryID, Status: string(LFGEntryQueued)}
+		if !requeue {
+			params.Status = string(LFGEntryLeft)
+			params.ProposalID = pgInt4(&id)
+		}
+		if err := q.SetLFGEntryStatus(ctx, params); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createProposedRun creates the run of an accepted proposal, led by its tank
+// and with every member confirmed in the role they were matched for. Proposals
+// accepted after their start time start at now instead.
+func createProposedRun(ctx context.Context, q repo.Querier, row repo.GetLFGProposalRow,
+	members []repo.GetLFGProposalMembersRow, now time.Time) (*Run, error) {
+	leader := members[slices.IndexFunc(members, func(m repo.GetLFGProposalMembersRow) bool {
+		return UserRole(m.Role) == RoleTank
+	})]
+	startsAt := row.LfgProposal.StartsAt.Time
+	if startsAt.Before(now) {
+		startsAt = now.Truncate(time.Minute).Add(time.Minute)
+	}
+	duration := (mapDungeon(row.Dungeon).ParTimer() + lfgRunPadding).Round(time.Minute)
+	keyLevel := row.LfgProposal.KeyLevel
+
+	r, err := q.CreateRun(ctx, createRunParams(leader.UserID, &runFields{
+		dungeon:         row.Dungeon,
+		difficulty:      DifficultyMythicPlus,
+		keyLevel:        &keyLevel,
+		startsAt:        startsAt,
+		timezone:        leader.Timezone,
+		durationMinutes: int32(max(duration, minRunDuration) / time.Minute),
+		notes:           "Matched from the looking for group queue.",
+		composition:     DefaultComposition,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range members {
+		signup, err := q.CreateSignup(ctx, repo.CreateSignupParams{
+			RunID:       r.ID,
+			UserID:      m.UserID,
+			Role:        m.Role,
+			Status:      string(SignupStatusConfirmed),
+			CharacterID: m.CharacterID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := recordSignupEvent(ctx, q, EventSignupConfirmed, signup); err != nil {
+			return nil, err
+		}
+	}
+
+	run := mapRun(r, row.Dungeon)
+	if err := emitWebhookEvent(ctx, q, WebhookRunCreated, nil, run); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// setProposalResponse stores the answer of the user with userID.
+func setProposalResponse(ctx context.Context, q repo.Querier, id, userID int32, response ProposalResponse) error {
+	_, err := q.SetLFGProposalResponse(ctx, repo.SetLFGProposalResponseParams{
+		ProposalID: id,
+		UserID:     userID,
+		Response:   string(response),
+	})
+	return err
+}
+
+// enqueueProposalNotification lets the user with userID know they were matched
+// into the group of proposal. The notification lapses with the proposal.
+func enqueueProposalNotification(ctx context.Context, q repo.Querier, userID int32, proposal repo.LfgProposal,
+	title string, role UserRole) error {
+	payload, err := json.Marshal(runNotificationPayload{
+		ProposalID: proposal.ID,
+		Title:      title,
+		StartsAt:   proposal.StartsAt.Time,
+		Role:       role,
+	})
+	if err != nil {
+		return err
+	}
+	return q.CreateNotification(ctx, repo.CreateNotificationParams{
+		UserID:    userID,
+		Type:      string(NotificationGroupProposed),
+		Payload:   payload,
+		ExpiresAt: proposal.ExpiresAt,
+	})
+}
+
+func loadProposal(ctx context.Context, q repo.Querier, id int32) (*LFGProposal, error) {
+	row, err := q.GetLFGProposal(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrProposalNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	members, err := q.GetLFGProposalMembers(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal := &LFGProposal{
+		ID:        row.LfgProposal.ID,
+		Dungeon:   mapDungeon(row.Dungeon),
+		KeyLevel:  row.LfgProposal.KeyLevel,
+		StartsAt:  row.LfgProposal.StartsAt.Time.UTC(),
+		Score:     row.LfgProposal.Score,
+		Seed:      row.LfgProposal.Seed,
+		Status:    ProposalStatus(row.LfgProposal.Status),
+		ExpiresAt: row.LfgProposal.ExpiresAt.Time.UTC(),
+		RunID:     int4Ptr(row.LfgProposal.RunID),
+		Members:   make([]*ProposalMember, 0, len(members)),
+	}
+	for _, m := range members {
+		proposal.Members = append(proposal.Members, &ProposalMember{
+			UserID:        m.UserID,
+			Username:      m.Username,
+			CharacterID:   int4Ptr(m.CharacterID),
+			CharacterName: m.CharacterName.String,
+			Role:          UserRole(m.Role),
+			Response:      ProposalResponse(m.Response),
+		})
+	}
+	return proposal, nil
+}
+
+// queueRoles returns the roles a user queues as, requested or else every
+// combat role in playable. Returns ErrRoleNotPlayable for roles the user
+// does not play.
+func queueRoles(playable, requested []UserRole) ([]UserRole, error) {
+	var roles []UserRole
+	for _, role := range combatRoles {
+		if slices.Contains(playable, role) && (len(requested) == 0 || slices.Contains(requested, role)) {
+			roles = append(roles, role)
+		}
+	}
+	for _, role := range requested {
+		if !slices.Contains(roles, role) {
+			return nil, fmt.Errorf("%w: %s", ErrRoleNotPlayable, role)
+		}
+	}
+	if len(roles) == 0 {
+		return nil, ErrRoleNotPlayable
+	}
+	return roles, nil
+}
+
+// isValidLFGInput checks the key range and availability of input for dungeon at now.
+func isValidLFGInput(input *LFGInput, dungeon *Dungeon, now time.Time) error {
+	if !dungeon.SupportsDifficulty(DifficultyMythicPlus) {
+		return fmt.Errorf("%w: %s has no Mythic+ keys", ErrInvalidLFGEntry, dungeon.Code)
+	}
+	if input.MinKeyLevel < minKeyLevel || input.MaxKeyLevel > maxKeyLevel || input.MinKeyLevel > input.MaxKeyLevel {
+		return ErrInvalidKeyLevel
+	}
+
+	from := input.AvailableFrom
+	if from.Before(now) {
+		from = now
+	}
+	if input.AvailableUntil.Sub(input.AvailableFrom) > maxLFGWindow {
+		return fmt.Errorf("%w: available for more than %s", ErrInvalidLFGEntry, maxLFGWindow)
+	}
+	if input.AvailableUntil.Sub(from) < dungeon.ParTimer() {
+		return fmt.Errorf("%w: not available long enough to finish the dungeon", ErrInvalidLFGEntry)
+	}
+	return nil
+}
+
+func newMatchCandidate(e repo.LfgEntry, d repo.Dungeon, reliability float64) *matchCandidate {
+	roles := make([]UserRole, 0, len(e.Roles))
+	for _, role := range e.Roles {
+		roles = append(roles, UserRole(role))
+	}
+	return &matchCandidate{
+		EntryID:     e.ID,
+		UserID:      e.UserID,
+		DungeonID:   e.DungeonID,
+		Par:         time.Duration(d.ParSeconds) * time.Second,
+		MinKeyLevel: e.MinKeyLevel,
+		MaxKeyLevel: e.MaxKeyLevel,
+		Roles:       roles,
+		From:        e.AvailableFrom.Time,
+		Until:       e.AvailableUntil.Time,
+		Reliability: reliability,
+	}
+}
+
+func mapLFGEntry(e repo.LfgEntry, d repo.Dungeon) *LFGEntry {
+	candidate := newMatchCandidate(e, d, 0)
+	return &LFGEntry{
+		ID:             e.ID,
+		UserID:         e.UserID,
+		CharacterID:    int4Ptr(e.CharacterID),
+		Dungeon:        mapDungeon(d),
+		MinKeyLevel:    e.MinKeyLevel,
+		MaxKeyLevel:    e.MaxKeyLevel,
+		Roles:          candidate.Roles,
+		AvailableFrom:  e.AvailableFrom.Time.UTC(),
+		AvailableUntil: e.AvailableUntil.Time.UTC(),
+		Status:         LFGEntryStatus(e.Status),
+		ProposalID:     int4Ptr(e.ProposalID),
+		CreatedAt:      e.CreatedAt.Time,
+	}
+}
+
+func roleStrings(roles []UserRole) []string {
+	s := make([]string, 0, len(roles))
+	for _, role := range roles {
+		s = append(s, string(role))
+	}
+	return s
+}
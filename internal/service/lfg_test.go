@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+func Test_queueRoles(t *testing.T) {
+	tests := []struct {
+		name      string
+		playable  []UserRole
+		requested []UserRole
+		want      []UserRole
+		wantErr   error
+	}{
+		{"Every Combat Role", []UserRole{RoleDPS, RoleTank, RoleLeader}, nil, []UserRole{RoleTank, RoleDPS}, nil},
+		{"Requested", []UserRole{RoleTank, RoleDPS}, []UserRole{RoleDPS}, []UserRole{RoleDPS}, nil},
+		{"Not Played", []UserRole{RoleDPS}, []UserRole{RoleHealer}, nil, ErrRoleNotPlayable},
+		{"No Combat Role", []UserRole{RoleLeader}, nil, nil, ErrRoleNotPlayable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := queueRoles(tt.playable, tt.requested)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_isValidLFGInput(t *testing.T) {
+	now := utc(2025, 3, 5, 20, 0)
+	dungeon := &Dungeon{Code: "ARAK", ParSeconds: 1800, Difficulties: []Difficulty{DifficultyMythicPlus}}
+	input := func(minLevel, maxLevel int32, from, until time.Time) *LFGInput {
+		return &LFGInput{MinKeyLevel: minLevel, MaxKeyLevel: maxLevel, AvailableFrom: from, AvailableUntil: until}
+	}
+
+	tests := []struct {
+		name    string
+		dungeon *Dungeon
+		input   *LFGInput
+		wantErr error
+	}{
+		{"Valid", dungeon, input(10, 12, now, now.Add(2*time.Hour)), nil},
+		{"Already Available", dungeon, input(10, 12, now.Add(-time.Hour), now.Add(time.Hour)), nil},
+		{"No Keys", &Dungeon{Code: "NWC", ParSeconds: 1800}, input(10, 12, now, now.Add(2*time.Hour)),
+			ErrInvalidLFGEntry},
+		{"Key Range Reversed", dungeon, input(12, 10, now, now.Add(2*time.Hour)), ErrInvalidKeyLevel},
+		{"Key Too High", dungeon, input(10, 41, now, now.Add(2*time.Hour)), ErrInvalidKeyLevel},
+		{"Too Short", dungeon, input(10, 12, now.Add(-time.Hour), now.Add(20*time.Minute)), ErrInvalidLFGEntry},
+		{"Too Long", dungeon, input(10, 12, now, now.Add(25*time.Hour)), ErrInvalidLFGEntry},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, isValidLFGInput(tt.input, tt.dungeon, now), tt.wantErr)
+		})
+	}
+}
+
+func Test_closeProposal(t *testing.T) {
+	members := []repo.GetLFGProposalMembersRow{
+		{UserID: 1, EntryID: 11, Response: "accepted"},
+		{UserID: 2, EntryID: 12, Response: "declined"},
+		{UserID: 3, EntryID: 13, Response: "pending"},
+	}
+	proposalID := pgtype.Int4{Int32: 5, Valid: true}
+
+	tests := []struct {
+		name       string
+		status     ProposalStatus
+		declinedBy int32
+		want       map[int32]LFGEntryStatus
+	}{
+		{"Declined", ProposalDeclined, 2,
+			map[int32]LFGEntryStatus{11: LFGEntryQueued, 12: LFGEntryLeft, 13: LFGEntryQueued}},
+		{"Expired", ProposalExpired, 0,
+			map[int32]LFGEntryStatus{11: LFGEntryQueued, 12: LFGEntryLeft, 13: LFGEntryLeft}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			mockq.EXPECT().SetLFGProposalStatus(ctx, repo.SetLFGProposalStatusParams{ID: 5, Status: string(tt.status)}).
+				Return(repo.LfgProposal{ID: 5}, nil)
+			for entryID, status := range tt.want {
+				params := repo.SetLFGEntryStatusParams{ID: entryID, Status: string(status)}
+				if status == LFGEntryLeft {
+					params.ProposalID = proposalID
+				}
+				mockq.EXPECT().SetLFGEntryStatus(ctx, params).Return(nil)
+			}
+
+			assert.NoError(t, closeProposal(ctx, mockq, 5, tt.status, members, tt.declinedBy))
+		})
+	}
+}
+
+func Test_respondToProposal(t *testing.T) {
+	now := utc(2025, 3, 5, 20, 0)
+	members := []repo.GetLFGProposalMembersRow{
+		{UserID: 1, EntryID: 11, Response: "pending"},
+		{UserID: 2, EntryID: 12, Response: "accepted"},
+	}
+
+	tests := []struct {
+		name      string
+		userID    int32
+		proposal  repo.LfgProposal
+		wantErr   error
+		wantCheck bool
+	}{
+		{"Expired", 1, repo.LfgProposal{ID: 5, Status: "pending", ExpiresAt: pgTimestamptz(now)},
+			ErrProposalClosed, false},
+		{"Declined Already", 1, repo.LfgProposal{ID: 5, Status: "declined", ExpiresAt: pgTimestamptz(now.Add(time.Minute))},
+			ErrProposalClosed, false},
+		{"Not A Member", 3, repo.LfgProposal{ID: 5, Status: "pending", ExpiresAt: pgTimestamptz(now.Add(time.Minute))},
+			ErrForbidden, true},
+		{"Answered Already", 2, repo.LfgProposal{ID: 5, Status: "pending", ExpiresAt: pgTimestamptz(now.Add(time.Minute))},
+			ErrProposalClosed, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			mockq.EXPECT().LockLFGProposal(ctx, int32(5)).Return(tt.proposal, nil)
+			if tt.wantCheck {
+				mockq.EXPECT().GetLFGProposalMembers(ctx, int32(5)).Return(members, nil)
+			}
+
+			_, err := respondToProposal(ctx, mockq, tt.userID, 5, true, now)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// runTransitions lists the statuses a run can be moved to from each status.
+// Depleted is never asked for, completed Mythic+ runs over par end up there.
+var runTransitions = map[RunStatus][]RunStatus{
+	RunStatusScheduled:  {RunStatusForming, RunStatusInProgress, RunStatusCancelled},
+	RunStatusForming:    {RunStatusInProgress, RunStatusCancelled},
+	RunStatusInProgress: {RunStatusCompleted, RunStatusAbandoned},
+}
+
+// requestableStatuses are the statuses a run can be moved to by its leader.
+var requestableStatuses = []RunStatus{RunStatusForming, RunStatusInProgress, RunStatusCompleted,
+	RunStatusAbandoned, RunStatusCancelled}
+
+// Fractions of the par timer a Mythic+ key has to be finished within for
+// each upgrade level.
+const (
+	threeUpgradeFraction = 0.6
+	twoUpgradeFraction   = 0.8
+)
+
+// TransitionRun moves a run to another status. Only the organizer, who leads
+// the run, can move it. Starting a run records when it started and finishing it
+// when it finished, both by the server clock, and the affixes active as it
+// started. Completed Mythic+ runs are given
+// an upgrade level from their elapsed time and the dungeon's par timer, runs
+// over par are depleted instead. The leader marks the attendance of the members
+// as they complete or abandon a run. Waitlisted members fill the slots still
+// open once a run starts forming. Returns a *TransitionError if the run can not
+// move to the status from its current one.
+func (s *runService) TransitionRun(ctx context.Context, actorID, id int32, to RunStatus,
+	attendance []AttendanceMark) (*Run, error) {
+	if !slices.Contains(requestableStatuses, to) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidRunStatus, to)
+	}
+	if len(attendance) > 0 && !slices.Contains(finishedStatuses, to) {
+		return nil, fmt.Errorf("%w: runs are marked as they finish", ErrInvalidAttendance)
+	}
+	if err := checkAttendanceMarks(attendance); err != nil {
+		return nil, err
+	}
+
+	var run *Run
+	err := inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		var err error
+		run, err = transitionRun(ctx, q, actorID, id, to, attendance, s.reset, s.now())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// transitionRun moves the run with id to the status to at now, see TransitionRun.
+// The week a run starts in is placed by reset.
+func transitionRun(ctx context.Context, q repo.Querier, actorID, id int32, to RunStatus,
+	attendance []AttendanceMark, reset WeeklyReset, now time.Time) (*Run, error) {
+	locked, err := q.LockRun(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrRunNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if locked.OrganizerID != actorID {
+		return nil, ErrForbidden
+	}
+	if err := checkRunTransition(RunStatus(locked.Status), to); err != nil {
+		return nil, err
+	}
+
+	row, err := q.GetRunByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	run := mapRun(row.Run, row.Dungeon)
+	if to == RunStatusCancelled {
+		if err := cancelRun(ctx, q, run); err != nil {
+			return nil, err
+		}
+		return run, nil
+	}
+
+	params := repo.TransitionRunParams{
+		ID:         id,
+		Status:     string(to),
+		StartedAt:  locked.StartedAt,
+		FinishedAt: locked.FinishedAt,
+	}
+	switch to {
+	case RunStatusInProgress:
+		params.StartedAt = pgTimestamptz(now)
+		params.Affixes, err = activeAffixes(ctx, q, reset, now)
+		if err != nil {
+			return nil, err
+		}
+	case RunStatusCompleted, RunStatusAbandoned:
+		params.FinishedAt = pgTimestamptz(now)
+	}
+	if to == RunStatusCompleted && run.Difficulty == DifficultyMythicPlus {
+		level := upgradeLevel(now.Sub(locked.StartedAt.Time), run.Dungeon.ParTimer())
+		if level == 0 {
+			params.Status = string(RunStatusDepleted)
+		}
+		params.UpgradeLevel = pgInt4(&level)
+	}
+
+	r, err := q.TransitionRun(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if to == RunStatusForming {
+		if err := fillOpenSlots(ctx, q, r); err != nil {
+			return nil, err
+		}
+	}
+	if err := markAttendance(ctx, q, id, attendance); err != nil {
+		return nil, err
+	}
+	updated := mapRun(r, row.Dungeon)
+	if _, err := recordRunEvent(ctx, q, id, EventRunStatusChanged, &actorID, updated); err != nil {
+		return nil, err
+	}
+	if err := emitWebhookEvent(ctx, q, WebhookRunStatusChanged, updated.GuildID, updated); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// checkRunTransition returns a *TransitionError unless a run can move from
+// the status from to the status to.
+func checkRunTransition(from, to RunStatus) error {
+	if !slices.Contains(runTransitions[from], to) {
+		return &TransitionError{From: from, To: to}
+	}
+	return nil
+}
+
+// upgradeLevel returns how many levels a key goes up when its dungeon is
+// finished in elapsed: 3 within 60% of par, 2 within 80% and 1 within par.
+// Keys finished over par are depleted and do not go up at all.
+func upgradeLevel(elapsed, par time.Duration) int32 {
+	switch {
+	case elapsed <= time.Duration(float64(par)*threeUpgradeFraction):
+		return 3
+	case elapsed <= time.Duration(float64(par)*twoUpgradeFraction):
+		return 2
+	case elapsed <= par:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// elapsedSeconds returns the whole seconds between started and finished,
+// or nil if the run has not finished.
+func elapsedSeconds(started, finished pgtype.Timestamptz) *int32 {
+	if !started.Valid || !finished.Valid {
+		return nil
+	}
+	seconds := int32(finished.Time.Sub(started.Time) / time.Second)
+	return &seconds
+}
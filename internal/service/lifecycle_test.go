@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+func Test_transitionRun(t *testing.T) {
+	now := utc(2025, 3, 5, 2, 0)
+	started := pgTimestamptz(now.Add(-24 * time.Minute))
+	dungeon := repo.Dungeon{ID: 3, Code: "ARAK", Name: "Ara-Kara, City of Echoes", ParSeconds: 1800}
+	run := func(status, difficulty string, startedAt pgtype.Timestamptz) repo.Run {
+		return repo.Run{ID: 1, OrganizerID: 7, Difficulty: difficulty, Status: status, StartedAt: startedAt}
+	}
+
+	tests := []struct {
+		name        string
+		actorID     int32
+		run         repo.Run
+		to          RunStatus
+		wantParams  repo.TransitionRunParams
+		wantErr     error
+		wantProblem string
+	}{
+		{"Forming", 7, run("scheduled", "Mythic+", pgtype.Timestamptz{}), RunStatusForming,
+			repo.TransitionRunParams{ID: 1, Status: "forming"}, nil, ""},
+		{"Started", 7, run("forming", "Mythic+", pgtype.Timestamptz{}), RunStatusInProgress,
+			repo.TransitionRunParams{ID: 1, Status: "in_progress", StartedAt: pgTimestamptz(now),
+				Affixes: []string{"OBLIVION", "FORTIFIED"}}, nil, ""},
+		{"Completed In Time", 7, run("in_progress", "Mythic+", started), RunStatusCompleted,
+			repo.TransitionRunParams{ID: 1, Status: "completed", StartedAt: started, FinishedAt: pgTimestamptz(now),
+				UpgradeLevel: pgInt4(int32Ptr(2))}, nil, ""},
+		{"Completed Over Par", 7, run("in_progress", "Mythic+", pgTimestamptz(now.Add(-31*time.Minute))),
+			RunStatusCompleted, repo.TransitionRunParams{ID: 1, Status: "depleted",
+				StartedAt: pgTimestamptz(now.Add(-31 * time.Minute)), FinishedAt: pgTimestamptz(now),
+				UpgradeLevel: pgInt4(int32Ptr(0))}, nil, ""},
+		{"Completed Without Key", 7, run("in_progress", "Heroic", started), RunStatusCompleted,
+			repo.TransitionRunParams{ID: 1, Status: "completed", StartedAt: started, FinishedAt: pgTimestamptz(now)},
+			nil, ""},
+		{"Abandoned", 7, run("in_progress", "Mythic+", started), RunStatusAbandoned,
+			repo.TransitionRunParams{ID: 1, Status: "abandoned", StartedAt: started, FinishedAt: pgTimestamptz(now)},
+			nil, ""},
+		{"Not Leader", 8, run("forming", "Mythic+", pgtype.Timestamptz{}), RunStatusInProgress,
+			repo.TransitionRunParams{}, ErrForbidden, ""},
+		{"Not Started", 7, run("forming", "Mythic+", pgtype.Timestamptz{}), RunStatusCompleted,
+			repo.TransitionRunParams{}, ErrInvalidTransition, "run_not_started"},
+		{"Already Started", 7, run("in_progress", "Mythic+", started), RunStatusInProgress,
+			repo.TransitionRunParams{}, ErrInvalidTransition, "run_in_progress"},
+		{"Finished", 7, run("depleted", "Mythic+", started), RunStatusAbandoned,
+			repo.TransitionRunParams{}, ErrInvalidTransition, "run_finished"},
+		{"Cancelled", 7, run("cancelled", "Mythic+", pgtype.Timestamptz{}), RunStatusForming,
+			repo.TransitionRunParams{}, ErrInvalidTransition, "run_cancelled"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			mockq.EXPECT().LockRun(ctx, int32(1)).Return(tt.run, nil)
+			if tt.wantErr == nil {
+				mockq.EXPECT().GetRunByID(ctx, int32(1)).Return(repo.GetRunByIDRow{Run: tt.run, Dungeon: dungeon}, nil)
+				if tt.to == RunStatusInProgress {
+					// The run starts in the second week of the season.
+					mockq.EXPECT().GetOpenSeason(ctx).Return(repo.Season{ID: 2,
+						StartsAt: pgTimestamptz(utc(2025, 2, 25, 16, 0))}, nil)
+					mockq.EXPECT().GetSeasonAffixes(ctx, int32(2)).Return([]repo.SeasonAffix{
+						{SeasonID: 2, Week: 1, Affixes: []string{"ASCENDANT", "TYRANNICAL"}},
+						{SeasonID: 2, Week: 2, Affixes: []string{"OBLIVION", "FORTIFIED"}},
+					}, nil)
+				}
+				updated := tt.run
+				updated.Status = tt.wantParams.Status
+				updated.StartedAt = tt.wantParams.StartedAt
+				updated.FinishedAt = tt.wantParams.FinishedAt
+				updated.UpgradeLevel = tt.wantParams.UpgradeLevel
+				updated.Affixes = tt.wantParams.Affixes
+				mockq.EXPECT().TransitionRun(ctx, tt.wantParams).Return(updated, nil)
+				mockq.EXPECT().CreateRunEvent(ctx, mock.MatchedBy(func(arg repo.CreateRunEventParams) bool {
+					return arg.Type == "run.status_changed" && arg.UserID.Int32 == 7
+				})).Return(repo.RunEvent{}, nil)
+				mockq.EXPECT().GetSubscribedWebhookEndpoints(ctx, repo.GetSubscribedWebhookEndpointsParams{
+					Event: "run.status_changed"}).Return(nil, nil)
+			}
+
+			got, err := transitionRun(ctx, mockq, tt.actorID, 1, tt.to, nil, DefaultWeeklyReset, now)
+			if tt.wantProblem != "" {
+				var transitionErr *TransitionError
+				if assert.ErrorAs(t, err, &transitionErr) {
+					assert.Equal(t, tt.wantProblem, transitionErr.ProblemCode())
+				}
+			}
+			if !assert.ErrorIs(t, err, tt.wantErr) || err != nil {
+				return
+			}
+			assert.Equal(t, RunStatus(tt.wantParams.Status), got.Status)
+			assert.Equal(t, int4Ptr(tt.wantParams.UpgradeLevel), got.UpgradeLevel)
+			assert.Equal(t, tt.wantParams.Affixes, got.Affixes)
+		})
+	}
+}
+
+func Test_transitionRun_NotFound(t *testing.T) {
+	ctx := context.Background()
+	mockq := repo.NewMockQuerier(t)
+	mockq.EXPECT().LockRun(ctx, int32(1)).Return(repo.Run{}, pgx.ErrNoRows)
+
+	_, err := transitionRun(ctx, mockq, 7, 1, RunStatusForming, nil, DefaultWeeklyReset, utc(2025, 3, 5, 2, 0))
+	assert.ErrorIs(t, err, ErrRunNotFound)
+}
+
+func Test_upgradeLevel(t *testing.T) {
+	par := 30 * time.Minute
+	tests := []struct {
+		name    string
+		elapsed time.Duration
+		want    int32
+	}{
+		{"Well Under Par", 10 * time.Minute, 3},
+		{"Exactly 60 Percent", 18 * time.Minute, 3},
+		{"Under 80 Percent", 24 * time.Minute, 2},
+		{"Just In Time", par, 1},
+		{"Over Par", par + time.Second, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, upgradeLevel(tt.elapsed, par))
+		})
+	}
+}
+
+func Test_elapsedSeconds(t *testing.T) {
+	start := utc(2025, 3, 5, 1, 0)
+	assert.Nil(t, elapsedSeconds(pgTimestamptz(start), pgtype.Timestamptz{}))
+	assert.Equal(t, int32Ptr(1500), elapsedSeconds(pgTimestamptz(start), pgTimestamptz(start.Add(25*time.Minute))))
+}
@@ -0,0 +1,204 @@
+package service
+
+import (
+	"math/rand/v2"
+	"slices"
+	"time"
+)
+
+// Weights of what makes a good group. A key level is worth as much as two
+// hours of extra time together or half of a perfectly reliable group, so the
+// matcher prefers pushing keys but not with players that might not show up.
+const (
+	matchKeyWeight         = 10
+	matchOverlapWeight     = 5 // per hour of overlap, up to maxMatchOverlap
+	matchReliabilityWeight = 20
+	maxMatchOverlap        = 3 * time.Hour
+)
+
+// maxMatchPool caps the candidates considered per dungeon in one pass, the
+// matcher looks at every tank and healer pairing. Later entries wait for the
+// next pass.
+const maxMatchPool = 60
+
+// matchCandidate is a queued player the matcher can put in a group.
+type matchCandidate struct {
+	EntryID     int32
+	UserID      int32
+	DungeonID   int32
+	Par         time.Duration
+	MinKeyLevel int32
+	MaxKeyLevel int32
+	Roles       []UserRole
+	From        time.Time
+	Until       time.Time
+	Reliability float64
+}
+
+// matchSlot is a candidate placed in a group as role.
+type matchSlot struct {
+	Candidate *matchCandidate
+	Role      UserRole
+}
+
+// groupMatch is a group of one tank, one healer and three DPS for a dungeon.
+// KeyLevel is the highest key every member queued for and StartsAt the first
+// minute they are all available.
+type groupMatch struct {
+	DungeonID int32
+	KeyLevel  int32
+	StartsAt  time.Time
+	Score     float64
+	Members   []matchSlot
+}
+
+// matchGroups forms as many 1/1/3 groups from candidates as it can. Every
+// group has a key level all members queued for and enough time together for
+// the dungeon's par timer from now on. Within a dungeon the best scoring group
+// is formed first, see scoreGroup. The order candidates are tried in is
+// shuffled with seed, which only decides between equally good groups, so the
+// same seed always gives the same groups.
+func matchGroups(candidates []*matchCandidate, now time.Time, seed int64) []*groupMatch {
+	rng := rand.New(rand.NewPCG(uint64(seed), uint64(seed)))
+
+	byDungeon := make(map[int32][]*matchCandidate)
+	var dungeonIDs []int32
+	for _, c := range candidates {
+		if _, ok := byDungeon[c.DungeonID]; !ok {
+			dungeonIDs = append(dungeonIDs, c.DungeonID)
+		}
+		byDungeon[c.DungeonID] = append(byDungeon[c.DungeonID], c)
+	}
+	slices.Sort(dungeonIDs)
+
+	var groups []*groupMatch
+	for _, dungeonID := range dungeonIDs {
+		pool := byDungeon[dungeonID]
+		if len(pool) > maxMatchPool {
+			pool = pool[:maxMatchPool]
+		}
+		pool = slices.Clone(pool)
+		rng.Shuffle(len(pool), func(i, j int) { pool[i], pool[j] = pool[j], pool[i] })
+
+		for {
+			group := bestGroup(pool, now)
+			if group == nil {
+				break
+			}
+			groups = append(groups, group)
+			pool = slices.DeleteFunc(pool, func(c *matchCandidate) bool {
+				return slices.ContainsFunc(group.Members, func(s matchSlot) bool { return s.Candidate == c })
+			})
+		}
+	}
+	return groups
+}
+
+// bestGroup returns the best scoring group it can find in pool, or nil if no
+// group can be formed. Every tank and healer pairing is tried, the DPS are then
+// added one at a time, each the one that keeps the group scoring highest.
+func bestGroup(pool []*matchCandidate, now time.Time) *groupMatch {
+	size := int(DefaultComposition.Size())
+	var best *groupMatch
+	for _, tank := range pool {
+		if !slices.Contains(tank.Roles, RoleTank) {
+			continue
+		}
+		for _, healer := range pool {
+			if healer == tank || !slices.Contains(healer.Roles, RoleHealer) {
+				continue
+			}
+			members := []matchSlot{{tank, RoleTank}, {healer, RoleHealer}}
+			if _, ok := scoreGroup(members, now); !ok {
+				continue
+			}
+
+			for len(members) < size {
+				var pick *matchCandidate
+				var pickScore float64
+				for _, dps := range pool {
+					if !slices.Contains(dps.Roles, RoleDPS) ||
+						slices.ContainsFunc(members, func(s matchSlot) bool { return s.Candidate == dps }) {
+						continue
+					}
+					score, ok := scoreGroup(append(members, matchSlot{dps, RoleDPS}), now)
+					if ok && (pick == nil || score > pickScore) {
+						pick, pickScore = dps, score
+					}
+				}
+				if pick == nil {
+					break
+				}
+				members = append(members, matchSlot{pick, RoleDPS})
+			}
+			if len(members) < size {
+				continue
+			}
+
+			group := newGroupMatch(members, now)
+			if best == nil || group.Score > best.Score {
+				best = group
+			}
+		}
+	}
+	return best
+}
+
+// newGroupMatch builds the group of members, which must be able to play together.
+func newGroupMatch(members []matchSlot, now time.Time) *groupMatch {
+	score, _ := scoreGroup(members, now)
+	keyLevel, startsAt, _ := groupWindow(members, now)
+	return &groupMatch{
+		DungeonID: members[0].Candidate.DungeonID,
+		KeyLevel:  keyLevel,
+		StartsAt:  startsAt,
+		Score:     score,
+		Members:   slices.Clone(members),
+	}
+}
+
+// scoreGroup rates members as a group, by the key level they can play, how long
+// they are available together and how reliable they are on average. It reports
+// false if they can not play together.
+func scoreGroup(members []matchSlot, now time.Time) (float64, bool) {
+	keyLevel, startsAt, until := groupWindow(members, now)
+	par := members[0].Candidate.Par
+	if keyLevel == 0 || until.Sub(startsAt) < par {
+		return 0, false
+	}
+
+	var reliability float64
+	for _, m := range members {
+		reliability += m.Candidate.Reliability
+	}
+	reliability /= float64(len(members))
+
+	overlap := min(until.Sub(startsAt), maxMatchOverlap)
+	return matchKeyWeight*float64(keyLevel) + matchOverlapWeight*overlap.Hours() +
+		matchReliabilityWeight*reliability/100, true
+}
+
+// groupWindow returns the highest key level every member queued for, 0 if
+// there is none, and the time they are all available from now on. The start
+// is rounded up to the minute.
+func groupWindow(members []matchSlot, now time.Time) (int32, time.Time, time.Time) {
+	minLevel, maxLevel := int32(0), int32(maxKeyLevel)
+	from, until := now, members[0].Candidate.Until
+	for _, m := range members {
+		minLevel = max(minLevel, m.Candidate.MinKeyLevel)
+		maxLevel = min(maxLevel, m.Candidate.MaxKeyLevel)
+		if m.Candidate.From.After(from) {
+			from = m.Candidate.From
+		}
+		if m.Candidate.Until.Before(until) {
+			until = m.Candidate.Until
+		}
+	}
+	if rounded := from.Truncate(time.Minute); rounded.Before(from) {
+		from = rounded.Add(time.Minute)
+	}
+	if minLevel > maxLevel {
+		return 0, from, until
+	}
+	return maxLevel, from, until
+}
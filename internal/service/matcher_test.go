@@ -0,0 +1,110 @@
+package service
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_matchGroups(t *testing.T) {
+	now := utc(2025, 3, 5, 20, 0)
+	candidate := func(id int32, role UserRole, minLevel, maxLevel int32, hours float64) *matchCandidate {
+		return &matchCandidate{
+			EntryID:     id,
+			UserID:      id,
+			DungeonID:   1,
+			Par:         30 * time.Minute,
+			MinKeyLevel: minLevel,
+			MaxKeyLevel: maxLevel,
+			Roles:       []UserRole{role},
+			From:        now,
+			Until:       now.Add(time.Duration(hours * float64(time.Hour))),
+			Reliability: 100,
+		}
+	}
+	group := []*matchCandidate{
+		candidate(1, RoleTank, 10, 15, 2),
+		candidate(2, RoleHealer, 10, 15, 2),
+		candidate(3, RoleDPS, 10, 15, 2),
+		candidate(4, RoleDPS, 10, 15, 2),
+		candidate(5, RoleDPS, 10, 15, 2),
+	}
+	users := func(g *groupMatch) []int32 {
+		var ids []int32
+		for _, m := range g.Members {
+			ids = append(ids, m.Candidate.UserID)
+		}
+		slices.Sort(ids)
+		return ids
+	}
+
+	tests := []struct {
+		name       string
+		candidates []*matchCandidate
+		wantUsers  [][]int32
+		wantLevels []int32
+	}{
+		{"Full Group", group, [][]int32{{1, 2, 3, 4, 5}}, []int32{15}},
+		{"Missing Healer", []*matchCandidate{group[0], group[2], group[3], group[4],
+			candidate(6, RoleDPS, 10, 15, 2)}, nil, nil},
+		{"No Common Key", append(group[:4:4], candidate(6, RoleDPS, 16, 20, 2)), nil, nil},
+		{"Not Enough Time", append(group[:4:4], candidate(6, RoleDPS, 10, 15, 0.25)), nil, nil},
+		{"Highest Key First", append(group[:4:4], candidate(6, RoleDPS, 2, 12, 2), candidate(7, RoleDPS, 12, 20, 2)),
+			[][]int32{{1, 2, 3, 4, 7}}, []int32{15}},
+		{"Two Groups", append(group[:5:5], candidate(11, RoleTank, 5, 8, 2), candidate(12, RoleHealer, 5, 8, 2),
+			candidate(13, RoleDPS, 5, 8, 2), candidate(14, RoleDPS, 5, 8, 2), candidate(15, RoleDPS, 5, 8, 2)),
+			[][]int32{{1, 2, 3, 4, 5}, {11, 12, 13, 14, 15}}, []int32{15, 8}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groups := matchGroups(tt.candidates, now, 42)
+
+			var gotUsers [][]int32
+			var gotLevels []int32
+			for _, g := range groups {
+				gotUsers = append(gotUsers, users(g))
+				gotLevels = append(gotLevels, g.KeyLevel)
+			}
+			assert.Equal(t, tt.wantUsers, gotUsers)
+			assert.Equal(t, tt.wantLevels, gotLevels)
+		})
+	}
+}
+
+func Test_matchGroups_Seeded(t *testing.T) {
+	now := utc(2025, 3, 5, 20, 0)
+	var candidates []*matchCandidate
+	for i := range int32(12) {
+		role := RoleDPS
+		switch i % 6 {
+		case 0:
+			role = RoleTank
+		case 1:
+			role = RoleHealer
+		}
+		candidates = append(candidates, &matchCandidate{
+			EntryID: i, UserID: i, DungeonID: 1, Par: 30 * time.Minute,
+			MinKeyLevel: 10, MaxKeyLevel: 10, Roles: []UserRole{role},
+			From: now, Until: now.Add(2 * time.Hour), Reliability: 90,
+		})
+	}
+
+	first := matchGroups(candidates, now, 7)
+	assert.Len(t, first, 2)
+	assert.Equal(t, first, matchGroups(candidates, now, 7))
+}
+
+func Test_groupWindow(t *testing.T) {
+	now := utc(2025, 3, 5, 20, 0).Add(20 * time.Second)
+	members := []matchSlot{
+		{&matchCandidate{MinKeyLevel: 8, MaxKeyLevel: 14, From: now.Add(-time.Hour), Until: now.Add(3 * time.Hour)}, RoleTank},
+		{&matchCandidate{MinKeyLevel: 10, MaxKeyLevel: 12, From: now, Until: now.Add(2 * time.Hour)}, RoleHealer},
+	}
+
+	level, from, until := groupWindow(members, now)
+	assert.Equal(t, int32(12), level)
+	assert.Equal(t, utc(2025, 3, 5, 20, 1), from)
+	assert.Equal(t, now.Add(2*time.Hour), until)
+}
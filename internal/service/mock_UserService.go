@@ -21,6 +21,65 @@ func (_m *mockUserService) EXPECT() *mockUserService_Expecter {
 	return &mockUserService_Expecter{mock: &_m.Mock}
 }
 
+// GetReliability provides a mock function with given fields: _a0, _a1
+func (_m *mockUserService) GetReliability(_a0 context.Context, _a1 int32) (*Reliability, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReliability")
+	}
+
+	var r0 *Reliability
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) (*Reliability, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) *Reliability); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Reliability)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockUserService_GetReliability_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReliability'
+type mockUserService_GetReliability_Call struct {
+	*mock.Call
+}
+
+// GetReliability is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 int32
+func (_e *mockUserService_Expecter) GetReliability(_a0 interface{}, _a1 interface{}) *mockUserService_GetReliability_Call {
+	return &mockUserService_GetReliability_Call{Call: _e.mock.On("GetReliability", _a0, _a1)}
+}
+
+func (_c *mockUserService_GetReliability_Call) Run(run func(_a0 context.Context, _a1 int32)) *mockUserService_GetReliability_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *mockUserService_GetReliability_Call) Return(_a0 *Reliability, _a1 error) *mockUserService_GetReliability_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockUserService_GetReliability_Call) RunAndReturn(run func(context.Context, int32) (*Reliability, error)) *mockUserService_GetReliability_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserByEmail provides a mock function with given fields: _a0, _a1
 func (_m *mockUserService) GetUserByEmail(_a0 context.Context, _a1 string) (*User, error) {
 	ret := _m.Called(_a0, _a1)
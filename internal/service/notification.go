@@ -0,0 +1,611 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tmaffia/dungeon-time-api/internal/notify"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// NotificationType identifies what a notification is about. Users choose
+// channels per type.
+type NotificationType string
+
+const (
+	NotificationRunCancelled   = NotificationType("run.cancelled")
+	NotificationSignupPromoted = NotificationType("signup.promoted")
+	NotificationSignupBooked   = NotificationType("signup.booked")
+	NotificationRunReminder    = NotificationType("run.reminder")
+	NotificationGroupProposed  = NotificationType("group.proposed")
+)
+
+// notificationTypes lists every type users can set preferences for.
+var notificationTypes = []NotificationType{NotificationRunCancelled, NotificationSignupPromoted,
+	NotificationSignupBooked, NotificationRunReminder, NotificationGroupProposed}
+
+// notificationChannels lists every channel users can choose. Channels the
+// server is not configured with are skipped at delivery.
+var notificationChannels = []string{notify.ChannelInApp, notify.ChannelEmail, notify.ChannelWebhook}
+
+// defaultNotificationChannels are used for types a user has no preference for.
+var defaultNotificationChannels = []string{notify.ChannelInApp, notify.ChannelEmail}
+
+// Notification statuses.
+const (
+	notificationPending   = "pending"
+	notificationDelivered = "delivered"
+	notificationFailed    = "failed"
+	notificationExpired   = "expired"
+)
+
+// Delivery limits. A notification that still fails after maxNotificationAttempts
+// is given up on, retries back off from notificationRetryDelay. A claimed
+// notification is leased to its worker for notificationLease, longer than
+// sending on every channel can take.
+const (
+	notificationBatchSize   = 50
+	maxNotificationAttempts = 5
+	notificationRetryDelay  = time.Minute
+	notificationLease       = 5 * time.Minute
+	inboxSize               = 50
+)
+
+// errLeaseLost is returned when a worker records the outcome of a delivery
+// whose lease ran out and was claimed again by another worker.
+var errLeaseLost = errors.New("lease lost")
+
+// notificationTimeLayout is how times are written in notifications, in the user's timezone.
+const notificationTimeLayout = "Mon Jan 2 15:04 MST"
+
+// NotificationPreferences is how a user wants to be notified. Channels lists
+// the channels for every notification type, an empty list turns a type off.
+// QuietHours is a wall clock range in the user's timezone, it may cross midnight.
+// Notifications due during quiet hours are held until they end.
+type NotificationPreferences struct {
+	Channels   map[NotificationType][]string `json:"channels"`
+	QuietHours *QuietHours                   `json:"quiet_hours"`
+	WebhookURL string                        `json:"webhook_url"`
+}
+
+// QuietHours is a daily range of wall clock times, "HH:MM", when a user does
+// not want to be notified.
+type QuietHours struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// InboxNotification is a notification delivered to the in-app inbox.
+type InboxNotification struct {
+	ID        int64            `json:"id"`
+	Type      NotificationType `json:"type"`
+	Subject   string           `json:"subject"`
+	Body      string           `json:"body"`
+	Read      bool             `json:"read"`
+	CreatedAt time.Time        `json:"created_at"`
+}
+
+// NotificationService is the interface for notification preferences, the
+// in-app inbox and delivering queued notifications.
+type NotificationService interface {
+	GetPreferences(context.Context, int32, int32) (*NotificationPreferences, error)
+	SetPreferences(context.Context, int32, int32, *NotificationPreferences) (*NotificationPreferences, error)
+	GetInbox(context.Context, int32, int32) ([]*InboxNotification, error)
+	MarkRead(context.Context, int32, int32, int64) error
+	DeliverPending(context.Context) (int, error)
+}
+
+// notificationService is the implementation of NotificationService.
+type notificationService struct {
+	dbPool           *pgxpool.Pool
+	notificationRepo repo.Querier
+	channels         map[string]notify.Channel
+	now              func() time.Time
+}
+
+// NewNotificationService creates a new notificationService with the provided database
+// connection pool, delivering through channels. The in-app channel is always available.
+// It returns a pointer to the notificationService.
+func NewNotificationService(dbPool *pgxpool.Pool, channels ...notify.Channel) *notificationService {
+	q := repo.New(dbPool)
+	s := &notificationService{
+		dbPool:           dbPool,
+		notificationRepo: q,
+		channels:         map[string]notify.Channel{notify.ChannelInApp: notify.NewInAppChannel(q)},
+		now:              time.Now,
+	}
+	for _, c := range channels {
+		s.channels[c.Name()] = c
+	}
+	return s
+}
+
+// GetPreferences returns a user's notification preferences with the default
+// channels filled in. Users can only see their own preferences.
+func (s *notificationService) GetPreferences(ctx context.Context, actorID, userID int32) (*NotificationPreferences, error) {
+	if actorID != userID {
+		return nil, ErrForbidden
+	}
+
+	if _, err := s.notificationRepo.GetUserByID(ctx, userID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	prefs := &NotificationPreferences{Channels: make(map[NotificationType][]string, len(notificationTypes))}
+	for _, t := range notificationTypes {
+		prefs.Channels[t] = defaultNotificationChannels
+	}
+
+	rows, err := s.notificationRepo.GetNotificationPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		prefs.Channels[NotificationType(row.Type)] = row.Channels
+	}
+
+	settings, err := s.notificationRepo.GetNotificationSettings(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return prefs, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	prefs.WebhookURL = settings.WebhookUrl
+	if settings.QuietStartMinute.Valid && settings.QuietEndMinute.Valid {
+		prefs.QuietHours = &QuietHours{
+			Start: formatClock(settings.QuietStartMinute.Int32),
+			End:   formatClock(settings.QuietEndMinute.Int32),
+		}
+	}
+	return prefs, nil
+}
+
+// SetPreferences replaces a user's notification preferences. Types left out
+// of Channels go back to the default channels. Users can only change their own preferences.
+func (s *notificationService) SetPreferences(ctx context.Context, actorID, userID int32,
+	prefs *NotificationPreferences) (*NotificationPreferences, error) {
+	if actorID != userID {
+		return nil, ErrForbidden
+	}
+
+	quietStart, quietEnd, err := validateNotificationPreferences(prefs)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.notificationRepo.GetUserByID(ctx, userID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	err = inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		err := q.UpsertNotificationSettings(ctx, repo.UpsertNotificationSettingsParams{
+			UserID:           userID,
+			QuietStartMinute: quietStart,
+			QuietEndMinute:   quietEnd,
+			WebhookUrl:       prefs.WebhookURL,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := q.DeleteNotificationPreferences(ctx, userID); err != nil {
+			return err
+		}
+		for _, t := range notificationTypes {
+			channels, ok := prefs.Channels[t]
+			if !ok {
+				continue
+			}
+			if channels == nil {
+				channels = []string{}
+			}
+			err := q.CreateNotificationPreference(ctx, repo.CreateNotificationPreferenceParams{
+				UserID:   userID,
+				Type:     string(t),
+				Channels: channels,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetPreferences(ctx, actorID, userID)
+}
+
+// GetInbox returns the latest notifications in a user's in-app inbox, newest first.
+// Users can only see their own inbox.
+func (s *notificationService) GetInbox(ctx context.Context, actorID, userID int32) ([]*InboxNotification, error) {
+	if actorID != userID {
+		return nil, ErrForbidden
+	}
+
+	rows, err := s.notificationRepo.GetInboxNotifications(ctx, repo.GetInboxNotificationsParams{
+		UserID: userID,
+		Limit:  inboxSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	inbox := make([]*InboxNotification, 0, len(rows))
+	for _, row := range rows {
+		inbox = append(inbox, &InboxNotification{
+			ID:        row.ID,
+			Type:      NotificationType(row.Type),
+			Subject:   row.Subject,
+			Body:      row.Body,
+			Read:      row.ReadAt.Valid,
+			CreatedAt: row.CreatedAt.Time,
+		})
+	}
+	return inbox, nil
+}
+
+// MarkRead marks a notification in a user's inbox as read.
+// Returns ErrNotificationNotFound if it is not in the user's inbox.
+func (s *notificationService) MarkRead(ctx context.Context, actorID, userID int32, id int64) error {
+	if actorID != userID {
+		return ErrForbidden
+	}
+
+	n, err := s.notificationRepo.MarkInboxNotificationRead(ctx, repo.MarkInboxNotificationReadParams{
+		ReadAt: pgTimestamptz(s.now()),
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotificationNotFound
+	}
+	return nil
+}
+
+// DeliverPending delivers notifications that are due, up to notificationBatchSize.
+// Each notification is claimed by moving deliver_after to the end of its lease,
+// which is committed before anything is sent, so several workers can deliver
+// at once without holding a transaction open while a channel is slow. A
+// notification whose worker dies is delivered again once its lease runs out.
+// It returns the number of notifications handled.
+func (s *notificationService) DeliverPending(ctx context.Context) (int, error) {
+	q := s.notificationRepo
+	handled := 0
+	for handled < notificationBatchSize {
+		now := s.now()
+		rows, err := q.ClaimNotifications(ctx, repo.ClaimNotificationsParams{
+			LeasedUntil: pgTimestamptz(now.Add(notificationLease)),
+			Now:         pgTimestamptz(now),
+			BatchSize:   1,
+		})
+		if err != nil {
+			return handled, err
+		}
+		if len(rows) == 0 {
+			break
+		}
+		if err := s.deliver(ctx, q, rows[0]); errors.Is(err, errLeaseLost) {
+			log.Printf("delivering notification %d: %v", rows[0].ID, err)
+		} else if err != nil {
+			return handled, err
+		}
+		handled++
+	}
+	return handled, nil
+}
+
+// Work delivers pending notifications every interval until ctx is done.
+func (s *notificationService) Work(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.DeliverPending(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("delivering notifications: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// deliver sends a claimed notification on the channels the user wants it on.
+// During the user's quiet hours it is held until they end instead. Channels
+// that fail are retried with backoff, the ones that succeeded are not sent again.
+// Notifications past their expiry are dropped without being sent. The outcome
+// is only recorded while n is still leased, otherwise errLeaseLost is returned.
+func (s *notificationService) deliver(ctx context.Context, q repo.Querier, n repo.Notification) error {
+	now := s.now()
+
+	if n.ExpiresAt.Valid && !now.Before(n.ExpiresAt.Time) {
+		return leaseHeld(q.UpdateNotificationDelivery(ctx, repo.UpdateNotificationDeliveryParams{
+			ID:                n.ID,
+			Status:            notificationExpired,
+			Attempts:          n.Attempts,
+			DeliveredChannels: n.DeliveredChannels,
+			LastError:         n.LastError,
+			DeliverAfter:      n.DeliverAfter,
+			LeasedUntil:       n.DeliverAfter,
+		}))
+	}
+
+	recipient, err := q.GetNotificationRecipient(ctx, n.UserID)
+	if err != nil {
+		return err
+	}
+	loc, err := time.LoadLocation(recipient.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	if recipient.QuietStartMinute.Valid && recipient.QuietEndMinute.Valid {
+		if until, quiet := quietUntil(now, loc, recipient.QuietStartMinute.Int32, recipient.QuietEndMinute.Int32); quiet {
+			return leaseHeld(q.DeferNotification(ctx, repo.DeferNotificationParams{
+				ID: n.ID, DeliverAfter: pgTimestamptz(until), LeasedUntil: n.DeliverAfter}))
+		}
+	}
+
+	channels := defaultNotificationChannels
+	pref, err := q.GetNotificationPreference(ctx, repo.GetNotificationPreferenceParams{UserID: n.UserID, Type: n.Type})
+	if err == nil {
+		channels = pref
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+
+	msg, err := renderNotification(n, recipient, loc)
+	if err != nil {
+		return err
+	}
+
+	delivered := slices.Clone(n.DeliveredChannels)
+	var failures []error
+	for _, name := range channels {
+		channel, ok := s.channels[name]
+		if !ok || slices.Contains(delivered, name) {
+			continue
+		}
+		err := channel.Send(ctx, msg)
+		if err != nil && !errors.Is(err, notify.ErrNoAddress) {
+			failures = append(failures, fmt.Errorf("%s: %w", name, err))
+			continue
+		}
+		delivered = append(delivered, name)
+	}
+
+	params := repo.UpdateNotificationDeliveryParams{
+		ID:                n.ID,
+		Status:            notificationDelivered,
+		Attempts:          n.Attempts + 1,
+		DeliveredChannels: delivered,
+		DeliverAfter:      n.DeliverAfter,
+		DeliveredAt:       pgTimestamptz(now),
+		LeasedUntil:       n.DeliverAfter,
+	}
+	if len(failures) > 0 {
+		params.LastError = errors.Join(failures...).Error()
+		params.DeliveredAt = pgtype.Timestamptz{}
+		params.Status = notificationPending
+		params.DeliverAfter = pgTimestamptz(now.Add(notificationRetryDelay << (params.Attempts - 1)))
+		if params.Attempts >= maxNotificationAttempts {
+			params.Status = notificationFailed
+		}
+	}
+	return leaseHeld(q.UpdateNotificationDelivery(ctx, params))
+}
+
+// leaseHeld turns a write that was conditional on a lease and affected no
+// rows into errLeaseLost.
+func leaseHeld(rows int64, err error) error {
+	if err == nil && rows == 0 {
+		return errLeaseLost
+	}
+	return err
+}
+
+// quietUntil reports whether now is within the daily quiet hours from start to
+// end, in minutes from local midnight in loc, and if so when they end.
+// Quiet hours with end before start cross midnight.
+func quietUntil(now time.Time, loc *time.Location, start, end int32) (time.Time, bool) {
+	if start == end {
+		return time.Time{}, false
+	}
+
+	wall := floating(now, loc)
+	minute := int32(wall.Hour()*60 + wall.Minute())
+	midnight := time.Date(wall.Year(), wall.Month(), wall.Day(), 0, 0, 0, 0, time.UTC)
+
+	var endDay time.Time
+	switch {
+	case start < end && minute >= start && minute < end:
+		endDay = midnight
+	case start > end && minute >= start:
+		endDay = midnight.AddDate(0, 0, 1)
+	case start > end && minute < end:
+		endDay = midnight
+	default:
+		return time.Time{}, false
+	}
+
+	until := localize(endDay.Add(time.Duration(end)*time.Minute), loc)
+	// The end can fall in the repeated hour when clocks go back and resolve
+	// to before now, quiet hours are over by then.
+	if !until.After(now) {
+		return time.Time{}, false
+	}
+	return until, true
+}
+
+// runNotificationPayload is the payload of notifications about a run.
+// Title is kept so notifications still make sense if the run changes later.
+// Notifications about a proposed group have a ProposalID instead of a RunID.
+type runNotificationPayload struct {
+	RunID      int32     `json:"run_id,omitempty"`
+	ProposalID int32     `json:"proposal_id,omitempty"`
+	Title      string    `json:"title"`
+	StartsAt   time.Time `json:"starts_at"`
+	Role       UserRole  `json:"role,omitempty"`
+}
+
+// renderNotification builds the message for a notification, with times in loc.
+func renderNotification(n repo.Notification, r repo.GetNotificationRecipientRow,
+	loc *time.Location) (notify.Message, error) {
+	var p runNotificationPayload
+	if err := json.Unmarshal(n.Payload, &p); err != nil {
+		return notify.Message{}, err
+	}
+	startsAt := p.StartsAt.In(loc).Format(notificationTimeLayout)
+
+	msg := notify.Message{
+		NotificationID: n.ID,
+		UserID:         n.UserID,
+		Type:           n.Type,
+		Payload:        n.Payload,
+		Email:          r.Email,
+		WebhookURL:     r.WebhookUrl,
+	}
+	switch NotificationType(n.Type) {
+	case NotificationRunCancelled:
+		msg.Subject = "Run cancelled: " + p.Title
+		msg.Body = fmt.Sprintf("%s on %s has been cancelled.", p.Title, startsAt)
+	case NotificationSignupPromoted:
+		msg.Subject = "You're in: " + p.Title
+		msg.Body = fmt.Sprintf("A %s slot opened up in %s on %s and you have been moved off the waitlist.",
+			p.Role, p.Title, startsAt)
+	case NotificationSignupBooked:
+		msg.Subject = "You're booked: " + p.Title
+		msg.Body = fmt.Sprintf("You have been booked as %s in %s on %s.", p.Role, p.Title, startsAt)
+	case NotificationRunReminder:
+		msg.Subject = "Reminder: " + p.Title
+		msg.Body = fmt.Sprintf("%s starts on %s.", p.Title, startsAt)
+		if p.Role != "" {
+			msg.Body += fmt.Sprintf(" You are signed up as %s.", p.Role)
+		}
+	case NotificationGroupProposed:
+		msg.Subject = "Group found: " + p.Title
+		msg.Body = fmt.Sprintf("A group for %s on %s wants you as %s. Accept it before it lapses.",
+			p.Title, startsAt, p.Role)
+	default:
+		return notify.Message{}, fmt.Errorf("unknown notification type %q", n.Type)
+	}
+	return msg, nil
+}
+
+// enqueueRunNotification queues a notification about run for the user with userID.
+// Reminders expire when the run starts. Call it with the Querier of the
+// transaction making the change.
+func enqueueRunNotification(ctx context.Context, q repo.Querier, userID int32, t NotificationType,
+	run *Run, role UserRole) error {
+	payload, err := json.Marshal(runNotificationPayload{
+		RunID:    run.ID,
+		Title:    runTitle(run),
+		StartsAt: run.StartsAt,
+		Role:     role,
+	})
+	if err != nil {
+		return err
+	}
+
+	params := repo.CreateNotificationParams{
+		UserID:  userID,
+		Type:    string(t),
+		RunID:   pgInt4(&run.ID),
+		Payload: payload,
+	}
+	if t == NotificationRunReminder {
+		params.ExpiresAt = pgTimestamptz(run.StartsAt)
+	}
+	return q.CreateNotification(ctx, params)
+}
+
+// notifyRunCancelled queues a run.cancelled notification for everyone signed up
+// for the run with runID, including the waitlist.
+func notifyRunCancelled(ctx context.Context, q repo.Querier, runID int32) error {
+	row, err := q.GetRunByID(ctx, runID)
+	if err != nil {
+		return err
+	}
+	run := mapRun(row.Run, row.Dungeon)
+
+	signups, err := q.GetRunSignups(ctx, runID)
+	if err != nil {
+		return err
+	}
+	for _, signup := range signups {
+		if err := enqueueRunNotification(ctx, q, signup.UserID, NotificationRunCancelled, run, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runTitle names a run in notifications, like "Ara-Kara +12" for keyed runs.
+func runTitle(run *Run) string {
+	if run.KeyLevel != nil {
+		return fmt.Sprintf("%s +%d", run.Dungeon.Name, *run.KeyLevel)
+	}
+	return fmt.Sprintf("%s (%s)", run.Dungeon.Name, run.Difficulty)
+}
+
+// validateNotificationPreferences checks preferences and returns the quiet hours
+// as minutes from midnight, or nulls if there are none.
+func validateNotificationPreferences(prefs *NotificationPreferences) (pgtype.Int4, pgtype.Int4, error) {
+	for t, channels := range prefs.Channels {
+		if !slices.Contains(notificationTypes, t) {
+			return pgtype.Int4{}, pgtype.Int4{}, fmt.Errorf("%w: unknown notification type %q",
+				ErrInvalidNotificationPreferences, t)
+		}
+		for i, c := range channels {
+			if !slices.Contains(notificationChannels, c) || slices.Contains(channels[:i], c) {
+				return pgtype.Int4{}, pgtype.Int4{}, fmt.Errorf("%w: invalid channel %q for %s",
+					ErrInvalidNotificationPreferences, c, t)
+			}
+		}
+	}
+
+	// The worker posts to the URL, so it must not point into our own network.
+	if prefs.WebhookURL != "" && !isValidWebhookURL(prefs.WebhookURL) {
+		return pgtype.Int4{}, pgtype.Int4{}, fmt.Errorf("%w: webhook_url must be a public https URL",
+			ErrInvalidNotificationPreferences)
+	}
+
+	if prefs.QuietHours == nil {
+		return pgtype.Int4{}, pgtype.Int4{}, nil
+	}
+	start, err := parseClock(prefs.QuietHours.Start)
+	if err != nil || start >= minutesPerDay {
+		return pgtype.Int4{}, pgtype.Int4{}, fmt.Errorf("%w: invalid quiet hours start %q",
+			ErrInvalidNotificationPreferences, prefs.QuietHours.Start)
+	}
+	end, err := parseClock(prefs.QuietHours.End)
+	if err != nil || end >= minutesPerDay {
+		return pgtype.Int4{}, pgtype.Int4{}, fmt.Errorf("%w: invalid quiet hours end %q",
+			ErrInvalidNotificationPreferences, prefs.QuietHours.End)
+	}
+	if start == end {
+		return pgtype.Int4{}, pgtype.Int4{}, fmt.Errorf("%w: quiet hours must not start and end at the same time",
+			ErrInvalidNotificationPreferences)
+	}
+	return pgtype.Int4{Int32: start, Valid: true}, pgtype.Int4{Int32: end, Valid: true}, nil
+}
@@ -0,0 +1,362 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/tmaffia/dungeon-time-api/internal/notify"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+func Test_quietUntil(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+
+	tests := []struct {
+		name      string
+		now       time.Time
+		loc       *time.Location
+		start     int32
+		end       int32
+		wantQuiet bool
+		want      time.Time
+	}{
+		{"Outside Same Day Range", utc(2025, 3, 5, 12, 0), time.UTC, 60, 420, false, time.Time{}},
+		{"Inside Same Day Range", utc(2025, 3, 5, 3, 0), time.UTC, 60, 420, true, utc(2025, 3, 5, 7, 0)},
+		{"End Is Exclusive", utc(2025, 3, 5, 7, 0), time.UTC, 60, 420, false, time.Time{}},
+		{"Before Midnight Across Midnight", utc(2025, 3, 5, 23, 0), time.UTC, 1320, 480, true, utc(2025, 3, 6, 8, 0)},
+		{"After Midnight Across Midnight", utc(2025, 3, 6, 2, 0), time.UTC, 1320, 480, true, utc(2025, 3, 6, 8, 0)},
+		{"In User Timezone", utc(2025, 3, 6, 4, 0), newYork, 1320, 480, true, utc(2025, 3, 6, 13, 0)},
+		{"Evening Is Not Quiet In User Timezone", utc(2025, 3, 6, 1, 0), newYork, 1320, 480, false, time.Time{}},
+		{"Ends After Spring Forward", utc(2025, 3, 9, 4, 0), newYork, 1320, 480, true, utc(2025, 3, 9, 12, 0)},
+		{"Ends After Fall Back", utc(2025, 11, 2, 4, 0), newYork, 1320, 480, true, utc(2025, 11, 2, 13, 0)},
+		{"Ends In Spring Forward Gap", utc(2025, 3, 9, 5, 0), newYork, 0, 150, true, utc(2025, 3, 9, 7, 30)},
+		{"Repeated Hour After End", utc(2025, 11, 2, 6, 15), newYork, 0, 90, false, time.Time{}},
+		{"Same Start And End", utc(2025, 3, 5, 3, 0), time.UTC, 60, 60, false, time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, quiet := quietUntil(tt.now, tt.loc, tt.start, tt.end)
+			assert.Equal(t, tt.wantQuiet, quiet)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_renderNotification(t *testing.T) {
+	recipient := repo.GetNotificationRecipientRow{ID: 8, Email: "tank@example.com", WebhookUrl: "https://example.com/hook"}
+	newYork := mustLoadLocation(t, "America/New_York")
+
+	tests := []struct {
+		name        string
+		n           repo.Notification
+		wantSubject string
+		wantBody    string
+		wantErr     bool
+	}{
+		{
+			"Run Cancelled",
+			repo.Notification{ID: 1, UserID: 8, Type: "run.cancelled",
+				Payload: []byte(`{"run_id":1,"title":"Ara-Kara +12","starts_at":"2025-03-05T01:00:00Z"}`)},
+			"Run cancelled: Ara-Kara +12",
+			"Ara-Kara +12 on Tue Mar 4 20:00 EST has been cancelled.",
+			false,
+		},
+		{
+			"Signup Promoted",
+			repo.Notification{ID: 2, UserID: 8, Type: "signup.promoted",
+				Payload: []byte(`{"run_id":1,"title":"Ara-Kara +12","starts_at":"2025-03-10T00:00:00Z","role":"tank"}`)},
+			"You're in: Ara-Kara +12",
+			"A tank slot opened up in Ara-Kara +12 on Sun Mar 9 20:00 EDT and you have been moved off the waitlist.",
+			false,
+		},
+		{
+			"Run Reminder",
+			repo.Notification{ID: 4, UserID: 8, Type: "run.reminder",
+				Payload: []byte(`{"run_id":1,"title":"Ara-Kara +12","starts_at":"2025-03-05T01:00:00Z","role":"Healer"}`)},
+			"Reminder: Ara-Kara +12",
+			"Ara-Kara +12 starts on Tue Mar 4 20:00 EST. You are signed up as Healer.",
+			false,
+		},
+		{
+			"Unknown Type",
+			repo.Notification{ID: 3, UserID: 8, Type: "run.exploded", Payload: []byte(`{}`)},
+			"", "", true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := renderNotification(tt.n, recipient, newYork)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, tt.wantSubject, msg.Subject)
+			assert.Equal(t, tt.wantBody, msg.Body)
+			assert.Equal(t, tt.n.ID, msg.NotificationID)
+			assert.Equal(t, "tank@example.com", msg.Email)
+			assert.Equal(t, "https://example.com/hook", msg.WebhookURL)
+		})
+	}
+}
+
+func Test_validateNotificationPreferences(t *testing.T) {
+	tests := []struct {
+		name      string
+		prefs     *NotificationPreferences
+		wantStart pgtype.Int4
+		wantEnd   pgtype.Int4
+		wantErr   error
+	}{
+		{"Empty", &NotificationPreferences{}, pgtype.Int4{}, pgtype.Int4{}, nil},
+		{"Channels And Quiet Hours", &NotificationPreferences{
+			Channels:   map[NotificationType][]string{NotificationRunCancelled: {"email", "webhook"}},
+			QuietHours: &QuietHours{Start: "22:00", End: "08:00"},
+			WebhookURL: "https://example.com/hook",
+		}, pgtype.Int4{Int32: 1320, Valid: true}, pgtype.Int4{Int32: 480, Valid: true}, nil},
+		{"Type Turned Off", &NotificationPreferences{
+			Channels: map[NotificationType][]string{NotificationSignupPromoted: {}},
+		}, pgtype.Int4{}, pgtype.Int4{}, nil},
+		{"Unknown Type", &NotificationPreferences{
+			Channels: map[NotificationType][]string{"run.exploded": {"email"}},
+		}, pgtype.Int4{}, pgtype.Int4{}, ErrInvalidNotificationPreferences},
+		{"Unknown Channel", &NotificationPreferences{
+			Channels: map[NotificationType][]string{NotificationRunCancelled: {"pigeon"}},
+		}, pgtype.Int4{}, pgtype.Int4{}, ErrInvalidNotificationPreferences},
+		{"Repeated Channel", &NotificationPreferences{
+			Channels: map[NotificationType][]string{NotificationRunCancelled: {"email", "email"}},
+		}, pgtype.Int4{}, pgtype.Int4{}, ErrInvalidNotificationPreferences},
+		{"Webhook Not HTTP", &NotificationPreferences{WebhookURL: "ftp://example.com/hook"},
+			pgtype.Int4{}, pgtype.Int4{}, ErrInvalidNotificationPreferences},
+		{"Webhook Not Absolute", &NotificationPreferences{WebhookURL: "/hook"},
+			pgtype.Int4{}, pgtype.Int4{}, ErrInvalidNotificationPreferences},
+		{"Webhook Plain HTTP", &NotificationPreferences{WebhookURL: "http://example.com/hook"},
+			pgtype.Int4{}, pgtype.Int4{}, ErrInvalidNotificationPreferences},
+		{"Webhook Private Address", &NotificationPreferences{WebhookURL: "https://10.0.0.5/hook"},
+			pgtype.Int4{}, pgtype.Int4{}, ErrInvalidNotificationPreferences},
+		{"Webhook Metadata Address", &NotificationPreferences{WebhookURL: "https://169.254.169.254/latest"},
+			pgtype.Int4{}, pgtype.Int4{}, ErrInvalidNotificationPreferences},
+		{"Webhook Localhost", &NotificationPreferences{WebhookURL: "https://localhost:8080/hook"},
+			pgtype.Int4{}, pgtype.Int4{}, ErrInvalidNotificationPreferences},
+		{"Quiet Hours Invalid Time", &NotificationPreferences{QuietHours: &QuietHours{Start: "25:00", End: "08:00"}},
+			pgtype.Int4{}, pgtype.Int4{}, ErrInvalidNotificationPreferences},
+		{"Quiet Hours Until Midnight", &NotificationPreferences{QuietHours: &QuietHours{Start: "22:00", End: "24:00"}},
+			pgtype.Int4{}, pgtype.Int4{}, ErrInvalidNotificationPreferences},
+		{"Quiet Hours Empty", &NotificationPreferences{QuietHours: &QuietHours{Start: "08:00", End: "08:00"}},
+			pgtype.Int4{}, pgtype.Int4{}, ErrInvalidNotificationPreferences},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, err := validateNotificationPreferences(tt.prefs)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantStart, start)
+			assert.Equal(t, tt.wantEnd, end)
+		})
+	}
+}
+
+// fakeChannel records the messages it is sent and fails with err.
+type fakeChannel struct {
+	name string
+	err  error
+	sent []notify.Message
+}
+
+func (c *fakeChannel) Name() string {
+	return c.name
+}
+
+func (c *fakeChannel) Send(ctx context.Context, m notify.Message) error {
+	c.sent = append(c.sent, m)
+	return c.err
+}
+
+func Test_notificationService_deliver(t *testing.T) {
+	now := utc(2025, 3, 5, 15, 0)
+	lease := pgTimestamptz(now.Add(notificationLease))
+	payload := []byte(`{"run_id":1,"title":"Ara-Kara +12","starts_at":"2025-03-06T01:00:00Z"}`)
+	recipient := repo.GetNotificationRecipientRow{ID: 8, Email: "tank@example.com", Timezone: "America/New_York"}
+	quiet := recipient
+	quiet.QuietStartMinute = pgtype.Int4{Int32: 0, Valid: true}
+	quiet.QuietEndMinute = pgtype.Int4{Int32: 720, Valid: true}
+
+	tests := []struct {
+		name       string
+		n          repo.Notification
+		recipient  repo.GetNotificationRecipientRow
+		channels   []string
+		prefErr    error
+		emailErr   error
+		wantSent   []string
+		wantDefer  time.Time
+		wantUpdate repo.UpdateNotificationDeliveryParams
+	}{
+		{
+			"Default Channels",
+			repo.Notification{ID: 1, UserID: 8, Type: "run.cancelled", Payload: payload, DeliverAfter: lease},
+			recipient, nil, pgx.ErrNoRows, nil,
+			[]string{"in_app", "email"}, time.Time{},
+			repo.UpdateNotificationDeliveryParams{ID: 1, Status: "delivered", Attempts: 1,
+				DeliveredChannels: []string{"in_app", "email"}, DeliveredAt: pgTimestamptz(now),
+				DeliverAfter: lease, LeasedUntil: lease},
+		},
+		{
+			"Preferred Channels",
+			repo.Notification{ID: 1, UserID: 8, Type: "run.cancelled", Payload: payload, DeliverAfter: lease},
+			recipient, []string{"webhook"}, nil, nil,
+			[]string{"webhook"}, time.Time{},
+			repo.UpdateNotificationDeliveryParams{ID: 1, Status: "delivered", Attempts: 1,
+				DeliveredChannels: []string{"webhook"}, DeliveredAt: pgTimestamptz(now),
+				DeliverAfter: lease, LeasedUntil: lease},
+		},
+		{
+			"Turned Off",
+			repo.Notification{ID: 1, UserID: 8, Type: "run.cancelled", Payload: payload, DeliverAfter: lease},
+			recipient, []string{}, nil, nil,
+			nil, time.Time{},
+			repo.UpdateNotificationDeliveryParams{ID: 1, Status: "delivered", Attempts: 1,
+				DeliveredChannels: nil, DeliveredAt: pgTimestamptz(now),
+				DeliverAfter: lease, LeasedUntil: lease},
+		},
+		{
+			"Failed Channel Is Retried",
+			repo.Notification{ID: 1, UserID: 8, Type: "run.cancelled", Payload: payload, DeliverAfter: lease, Attempts: 1},
+			recipient, nil, pgx.ErrNoRows, errors.New("connection refused"),
+			[]string{"in_app", "email"}, time.Time{},
+			repo.UpdateNotificationDeliveryParams{ID: 1, Status: "pending", Attempts: 2,
+				DeliveredChannels: []string{"in_app"}, LastError: "email: connection refused",
+				DeliverAfter: pgTimestamptz(now.Add(2 * time.Minute)), LeasedUntil: lease},
+		},
+		{
+			"Retry Skips Delivered Channels",
+			repo.Notification{ID: 1, UserID: 8, Type: "run.cancelled", Payload: payload, Attempts: 1,
+				DeliverAfter: lease, DeliveredChannels: []string{"in_app"}},
+			recipient, nil, pgx.ErrNoRows, nil,
+			[]string{"email"}, time.Time{},
+			repo.UpdateNotificationDeliveryParams{ID: 1, Status: "delivered", Attempts: 2,
+				DeliveredChannels: []string{"in_app", "email"}, DeliveredAt: pgTimestamptz(now),
+				DeliverAfter: lease, LeasedUntil: lease},
+		},
+		{
+			"Gives Up After Max Attempts",
+			repo.Notification{ID: 1, UserID: 8, Type: "run.cancelled", Payload: payload, DeliverAfter: lease, Attempts: 4},
+			recipient, []string{"email"}, nil, errors.New("connection refused"),
+			[]string{"email"}, time.Time{},
+			repo.UpdateNotificationDeliveryParams{ID: 1, Status: "failed", Attempts: 5,
+				DeliveredChannels: nil, LastError: "email: connection refused",
+				DeliverAfter: pgTimestamptz(now.Add(16 * time.Minute)), LeasedUntil: lease},
+		},
+		{
+			"No Address Is Not Retried",
+			repo.Notification{ID: 1, UserID: 8, Type: "run.cancelled", Payload: payload, DeliverAfter: lease},
+			recipient, []string{"email"}, nil, notify.ErrNoAddress,
+			[]string{"email"}, time.Time{},
+			repo.UpdateNotificationDeliveryParams{ID: 1, Status: "delivered", Attempts: 1,
+				DeliveredChannels: []string{"email"}, DeliveredAt: pgTimestamptz(now),
+				DeliverAfter: lease, LeasedUntil: lease},
+		},
+		{
+			"Held During Quiet Hours",
+			repo.Notification{ID: 1, UserID: 8, Type: "run.cancelled", Payload: payload, DeliverAfter: lease},
+			quiet, nil, nil, nil,
+			nil, utc(2025, 3, 5, 17, 0),
+			repo.UpdateNotificationDeliveryParams{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			mockq.EXPECT().GetNotificationRecipient(ctx, int32(8)).Return(tt.recipient, nil)
+			if !tt.wantDefer.IsZero() {
+				mockq.EXPECT().DeferNotification(ctx, repo.DeferNotificationParams{
+					ID: 1, DeliverAfter: pgTimestamptz(tt.wantDefer), LeasedUntil: lease}).Return(1, nil)
+			} else {
+				mockq.EXPECT().GetNotificationPreference(ctx, repo.GetNotificationPreferenceParams{
+					UserID: 8, Type: "run.cancelled"}).Return(tt.channels, tt.prefErr)
+				mockq.EXPECT().UpdateNotificationDelivery(ctx, tt.wantUpdate).Return(1, nil)
+			}
+
+			inApp := &fakeChannel{name: "in_app"}
+			email := &fakeChannel{name: "email", err: tt.emailErr}
+			webhook := &fakeChannel{name: "webhook"}
+			s := &notificationService{
+				notificationRepo: mockq,
+				channels:         map[string]notify.Channel{"in_app": inApp, "email": email, "webhook": webhook},
+				now:              func() time.Time { return now },
+			}
+
+			err := s.deliver(ctx, mockq, tt.n)
+			if !assert.NoError(t, err) {
+				return
+			}
+			var sent []string
+			for _, c := range []*fakeChannel{inApp, email, webhook} {
+				if len(c.sent) > 0 {
+					sent = append(sent, c.name)
+				}
+			}
+			assert.ElementsMatch(t, tt.wantSent, sent)
+		})
+	}
+}
+
+func Test_notificationService_deliver_expired(t *testing.T) {
+	now := utc(2025, 3, 6, 1, 0)
+	ctx := context.Background()
+	mockq := repo.NewMockQuerier(t)
+	mockq.EXPECT().UpdateNotificationDelivery(ctx, repo.UpdateNotificationDeliveryParams{
+		ID: 1, Status: "expired", Attempts: 1, DeliveredChannels: []string{"in_app"},
+		LastError: "email: connection refused", DeliverAfter: pgTimestamptz(utc(2025, 3, 6, 0, 58)),
+		LeasedUntil: pgTimestamptz(utc(2025, 3, 6, 0, 58)),
+	}).Return(1, nil)
+	email := &fakeChannel{name: "email"}
+	s := &notificationService{
+		notificationRepo: mockq,
+		channels:         map[string]notify.Channel{"email": email},
+		now:              func() time.Time { return now },
+	}
+
+	err := s.deliver(ctx, mockq, repo.Notification{ID: 1, UserID: 8, Type: "run.reminder", Attempts: 1,
+		DeliveredChannels: []string{"in_app"}, LastError: "email: connection refused",
+		DeliverAfter: pgTimestamptz(utc(2025, 3, 6, 0, 58)), ExpiresAt: pgTimestamptz(now)})
+	assert.NoError(t, err)
+	assert.Empty(t, email.sent, "expired notifications are not sent")
+}
+
+func Test_notificationService_DeliverPending(t *testing.T) {
+	now := utc(2025, 3, 6, 1, 0)
+	lease := pgTimestamptz(now.Add(notificationLease))
+	claim := repo.ClaimNotificationsParams{LeasedUntil: lease, Now: pgTimestamptz(now), BatchSize: 1}
+	expired := repo.Notification{ID: 1, UserID: 8, Type: "run.reminder", DeliverAfter: lease, ExpiresAt: pgTimestamptz(now)}
+
+	tests := []struct {
+		name    string
+		updated int64
+	}{
+		{"Outcome Is Recorded Under The Lease", 1},
+		{"Lost Lease Is Skipped", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			mockq.EXPECT().ClaimNotifications(ctx, claim).Return([]repo.Notification{expired}, nil).Once()
+			mockq.EXPECT().UpdateNotificationDelivery(ctx, repo.UpdateNotificationDeliveryParams{
+				ID: 1, Status: "expired", DeliverAfter: lease, LeasedUntil: lease}).Return(tt.updated, nil)
+			mockq.EXPECT().ClaimNotifications(ctx, claim).Return(nil, nil).Once()
+			s := &notificationService{notificationRepo: mockq, now: func() time.Time { return now }}
+
+			handled, err := s.DeliverPending(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, 1, handled)
+		})
+	}
+}
@@ -0,0 +1,419 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tmaffia/dungeon-time-api/internal/jobs"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// ReadyCheckStatus is where a ready check is at.
+type ReadyCheckStatus string
+
+const (
+	ReadyCheckPending = ReadyCheckStatus("pending")
+	ReadyCheckPassed  = ReadyCheckStatus("passed")
+	ReadyCheckFailed  = ReadyCheckStatus("failed")
+)
+
+// Ready check timeouts. A check started without a timeout gets the default.
+const (
+	DefaultReadyCheckTimeout = 30 * time.Second
+	minReadyCheckTimeout     = 10 * time.Second
+	maxReadyCheckTimeout     = 5 * time.Minute
+)
+
+// ReadyCheck is a check by a run's organizer that everyone taking part is
+// ready to start. Everyone confirmed for the run takes part, and so does the
+// organizer. It passes when all of them answer ready before it times out.
+type ReadyCheck struct {
+	ID         int32             `json:"id"`
+	RunID      int32             `json:"run_id"`
+	StartedBy  *int32            `json:"started_by"`
+	Status     ReadyCheckStatus  `json:"status"`
+	ExpiresAt  time.Time         `json:"expires_at"`
+	FinishedAt *time.Time        `json:"finished_at"`
+	Answers    []ReadyCheckReply `json:"answers"`
+}
+
+// ReadyCheckReply is what one player answered to a ready check. Ready is nil
+// until they answer.
+type ReadyCheckReply struct {
+	UserID      int32      `json:"user_id"`
+	Ready       *bool      `json:"ready"`
+	RespondedAt *time.Time `json:"responded_at"`
+}
+
+// ExpireReadyCheckArgs are the arguments of the job that finishes a ready
+// check when it times out.
+type ExpireReadyCheckArgs struct {
+	ReadyCheckID int32 `json:"ready_check_id"`
+}
+
+func (ExpireReadyCheckArgs) Kind() string {
+	return "ready_check.expire"
+}
+
+// ReadyCheckService is the interface for running ready checks.
+type ReadyCheckService interface {
+	GetReadyCheck(ctx context.Context, actorID, runID int32) (*ReadyCheck, error)
+	StartReadyCheck(ctx context.Context, actorID, runID int32, timeout time.Duration) (*ReadyCheck, error)
+	RespondReadyCheck(ctx context.Context, actorID, runID int32, ready bool) (*ReadyCheck, error)
+}
+
+// readyCheckService is the implementation of ReadyCheckService.
+type readyCheckService struct {
+	dbPool         *pgxpool.Pool
+	readyCheckRepo repo.Querier
+	now            func() time.Time
+}
+
+// NewReadyCheckService creates a new readyCheckService with the provided
+// database connection pool.
+// It returns a pointer to the readyCheckService.
+func NewReadyCheckService(dbPool *pgxpool.Pool) *readyCheckService {
+	return &readyCheckService{
+		dbPool:         dbPool,
+		readyCheckRepo: repo.New(dbPool),
+		now:            time.Now,
+	}
+}
+
+// GetReadyCheck returns the latest ready check of a run, to someone taking
+// part in it. Returns ErrReadyCheckNotFound if the run never had one.
+func (s *readyCheckService) GetReadyCheck(ctx context.Context, actorID, runID int32) (*ReadyCheck, error) {
+	row, err := s.readyCheckRepo.GetRunByID(ctx, runID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrRunNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	participants, err := readyCheckParticipants(ctx, s.readyCheckRepo, row.Run)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(participants, actorID) {
+		return nil, ErrForbidden
+	}
+
+	check, err := s.readyCheckRepo.GetLatestReadyCheck(ctx, runID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrReadyCheckNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return loadReadyCheck(ctx, s.readyCheckRepo, check, participants)
+}
+
+// StartReadyCheck starts a ready check that times out after timeout. Only the
+// organizer can start one, and only when none is pending.
+func (s *readyCheckService) StartReadyCheck(ctx context.Context, actorID, runID int32,
+	timeout time.Duration) (*ReadyCheck, error) {
+	if timeout == 0 {
+		timeout = DefaultReadyCheckTimeout
+	}
+	if timeout < minReadyCheckTimeout || timeout > maxReadyCheckTimeout {
+		return nil, fmt.Errorf("%w: timeout must be between %s and %s", ErrInvalidReadyCheck,
+			minReadyCheckTimeout, maxReadyCheckTimeout)
+	}
+
+	var check *ReadyCheck
+	err := inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		var err error
+		check, err = startReadyCheck(ctx, q, actorID, runID, s.now(), timeout)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return check, nil
+}
+
+// RespondReadyCheck records whether actorID is ready for the pending ready
+// check of a run. Players can change their answer until the check finishes,
+// which it does as soon as everyone has answered.
+func (s *readyCheckService) RespondReadyCheck(ctx context.Context, actorID, runID int32,
+	ready bool) (*ReadyCheck, error) {
+	var check *ReadyCheck
+	err := inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		var err error
+		check, err = respondReadyCheck(ctx, q, actorID, runID, ready, s.now())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return check, nil
+}
+
+// ExpireReadyCheck is the job handler that fails a ready check that timed out
+// before everyone answered.
+func (s *readyCheckService) ExpireReadyCheck(ctx context.Context, job *jobs.Job[ExpireReadyCheckArgs]) error {
+	return inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		return expireReadyCheck(ctx, q, job.Args.ReadyCheckID)
+	})
+}
+
+// startReadyCheck creates a ready check that times out after timeout and
+// queues the job that times it out.
+func startReadyCheck(ctx context.Context, q repo.Querier, actorID, runID int32, now time.Time,
+	timeout time.Duration) (*ReadyCheck, error) {
+	run, err := q.LockRun(ctx, runID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrRunNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if run.OrganizerID != actorID {
+		return nil, ErrForbidden
+	}
+	if RunStatus(run.Status) == RunStatusCancelled {
+		return nil, ErrRunCancelled
+	}
+
+	pending, err := q.LockPendingReadyCheck(ctx, runID)
+	if err == nil && now.Before(pending.ExpiresAt.Time) {
+		return nil, ErrReadyCheckInProgress
+	}
+	if err == nil {
+		// Timed out, but the expire job has not run yet.
+		if err := timeOutReadyCheck(ctx, q, run, pending); err != nil {
+			return nil, err
+		}
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	expiresAt := now.Add(timeout)
+	c, err := q.CreateReadyCheck(ctx, repo.CreateReadyCheckParams{
+		RunID:     runID,
+		StartedBy: pgInt4(&actorID),
+		ExpiresAt: pgTimestamptz(expiresAt),
+	})
+	if err != nil {
+		return nil, err
+	}
+	_, err = jobs.Enqueue(ctx, q, ExpireReadyCheckArgs{ReadyCheckID: c.ID}, &jobs.EnqueueOptions{RunAt: expiresAt})
+	if err != nil {
+		return nil, err
+	}
+
+	participants, err := readyCheckParticipants(ctx, q, run)
+	if err != nil {
+		return nil, err
+	}
+	check, err := loadReadyCheck(ctx, q, c, participants)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := recordRunEvent(ctx, q, runID, EventReadyCheckStarted, &actorID, check); err != nil {
+		return nil, err
+	}
+	return check, nil
+}
+
+// respondReadyCheck records an answer to the pending ready check of a run and
+// finishes the check once everyone has answered. The run is locked like for
+// signups, so the events of a run are committed in the order of their ids.
+func respondReadyCheck(ctx context.Context, q repo.Querier, actorID, runID int32, ready bool,
+	now time.Time) (*ReadyCheck, error) {
+	run, err := q.LockRun(ctx, runID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrRunNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := q.LockPendingReadyCheck(ctx, runID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrReadyCheckNotPending
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !now.Before(c.ExpiresAt.Time) {
+		// Timed out, the expire job finishes it.
+		return nil, ErrReadyCheckNotPending
+	}
+
+	participants, err := readyCheckParticipants(ctx, q, run)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(participants, actorID) {
+		return nil, ErrForbidden
+	}
+
+	err = q.UpsertReadyCheckResponse(ctx, repo.UpsertReadyCheckResponseParams{
+		ReadyCheckID: c.ID,
+		UserID:       actorID,
+		Ready:        ready,
+		RespondedAt:  pgTimestamptz(now),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	check, err := loadReadyCheck(ctx, q, c, participants)
+	if err != nil {
+		return nil, err
+	}
+	if !allAnswered(check) {
+		if _, err := recordRunEvent(ctx, q, runID, EventReadyCheckAnswered, &actorID, check); err != nil {
+			return nil, err
+		}
+		return check, nil
+	}
+	return finishReadyCheck(ctx, q, c, check, now)
+}
+
+// expireReadyCheck fails the ready check with id if it is still pending. Its
+// run is locked before the check, like when starting and answering checks.
+func expireReadyCheck(ctx context.Context, q repo.Querier, id int32) error {
+	c, err := q.GetReadyCheck(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// The run was deleted along with its ready checks.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	run, err := q.LockRun(ctx, c.RunID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	c, err = q.LockReadyCheck(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if ReadyCheckStatus(c.Status) != ReadyCheckPending {
+		return nil
+	}
+	return timeOutReadyCheck(ctx, q, run, c)
+}
+
+// timeOutReadyCheck finishes the pending ready check c of run at its expiry.
+// Call it with run locked.
+func timeOutReadyCheck(ctx context.Context, q repo.Querier, run repo.Run, c repo.ReadyCheck) error {
+	participants, err := readyCheckParticipants(ctx, q, run)
+	if err != nil {
+		return err
+	}
+	check, err := loadReadyCheck(ctx, q, c, participants)
+	if err != nil {
+		return err
+	}
+	_, err = finishReadyCheck(ctx, q, c, check, c.ExpiresAt.Time)
+	return err
+}
+
+// finishReadyCheck stores the outcome of a ready check and records the event.
+func finishReadyCheck(ctx context.Context, q repo.Querier, c repo.ReadyCheck, check *ReadyCheck,
+	now time.Time) (*ReadyCheck, error) {
+	status := ReadyCheckFailed
+	if readyCheckPassed(check) {
+		status = ReadyCheckPassed
+	}
+	finished, err := q.FinishReadyCheck(ctx, repo.FinishReadyCheckParams{
+		ID:         c.ID,
+		Status:     string(status),
+		FinishedAt: pgTimestamptz(now),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	check.Status = ReadyCheckStatus(finished.Status)
+	check.FinishedAt = timestamptzPtr(finished.FinishedAt)
+	if _, err := recordRunEvent(ctx, q, c.RunID, EventReadyCheckFinished, nil, check); err != nil {
+		return nil, err
+	}
+	return check, nil
+}
+
+// readyCheckParticipants returns who takes part in the ready checks of run:
+// the organizer and everyone confirmed.
+func readyCheckParticipants(ctx context.Context, q repo.Querier, run repo.Run) ([]int32, error) {
+	signups, err := q.GetRunSignups(ctx, run.ID)
+	if err != nil {
+		return nil, err
+	}
+	participants := []int32{run.OrganizerID}
+	for _, signup := range signups {
+		if SignupStatus(signup.Status) == SignupStatusConfirmed && !slices.Contains(participants, signup.UserID) {
+			participants = append(participants, signup.UserID)
+		}
+	}
+	return participants, nil
+}
+
+// loadReadyCheck maps a ready check with the answers of participants,
+// answered or not. Answers of anyone who no longer takes part are left out.
+func loadReadyCheck(ctx context.Context, q repo.Querier, c repo.ReadyCheck,
+	participants []int32) (*ReadyCheck, error) {
+	responses, err := q.GetReadyCheckResponses(ctx, c.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	check := &ReadyCheck{
+		ID:         c.ID,
+		RunID:      c.RunID,
+		StartedBy:  int4Ptr(c.StartedBy),
+		Status:     ReadyCheckStatus(c.Status),
+		ExpiresAt:  c.ExpiresAt.Time,
+		FinishedAt: timestamptzPtr(c.FinishedAt),
+		Answers:    make([]ReadyCheckReply, 0, len(participants)),
+	}
+	for _, userID := range participants {
+		check.Answers = append(check.Answers, ReadyCheckReply{UserID: userID})
+	}
+	for _, r := range responses {
+		i := slices.Index(participants, r.UserID)
+		if i < 0 {
+			continue
+		}
+		ready := r.Ready
+		check.Answers[i].Ready = &ready
+		check.Answers[i].RespondedAt = timestamptzPtr(r.RespondedAt)
+	}
+	return check, nil
+}
+
+// allAnswered reports whether everyone taking part in check has answered.
+func allAnswered(check *ReadyCheck) bool {
+	for _, a := range check.Answers {
+		if a.Ready == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// readyCheckPassed reports whether everyone taking part in check answered
+// ready.
+func readyCheckPassed(check *ReadyCheck) bool {
+	for _, a := range check.Answers {
+		if a.Ready == nil || !*a.Ready {
+			return false
+		}
+	}
+	return true
+}
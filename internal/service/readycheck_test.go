@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+func Test_startReadyCheck(t *testing.T) {
+	now := utc(2025, 3, 5, 1, 0)
+	run := repo.Run{ID: 1, OrganizerID: 2, Status: "scheduled"}
+	created := repo.ReadyCheck{ID: 7, RunID: 1, StartedBy: pgInt4(int32Ptr(2)), Status: "pending",
+		ExpiresAt: pgTimestamptz(now.Add(30 * time.Second))}
+
+	tests := []struct {
+		name       string
+		actorID    int32
+		run        repo.Run
+		pending    *repo.ReadyCheck
+		wantExpire bool
+		wantErr    error
+	}{
+		{"Started", 2, run, nil, false, nil},
+		{"Not Organizer", 3, run, nil, false, ErrForbidden},
+		{"Cancelled Run", 2, repo.Run{ID: 1, OrganizerID: 2, Status: "cancelled"}, nil, false, ErrRunCancelled},
+		{"Already Pending", 2, run, &repo.ReadyCheck{ID: 6, RunID: 1, Status: "pending",
+			ExpiresAt: pgTimestamptz(now.Add(time.Second))}, false, ErrReadyCheckInProgress},
+		{"Timed Out Check Is Finished First", 2, run, &repo.ReadyCheck{ID: 6, RunID: 1, Status: "pending",
+			ExpiresAt: pgTimestamptz(now.Add(-time.Second))}, true, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			mockq.EXPECT().LockRun(ctx, int32(1)).Return(tt.run, nil)
+			if tt.actorID == tt.run.OrganizerID && tt.run.Status == "scheduled" {
+				if tt.pending != nil {
+					mockq.EXPECT().LockPendingReadyCheck(ctx, int32(1)).Return(*tt.pending, nil)
+				} else {
+					mockq.EXPECT().LockPendingReadyCheck(ctx, int32(1)).Return(repo.ReadyCheck{}, pgx.ErrNoRows)
+				}
+			}
+			if tt.wantExpire {
+				mockq.EXPECT().GetReadyCheckResponses(ctx, int32(6)).Return(nil, nil)
+				mockq.EXPECT().FinishReadyCheck(ctx, repo.FinishReadyCheckParams{ID: 6, Status: "failed",
+					FinishedAt: tt.pending.ExpiresAt}).Return(repo.ReadyCheck{ID: 6, RunID: 1, Status: "failed"}, nil)
+				mockq.EXPECT().CreateRunEvent(ctx, mock.MatchedBy(func(arg repo.CreateRunEventParams) bool {
+					return arg.Type == "ready_check.finished"
+				})).Return(repo.RunEvent{}, nil)
+			}
+			if tt.wantErr == nil {
+				mockq.EXPECT().CreateReadyCheck(ctx, repo.CreateReadyCheckParams{RunID: 1,
+					StartedBy: created.StartedBy, ExpiresAt: created.ExpiresAt}).Return(created, nil)
+				mockq.EXPECT().CreateJob(ctx, repo.CreateJobParams{Queue: "default", Kind: "ready_check.expire",
+					Args: []byte(`{"ready_check_id":7}`), MaxAttempts: 10, RunAt: created.ExpiresAt}).Return(1, nil)
+				mockq.EXPECT().GetRunSignups(ctx, int32(1)).Return([]repo.GetRunSignupsRow{
+					{RunID: 1, UserID: 5, Status: "confirmed"},
+					{RunID: 1, UserID: 6, Status: "waitlisted"},
+				}, nil)
+				mockq.EXPECT().GetReadyCheckResponses(ctx, int32(7)).Return(nil, nil)
+				mockq.EXPECT().CreateRunEvent(ctx, mock.MatchedBy(func(arg repo.CreateRunEventParams) bool {
+					return arg.Type == "ready_check.started" && arg.UserID.Int32 == 2
+				})).Return(repo.RunEvent{}, nil)
+			}
+
+			check, err := startReadyCheck(ctx, mockq, tt.actorID, 1, now, 30*time.Second)
+			if !assert.ErrorIs(t, err, tt.wantErr) || err != nil {
+				return
+			}
+			assert.Equal(t, ReadyCheckPending, check.Status)
+			assert.Len(t, check.Answers, 2, "the organizer and the confirmed player take part")
+		})
+	}
+}
+
+func Test_respondReadyCheck(t *testing.T) {
+	now := utc(2025, 3, 5, 1, 0)
+	pending := repo.ReadyCheck{ID: 7, RunID: 1, Status: "pending", ExpiresAt: pgTimestamptz(now.Add(20 * time.Second))}
+	run := repo.Run{ID: 1, OrganizerID: 2, Status: "scheduled"}
+	signups := []repo.GetRunSignupsRow{{RunID: 1, UserID: 5, Status: "confirmed"}}
+
+	tests := []struct {
+		name       string
+		actorID    int32
+		ready      bool
+		pending    repo.ReadyCheck
+		pendingErr error
+		answered   []repo.ReadyCheckResponse
+		wantEvent  string
+		wantStatus ReadyCheckStatus
+		wantErr    error
+	}{
+		{"First Answer", 5, true, pending, nil,
+			[]repo.ReadyCheckResponse{{ReadyCheckID: 7, UserID: 5, Ready: true}},
+			"ready_check.answered", ReadyCheckPending, nil},
+		{"Everyone Ready", 5, true, pending, nil,
+			[]repo.ReadyCheckResponse{{ReadyCheckID: 7, UserID: 2, Ready: true}, {ReadyCheckID: 7, UserID: 5, Ready: true}},
+			"ready_check.finished", ReadyCheckPassed, nil},
+		{"Someone Not Ready", 5, false, pending, nil,
+			[]repo.ReadyCheckResponse{{ReadyCheckID: 7, UserID: 2, Ready: true}, {ReadyCheckID: 7, UserID: 5, Ready: false}},
+			"ready_check.finished", ReadyCheckFailed, nil},
+		{"Not Taking Part", 9, true, pending, nil, nil, "", "", ErrForbidden},
+		{"None Pending", 5, true, repo.ReadyCheck{}, pgx.ErrNoRows, nil, "", "", ErrReadyCheckNotPending},
+		{"Timed Out", 5, true, repo.ReadyCheck{ID: 7, RunID: 1, Status: "pending", ExpiresAt: pgTimestamptz(now)},
+			nil, nil, "", "", ErrReadyCheckNotPending},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			mockq.EXPECT().LockRun(ctx, int32(1)).Return(run, nil)
+			mockq.EXPECT().LockPendingReadyCheck(ctx, int32(1)).Return(tt.pending, tt.pendingErr)
+			if tt.pendingErr == nil && tt.pending.ExpiresAt.Time.After(now) {
+				mockq.EXPECT().GetRunSignups(ctx, int32(1)).Return(signups, nil)
+			}
+			if tt.wantEvent != "" {
+				mockq.EXPECT().UpsertReadyCheckResponse(ctx, repo.UpsertReadyCheckResponseParams{ReadyCheckID: 7,
+					UserID: tt.actorID, Ready: tt.ready, RespondedAt: pgTimestamptz(now)}).Return(nil)
+				mockq.EXPECT().GetReadyCheckResponses(ctx, int32(7)).Return(tt.answered, nil)
+				mockq.EXPECT().CreateRunEvent(ctx, mock.MatchedBy(func(arg repo.CreateRunEventParams) bool {
+					return arg.Type == tt.wantEvent
+				})).Return(repo.RunEvent{}, nil)
+			}
+			if tt.wantStatus != "" && tt.wantStatus != ReadyCheckPending {
+				mockq.EXPECT().FinishReadyCheck(ctx, repo.FinishReadyCheckParams{ID: 7, Status: string(tt.wantStatus),
+					FinishedAt: pgTimestamptz(now)}).Return(repo.ReadyCheck{ID: 7, RunID: 1,
+					Status: string(tt.wantStatus), FinishedAt: pgTimestamptz(now)}, nil)
+			}
+
+			check, err := respondReadyCheck(ctx, mockq, tt.actorID, 1, tt.ready, now)
+			if !assert.ErrorIs(t, err, tt.wantErr) || err != nil {
+				return
+			}
+			assert.Equal(t, tt.wantStatus, check.Status)
+		})
+	}
+}
+
+func Test_expireReadyCheck(t *testing.T) {
+	ctx := context.Background()
+	expiresAt := pgTimestamptz(utc(2025, 3, 5, 1, 0))
+
+	run := repo.Run{ID: 1, OrganizerID: 2}
+
+	t.Run("Already Finished", func(t *testing.T) {
+		mockq := repo.NewMockQuerier(t)
+		mockq.EXPECT().GetReadyCheck(ctx, int32(7)).Return(repo.ReadyCheck{ID: 7, RunID: 1, Status: "pending"}, nil)
+		mockq.EXPECT().LockRun(ctx, int32(1)).Return(run, nil)
+		mockq.EXPECT().LockReadyCheck(ctx, int32(7)).Return(repo.ReadyCheck{ID: 7, RunID: 1, Status: "passed"}, nil)
+		assert.NoError(t, expireReadyCheck(ctx, mockq, 7))
+	})
+
+	t.Run("Unanswered Fails", func(t *testing.T) {
+		mockq := repo.NewMockQuerier(t)
+		pending := repo.ReadyCheck{ID: 7, RunID: 1, Status: "pending", ExpiresAt: expiresAt}
+		mockq.EXPECT().GetReadyCheck(ctx, int32(7)).Return(pending, nil)
+		mockq.EXPECT().LockRun(ctx, int32(1)).Return(run, nil)
+		mockq.EXPECT().LockReadyCheck(ctx, int32(7)).Return(pending, nil)
+		mockq.EXPECT().GetRunSignups(ctx, int32(1)).Return(nil, nil)
+		mockq.EXPECT().GetReadyCheckResponses(ctx, int32(7)).Return(nil, nil)
+		mockq.EXPECT().FinishReadyCheck(ctx, repo.FinishReadyCheckParams{ID: 7, Status: "failed",
+			FinishedAt: expiresAt}).Return(repo.ReadyCheck{ID: 7, RunID: 1, Status: "failed"}, nil)
+		mockq.EXPECT().CreateRunEvent(ctx, mock.MatchedBy(func(arg repo.CreateRunEventParams) bool {
+			return arg.Type == "ready_check.finished" && !arg.UserID.Valid
+		})).Return(repo.RunEvent{}, nil)
+		assert.NoError(t, expireReadyCheck(ctx, mockq, 7))
+	})
+
+	t.Run("Deleted Run", func(t *testing.T) {
+		mockq := repo.NewMockQuerier(t)
+		mockq.EXPECT().GetReadyCheck(ctx, int32(7)).Return(repo.ReadyCheck{}, pgx.ErrNoRows)
+		assert.NoError(t, expireReadyCheck(ctx, mockq, 7))
+	})
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// Reliability decays so members can make up for a bad week. A run counts half
+// as much every reliabilityHalfLife and runs older than reliabilityHistory are
+// not counted at all.
+const (
+	reliabilityHalfLife = 30 * 24 * time.Hour
+	reliabilityHistory  = 180 * 24 * time.Hour
+	// reliabilityPrior is how many attended runs everyone starts with, so a
+	// single no-show does not take a new member to 0.
+	reliabilityPrior = 2
+)
+
+// Reliability is how dependable a member is about showing up for runs they
+// sign up for. Score goes from 0, never shows up, to 100. Attending counts in
+// full and being late for half. Withdrawing less than 24 hours before the start
+// counts for up to half depending on the notice, earlier withdrawals do not
+// count. The counts cover the last 180 days.
+type Reliability struct {
+	Score           float64 `json:"score"`
+	Attended        int32   `json:"attended"`
+	Late            int32   `json:"late"`
+	NoShows         int32   `json:"no_shows"`
+	LateWithdrawals int32   `json:"late_withdrawals"`
+}
+
+// SignupPolicy holds the rules a guild applies to signups for its runs.
+// Members below WaitlistBelowReliability join the waitlist even while slots
+// are open, and are promoted once the run starts forming if slots are left.
+type SignupPolicy struct {
+	WaitlistBelowReliability *int32 `json:"waitlist_below_reliability"`
+}
+
+// GetReliability returns the reliability of the user with id.
+func (s *userService) GetReliability(ctx context.Context, id int32) (*Reliability, error) {
+	return loadReliability(ctx, s.userRepo, id, s.now())
+}
+
+// loadReliability returns the reliability of the user with userID at now.
+func loadReliability(ctx context.Context, q repo.Querier, userID int32, now time.Time) (*Reliability, error) {
+	rows, err := q.GetUserAttendance(ctx, repo.GetUserAttendanceParams{
+		UserID: userID,
+		Since:  pgTimestamptz(now.Add(-reliabilityHistory)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rateReliability(rows, now), nil
+}
+
+// rateReliability averages how well a member showed up for each run, weighing
+// runs by how recent they are.
+func rateReliability(rows []repo.GetUserAttendanceRow, now time.Time) *Reliability {
+	reliability := &Reliability{}
+	total, weights := float64(reliabilityPrior), float64(reliabilityPrior)
+	for _, row := range rows {
+		var value float64
+		switch {
+		case SignupStatus(row.Status) == SignupStatusWithdrawn:
+			notice := noticeBefore(row.WithdrawnAt, row.StartsAt)
+			if notice >= lateWithdrawalWindow {
+				continue
+			}
+			reliability.LateWithdrawals++
+			value = 0.5 * float64(notice) / float64(lateWithdrawalWindow)
+		case Attendance(row.Attendance.String) == AttendanceAttended:
+			reliability.Attended++
+			value = 1
+		case Attendance(row.Attendance.String) == AttendanceLate:
+			reliability.Late++
+			value = 0.5
+		case Attendance(row.Attendance.String) == AttendanceNoShow:
+			reliability.NoShows++
+		default:
+			continue
+		}
+
+		age := max(now.Sub(row.StartsAt.Time), 0)
+		weight := math.Pow(0.5, float64(age)/float64(reliabilityHalfLife))
+		total += weight * value
+		weights += weight
+	}
+	reliability.Score = math.Round(1000*total/weights) / 10
+	return reliability
+}
+
+// heldBackByPolicy reports whether the guild of run keeps the user with userID
+// on the waitlist because of their reliability.
+func heldBackByPolicy(ctx context.Context, q repo.Querier, run repo.Run, userID int32, now time.Time) (bool, error) {
+	if !run.GuildID.Valid {
+		return false, nil
+	}
+	guild, err := q.GetGuildByID(ctx, run.GuildID.Int32)
+	if err != nil {
+		return false, err
+	}
+	if !guild.WaitlistBelowReliability.Valid {
+		return false, nil
+	}
+
+	reliability, err := loadReliability(ctx, q, userID, now)
+	if err != nil {
+		return false, err
+	}
+	return reliability.Score < float64(guild.WaitlistBelowReliability.Int32), nil
+}
+
+// fillOpenSlots promotes waitlisted users into the slots of run that are still
+// open, like those left by members held back by their guild's signup policy.
+func fillOpenSlots(ctx context.Context, q repo.Querier, run repo.Run) error {
+	composition := runComposition(run)
+	for _, role := range combatRoles {
+		slots := composition.Slots(role)
+		if slots == 0 {
+			continue
+		}
+		confirmed, err := q.CountConfirmedSignups(ctx, repo.CountConfirmedSignupsParams{
+			RunID: run.ID,
+			Role:  string(role),
+		})
+		if err != nil {
+			return err
+		}
+		for range max(int64(slots)-confirmed, 0) {
+			if err := promoteNext(ctx, q, run.ID, role); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
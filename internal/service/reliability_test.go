@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+func Test_rateReliability(t *testing.T) {
+	now := utc(2025, 3, 5, 20, 0)
+	marked := func(attendance Attendance, startsAt time.Time) repo.GetUserAttendanceRow {
+		return repo.GetUserAttendanceRow{Status: "confirmed", StartsAt: pgTimestamptz(startsAt),
+			Attendance: pgtype.Text{String: string(attendance), Valid: true}}
+	}
+	withdrew := func(notice time.Duration) repo.GetUserAttendanceRow {
+		startsAt := now.Add(-time.Hour)
+		return repo.GetUserAttendanceRow{Status: "withdrawn", StartsAt: pgTimestamptz(startsAt),
+			WithdrawnAt: pgTimestamptz(startsAt.Add(-notice))}
+	}
+
+	tests := []struct {
+		name string
+		rows []repo.GetUserAttendanceRow
+		want *Reliability
+	}{
+		{"New Member", nil, &Reliability{Score: 100}},
+		{"Attended", []repo.GetUserAttendanceRow{marked(AttendanceAttended, now)},
+			&Reliability{Score: 100, Attended: 1}},
+		{"No Show", []repo.GetUserAttendanceRow{marked(AttendanceNoShow, now)},
+			&Reliability{Score: 66.7, NoShows: 1}},
+		{"Late", []repo.GetUserAttendanceRow{marked(AttendanceLate, now)},
+			&Reliability{Score: 83.3, Late: 1}},
+		{"No Show A Month Ago", []repo.GetUserAttendanceRow{marked(AttendanceNoShow, now.Add(-reliabilityHalfLife))},
+			&Reliability{Score: 80, NoShows: 1}},
+		{"Withdrew Half A Day Before", []repo.GetUserAttendanceRow{withdrew(12 * time.Hour)},
+			&Reliability{Score: 75, LateWithdrawals: 1}},
+		{"Withdrew Days Before", []repo.GetUserAttendanceRow{withdrew(72 * time.Hour)},
+			&Reliability{Score: 100}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, rateReliability(tt.rows, now))
+		})
+	}
+}
+
+func Test_heldBackByPolicy(t *testing.T) {
+	ctx := context.Background()
+	now := utc(2025, 3, 5, 20, 0)
+	noShows := []repo.GetUserAttendanceRow{
+		{Status: "confirmed", StartsAt: pgTimestamptz(now), Attendance: pgtype.Text{String: "no_show", Valid: true}},
+	}
+
+	tests := []struct {
+		name      string
+		guildID   pgtype.Int4
+		threshold pgtype.Int4
+		want      bool
+	}{
+		{"No Guild", pgtype.Int4{}, pgtype.Int4{}, false},
+		{"No Policy", pgInt4(int32Ptr(4)), pgtype.Int4{}, false},
+		{"Below Threshold", pgInt4(int32Ptr(4)), pgInt4(int32Ptr(70)), true},
+		{"Above Threshold", pgInt4(int32Ptr(4)), pgInt4(int32Ptr(60)), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockq := repo.NewMockQuerier(t)
+			if tt.guildID.Valid {
+				mockq.EXPECT().GetGuildByID(ctx, int32(4)).Return(repo.Guild{ID: 4,
+					WaitlistBelowReliability: tt.threshold}, nil)
+			}
+			if tt.threshold.Valid {
+				mockq.EXPECT().GetUserAttendance(ctx, repo.GetUserAttendanceParams{UserID: 5,
+					Since: pgTimestamptz(now.Add(-reliabilityHistory))}).Return(noShows, nil)
+			}
+
+			held, err := heldBackByPolicy(ctx, mockq, repo.Run{ID: 1, GuildID: tt.guildID}, 5, now)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, held)
+			}
+		})
+	}
+}
+
+func Test_fillOpenSlots(t *testing.T) {
+	ctx := context.Background()
+	run := repo.Run{ID: 1, TankSlots: 1, HealerSlots: 1, DpsSlots: 3, Status: "forming"}
+
+	mockq := repo.NewMockQuerier(t)
+	mockq.EXPECT().CountConfirmedSignups(ctx, repo.CountConfirmedSignupsParams{RunID: 1, Role: "Tank"}).Return(1, nil)
+	mockq.EXPECT().CountConfirmedSignups(ctx, repo.CountConfirmedSignupsParams{RunID: 1, Role: "Healer"}).Return(1, nil)
+	mockq.EXPECT().CountConfirmedSignups(ctx, repo.CountConfirmedSignupsParams{RunID: 1, Role: "DPS"}).Return(2, nil)
+	mockq.EXPECT().GetWaitlist(ctx, repo.GetWaitlistParams{RunID: 1, Role: "DPS"}).Return([]repo.GetWaitlistRow{
+		{ID: 9, RunID: 1, UserID: 5, Role: "DPS", UserRoles: []string{"DPS"}},
+	}, nil)
+	mockq.EXPECT().PromoteSignup(ctx, int32(9)).Return(repo.RunSignup{ID: 9, RunID: 1, UserID: 5, Role: "DPS",
+		Status: "confirmed"}, nil)
+	mockq.EXPECT().CreateRunEvent(ctx, mock.MatchedBy(func(arg repo.CreateRunEventParams) bool {
+		return arg.Type == "signup.promoted"
+	})).Return(repo.RunEvent{}, nil)
+	mockq.EXPECT().GetRunByID(ctx, int32(1)).Return(repo.GetRunByIDRow{Run: run}, nil)
+	mockq.EXPECT().CreateNotification(ctx, mock.MatchedBy(func(arg repo.CreateNotificationParams) bool {
+		return arg.UserID == 5
+	})).Return(nil)
+
+	assert.NoError(t, fillOpenSlots(ctx, mockq, run))
+}
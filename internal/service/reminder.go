@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// reminderBatchSize is the most runs reminded of per call to SendDue.
+const reminderBatchSize = 50
+
+// DefaultReminderOffsets are how long before a run starts reminders are sent
+// when none are configured.
+var DefaultReminderOffsets = []time.Duration{24 * time.Hour, 15 * time.Minute}
+
+// ReminderService is the interface for reminding players of upcoming runs.
+type ReminderService interface {
+	SendDue(context.Context) (int, error)
+}
+
+// reminderService is the implementation of ReminderService.
+type reminderService struct {
+	dbPool       *pgxpool.Pool
+	reminderRepo repo.Querier
+	offsets      []time.Duration
+	now          func() time.Time
+}
+
+// NewReminderService creates a new reminderService with the provided database
+// connection pool, sending a reminder at each of offsets before a run starts.
+// Offsets are rounded down to whole minutes.
+// It returns a pointer to the reminderService.
+func NewReminderService(dbPool *pgxpool.Pool, offsets []time.Duration) *reminderService {
+	return &reminderService{
+		dbPool:       dbPool,
+		reminderRepo: repo.New(dbPool),
+		offsets:      offsets,
+		now:          time.Now,
+	}
+}
+
+// SendDue queues reminders for runs with a reminder due, up to
+// reminderBatchSize runs. Each run is claimed in its own transaction and
+// reminders are recorded with the notifications they queue, so several workers
+// can run at once without reminding anyone twice.
+// It returns the number of runs reminded of.
+func (s *reminderService) SendDue(ctx context.Context) (int, error) {
+	offsets := offsetMinutes(s.offsets)
+	if len(offsets) == 0 {
+		return 0, nil
+	}
+
+	handled := 0
+	for handled < reminderBatchSize {
+		claimed := false
+		err := inTx(ctx, s.dbPool, func(q repo.Querier) error {
+			now := s.now()
+			runs, err := q.ClaimRunsDueReminders(ctx, repo.ClaimRunsDueRemindersParams{
+				Now:           pgTimestamptz(now),
+				OffsetMinutes: offsets,
+				BatchSize:     1,
+			})
+			if err != nil || len(runs) == 0 {
+				return err
+			}
+			claimed = true
+			return remindRun(ctx, q, runs[0].ID, offsets, now)
+		})
+		if err != nil {
+			return handled, err
+		}
+		if !claimed {
+			break
+		}
+		handled++
+	}
+	return handled, nil
+}
+
+// Work sends due reminders every interval until ctx is done.
+func (s *reminderService) Work(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.SendDue(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("sending reminders: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// remindRun queues a run.reminder notification for the organizer and confirmed
+// players of the run with runID and records every reminder offset that is due.
+// When several are due at once, e.g. for a run created an hour before it
+// starts, only one reminder is sent.
+func remindRun(ctx context.Context, q repo.Querier, runID int32, offsets []int32, now time.Time) error {
+	row, err := q.GetRunByID(ctx, runID)
+	if err != nil {
+		return err
+	}
+	run := mapRun(row.Run, row.Dungeon)
+
+	sent, err := q.GetRunReminderOffsets(ctx, runID)
+	if err != nil {
+		return err
+	}
+	due := dueReminders(run.StartsAt, now, offsets, sent)
+	if len(due) == 0 {
+		return nil
+	}
+	for _, offset := range due {
+		err := q.CreateRunReminder(ctx, repo.CreateRunReminderParams{RunID: runID, OffsetMinutes: offset})
+		if err != nil {
+			return err
+		}
+	}
+
+	signups, err := q.GetRunSignups(ctx, runID)
+	if err != nil {
+		return err
+	}
+	organizerSignedUp := false
+	for _, signup := range signups {
+		if SignupStatus(signup.Status) != SignupStatusConfirmed {
+			continue
+		}
+		organizerSignedUp = organizerSignedUp || signup.UserID == run.OrganizerID
+		err := enqueueRunNotification(ctx, q, signup.UserID, NotificationRunReminder, run, UserRole(signup.Role))
+		if err != nil {
+			return err
+		}
+	}
+	if !organizerSignedUp {
+		return enqueueRunNotification(ctx, q, run.OrganizerID, NotificationRunReminder, run, "")
+	}
+	return nil
+}
+
+// dueReminders returns the offsets, in minutes before startsAt, that are due
+// at now and not in sent.
+func dueReminders(startsAt, now time.Time, offsets, sent []int32) []int32 {
+	if !now.Before(startsAt) {
+		return nil
+	}
+	var due []int32
+	for _, offset := range offsets {
+		if slices.Contains(sent, offset) {
+			continue
+		}
+		if !startsAt.Add(-time.Duration(offset) * time.Minute).After(now) {
+			due = append(due, offset)
+		}
+	}
+	return due
+}
+
+// offsetMinutes converts reminder offsets to whole minutes, dropping
+// duplicates and offsets under a minute.
+func offsetMinutes(offsets []time.Duration) []int32 {
+	var minutes []int32
+	for _, offset := range offsets {
+		m := int32(offset / time.Minute)
+		if m > 0 && !slices.Contains(minutes, m) {
+			minutes = append(minutes, m)
+		}
+	}
+	return minutes
+}
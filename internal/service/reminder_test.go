@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+func Test_dueReminders(t *testing.T) {
+	startsAt := utc(2025, 3, 5, 1, 0)
+	offsets := []int32{1440, 15}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		sent []int32
+		want []int32
+	}{
+		{"None Due", utc(2025, 3, 3, 12, 0), nil, nil},
+		{"Day Before", utc(2025, 3, 4, 1, 0), nil, []int32{1440}},
+		{"Day Before Already Sent", utc(2025, 3, 4, 12, 0), []int32{1440}, nil},
+		{"Fifteen Minutes Before", utc(2025, 3, 5, 0, 50), []int32{1440}, []int32{15}},
+		{"Both Due For A Late Run", utc(2025, 3, 5, 0, 50), nil, []int32{1440, 15}},
+		{"Started", utc(2025, 3, 5, 1, 0), nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, dueReminders(startsAt, tt.now, offsets, tt.sent))
+		})
+	}
+}
+
+func Test_offsetMinutes(t *testing.T) {
+	got := offsetMinutes([]time.Duration{24 * time.Hour, 15 * time.Minute, 900 * time.Second, 30 * time.Second})
+	assert.Equal(t, []int32{1440, 15}, got)
+}
+
+func Test_remindRun(t *testing.T) {
+	now := utc(2025, 3, 5, 0, 50)
+	row := repo.GetRunByIDRow{
+		Run: repo.Run{ID: 1, OrganizerID: 2, Difficulty: "Mythic", StartsAt: pgTimestamptz(utc(2025, 3, 5, 1, 0)),
+			DurationMinutes: 45, TankSlots: 1, HealerSlots: 1, DpsSlots: 3, Status: "scheduled"},
+		Dungeon: repo.Dungeon{ID: 3, Code: "ARAK", Name: "Ara-Kara, City of Echoes"},
+	}
+
+	tests := []struct {
+		name      string
+		sent      []int32
+		signups   []repo.GetRunSignupsRow
+		wantSaved []int32
+		wantUsers []int32
+	}{
+		{
+			"Confirmed And Organizer",
+			[]int32{1440},
+			[]repo.GetRunSignupsRow{
+				{RunID: 1, UserID: 5, Role: "Tank", Status: "confirmed"},
+				{RunID: 1, UserID: 6, Role: "DPS", Status: "waitlisted"},
+			},
+			[]int32{15},
+			[]int32{5, 2},
+		},
+		{
+			"Organizer Signed Up Once",
+			[]int32{1440},
+			[]repo.GetRunSignupsRow{{RunID: 1, UserID: 2, Role: "Healer", Status: "confirmed"}},
+			[]int32{15},
+			[]int32{2},
+		},
+		{
+			"One Reminder When Several Are Due",
+			nil,
+			nil,
+			[]int32{1440, 15},
+			[]int32{2},
+		},
+		{
+			"Already Sent",
+			[]int32{1440, 15},
+			nil,
+			nil,
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			mockq.EXPECT().GetRunByID(ctx, int32(1)).Return(row, nil)
+			mockq.EXPECT().GetRunReminderOffsets(ctx, int32(1)).Return(tt.sent, nil)
+			for _, offset := range tt.wantSaved {
+				mockq.EXPECT().CreateRunReminder(ctx, repo.CreateRunReminderParams{RunID: 1, OffsetMinutes: offset}).
+					Return(nil)
+			}
+			var notified []int32
+			if len(tt.wantUsers) > 0 {
+				mockq.EXPECT().GetRunSignups(ctx, int32(1)).Return(tt.signups, nil)
+				mockq.EXPECT().CreateNotification(ctx, mock.Anything).
+					RunAndReturn(func(_ context.Context, p repo.CreateNotificationParams) error {
+						var payload runNotificationPayload
+						assert.NoError(t, json.Unmarshal(p.Payload, &payload))
+						assert.Equal(t, "run.reminder", p.Type)
+						assert.Equal(t, "Ara-Kara, City of Echoes (Mythic)", payload.Title)
+						assert.Equal(t, pgTimestamptz(utc(2025, 3, 5, 1, 0)), p.ExpiresAt)
+						notified = append(notified, p.UserID)
+						return nil
+					}).Times(len(tt.wantUsers))
+			}
+
+			err := remindRun(ctx, mockq, 1, []int32{1440, 15}, now)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantUsers, notified)
+		})
+	}
+}
@@ -0,0 +1,703 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// Limits on how long a run can be scheduled for, how high a key can go and how
+// many characters its notes can have.
+const (
+	minRunDuration = 10 * time.Minute
+	maxRunDuration = 12 * time.Hour
+	minKeyLevel    = 2
+	maxKeyLevel    = 40
+	maxNotesLength = 2000
+)
+
+// localTimeLayout is the layout for start times given as a wall clock time
+// in the run's timezone, without a UTC offset.
+const localTimeLayout = "2006-01-02T15:04"
+
+// RunStatus represents where a run is in its lifecycle
+type RunStatus string
+
+const (
+	RunStatusScheduled  = RunStatus("scheduled")
+	RunStatusForming    = RunStatus("forming")
+	RunStatusInProgress = RunStatus("in_progress")
+	RunStatusCompleted  = RunStatus("completed")
+	RunStatusDepleted   = RunStatus("depleted")
+	RunStatusAbandoned  = RunStatus("abandoned")
+	RunStatusCancelled  = RunStatus("cancelled")
+)
+
+// Open reports whether the run has neither started nor been cancelled,
+// so its details and roster can still change.
+func (s RunStatus) Open() bool {
+	return s == RunStatusScheduled || s == RunStatusForming
+}
+
+// Run represents a scheduled dungeon run organized by a user.
+// StartsAt is always in UTC, Timezone is the IANA zone the run was scheduled in
+// and is used to interpret local start times. Runs created from a Series keep
+// the series ID and the start time the recurrence rule produced for them.
+// Sequence counts the changes made to the run. StartedAt and FinishedAt are
+// set by the server as the run moves through its lifecycle, see TransitionRun.
+type Run struct {
+	ID              int32       `json:"id"`
+	Dungeon         *Dungeon    `json:"dungeon"`
+	Difficulty      Difficulty  `json:"difficulty"`
+	KeyLevel        *int32      `json:"key_level,omitempty"`
+	OrganizerID     int32       `json:"organizer_id"`
+	StartsAt        time.Time   `json:"starts_at"`
+	Timezone        string      `json:"timezone"`
+	DurationMinutes int32       `json:"duration_minutes"`
+	Notes           string      `json:"notes"`
+	Composition     Composition `json:"composition"`
+	Status          RunStatus   `json:"status"`
+	Sequence        int32       `json:"sequence"`
+	SeriesID        *int32      `json:"series_id,omitempty"`
+	OccurrenceAt    *time.Time  `json:"occurrence_at,omitempty"`
+	GuildID         *int32      `json:"guild_id,omitempty"`
+	StartedAt       *time.Time  `json:"started_at,omitempty"`
+	FinishedAt      *time.Time  `json:"finished_at,omitempty"`
+	ElapsedSeconds  *int32      `json:"elapsed_seconds,omitempty"`
+	UpgradeLevel    *int32      `json:"upgrade_level,omitempty"`
+	Affixes         []string    `json:"affixes,omitempty"`
+	CreatedAt       time.Time   `json:"created_at"`
+	UpdatedAt       time.Time   `json:"updated_at"`
+}
+
+// Duration returns the expected duration of the run
+func (r *Run) Duration() time.Duration {
+	return time.Duration(r.DurationMinutes) * time.Minute
+}
+
+// EndsAt returns the time the run is expected to end
+func (r *Run) EndsAt() time.Time {
+	return r.StartsAt.Add(r.Duration())
+}
+
+// Location returns the timezone the run was scheduled in.
+// Falls back to UTC if the stored timezone can not be loaded.
+func (r *Run) Location() *time.Location {
+	loc, err := time.LoadLocation(r.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// RunInput holds the client provided fields used to create or update a run.
+// StartsAt is either an RFC 3339 timestamp or a wall clock time such as
+// "2025-03-14T20:00" which is interpreted in Timezone. Timezone defaults to
+// the organizer's timezone for new runs and to the current timezone for updates.
+// Composition defaults to DefaultComposition for new runs and is left unchanged
+// by updates that omit it, as is GuildID. Runs for a guild are announced to it
+// and can only be organized by its members.
+type RunInput struct {
+	DungeonCode     string       `json:"dungeon"`
+	Difficulty      Difficulty   `json:"difficulty"`
+	KeyLevel        *int32       `json:"key_level"`
+	StartsAt        string       `json:"starts_at"`
+	Timezone        string       `json:"timezone"`
+	DurationMinutes int32        `json:"duration_minutes"`
+	Notes           string       `json:"notes"`
+	Composition     *Composition `json:"composition"`
+	GuildID         *int32       `json:"guild_id"`
+}
+
+// RunView is a Run with its start time rendered for a specific viewer,
+// both in UTC and in the viewer's own timezone.
+type RunView struct {
+	*Run
+	StartsAtUTC    string `json:"starts_at_utc"`
+	StartsAtLocal  string `json:"starts_at_local"`
+	ViewerTimezone string `json:"viewer_timezone"`
+}
+
+// NewRunView renders run for a viewer in the provided location.
+func NewRunView(run *Run, viewer *time.Location) *RunView {
+	return &RunView{
+		Run:            run,
+		StartsAtUTC:    run.StartsAt.UTC().Format(time.RFC3339),
+		StartsAtLocal:  run.StartsAt.In(viewer).Format(time.RFC3339),
+		ViewerTimezone: viewer.String(),
+	}
+}
+
+// RunService is the interface for scheduling dungeon runs.
+type RunService interface {
+	CreateRun(context.Context, int32, *RunInput) (*Run, error)
+	GetRuns(context.Context, time.Time, time.Time) ([]*Run, error)
+	GetRunByID(context.Context, int32) (*Run, error)
+	UpdateRun(context.Context, int32, int32, *RunInput) (*Run, error)
+	CancelRun(context.Context, int32, int32) (*Run, error)
+	TransitionRun(context.Context, int32, int32, RunStatus, []AttendanceMark) (*Run, error)
+	GetAttendance(context.Context, int32) ([]*AttendanceRecord, error)
+	MarkAttendance(context.Context, int32, int32, []AttendanceMark) ([]*AttendanceRecord, error)
+}
+
+// runService is the implementation of RunService.
+// now is used for every comparison against the current time so tests can pin it.
+type runService struct {
+	dbPool  *pgxpool.Pool
+	runRepo repo.Querier
+	reset   WeeklyReset
+	now     func() time.Time
+}
+
+// NewRunService creates a new runService with the provided database connection pool
+// and the weekly reset that picks the affixes runs start with.
+// It returns a pointer to the runService.
+func NewRunService(dbPool *pgxpool.Pool, reset WeeklyReset) *runService {
+	return &runService{
+		dbPool:  dbPool,
+		runRepo: repo.New(dbPool),
+		reset:   reset,
+		now:     time.Now,
+	}
+}
+
+// runFields are the validated, database ready fields of a RunInput.
+type runFields struct {
+	dungeon         repo.Dungeon
+	difficulty      Difficulty
+	keyLevel        *int32
+	startsAt        time.Time
+	timezone        string
+	durationMinutes int32
+	notes           string
+	composition     Composition
+	guildID         *int32
+}
+
+// CreateRun schedules a new run organized by the user with organizerID.
+// Returns an error specific to the validation problem if the input is invalid.
+func (s *runService) CreateRun(ctx context.Context, organizerID int32, input *RunInput) (*Run, error) {
+	f, err := s.newRunFields(ctx, organizerID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	var run *Run
+	err = inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		run, err = createRun(ctx, q, organizerID, f)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// createRun inserts a run organized by the user with organizerID, see runCreated.
+// Call it with the Querier of the transaction creating the run.
+func createRun(ctx context.Context, q repo.Querier, organizerID int32, f *runFields) (*Run, error) {
+	r, err := q.CreateRun(ctx, createRunParams(organizerID, f))
+	if err != nil {
+		return nil, err
+	}
+	run := mapRun(r, f.dungeon)
+	if err := runCreated(ctx, q, run); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// runCreated announces a run that was just inserted in its guild and emits the
+// run.created webhook event, with the Querier of the transaction that inserted it.
+func runCreated(ctx context.Context, q repo.Querier, run *Run) error {
+	if err := announceRun(ctx, q, run.ID, run.GuildID, AnnouncementRunCreated); err != nil {
+		return err
+	}
+	return emitWebhookEvent(ctx, q, WebhookRunCreated, run.GuildID, run)
+}
+
+// newRunFields validates the input for a new run organized by the user with organizerID.
+func (s *runService) newRunFields(ctx context.Context, organizerID int32, input *RunInput) (*runFields, error) {
+	organizer, err := s.runRepo.GetUserByID(ctx, organizerID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	timezone := input.Timezone
+	if timezone == "" {
+		timezone = organizer.Timezone
+	}
+
+	f, err := s.validateRunInput(ctx, input, timezone, DefaultComposition)
+	if err != nil {
+		return nil, err
+	}
+	if !f.startsAt.After(s.now()) {
+		return nil, ErrRunInPast
+	}
+	if err := checkRunGuild(ctx, s.runRepo, f.guildID, organizerID); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// GetRuns returns the runs starting in [from, to) that have not been cancelled,
+// ordered by start time.
+func (s *runService) GetRuns(ctx context.Context, from, to time.Time) ([]*Run, error) {
+	if !to.After(from) {
+		return nil, ErrInvalidTimeRange
+	}
+
+	rows, err := s.runRepo.GetRuns(ctx, repo.GetRunsParams{
+		StartsAfter:  pgTimestamptz(from),
+		StartsBefore: pgTimestamptz(to),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var runs []*Run
+	for _, row := range rows {
+		runs = append(runs, mapRun(row.Run, row.Dungeon))
+	}
+	return runs, nil
+}
+
+// GetRunByID returns a run by ID. Returns ErrRunNotFound if it does not exist.
+func (s *runService) GetRunByID(ctx context.Context, id int32) (*Run, error) {
+	row, err := s.runRepo.GetRunByID(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrRunNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return mapRun(row.Run, row.Dungeon), nil
+}
+
+// UpdateRun replaces the details of a run. Only the organizer can update a run
+// and runs that are cancelled or have started can not be changed. A new start time must be in the future
+// and a new composition must still fit everyone that is already confirmed.
+// Slots a larger composition adds go to the waitlist first.
+// Updating a run that belongs to a series marks the occurrence as overridden,
+// so later changes to the series leave it alone.
+func (s *runService) UpdateRun(ctx context.Context, actorID, id int32, input *RunInput) (*Run, error) {
+	run, err := s.organizedRun(ctx, actorID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !run.Status.Open() {
+		return nil, ErrRunStarted
+	}
+
+	timezone := input.Timezone
+	if timezone == "" {
+		timezone = run.Timezone
+	}
+
+	f, err := s.validateRunInput(ctx, input, timezone, run.Composition)
+	if err != nil {
+		return nil, err
+	}
+	if !f.startsAt.Equal(run.StartsAt) && !f.startsAt.After(s.now()) {
+		return nil, ErrRunInPast
+	}
+	if f.guildID == nil {
+		f.guildID = run.GuildID
+	} else if err := checkRunGuild(ctx, s.runRepo, f.guildID, actorID); err != nil {
+		return nil, err
+	}
+
+	var updated *Run
+	err = inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		updated, err = updateRun(ctx, q, actorID, id, f)
+		if err != nil || updated.SeriesID == nil {
+			return err
+		}
+		return q.UpsertSeriesException(ctx, repo.UpsertSeriesExceptionParams{
+			SeriesID:     *updated.SeriesID,
+			OccurrenceAt: pgTimestamptzPtr(updated.OccurrenceAt),
+			Kind:         string(exceptionOverride),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// updateRun stores the validated fields f of the run with id, checking that
+// actorID can still change it and that the new composition fits everyone
+// confirmed, and promoting waitlisted users into the slots it adds.
+func updateRun(ctx context.Context, q repo.Querier, actorID, id int32, f *runFields) (*Run, error) {
+	locked, err := lockOrganizedRun(ctx, q, actorID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !RunStatus(locked.Status).Open() {
+		return nil, ErrRunStarted
+	}
+
+	for _, role := range combatRoles {
+		confirmed, err := q.CountConfirmedSignups(ctx, repo.CountConfirmedSignupsParams{
+			RunID: id,
+			Role:  string(role),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if confirmed > int64(f.composition.Slots(role)) {
+			return nil, fmt.Errorf("%w: %d %s already signed up", ErrInvalidComposition, confirmed, role)
+		}
+	}
+
+	r, err := q.UpdateRun(ctx, repo.UpdateRunParams{
+		ID:              id,
+		DungeonID:       f.dungeon.ID,
+		Difficulty:      string(f.difficulty),
+		KeyLevel:        pgInt4(f.keyLevel),
+		StartsAt:        pgTimestamptz(f.startsAt),
+		Timezone:        f.timezone,
+		DurationMinutes: f.durationMinutes,
+		Notes:           f.notes,
+		TankSlots:       f.composition.Tanks,
+		HealerSlots:     f.composition.Healers,
+		DpsSlots:        f.composition.DPS,
+		GuildID:         pgInt4(f.guildID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Players waiting go ahead of new signups for the slots the update opens.
+	if addsSlots(runComposition(locked), f.composition) {
+		if err := fillOpenSlots(ctx, q, r); err != nil {
+			return nil, err
+		}
+	}
+
+	// Reminders already sent were for the old start time.
+	if !f.startsAt.Equal(locked.StartsAt.Time) {
+		if err := q.DeleteRunReminders(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	updated := mapRun(r, f.dungeon)
+	if _, err := recordRunEvent(ctx, q, id, EventRunUpdated, &actorID, updated); err != nil {
+		return nil, err
+	}
+	if err := announceRun(ctx, q, updated.ID, updated.GuildID, AnnouncementRunUpdated); err != nil {
+		return nil, err
+	}
+	if err := emitWebhookEvent(ctx, q, WebhookRunUpdated, updated.GuildID, updated); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// addsSlots reports whether to has more slots than from for any role.
+func addsSlots(from, to Composition) bool {
+	for _, role := range combatRoles {
+		if to.Slots(role) > from.Slots(role) {
+			return true
+		}
+	}
+	return false
+}
+
+// CancelRun marks a run as cancelled. Only the organizer can cancel a run, and
+// only before it starts. Cancelled runs are kept so that calendars and
+// notifications can refer to them.
+func (s *runService) CancelRun(ctx context.Context, actorID, id int32) (*Run, error) {
+	run, err := s.organizedRun(ctx, actorID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRunTransition(run.Status, RunStatusCancelled); err != nil {
+		return nil, err
+	}
+
+	err = inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		run, err = cancelOrganizedRun(ctx, q, actorID, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// cancelOrganizedRun cancels the run with id for actorID, checking the run as
+// it is once locked, see cancelRun.
+func cancelOrganizedRun(ctx context.Context, q repo.Querier, actorID, id int32) (*Run, error) {
+	locked, err := lockOrganizedRun(ctx, q, actorID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRunTransition(RunStatus(locked.Status), RunStatusCancelled); err != nil {
+		return nil, err
+	}
+
+	row, err := q.GetRunByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	run := mapRun(row.Run, row.Dungeon)
+	if err := cancelRun(ctx, q, run); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// cancelRun cancels run, records the event, lets everyone signed up for it
+// know and announces the cancellation to its guild and to webhooks.
+func cancelRun(ctx context.Context, q repo.Querier, run *Run) error {
+	r, err := q.SetRunStatus(ctx, repo.SetRunStatusParams{
+		ID:     run.ID,
+		Status: string(RunStatusCancelled),
+	})
+	if err != nil {
+		return err
+	}
+
+	run.Status = RunStatus(r.Status)
+	run.Sequence = r.Sequence
+	run.UpdatedAt = r.UpdatedAt.Time
+	if _, err := recordRunEvent(ctx, q, run.ID, EventRunCancelled, &run.OrganizerID, run); err != nil {
+		return err
+	}
+	if err := notifyRunCancelled(ctx, q, run.ID); err != nil {
+		return err
+	}
+	if err := announceRun(ctx, q, run.ID, run.GuildID, AnnouncementRunCancelled); err != nil {
+		return err
+	}
+	return emitWebhookEvent(ctx, q, WebhookRunCancelled, run.GuildID, run)
+}
+
+// organizedRun loads a run that actorID is allowed to change.
+func (s *runService) organizedRun(ctx context.Context, actorID, id int32) (*Run, error) {
+	run, err := s.GetRunByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if run.OrganizerID != actorID {
+		return nil, ErrForbidden
+	}
+	if run.Status == RunStatusCancelled {
+		return nil, ErrRunCancelled
+	}
+	return run, nil
+}
+
+// lockOrganizedRun locks the run with id for the rest of the transaction and
+// checks that actorID organizes it and that it was not cancelled, on the row
+// as it is now rather than as it was read before the transaction.
+func lockOrganizedRun(ctx context.Context, q repo.Querier, actorID, id int32) (repo.Run, error) {
+	locked, err := q.LockRun(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return repo.Run{}, ErrRunNotFound
+	}
+	if err != nil {
+		return repo.Run{}, err
+	}
+	if locked.OrganizerID != actorID {
+		return repo.Run{}, ErrForbidden
+	}
+	if RunStatus(locked.Status) == RunStatusCancelled {
+		return repo.Run{}, ErrRunCancelled
+	}
+	return locked, nil
+}
+
+// validateRunInput checks everything about a RunInput that does not depend on
+// whether the run is being created or updated. composition is used when the
+// input does not specify one.
+func (s *runService) validateRunInput(ctx context.Context, input *RunInput, timezone string,
+	composition Composition) (*runFields, error) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, ErrInvalidTimezone
+	}
+
+	dungeon, err := s.runRepo.GetDungeonByCode(ctx, input.DungeonCode)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && !dungeon.Active) {
+		return nil, ErrDungeonNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !mapDungeon(dungeon).SupportsDifficulty(input.Difficulty) {
+		return nil, ErrInvalidDifficulty
+	}
+
+	if err := isValidKeyLevel(input.Difficulty, input.KeyLevel); err != nil {
+		return nil, err
+	}
+
+	startsAt, err := parseStartTime(input.StartsAt, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isValidRunDuration(input.DurationMinutes) {
+		return nil, ErrInvalidDuration
+	}
+
+	if utf8.RuneCountInString(input.Notes) > maxNotesLength {
+		return nil, ErrInvalidNotes
+	}
+
+	if input.Composition != nil {
+		composition = *input.Composition
+	}
+	if !isValidComposition(composition) {
+		return nil, ErrInvalidComposition
+	}
+
+	return &runFields{
+		dungeon:         dungeon,
+		difficulty:      input.Difficulty,
+		keyLevel:        input.KeyLevel,
+		startsAt:        startsAt,
+		timezone:        loc.String(),
+		durationMinutes: input.DurationMinutes,
+		notes:           input.Notes,
+		composition:     composition,
+		guildID:         input.GuildID,
+	}, nil
+}
+
+// checkRunGuild checks that the user with organizerID can organize runs for
+// the guild with guildID, if there is one.
+func checkRunGuild(ctx context.Context, q repo.Querier, guildID *int32, organizerID int32) error {
+	if guildID == nil {
+		return nil
+	}
+
+	if _, err := q.GetGuildByID(ctx, *guildID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrGuildNotFound
+		}
+		return err
+	}
+
+	_, err := q.GetGuildMember(ctx, repo.GetGuildMemberParams{GuildID: *guildID, UserID: organizerID})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrForbidden
+	}
+	return err
+}
+
+func createRunParams(organizerID int32, f *runFields) repo.CreateRunParams {
+	return repo.CreateRunParams{
+		DungeonID:       f.dungeon.ID,
+		Difficulty:      string(f.difficulty),
+		KeyLevel:        pgInt4(f.keyLevel),
+		OrganizerID:     organizerID,
+		StartsAt:        pgTimestamptz(f.startsAt),
+		Timezone:        f.timezone,
+		DurationMinutes: f.durationMinutes,
+		Notes:           f.notes,
+		TankSlots:       f.composition.Tanks,
+		HealerSlots:     f.composition.Healers,
+		DpsSlots:        f.composition.DPS,
+		GuildID:         pgInt4(f.guildID),
+	}
+}
+
+// parseStartTime parses a run start time. Values with a UTC offset are used as is,
+// wall clock values are interpreted in loc. Wall clock times that do not exist
+// in loc, such as 02:30 on the day clocks spring forward, are rejected.
+// Ambiguous times on the day clocks fall back resolve to the earlier instant,
+// see wallClockTime.
+func parseStartTime(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.UTC(), nil
+	}
+
+	wall, err := time.Parse(localTimeLayout, value)
+	if err != nil || wall.Format(localTimeLayout) != value {
+		return time.Time{}, ErrInvalidStartTime
+	}
+
+	t, ok := wallClockTime(wall, loc)
+	if !ok {
+		return time.Time{}, fmt.Errorf("%w: %s does not exist in %s", ErrInvalidStartTime, value, loc)
+	}
+	return t, nil
+}
+
+// wallClockTime returns the earliest instant, in UTC, at which the wall clock
+// in loc shows the date and time of wall, a floating time in UTC. ok is false
+// if the wall clock skips that time because clocks spring forward.
+func wallClockTime(wall time.Time, loc *time.Location) (t time.Time, ok bool) {
+	guess := time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), 0, loc)
+	// time.Date picks either instant of an ambiguous time depending on the
+	// zone. The other one uses the UTC offset in effect an hour before.
+	for _, candidate := range []time.Time{guess, guess.Add(-time.Hour)} {
+		_, offset := candidate.Zone()
+		instant := wall.Add(-time.Duration(offset) * time.Second)
+		if floating(instant, loc).Equal(wall) && (!ok || instant.Before(t)) {
+			t, ok = instant, true
+		}
+	}
+	return t, ok
+}
+
+func isValidKeyLevel(difficulty Difficulty, keyLevel *int32) error {
+	if difficulty != DifficultyMythicPlus {
+		if keyLevel != nil {
+			return ErrInvalidKeyLevel
+		}
+		return nil
+	}
+
+	if keyLevel == nil || *keyLevel < minKeyLevel || *keyLevel > maxKeyLevel {
+		return ErrInvalidKeyLevel
+	}
+	return nil
+}
+
+func isValidRunDuration(minutes int32) bool {
+	d := time.Duration(minutes) * time.Minute
+	return d >= minRunDuration && d <= maxRunDuration
+}
+
+func mapRun(r repo.Run, d repo.Dungeon) *Run {
+	return &Run{
+		ID:              r.ID,
+		Dungeon:         mapDungeon(d),
+		Difficulty:      Difficulty(r.Difficulty),
+		KeyLevel:        int4Ptr(r.KeyLevel),
+		OrganizerID:     r.OrganizerID,
+		StartsAt:        r.StartsAt.Time.UTC(),
+		Timezone:        r.Timezone,
+		DurationMinutes: r.DurationMinutes,
+		Notes:           r.Notes,
+		Composition:     runComposition(r),
+		Status:          RunStatus(r.Status),
+		Sequence:        r.Sequence,
+		SeriesID:        int4Ptr(r.SeriesID),
+		OccurrenceAt:    timestamptzPtr(r.OccurrenceAt),
+		GuildID:         int4Ptr(r.GuildID),
+		StartedAt:       timestamptzPtr(r.StartedAt),
+		FinishedAt:      timestamptzPtr(r.FinishedAt),
+		ElapsedSeconds:  elapsedSeconds(r.StartedAt, r.FinishedAt),
+		UpgradeLevel:    int4Ptr(r.UpgradeLevel),
+		Affixes:         r.Affixes,
+		CreatedAt:       r.CreatedAt.Time,
+		UpdatedAt:       r.UpdatedAt.Time,
+	}
+}
@@ -0,0 +1,387 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("LoadLocation(%q) error = %v", name, err)
+	}
+	return loc
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+func Test_parseStartTime(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+	sydney := mustLoadLocation(t, "Australia/Sydney")
+
+	tests := []struct {
+		name    string
+		value   string
+		loc     *time.Location
+		want    time.Time
+		wantErr error
+	}{
+		{"RFC3339 Ignores Location", "2025-03-14T20:00:00-04:00", berlin,
+			time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC), nil},
+		{"Wall Clock Standard Time", "2025-01-14T20:00", newYork,
+			time.Date(2025, 1, 15, 1, 0, 0, 0, time.UTC), nil},
+		{"Wall Clock Daylight Time", "2025-07-14T20:00", newYork,
+			time.Date(2025, 7, 15, 0, 0, 0, 0, time.UTC), nil},
+		{"Day After Spring Forward", "2025-03-10T20:00", newYork,
+			time.Date(2025, 3, 11, 0, 0, 0, 0, time.UTC), nil},
+		{"Spring Forward Gap", "2025-03-09T02:30", newYork, time.Time{}, ErrInvalidStartTime},
+		{"Spring Forward Gap Europe", "2025-03-30T02:15", berlin, time.Time{}, ErrInvalidStartTime},
+		{"Fall Back Ambiguous Uses First", "2025-11-02T01:30", newYork,
+			time.Date(2025, 11, 2, 5, 30, 0, 0, time.UTC), nil},
+		{"Fall Back Ambiguous Uses First Europe", "2024-10-27T02:30", berlin,
+			time.Date(2024, 10, 27, 0, 30, 0, 0, time.UTC), nil},
+		{"Fall Back Ambiguous Uses First Southern Hemisphere", "2024-04-07T02:30", sydney,
+			time.Date(2024, 4, 6, 15, 30, 0, 0, time.UTC), nil},
+		{"Garbage", "next tuesday", newYork, time.Time{}, ErrInvalidStartTime},
+		{"Missing Leading Zero", "2025-1-14T20:00", newYork, time.Time{}, ErrInvalidStartTime},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseStartTime(tt.value, tt.loc)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, tt.want.Equal(got), "got %v, want %v", got, tt.want)
+		})
+	}
+}
+
+func Test_isValidKeyLevel(t *testing.T) {
+	tests := []struct {
+		name       string
+		difficulty Difficulty
+		keyLevel   *int32
+		wantErr    error
+	}{
+		{"Mythic Plus With Level", DifficultyMythicPlus, int32Ptr(12), nil},
+		{"Mythic Plus Without Level", DifficultyMythicPlus, nil, ErrInvalidKeyLevel},
+		{"Mythic Plus Too Low", DifficultyMythicPlus, int32Ptr(1), ErrInvalidKeyLevel},
+		{"Mythic Plus Too High", DifficultyMythicPlus, int32Ptr(99), ErrInvalidKeyLevel},
+		{"Heroic Without Level", DifficultyHeroic, nil, nil},
+		{"Heroic With Level", DifficultyHeroic, int32Ptr(5), ErrInvalidKeyLevel},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, isValidKeyLevel(tt.difficulty, tt.keyLevel), tt.wantErr)
+		})
+	}
+}
+
+func Test_isValidRunDuration(t *testing.T) {
+	tests := []struct {
+		name    string
+		minutes int32
+		want    bool
+	}{
+		{"Zero", 0, false},
+		{"Negative", -30, false},
+		{"Too Short", 5, false},
+		{"Typical Key", 45, true},
+		{"Twelve Hours", 720, true},
+		{"Over Twelve Hours", 721, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isValidRunDuration(tt.minutes))
+		})
+	}
+}
+
+func TestNewRunView(t *testing.T) {
+	run := &Run{StartsAt: time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)}
+
+	got := NewRunView(run, mustLoadLocation(t, "America/Los_Angeles"))
+	assert.Equal(t, "2025-03-15T00:00:00Z", got.StartsAtUTC)
+	assert.Equal(t, "2025-03-14T17:00:00-07:00", got.StartsAtLocal)
+	assert.Equal(t, "America/Los_Angeles", got.ViewerTimezone)
+}
+
+func Test_runService_CreateRun(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	dungeon := repo.Dungeon{ID: 3, Code: "ARAK", Active: true, ParSeconds: 1800,
+		Difficulties: []string{"Mythic", "Mythic+"}}
+	organizer := repo.GetUserByIDRow{ID: 7, Timezone: "America/New_York"}
+
+	tests := []struct {
+		name    string
+		input   *RunInput
+		want    repo.CreateRunParams
+		wantErr error
+	}{
+		{
+			"CreateRun Uses Organizer Timezone",
+			&RunInput{DungeonCode: "ARAK", Difficulty: DifficultyMythicPlus, KeyLevel: int32Ptr(10),
+				StartsAt: "2025-03-14T20:00", DurationMinutes: 45},
+			repo.CreateRunParams{DungeonID: 3, Difficulty: "Mythic+", KeyLevel: pgInt4(int32Ptr(10)),
+				OrganizerID: 7, StartsAt: pgTimestamptz(time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)),
+				Timezone: "America/New_York", DurationMinutes: 45, TankSlots: 1, HealerSlots: 1, DpsSlots: 3},
+			nil,
+		},
+		{
+			"CreateRun In The Past",
+			&RunInput{DungeonCode: "ARAK", Difficulty: DifficultyMythic,
+				StartsAt: "2025-02-28T20:00", DurationMinutes: 45},
+			repo.CreateRunParams{},
+			ErrRunInPast,
+		},
+		{
+			"CreateRun Unsupported Difficulty",
+			&RunInput{DungeonCode: "ARAK", Difficulty: DifficultyHeroic,
+				StartsAt: "2025-03-14T20:00", DurationMinutes: 45},
+			repo.CreateRunParams{},
+			ErrInvalidDifficulty,
+		},
+		{
+			"CreateRun Notes Too Long",
+			&RunInput{DungeonCode: "ARAK", Difficulty: DifficultyMythic, StartsAt: "2025-03-14T20:00",
+				DurationMinutes: 45, Notes: strings.Repeat("é", maxNotesLength+1)},
+			repo.CreateRunParams{},
+			ErrInvalidNotes,
+		},
+		{
+			"CreateRun Invalid Timezone",
+			&RunInput{DungeonCode: "ARAK", Difficulty: DifficultyMythic, Timezone: "Mars/Olympus",
+				StartsAt: "2025-03-14T20:00", DurationMinutes: 45},
+			repo.CreateRunParams{},
+			ErrInvalidTimezone,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			mockq.EXPECT().GetUserByID(ctx, organizer.ID).Return(organizer, nil)
+			if tt.wantErr != ErrInvalidTimezone {
+				mockq.EXPECT().GetDungeonByCode(ctx, "ARAK").Return(dungeon, nil)
+			}
+			s := &runService{runRepo: mockq, now: func() time.Time { return now }}
+
+			f, err := s.newRunFields(ctx, organizer.ID, tt.input)
+			if !assert.ErrorIs(t, err, tt.wantErr) || tt.wantErr != nil {
+				return
+			}
+			assert.Equal(t, tt.want, createRunParams(organizer.ID, f))
+			assert.Equal(t, "ARAK", f.dungeon.Code)
+		})
+	}
+}
+
+func Test_checkRunGuild(t *testing.T) {
+	guildID := int32(4)
+
+	tests := []struct {
+		name      string
+		guildID   *int32
+		guildErr  error
+		memberErr error
+		wantErr   error
+	}{
+		{"No Guild", nil, nil, nil, nil},
+		{"Member", &guildID, nil, nil, nil},
+		{"Not A Member", &guildID, nil, pgx.ErrNoRows, ErrForbidden},
+		{"Guild Not Found", &guildID, pgx.ErrNoRows, nil, ErrGuildNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			if tt.guildID != nil {
+				mockq.EXPECT().GetGuildByID(ctx, guildID).Return(repo.Guild{ID: guildID}, tt.guildErr)
+			}
+			if tt.guildID != nil && tt.guildErr == nil {
+				mockq.EXPECT().GetGuildMember(ctx, repo.GetGuildMemberParams{GuildID: guildID, UserID: 7}).
+					Return(repo.GuildMember{GuildID: guildID, UserID: 7}, tt.memberErr)
+			}
+
+			assert.ErrorIs(t, checkRunGuild(ctx, mockq, tt.guildID, 7), tt.wantErr)
+		})
+	}
+}
+
+func Test_updateRun(t *testing.T) {
+	ctx := context.Background()
+	dungeon := repo.Dungeon{ID: 3, Code: "ARAK", Name: "Ara-Kara, City of Echoes"}
+	startsAt := utc(2025, 3, 5, 1, 0)
+
+	tests := []struct {
+		name        string
+		actorID     int32
+		status      RunStatus
+		composition Composition
+		promoted    bool
+		wantErr     error
+	}{
+		{"Composition Grows", 7, RunStatusScheduled, Composition{Tanks: 1, Healers: 1, DPS: 4}, true, nil},
+		{"Composition Unchanged", 7, RunStatusForming, DefaultComposition, false, nil},
+		// The run is checked again once locked, it may have changed since it was read.
+		{"Started Meanwhile", 7, RunStatusInProgress, DefaultComposition, false, ErrRunStarted},
+		{"Cancelled Meanwhile", 7, RunStatusCancelled, DefaultComposition, false, ErrRunCancelled},
+		{"Not Organizer", 8, RunStatusScheduled, DefaultComposition, false, ErrForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &runFields{dungeon: dungeon, difficulty: DifficultyMythic, startsAt: startsAt,
+				timezone: "UTC", durationMinutes: 45, composition: tt.composition}
+			locked := repo.Run{ID: 1, OrganizerID: 7, Status: string(tt.status), StartsAt: pgTimestamptz(startsAt),
+				TankSlots: 1, HealerSlots: 1, DpsSlots: 3}
+			updated := locked
+			updated.TankSlots, updated.HealerSlots, updated.DpsSlots =
+				tt.composition.Tanks, tt.composition.Healers, tt.composition.DPS
+
+			mockq := repo.NewMockQuerier(t)
+			mockq.EXPECT().LockRun(ctx, int32(1)).Return(locked, nil)
+			if tt.wantErr != nil {
+				_, err := updateRun(ctx, mockq, tt.actorID, 1, f)
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			mockq.EXPECT().CountConfirmedSignups(ctx, repo.CountConfirmedSignupsParams{RunID: 1, Role: "Tank"}).
+				Return(1, nil)
+			mockq.EXPECT().CountConfirmedSignups(ctx, repo.CountConfirmedSignupsParams{RunID: 1, Role: "Healer"}).
+				Return(1, nil)
+			mockq.EXPECT().CountConfirmedSignups(ctx, repo.CountConfirmedSignupsParams{RunID: 1, Role: "DPS"}).
+				Return(3, nil)
+			mockq.EXPECT().UpdateRun(ctx, mock.MatchedBy(func(arg repo.UpdateRunParams) bool {
+				return arg.ID == 1 && arg.DpsSlots == tt.composition.DPS
+			})).Return(updated, nil)
+			if tt.promoted {
+				mockq.EXPECT().GetWaitlist(ctx, repo.GetWaitlistParams{RunID: 1, Role: "DPS"}).
+					Return([]repo.GetWaitlistRow{{ID: 9, RunID: 1, UserID: 5, Role: "DPS", UserRoles: []string{"DPS"}}}, nil)
+				mockq.EXPECT().PromoteSignup(ctx, int32(9)).Return(repo.RunSignup{ID: 9, RunID: 1, UserID: 5,
+					Role: "DPS", Status: "confirmed"}, nil)
+				mockq.EXPECT().CreateRunEvent(ctx, mock.MatchedBy(func(arg repo.CreateRunEventParams) bool {
+					return arg.Type == "signup.promoted" && arg.UserID.Int32 == 5
+				})).Return(repo.RunEvent{}, nil)
+				mockq.EXPECT().GetRunByID(ctx, int32(1)).Return(repo.GetRunByIDRow{Run: updated, Dungeon: dungeon}, nil)
+				mockq.EXPECT().CreateNotification(ctx, mock.MatchedBy(func(arg repo.CreateNotificationParams) bool {
+					return arg.UserID == 5 && arg.Type == "signup.promoted"
+				})).Return(nil)
+			}
+			mockq.EXPECT().CreateRunEvent(ctx, mock.MatchedBy(func(arg repo.CreateRunEventParams) bool {
+				return arg.Type == "run.updated"
+			})).Return(repo.RunEvent{}, nil)
+			mockq.EXPECT().GetSubscribedWebhookEndpoints(ctx, repo.GetSubscribedWebhookEndpointsParams{
+				Event: "run.updated"}).Return(nil, nil)
+
+			got, err := updateRun(ctx, mockq, tt.actorID, 1, f)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.composition, got.Composition)
+			}
+		})
+	}
+}
+
+func Test_runService_CancelRun(t *testing.T) {
+	tests := []struct {
+		name    string
+		actorID int32
+		row     repo.GetRunByIDRow
+		rowErr  error
+		wantErr error
+	}{
+		{"CancelRun Not Organizer", 8, repo.GetRunByIDRow{Run: repo.Run{ID: 1, OrganizerID: 7, Status: "scheduled"}}, nil, ErrForbidden},
+		{"CancelRun Already Cancelled", 7, repo.GetRunByIDRow{Run: repo.Run{ID: 1, OrganizerID: 7, Status: "cancelled"}}, nil, ErrRunCancelled},
+		{"CancelRun Not Found", 7, repo.GetRunByIDRow{}, pgx.ErrNoRows, ErrRunNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			mockq.EXPECT().GetRunByID(ctx, int32(1)).Return(tt.row, tt.rowErr)
+			s := &runService{runRepo: mockq, now: time.Now}
+
+			_, err := s.CancelRun(ctx, tt.actorID, 1)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_cancelOrganizedRun(t *testing.T) {
+	tests := []struct {
+		name    string
+		actorID int32
+		status  RunStatus
+		wantErr error
+	}{
+		{"Started Meanwhile", 7, RunStatusInProgress, &TransitionError{From: RunStatusInProgress,
+			To: RunStatusCancelled}},
+		{"Completed Meanwhile", 7, RunStatusCompleted, &TransitionError{From: RunStatusCompleted,
+			To: RunStatusCancelled}},
+		{"Cancelled Meanwhile", 7, RunStatusCancelled, ErrRunCancelled},
+		{"Not Organizer", 8, RunStatusScheduled, ErrForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			mockq.EXPECT().LockRun(ctx, int32(1)).Return(repo.Run{ID: 1, OrganizerID: 7, Status: string(tt.status)}, nil)
+
+			_, err := cancelOrganizedRun(ctx, mockq, tt.actorID, 1)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func Test_cancelRun(t *testing.T) {
+	ctx := context.Background()
+	row := repo.GetRunByIDRow{
+		Run: repo.Run{ID: 1, OrganizerID: 7, Difficulty: "Mythic+", KeyLevel: pgtype.Int4{Int32: 12, Valid: true},
+			Status: "cancelled", StartsAt: pgTimestamptz(utc(2025, 3, 5, 1, 0))},
+		Dungeon: repo.Dungeon{ID: 3, Code: "ARAK", Name: "Ara-Kara, City of Echoes"},
+	}
+	mockq := repo.NewMockQuerier(t)
+	mockq.EXPECT().SetRunStatus(ctx, repo.SetRunStatusParams{ID: 1, Status: "cancelled"}).
+		Return(repo.Run{ID: 1, OrganizerID: 7, Status: "cancelled", Sequence: 2}, nil)
+	mockq.EXPECT().CreateRunEvent(ctx, mock.MatchedBy(func(arg repo.CreateRunEventParams) bool {
+		return arg.RunID == 1 && arg.Type == "run.cancelled" && arg.UserID.Int32 == 7
+	})).Return(repo.RunEvent{ID: 4, RunID: 1, Type: "run.cancelled"}, nil)
+	mockq.EXPECT().GetRunByID(ctx, int32(1)).Return(row, nil)
+	mockq.EXPECT().GetRunSignups(ctx, int32(1)).Return([]repo.GetRunSignupsRow{
+		{RunID: 1, UserID: 8, Role: "tank", Status: "confirmed"},
+		{RunID: 1, UserID: 9, Role: "dps", Status: "waitlisted"},
+	}, nil)
+	for _, userID := range []int32{8, 9} {
+		mockq.EXPECT().CreateNotification(ctx, repo.CreateNotificationParams{
+			UserID:  userID,
+			Type:    "run.cancelled",
+			RunID:   pgtype.Int4{Int32: 1, Valid: true},
+			Payload: []byte(`{"run_id":1,"title":"Ara-Kara, City of Echoes +12","starts_at":"2025-03-05T01:00:00Z"}`),
+		}).Return(nil)
+	}
+
+	mockq.EXPECT().GetSubscribedWebhookEndpoints(ctx, repo.GetSubscribedWebhookEndpointsParams{
+		Event: "run.cancelled"}).Return(nil, nil)
+
+	run := &Run{ID: 1, OrganizerID: 7, Status: RunStatusScheduled}
+	err := cancelRun(ctx, mockq, run)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, RunStatusCancelled, run.Status)
+	assert.Equal(t, int32(2), run.Sequence)
+}
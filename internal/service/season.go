@@ -0,0 +1,335 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// seasonOpenIndex is the unique index that keeps a single season open.
+const seasonOpenIndex = "seasons_open_idx"
+
+const (
+	// maxSeasonWeeks bounds the calendar of a season, and its affix rotation.
+	maxSeasonWeeks = 52
+	// maxWeekAffixes is how many affixes can be active in a week.
+	maxWeekAffixes = 4
+)
+
+// Season is a competitive season, like the dungeon catalog's "TWW-S1". Runs
+// count towards the season they were finished in. EndsAt is the planned end,
+// if the season was opened with one. A season is open until it is closed,
+// which sets EndsAt and freezes the guild leaderboards of the season.
+type Season struct {
+	ID        int32      `json:"id"`
+	Code      string     `json:"code"`
+	Name      string     `json:"name"`
+	StartsAt  time.Time  `json:"starts_at"`
+	EndsAt    *time.Time `json:"ends_at,omitempty"`
+	ClosedAt  *time.Time `json:"closed_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// Closed reports whether the season is over and its leaderboards frozen.
+func (s *Season) Closed() bool {
+	return s.ClosedAt != nil
+}
+
+// SeasonInput holds the fields used to open a season. AffixRotation lists the
+// codes of the affixes of each week, starting over once every week of it was
+// played. Without one the season takes its rotation from the embedded seed.
+type SeasonInput struct {
+	Code          string     `json:"code"`
+	Name          string     `json:"name"`
+	StartsAt      time.Time  `json:"starts_at"`
+	EndsAt        *time.Time `json:"ends_at"`
+	AffixRotation [][]string `json:"affix_rotation"`
+}
+
+// SeasonWeek is a week of a season's calendar, numbered from 1 for the week
+// the season starts in, with the affixes of the rotation active in it.
+type SeasonWeek struct {
+	Number int32 `json:"number"`
+	Week
+	Affixes []string `json:"affixes,omitempty"`
+}
+
+// SeasonCalendar is the weeks of a season, by the weekly reset of Region. It
+// runs until the end of the season, or until the current week while the
+// season has no planned end. CurrentWeek is set while now is in the calendar.
+type SeasonCalendar struct {
+	Season      *Season      `json:"season"`
+	Region      Region       `json:"region,omitempty"`
+	CurrentWeek *int32       `json:"current_week,omitempty"`
+	Weeks       []SeasonWeek `json:"weeks"`
+}
+
+// SeasonService is the interface for managing seasons.
+type SeasonService interface {
+	GetSeasons(ctx context.Context) ([]*Season, error)
+	CreateSeason(ctx context.Context, input *SeasonInput) (*Season, error)
+	CloseSeason(ctx context.Context, code string) (*Season, error)
+	SetAffixRotation(ctx context.Context, code string, rotation [][]string) error
+	GetCalendar(ctx context.Context, code string, region Region) (*SeasonCalendar, error)
+}
+
+// seasonService is the implementation of SeasonService.
+type seasonService struct {
+	dbPool     *pgxpool.Pool
+	seasonRepo repo.Querier
+	reset      WeeklyReset
+	now        func() time.Time
+}
+
+// NewSeasonService creates a new seasonService with the provided database connection pool
+// and the weekly reset calendars are laid out by unless a region is asked for.
+// It returns a pointer to the seasonService.
+func NewSeasonService(dbPool *pgxpool.Pool, reset WeeklyReset) *seasonService {
+	return &seasonService{
+		dbPool:     dbPool,
+		seasonRepo: repo.New(dbPool),
+		reset:      reset,
+		now:        time.Now,
+	}
+}
+
+// GetSeasons returns every season, the latest first.
+func (s *seasonService) GetSeasons(ctx context.Context) ([]*Season, error) {
+	rows, err := s.seasonRepo.GetSeasons(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	seasons := make([]*Season, 0, len(rows))
+	for _, row := range rows {
+		seasons = append(seasons, mapSeason(row))
+	}
+	return seasons, nil
+}
+
+// CreateSeason opens a new season. Returns ErrSeasonOpen while another season
+// is still open and ErrSeasonExists if the code is taken.
+func (s *seasonService) CreateSeason(ctx context.Context, input *SeasonInput) (*Season, error) {
+	if err := isValidSeasonInput(input); err != nil {
+		return nil, err
+	}
+
+	var season *Season
+	err := inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		var err error
+		season, err = createSeason(ctx, q, input)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return season, nil
+}
+
+// createSeason creates the season of a valid input with its affix rotation.
+// Returns ErrInvalidAffixRotation if the rotation lists unknown affixes.
+func createSeason(ctx context.Context, q repo.Querier, input *SeasonInput) (*Season, error) {
+	rotation := input.AffixRotation
+	var err error
+	if len(rotation) == 0 {
+		rotation, err = seedAffixRotation(input.Code)
+	} else {
+		err = checkAffixRotation(ctx, q, rotation)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	season, err := q.CreateSeason(ctx, repo.CreateSeasonParams{
+		Code:     input.Code,
+		Name:     strings.TrimSpace(input.Name),
+		StartsAt: pgTimestamptz(input.StartsAt),
+		EndsAt:   pgTimestamptzPtr(input.EndsAt),
+	})
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		if pgErr.ConstraintName == seasonOpenIndex {
+			return nil, ErrSeasonOpen
+		}
+		return nil, ErrSeasonExists
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := createAffixRotation(ctx, q, season.ID, rotation); err != nil {
+		return nil, err
+	}
+	return mapSeason(season), nil
+}
+
+// CloseSeason ends the season with code now and freezes the leaderboard of
+// every guild as it stands. Returns ErrSeasonClosed if it is already closed.
+func (s *seasonService) CloseSeason(ctx context.Context, code string) (*Season, error) {
+	var season *Season
+	err := inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		var err error
+		season, err = closeSeason(ctx, q, code, s.now())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return season, nil
+}
+
+// closeSeason closes the season with code at now, see CloseSeason.
+func closeSeason(ctx context.Context, q repo.Querier, code string, now time.Time) (*Season, error) {
+	season, err := loadSeason(ctx, q, code)
+	if err != nil {
+		return nil, err
+	}
+	if season.Closed() {
+		return nil, ErrSeasonClosed
+	}
+
+	closed, err := q.CloseSeason(ctx, repo.CloseSeasonParams{ID: season.ID, ClosedAt: pgTimestamptz(now)})
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Closed by someone else in the meantime.
+		return nil, ErrSeasonClosed
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	season = mapSeason(closed)
+	if err := freezeLeaderboards(ctx, q, season); err != nil {
+		return nil, err
+	}
+	return season, nil
+}
+
+// SetAffixRotation replaces the affix rotation of the season with code, or of
+// the open season if code is empty. Runs keep the affixes they started with.
+// Returns ErrInvalidAffixRotation if the rotation lists unknown affixes.
+func (s *seasonService) SetAffixRotation(ctx context.Context, code string, rotation [][]string) error {
+	if err := isValidAffixRotation(rotation); err != nil {
+		return err
+	}
+
+	return inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		season, err := loadSeason(ctx, q, code)
+		if err != nil {
+			return err
+		}
+		if err := checkAffixRotation(ctx, q, rotation); err != nil {
+			return err
+		}
+		return replaceAffixRotation(ctx, q, season.ID, rotation)
+	})
+}
+
+// GetCalendar returns the calendar of the season with code, or of the open
+// season if code is empty, by the weekly reset of region. An empty region lays
+// it out by the configured reset. Returns ErrInvalidRegion for an unknown
+// region.
+func (s *seasonService) GetCalendar(ctx context.Context, code string, region Region) (*SeasonCalendar, error) {
+	reset := s.reset
+	if region != "" {
+		var ok bool
+		if reset, ok = RegionReset(region); !ok {
+			return nil, ErrInvalidRegion
+		}
+		region = Region(strings.ToLower(string(region)))
+	}
+
+	season, err := loadSeason(ctx, s.seasonRepo, code)
+	if err != nil {
+		return nil, err
+	}
+	rotation, err := loadAffixRotation(ctx, s.seasonRepo, season.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar := newSeasonCalendar(season, rotation, reset, s.now())
+	calendar.Region = region
+	return calendar, nil
+}
+
+// newSeasonCalendar lays out the weeks of season by reset, see SeasonCalendar.
+func newSeasonCalendar(season *Season, rotation [][]string, reset WeeklyReset, now time.Time) *SeasonCalendar {
+	end := reset.CurrentWeek(now).EndsAt
+	if season.EndsAt != nil {
+		end = *season.EndsAt
+	}
+
+	calendar := &SeasonCalendar{Season: season}
+	week := reset.CurrentWeek(season.StartsAt)
+	for n := int32(1); n <= maxSeasonWeeks; n++ {
+		if n > 1 && !week.StartsAt.Before(end) {
+			break
+		}
+		seasonWeek := SeasonWeek{Number: n, Week: week, Affixes: rotationWeek(rotation, n)}
+		calendar.Weeks = append(calendar.Weeks, seasonWeek)
+		if week.Contains(now) {
+			calendar.CurrentWeek = &seasonWeek.Number
+		}
+		week = reset.CurrentWeek(week.EndsAt)
+	}
+	return calendar
+}
+
+// seasonWeekNumber returns the number of the week of season that t is in, by
+// reset. Times before the season are in its first week.
+func seasonWeekNumber(season *Season, reset WeeklyReset, t time.Time) int32 {
+	n := int32(1)
+	for week := reset.CurrentWeek(season.StartsAt); !t.Before(week.EndsAt); week = reset.CurrentWeek(week.EndsAt) {
+		n++
+	}
+	return n
+}
+
+// loadSeason loads the season with code, or the open season if code is empty.
+func loadSeason(ctx context.Context, q repo.Querier, code string) (*Season, error) {
+	var season repo.Season
+	var err error
+	if code == "" {
+		season, err = q.GetOpenSeason(ctx)
+	} else {
+		season, err = q.GetSeasonByCode(ctx, code)
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrSeasonNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return mapSeason(season), nil
+}
+
+var seasonCodeRegex = regexp.MustCompile(`^[A-Z0-9][A-Z0-9-]{1,15}$`)
+
+func isValidSeasonInput(input *SeasonInput) error {
+	if !seasonCodeRegex.MatchString(input.Code) || strings.TrimSpace(input.Name) == "" ||
+		input.StartsAt.IsZero() || (input.EndsAt != nil && !input.EndsAt.After(input.StartsAt)) {
+		return ErrInvalidSeason
+	}
+	return isValidAffixRotation(input.AffixRotation)
+}
+
+func mapSeason(s repo.Season) *Season {
+	return &Season{
+		ID:        s.ID,
+		Code:      s.Code,
+		Name:      s.Name,
+		StartsAt:  s.StartsAt.Time.UTC(),
+		EndsAt:    timestamptzPtr(s.EndsAt),
+		ClosedAt:  timestamptzPtr(s.ClosedAt),
+		CreatedAt: s.CreatedAt.Time,
+		UpdatedAt: s.UpdatedAt.Time,
+	}
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+func Test_createSeason(t *testing.T) {
+	startsAt := utc(2025, 3, 4, 15, 0)
+	endsAt := utc(2025, 8, 12, 15, 0)
+	catalog := []repo.Affix{{Code: "ASCENDANT"}, {Code: "DEVOUR"}, {Code: "FORTIFIED"}, {Code: "TYRANNICAL"}}
+	rotation := [][]string{{"ASCENDANT", "TYRANNICAL"}, {"DEVOUR", "FORTIFIED"}}
+	seed, err := seedAffixRotation("TWW-S1")
+	if !assert.NoError(t, err) || !assert.NotEmpty(t, seed) {
+		return
+	}
+
+	tests := []struct {
+		name         string
+		input        SeasonInput
+		createErr    error
+		wantRotation [][]string
+		wantErr      error
+	}{
+		{"Created", SeasonInput{Code: "TWW-S2", Name: "The War Within Season 2", StartsAt: startsAt}, nil, nil, nil},
+		{"Seeded Rotation", SeasonInput{Code: "TWW-S1", Name: "Season 1", StartsAt: startsAt}, nil, seed, nil},
+		{"Planned With Rotation", SeasonInput{Code: "TWW-S2", Name: "Season 2", StartsAt: startsAt, EndsAt: &endsAt,
+			AffixRotation: rotation}, nil, rotation, nil},
+		{"Unknown Affix", SeasonInput{Code: "TWW-S2", Name: "Season 2", StartsAt: startsAt,
+			AffixRotation: [][]string{{"ASCENDANT", "BURSTING"}}}, nil, nil, ErrInvalidAffixRotation},
+		{"Ends Before Start", SeasonInput{Code: "TWW-S2", Name: "Season 2", StartsAt: startsAt, EndsAt: &startsAt},
+			nil, nil, ErrInvalidSeason},
+		{"Empty Week", SeasonInput{Code: "TWW-S2", Name: "Season 2", StartsAt: startsAt,
+			AffixRotation: [][]string{{"TYRANNICAL"}, {}}}, nil, nil, ErrInvalidAffixRotation},
+		{"Too Many Affixes", SeasonInput{Code: "TWW-S2", Name: "Season 2", StartsAt: startsAt,
+			AffixRotation: [][]string{{"TYRANNICAL", "BOLSTERING", "SANGUINE", "STORMING", "THUNDERING"}}}, nil, nil,
+			ErrInvalidAffixRotation},
+		{"Duplicate Affix", SeasonInput{Code: "TWW-S2", Name: "Season 2", StartsAt: startsAt,
+			AffixRotation: [][]string{{"TYRANNICAL", "TYRANNICAL"}}}, nil, nil, ErrInvalidAffixRotation},
+		{"Lower Case Code", SeasonInput{Code: "tww-s2", Name: "Season 2", StartsAt: startsAt}, nil, nil,
+			ErrInvalidSeason},
+		{"No Start", SeasonInput{Code: "TWW-S2", Name: "Season 2"}, nil, nil, ErrInvalidSeason},
+		{"Another Open", SeasonInput{Code: "TWW-S2", Name: "Season 2", StartsAt: startsAt},
+			&pgconn.PgError{Code: "23505", ConstraintName: "seasons_open_idx"}, nil, ErrSeasonOpen},
+		{"Code Taken", SeasonInput{Code: "TWW-S2", Name: "Season 2", StartsAt: startsAt},
+			&pgconn.PgError{Code: "23505", ConstraintName: "seasons_code_key"}, nil, ErrSeasonExists},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			err := isValidSeasonInput(&tt.input)
+			if err == nil && len(tt.input.AffixRotation) > 0 {
+				mockq.EXPECT().GetAffixes(ctx).Return(catalog, nil)
+			}
+			if tt.wantErr == nil || tt.createErr != nil {
+				mockq.EXPECT().CreateSeason(ctx, repo.CreateSeasonParams{Code: tt.input.Code, Name: tt.input.Name,
+					StartsAt: pgTimestamptz(startsAt), EndsAt: pgTimestamptzPtr(tt.input.EndsAt)}).Return(repo.Season{ID: 2,
+					Code: tt.input.Code, StartsAt: pgTimestamptz(startsAt)}, tt.createErr)
+			}
+			for i, affixes := range tt.wantRotation {
+				mockq.EXPECT().CreateSeasonAffixes(ctx, repo.CreateSeasonAffixesParams{SeasonID: 2, Week: int32(i + 1),
+					Affixes: affixes}).Return(nil)
+			}
+
+			var season *Season
+			if err == nil {
+				season, err = createSeason(ctx, mockq, &tt.input)
+			}
+			if !assert.ErrorIs(t, err, tt.wantErr) || err != nil {
+				return
+			}
+			assert.False(t, season.Closed())
+		})
+	}
+}
+
+func Test_closeSeason(t *testing.T) {
+	ctx := context.Background()
+	now := utc(2025, 3, 4, 15, 0)
+	open := repo.Season{ID: 1, Code: "TWW-S1", StartsAt: pgTimestamptz(utc(2024, 9, 10, 15, 0))}
+
+	t.Run("Freezes Leaderboards", func(t *testing.T) {
+		closed := open
+		closed.EndsAt = pgTimestamptz(now)
+		closed.ClosedAt = pgTimestamptz(now)
+
+		mockq := repo.NewMockQuerier(t)
+		mockq.EXPECT().GetSeasonByCode(ctx, "TWW-S1").Return(open, nil)
+		mockq.EXPECT().CloseSeason(ctx, repo.CloseSeasonParams{ID: 1, ClosedAt: pgTimestamptz(now)}).Return(closed, nil)
+		mockq.EXPECT().GetGuilds(ctx).Return([]repo.Guild{{ID: 4, LeaderboardScoring: "key_level"}}, nil)
+		mockq.EXPECT().GetSeasonTimedRuns(ctx, repo.GetSeasonTimedRunsParams{GuildID: 4, StartsAt: open.StartsAt,
+			EndsAt: pgTimestamptz(now)}).Return([]repo.GetSeasonTimedRunsRow{
+			{UserID: 5, Username: "thrall", RunID: 9, DungeonCode: "ARAK", KeyLevel: 12, UpgradeLevel: 1,
+				ElapsedSeconds: 1700, ParSeconds: 1800},
+		}, nil)
+		mockq.EXPECT().CreateLeaderboardSnapshot(ctx, mock.MatchedBy(func(arg repo.CreateLeaderboardSnapshotParams) bool {
+			return arg.SeasonID == 1 && arg.GuildID == 4 && arg.UserID == 5 && arg.Rank == 1 && arg.Rating == 12 &&
+				arg.Scoring == "key_level"
+		})).Return(nil)
+
+		season, err := closeSeason(ctx, mockq, "TWW-S1", now)
+		if assert.NoError(t, err) {
+			assert.True(t, season.Closed())
+			assert.Equal(t, now, *season.EndsAt)
+		}
+	})
+
+	t.Run("Already Closed", func(t *testing.T) {
+		closed := open
+		closed.ClosedAt = pgTimestamptz(now)
+		mockq := repo.NewMockQuerier(t)
+		mockq.EXPECT().GetSeasonByCode(ctx, "TWW-S1").Return(closed, nil)
+
+		_, err := closeSeason(ctx, mockq, "TWW-S1", now)
+		assert.ErrorIs(t, err, ErrSeasonClosed)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		mockq := repo.NewMockQuerier(t)
+		mockq.EXPECT().GetSeasonByCode(ctx, "TWW-S9").Return(repo.Season{}, pgx.ErrNoRows)
+
+		_, err := closeSeason(ctx, mockq, "TWW-S9", now)
+		assert.ErrorIs(t, err, ErrSeasonNotFound)
+	})
+}
+
+func Test_newSeasonCalendar(t *testing.T) {
+	rotation := [][]string{{"Tyrannical"}, {"Fortified"}}
+	startsAt := utc(2026, 9, 15, 15, 0)
+	endsAt := utc(2026, 11, 3, 16, 0)
+	tests := []struct {
+		name        string
+		endsAt      *time.Time
+		reset       WeeklyReset
+		now         time.Time
+		wantWeeks   int
+		wantCurrent *int32
+		wantLast    Week
+	}{
+		// The US reset moves from 15:00 to 16:00 UTC when DST ends on Nov 1.
+		{"Planned End", &endsAt, regionResets[RegionUS], utc(2026, 10, 19, 12, 0), 7, int32Ptr(5),
+			Week{StartsAt: utc(2026, 10, 27, 15, 0), EndsAt: utc(2026, 11, 3, 16, 0)}},
+		{"Open Ended", nil, regionResets[RegionUS], utc(2026, 10, 19, 12, 0), 5, int32Ptr(5),
+			Week{StartsAt: utc(2026, 10, 13, 15, 0), EndsAt: utc(2026, 10, 20, 15, 0)}},
+		// The season starts a day before the EU reset, in the EU week before.
+		{"EU Region", &endsAt, regionResets[RegionEU], utc(2026, 12, 1, 12, 0), 8, nil,
+			Week{StartsAt: utc(2026, 10, 28, 4, 0), EndsAt: utc(2026, 11, 4, 4, 0)}},
+		{"Not Started", nil, regionResets[RegionUS], utc(2026, 9, 1, 12, 0), 1, nil,
+			Week{StartsAt: utc(2026, 9, 15, 15, 0), EndsAt: utc(2026, 9, 22, 15, 0)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			season := &Season{ID: 3, Code: "MN-S1", StartsAt: startsAt, EndsAt: tt.endsAt}
+
+			calendar := newSeasonCalendar(season, rotation, tt.reset, tt.now)
+			if !assert.Len(t, calendar.Weeks, tt.wantWeeks) {
+				return
+			}
+			assert.Equal(t, tt.wantCurrent, calendar.CurrentWeek)
+			last := calendar.Weeks[len(calendar.Weeks)-1]
+			assert.Equal(t, tt.wantLast, last.Week)
+			assert.Equal(t, rotation[(tt.wantWeeks-1)%2], last.Affixes)
+		})
+	}
+}
+
+func Test_seasonService_GetCalendar(t *testing.T) {
+	ctx := context.Background()
+	now := utc(2026, 10, 19, 12, 0)
+	season := repo.Season{ID: 3, Code: "MN-S1", StartsAt: pgTimestamptz(utc(2026, 9, 15, 15, 0))}
+
+	t.Run("Region", func(t *testing.T) {
+		mockq := repo.NewMockQuerier(t)
+		mockq.EXPECT().GetOpenSeason(ctx).Return(season, nil)
+		mockq.EXPECT().GetSeasonAffixes(ctx, int32(3)).Return([]repo.SeasonAffix{
+			{SeasonID: 3, Week: 1, Affixes: []string{"Tyrannical"}},
+		}, nil)
+		s := &seasonService{seasonRepo: mockq, reset: DefaultWeeklyReset, now: func() time.Time { return now }}
+
+		calendar, err := s.GetCalendar(ctx, "", "EU")
+		if assert.NoError(t, err) {
+			assert.Equal(t, RegionEU, calendar.Region)
+			assert.Equal(t, utc(2026, 9, 9, 3, 0), calendar.Weeks[0].StartsAt)
+			assert.Equal(t, []string{"Tyrannical"}, calendar.Weeks[0].Affixes)
+		}
+	})
+
+	t.Run("Unknown Region", func(t *testing.T) {
+		s := &seasonService{seasonRepo: repo.NewMockQuerier(t), reset: DefaultWeeklyReset, now: func() time.Time { return now }}
+
+		_, err := s.GetCalendar(ctx, "", "kr")
+		assert.ErrorIs(t, err, ErrInvalidRegion)
+	})
+}
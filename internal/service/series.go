@@ -0,0 +1,657 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/teambition/rrule-go"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// maxOccurrenceWindow is the longest range of occurrences that can be listed at once.
+// Occurrences are only created as runs when they are listed, so this also bounds
+// how many runs a single request can create.
+const maxOccurrenceWindow = 92 * 24 * time.Hour
+
+// seriesExceptionKind is how an occurrence deviates from its series.
+type seriesExceptionKind string
+
+const (
+	exceptionSkip     = seriesExceptionKind("skip")
+	exceptionOverride = seriesExceptionKind("override")
+)
+
+// Series is a recurring run. Its occurrences are produced by an RFC 5545
+// recurrence rule evaluated in Timezone, starting at StartsAt, so a weekly
+// series keeps its wall clock start time across daylight saving changes.
+// UntilAt is set when the series was split and no longer produces
+// occurrences starting at or after it.
+type Series struct {
+	ID              int32       `json:"id"`
+	Dungeon         *Dungeon    `json:"dungeon"`
+	Difficulty      Difficulty  `json:"difficulty"`
+	KeyLevel        *int32      `json:"key_level,omitempty"`
+	OrganizerID     int32       `json:"organizer_id"`
+	RRule           string      `json:"rrule"`
+	StartsAt        time.Time   `json:"starts_at"`
+	Timezone        string      `json:"timezone"`
+	UntilAt         *time.Time  `json:"until_at,omitempty"`
+	DurationMinutes int32       `json:"duration_minutes"`
+	Notes           string      `json:"notes"`
+	Composition     Composition `json:"composition"`
+	GuildID         *int32      `json:"guild_id,omitempty"`
+	CreatedAt       time.Time   `json:"created_at"`
+	UpdatedAt       time.Time   `json:"updated_at"`
+}
+
+// Location returns the timezone the series is scheduled in.
+// Falls back to UTC if the stored timezone can not be loaded.
+func (s *Series) Location() *time.Location {
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// SeriesInput holds the client provided fields used to create a series.
+// StartsAt is the start of the first occurrence and RRule is a recurrence rule
+// such as "FREQ=WEEKLY;BYDAY=TU", with or without the "RRULE:" prefix.
+// When splitting a series, empty fields default to the series being split and
+// StartsAt defaults to the occurrence the split starts at.
+type SeriesInput struct {
+	RunInput
+	RRule string `json:"rrule"`
+}
+
+// SeriesService is the interface for scheduling recurring runs.
+type SeriesService interface {
+	CreateSeries(context.Context, int32, *SeriesInput) (*Series, error)
+	GetSeriesByID(context.Context, int32) (*Series, error)
+	GetOccurrences(context.Context, int32, time.Time, time.Time) ([]*Run, error)
+	SkipOccurrence(context.Context, int32, int32, time.Time) error
+	OverrideOccurrence(context.Context, int32, int32, time.Time, *RunInput) (*Run, error)
+	SplitSeries(context.Context, int32, int32, time.Time, *SeriesInput) (*Series, error)
+}
+
+// seriesService is the implementation of SeriesService.
+// Run validation is shared with runs, so it embeds a runService using the same repo.
+type seriesService struct {
+	dbPool     *pgxpool.Pool
+	seriesRepo repo.Querier
+	runs       *runService
+	now        func() time.Time
+}
+
+// NewSeriesService creates a new seriesService with the provided database connection pool.
+// It returns a pointer to the seriesService.
+func NewSeriesService(dbPool *pgxpool.Pool) *seriesService {
+	// Series never start runs, so the weekly reset goes unused.
+	return &seriesService{
+		dbPool:     dbPool,
+		seriesRepo: repo.New(dbPool),
+		runs:       NewRunService(dbPool, DefaultWeeklyReset),
+		now:        time.Now,
+	}
+}
+
+// CreateSeries schedules a new recurring run organized by the user with organizerID.
+// The timezone defaults to the organizer's timezone and the first occurrence
+// must be in the future.
+func (s *seriesService) CreateSeries(ctx context.Context, organizerID int32, input *SeriesInput) (*Series, error) {
+	organizer, err := s.seriesRepo.GetUserByID(ctx, organizerID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	timezone := input.Timezone
+	if timezone == "" {
+		timezone = organizer.Timezone
+	}
+
+	f, rule, err := s.validateSeriesInput(ctx, input, timezone, DefaultComposition)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRunGuild(ctx, s.seriesRepo, f.guildID, organizerID); err != nil {
+		return nil, err
+	}
+
+	r, err := s.seriesRepo.CreateSeries(ctx, createSeriesParams(organizerID, f, rule))
+	if err != nil {
+		return nil, err
+	}
+	return mapSeries(r, f.dungeon), nil
+}
+
+// GetSeriesByID returns a series by ID. Returns ErrSeriesNotFound if it does not exist.
+func (s *seriesService) GetSeriesByID(ctx context.Context, id int32) (*Series, error) {
+	row, err := s.seriesRepo.GetSeriesByID(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrSeriesNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return mapSeries(row.RunSeries, row.Dungeon), nil
+}
+
+// GetOccurrences returns the runs of a series starting in [from, to) that have
+// not been skipped or cancelled. Upcoming occurrences are created as runs the
+// first time they are listed so people can sign up for them.
+func (s *seriesService) GetOccurrences(ctx context.Context, id int32, from, to time.Time) ([]*Run, error) {
+	if !to.After(from) || to.Sub(from) > maxOccurrenceWindow {
+		return nil, ErrInvalidTimeRange
+	}
+
+	series, err := s.GetSeriesByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	rec, err := newRecurrence(series.RRule, series.StartsAt, series.Location(), series.UntilAt)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	err = inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		for _, occurrence := range rec.Between(from, to) {
+			if !occurrence.After(now) {
+				continue
+			}
+			if err := materializeSeriesRun(ctx, q, id, occurrence); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.seriesRepo.GetSeriesRuns(ctx, repo.GetSeriesRunsParams{
+		SeriesID:     pgInt4(&id),
+		StartsAfter:  pgTimestamptz(from),
+		StartsBefore: pgTimestamptz(to),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var runs []*Run
+	for _, row := range rows {
+		runs = append(runs, mapRun(row.Run, row.Dungeon))
+	}
+	return runs, nil
+}
+
+// SkipOccurrence cancels a single occurrence of a series, whether or not it
+// has been created as a run yet. Only the organizer can skip occurrences.
+func (s *seriesService) SkipOccurrence(ctx context.Context, actorID, id int32, occurrence time.Time) error {
+	if _, err := s.organizedOccurrence(ctx, actorID, id, occurrence); err != nil {
+		return err
+	}
+
+	return inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		err := q.UpsertSeriesException(ctx, repo.UpsertSeriesExceptionParams{
+			SeriesID:     id,
+			OccurrenceAt: pgTimestamptz(occurrence),
+			Kind:         string(exceptionSkip),
+		})
+		if err != nil {
+			return err
+		}
+		return cancelSeriesRun(ctx, q, id, occurrence)
+	})
+}
+
+// cancelSeriesRun cancels the run created for occurrence of the series with id
+// like any other run, see cancelRun. Nothing is cancelled if there is no run
+// for the occurrence yet or it is no longer scheduled.
+func cancelSeriesRun(ctx context.Context, q repo.Querier, id int32, occurrence time.Time) error {
+	locked, err := q.LockSeriesRun(ctx, repo.LockSeriesRunParams{
+		SeriesID:     pgInt4(&id),
+		OccurrenceAt: pgTimestamptz(occurrence),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !RunStatus(locked.Status).Open() {
+		return nil
+	}
+
+	row, err := q.GetRunByID(ctx, locked.ID)
+	if err != nil {
+		return err
+	}
+	return cancelRun(ctx, q, mapRun(row.Run, row.Dungeon))
+}
+
+// OverrideOccurrence changes a single occurrence of a series without changing
+// the rest of the series. The occurrence is updated like any other run, see
+// RunService.UpdateRun.
+func (s *seriesService) OverrideOccurrence(ctx context.Context, actorID, id int32, occurrence time.Time,
+	input *RunInput) (*Run, error) {
+	if _, err := s.organizedOccurrence(ctx, actorID, id, occurrence); err != nil {
+		return nil, err
+	}
+
+	err := inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		return materializeSeriesRun(ctx, q, id, occurrence)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	run, err := s.seriesRepo.GetSeriesRun(ctx, repo.GetSeriesRunParams{
+		SeriesID:     pgInt4(&id),
+		OccurrenceAt: pgTimestamptz(occurrence),
+	})
+	// Skipped occurrences are never created as runs.
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrRunCancelled
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.runs.UpdateRun(ctx, actorID, run.ID, input)
+}
+
+// SplitSeries changes an occurrence and every occurrence after it by ending the
+// series before occurrence and starting a new series from it, see splitSeries.
+// Only the organizer can split a series.
+func (s *seriesService) SplitSeries(ctx context.Context, actorID, id int32, occurrence time.Time,
+	input *SeriesInput) (*Series, error) {
+	series, err := s.organizedOccurrence(ctx, actorID, id, occurrence)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := *input
+	if merged.RRule == "" {
+		merged.RRule = series.RRule
+	}
+	if merged.StartsAt == "" {
+		merged.StartsAt = occurrence.Format(time.RFC3339)
+	}
+	timezone := merged.Timezone
+	if timezone == "" {
+		timezone = series.Timezone
+	}
+
+	f, rule, err := s.validateSeriesInput(ctx, &merged, timezone, series.Composition)
+	if err != nil {
+		return nil, err
+	}
+	if f.guildID == nil {
+		f.guildID = series.GuildID
+	} else if err := checkRunGuild(ctx, s.seriesRepo, f.guildID, actorID); err != nil {
+		return nil, err
+	}
+
+	var created *Series
+	err = inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		created, err = splitSeries(ctx, q, actorID, id, occurrence, f, rule)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// splitSeries ends the series with id before occurrence and creates the series
+// of f and rule, organized by actorID, to take over from it. Skips from
+// occurrence on carry over to the new series, and so do the runs created for
+// the old series, with their signups, see moveSeriesRun. Skips and runs at a
+// time the new series does not produce go to its occurrence on the same day.
+func splitSeries(ctx context.Context, q repo.Querier, actorID, id int32, occurrence time.Time, f *runFields,
+	rule string) (*Series, error) {
+	err := q.EndSeries(ctx, repo.EndSeriesParams{
+		ID:      id,
+		UntilAt: pgTimestamptz(occurrence),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := q.CreateSeries(ctx, createSeriesParams(actorID, f, rule))
+	if err != nil {
+		return nil, err
+	}
+	created := mapSeries(r, f.dungeon)
+	rec, err := newRecurrence(created.RRule, created.StartsAt, created.Location(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	skips, err := q.GetSeriesSkipsFrom(ctx, repo.GetSeriesSkipsFromParams{
+		SeriesID:     id,
+		OccurrenceAt: pgTimestamptz(occurrence),
+	})
+	if err != nil {
+		return nil, err
+	}
+	rows, err := q.GetSeriesRunsFrom(ctx, repo.GetSeriesRunsFromParams{
+		SeriesID:     pgInt4(&id),
+		OccurrenceAt: pgTimestamptz(occurrence),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Each occurrence of the new series is held by one run or skip at most.
+	// Runs that keep their occurrence hold it first, then the skips and the
+	// occurrences of runs that were cancelled or have started are carried over,
+	// and the other runs move into what is left.
+	taken := make(map[int64]bool)
+	var moving []repo.GetSeriesRunsFromRow
+	for _, row := range rows {
+		open := RunStatus(row.Run.Status).Open()
+		if open && (row.Overridden || rec.Includes(row.Run.OccurrenceAt.Time)) {
+			taken[row.Run.OccurrenceAt.Time.Unix()] = true
+		}
+		if open {
+			moving = append(moving, row)
+			continue
+		}
+		skips = append(skips, row.Run.OccurrenceAt)
+	}
+	for _, skip := range skips {
+		if err := skipSeriesOccurrence(ctx, q, created.ID, rec, skip.Time, taken); err != nil {
+			return nil, err
+		}
+	}
+	for _, row := range moving {
+		if err := moveSeriesRun(ctx, q, actorID, created, rec, f, row, taken); err != nil {
+			return nil, err
+		}
+	}
+	return created, nil
+}
+
+// moveSeriesRun hands a scheduled run of a series that was split over to
+// series, the new series with recurrence rec and fields f. The run keeps its
+// signups: if it is at an occurrence of series, or series has an occurrence
+// that is not taken on the same day, it starts then and takes on f. Runs that
+// were overridden or that f no longer fits become overrides of series instead.
+// Runs on a day series has no free occurrence on are cancelled. The
+// occurrence the run moves to is added to taken.
+func moveSeriesRun(ctx context.Context, q repo.Querier, actorID int32, series *Series, rec *recurrence,
+	f *runFields, row repo.GetSeriesRunsFromRow, taken map[int64]bool) error {
+	run := mapRun(row.Run, row.Dungeon)
+	occurrence := row.Run.OccurrenceAt.Time
+	if !row.Overridden && !rec.Includes(occurrence) {
+		moved, ok := rec.SameDay(occurrence, taken)
+		if !ok {
+			return cancelRun(ctx, q, run)
+		}
+		taken[moved.Unix()] = true
+		occurrence = moved
+	}
+
+	err := q.MoveSeriesRun(ctx, repo.MoveSeriesRunParams{
+		ID:           run.ID,
+		ToSeriesID:   pgInt4(&series.ID),
+		OccurrenceAt: pgTimestamptz(occurrence),
+	})
+	if err != nil {
+		return err
+	}
+	if !row.Overridden {
+		fields := *f
+		fields.startsAt = occurrence
+		_, err := updateRun(ctx, q, actorID, run.ID, &fields)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, ErrInvalidComposition) {
+			return err
+		}
+	}
+	return q.UpsertSeriesException(ctx, repo.UpsertSeriesExceptionParams{
+		SeriesID:     series.ID,
+		OccurrenceAt: pgTimestamptz(occurrence),
+		Kind:         string(exceptionOverride),
+	})
+}
+
+// materializeSeriesRun creates the run for occurrence of series, unless it
+// already exists or the occurrence was skipped. Like any new run, a run that is
+// created is announced and emitted as a run.created webhook event, see runCreated.
+func materializeSeriesRun(ctx context.Context, q repo.Querier, id int32, occurrence time.Time) error {
+	r, err := q.MaterializeSeriesRun(ctx, repo.MaterializeSeriesRunParams{
+		SeriesID:     id,
+		OccurrenceAt: pgTimestamptz(occurrence),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	row, err := q.GetRunByID(ctx, r.ID)
+	if err != nil {
+		return err
+	}
+	return runCreated(ctx, q, mapRun(row.Run, row.Dungeon))
+}
+
+// skipSeriesOccurrence skips occurrence in the series with id and recurrence
+// rec, or the occurrence on the same day if rec does not produce occurrence,
+// see recurrence.SameDay. Nothing is skipped if there is no such occurrence
+// that is not taken. The skipped occurrence is added to taken.
+func skipSeriesOccurrence(ctx context.Context, q repo.Querier, id int32, rec *recurrence,
+	occurrence time.Time, taken map[int64]bool) error {
+	if !rec.Includes(occurrence) {
+		moved, ok := rec.SameDay(occurrence, taken)
+		if !ok {
+			return nil
+		}
+		occurrence = moved
+	}
+	taken[occurrence.Unix()] = true
+	return q.UpsertSeriesException(ctx, repo.UpsertSeriesExceptionParams{
+		SeriesID:     id,
+		OccurrenceAt: pgTimestamptz(occurrence),
+		Kind:         string(exceptionSkip),
+	})
+}
+
+// organizedOccurrence loads a series that actorID is allowed to change and checks
+// that occurrence is one of its occurrences that has not started yet.
+func (s *seriesService) organizedOccurrence(ctx context.Context, actorID, id int32,
+	occurrence time.Time) (*Series, error) {
+	series, err := s.GetSeriesByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if series.OrganizerID != actorID {
+		return nil, ErrForbidden
+	}
+
+	rec, err := newRecurrence(series.RRule, series.StartsAt, series.Location(), series.UntilAt)
+	if err != nil {
+		return nil, err
+	}
+	if !rec.Includes(occurrence) {
+		return nil, ErrOccurrenceNotFound
+	}
+	if !occurrence.After(s.now()) {
+		return nil, ErrRunInPast
+	}
+	return series, nil
+}
+
+// validateSeriesInput validates the run fields of a series like a single run,
+// then checks the recurrence rule.
+func (s *seriesService) validateSeriesInput(ctx context.Context, input *SeriesInput, timezone string,
+	composition Composition) (*runFields, string, error) {
+	f, err := s.runs.validateRunInput(ctx, &input.RunInput, timezone, composition)
+	if err != nil {
+		return nil, "", err
+	}
+	if !f.startsAt.After(s.now()) {
+		return nil, "", ErrRunInPast
+	}
+
+	rule := strings.TrimPrefix(strings.TrimSpace(input.RRule), "RRULE:")
+	loc, _ := time.LoadLocation(f.timezone)
+	if _, err := newRecurrence(rule, f.startsAt, loc, nil); err != nil {
+		return nil, "", err
+	}
+	return f, rule, nil
+}
+
+// recurrence expands a recurrence rule into start times.
+// The rule is evaluated in floating time, the wall clock of loc stored as UTC,
+// and every occurrence is then placed in loc. Doing it this way keeps daylight
+// saving handling in one place, see localize.
+type recurrence struct {
+	rule    *rrule.RRule
+	loc     *time.Location
+	untilAt *time.Time
+}
+
+// newRecurrence parses rule as the recurrence of a series starting at startsAt in loc.
+// Rules more frequent than daily and rules that set their own start are rejected.
+func newRecurrence(rule string, startsAt time.Time, loc *time.Location, untilAt *time.Time) (*recurrence, error) {
+	if strings.Contains(rule, "DTSTART") {
+		return nil, fmt.Errorf("%w: the start comes from starts_at", ErrInvalidRRule)
+	}
+
+	opt, err := rrule.StrToROption(rule)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRRule, err)
+	}
+	if opt.Freq > rrule.DAILY {
+		return nil, fmt.Errorf("%w: runs can repeat at most daily", ErrInvalidRRule)
+	}
+
+	opt.Dtstart = floating(startsAt, loc)
+	r, err := rrule.NewRRule(*opt)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRRule, err)
+	}
+	return &recurrence{rule: r, loc: loc, untilAt: untilAt}, nil
+}
+
+// Between returns the occurrences starting in [from, to), in UTC and in order.
+func (r *recurrence) Between(from, to time.Time) []time.Time {
+	// UTC offsets are at most 14 hours, so a day either side of the window
+	// in floating time covers every occurrence that can land inside it.
+	after := floating(from, r.loc).Add(-24 * time.Hour)
+	before := floating(to, r.loc).Add(24 * time.Hour)
+
+	var occurrences []time.Time
+	for _, wall := range r.rule.Between(after, before, true) {
+		t := localize(wall, r.loc)
+		if t.Before(from) || !t.Before(to) {
+			continue
+		}
+		if r.untilAt != nil && !t.Before(*r.untilAt) {
+			continue
+		}
+		occurrences = append(occurrences, t)
+	}
+	return occurrences
+}
+
+// Includes reports whether t is exactly one of the occurrences.
+func (r *recurrence) Includes(t time.Time) bool {
+	occurrences := r.Between(t, t.Add(time.Second))
+	return len(occurrences) == 1 && occurrences[0].Equal(t)
+}
+
+// SameDay returns the occurrence closest to t on the same day as t in the
+// timezone of the recurrence, leaving out occurrences whose Unix time is in
+// taken. It reports false if there is none.
+func (r *recurrence) SameDay(t time.Time, taken map[int64]bool) (time.Time, bool) {
+	l := t.In(r.loc)
+	day := time.Date(l.Year(), l.Month(), l.Day(), 0, 0, 0, 0, r.loc)
+
+	var closest time.Time
+	found := false
+	for _, occurrence := range r.Between(day, day.AddDate(0, 0, 1)) {
+		if taken[occurrence.Unix()] {
+			continue
+		}
+		if !found || occurrence.Sub(t).Abs() < closest.Sub(t).Abs() {
+			closest, found = occurrence, true
+		}
+	}
+	return closest, found
+}
+
+// floating returns the wall clock time of t in loc as a UTC time.
+func floating(t time.Time, loc *time.Location) time.Time {
+	l := t.In(loc)
+	return time.Date(l.Year(), l.Month(), l.Day(), l.Hour(), l.Minute(), l.Second(), 0, time.UTC)
+}
+
+// localize returns the instant a floating wall clock time happens in loc, in UTC.
+// Ambiguous times on the day clocks fall back resolve to the earlier instant,
+// see wallClockTime. Times that do not exist because clocks spring forward are
+// interpreted with the UTC offset from before the gap as RFC 5545 requires, so
+// a 02:30 occurrence in New York happens at 03:30 daylight time.
+func localize(wall time.Time, loc *time.Location) time.Time {
+	if t, ok := wallClockTime(wall, loc); ok {
+		return t
+	}
+
+	t := time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), 0, loc)
+	_, offset := t.Add(-24 * time.Hour).Zone()
+	return wall.Add(-time.Duration(offset) * time.Second)
+}
+
+func createSeriesParams(organizerID int32, f *runFields, rule string) repo.CreateSeriesParams {
+	return repo.CreateSeriesParams{
+		OrganizerID:     organizerID,
+		DungeonID:       f.dungeon.ID,
+		Difficulty:      string(f.difficulty),
+		KeyLevel:        pgInt4(f.keyLevel),
+		Rrule:           rule,
+		Timezone:        f.timezone,
+		StartsAt:        pgTimestamptz(f.startsAt),
+		DurationMinutes: f.durationMinutes,
+		Notes:           f.notes,
+		TankSlots:       f.composition.Tanks,
+		HealerSlots:     f.composition.Healers,
+		DpsSlots:        f.composition.DPS,
+		GuildID:         pgInt4(f.guildID),
+	}
+}
+
+func mapSeries(r repo.RunSeries, d repo.Dungeon) *Series {
+	return &Series{
+		ID:              r.ID,
+		Dungeon:         mapDungeon(d),
+		Difficulty:      Difficulty(r.Difficulty),
+		KeyLevel:        int4Ptr(r.KeyLevel),
+		OrganizerID:     r.OrganizerID,
+		RRule:           r.Rrule,
+		StartsAt:        r.StartsAt.Time.UTC(),
+		Timezone:        r.Timezone,
+		UntilAt:         timestamptzPtr(r.UntilAt),
+		DurationMinutes: r.DurationMinutes,
+		Notes:           r.Notes,
+		Composition:     Composition{Tanks: r.TankSlots, Healers: r.HealerSlots, DPS: r.DpsSlots},
+		GuildID:         int4Ptr(r.GuildID),
+		CreatedAt:       r.CreatedAt.Time,
+		UpdatedAt:       r.UpdatedAt.Time,
+	}
+}
@@ -0,0 +1,449 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+func utc(year int, month time.Month, day, hour, min int) time.Time {
+	return time.Date(year, month, day, hour, min, 0, 0, time.UTC)
+}
+
+func Test_recurrence_Between(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+	cutoff := utc(2025, 3, 12, 0, 0)
+
+	tests := []struct {
+		name     string
+		rule     string
+		startsAt time.Time
+		loc      *time.Location
+		untilAt  *time.Time
+		from     time.Time
+		to       time.Time
+		want     []time.Time
+	}{
+		{
+			"Weekly Keeps Wall Clock Across Spring Forward",
+			"FREQ=WEEKLY;BYDAY=TU", utc(2025, 3, 5, 1, 0), newYork, nil,
+			utc(2025, 3, 1, 0, 0), utc(2025, 3, 20, 0, 0),
+			[]time.Time{utc(2025, 3, 5, 1, 0), utc(2025, 3, 12, 0, 0), utc(2025, 3, 19, 0, 0)},
+		},
+		{
+			"Weekly Keeps Wall Clock Across Fall Back",
+			"FREQ=WEEKLY;BYDAY=TU", utc(2025, 10, 29, 0, 0), newYork, nil,
+			utc(2025, 10, 28, 0, 0), utc(2025, 11, 6, 0, 0),
+			[]time.Time{utc(2025, 10, 29, 0, 0), utc(2025, 11, 5, 1, 0)},
+		},
+		{
+			"Weekly Europe Changes On A Different Date",
+			"FREQ=WEEKLY", utc(2025, 3, 23, 19, 0), berlin, nil,
+			utc(2025, 3, 20, 0, 0), utc(2025, 4, 1, 0, 0),
+			[]time.Time{utc(2025, 3, 23, 19, 0), utc(2025, 3, 30, 18, 0)},
+		},
+		{
+			"Daily In Spring Forward Gap Uses Offset Before Gap",
+			"FREQ=DAILY", utc(2025, 3, 8, 7, 30), newYork, nil,
+			utc(2025, 3, 8, 0, 0), utc(2025, 3, 11, 0, 0),
+			[]time.Time{utc(2025, 3, 8, 7, 30), utc(2025, 3, 9, 7, 30), utc(2025, 3, 10, 6, 30)},
+		},
+		{
+			"Daily In Fall Back Overlap Uses First",
+			"FREQ=DAILY", utc(2025, 11, 1, 5, 30), newYork, nil,
+			utc(2025, 11, 1, 0, 0), utc(2025, 11, 4, 0, 0),
+			[]time.Time{utc(2025, 11, 1, 5, 30), utc(2025, 11, 2, 5, 30), utc(2025, 11, 3, 6, 30)},
+		},
+		{
+			"Daily In Fall Back Overlap Europe Uses First",
+			"FREQ=DAILY", utc(2025, 10, 25, 0, 30), berlin, nil,
+			utc(2025, 10, 25, 0, 0), utc(2025, 10, 28, 0, 0),
+			[]time.Time{utc(2025, 10, 25, 0, 30), utc(2025, 10, 26, 0, 30), utc(2025, 10, 27, 1, 30)},
+		},
+		{
+			"Count Limits Occurrences",
+			"FREQ=WEEKLY;COUNT=2", utc(2025, 3, 5, 1, 0), newYork, nil,
+			utc(2025, 3, 1, 0, 0), utc(2025, 4, 1, 0, 0),
+			[]time.Time{utc(2025, 3, 5, 1, 0), utc(2025, 3, 12, 0, 0)},
+		},
+		{
+			"Until At Is Exclusive",
+			"FREQ=WEEKLY", utc(2025, 3, 5, 1, 0), newYork, &cutoff,
+			utc(2025, 3, 1, 0, 0), utc(2025, 4, 1, 0, 0),
+			[]time.Time{utc(2025, 3, 5, 1, 0)},
+		},
+		{
+			"Window Is Half Open",
+			"FREQ=WEEKLY", utc(2025, 3, 5, 1, 0), newYork, nil,
+			utc(2025, 3, 5, 1, 0), utc(2025, 3, 12, 0, 0),
+			[]time.Time{utc(2025, 3, 5, 1, 0)},
+		},
+		{
+			"Window Before Start",
+			"FREQ=WEEKLY", utc(2025, 3, 5, 1, 0), newYork, nil,
+			utc(2025, 2, 1, 0, 0), utc(2025, 3, 1, 0, 0),
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, err := newRecurrence(tt.rule, tt.startsAt, tt.loc, tt.untilAt)
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, tt.want, rec.Between(tt.from, tt.to))
+		})
+	}
+}
+
+func Test_newRecurrence(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    string
+		wantErr error
+	}{
+		{"Weekly", "FREQ=WEEKLY;BYDAY=TU,TH", nil},
+		{"Daily With Interval", "FREQ=DAILY;INTERVAL=2", nil},
+		{"Monthly", "FREQ=MONTHLY;BYDAY=1TU", nil},
+		{"Hourly", "FREQ=HOURLY", ErrInvalidRRule},
+		{"Missing Frequency", "BYDAY=TU", ErrInvalidRRule},
+		{"Unknown Property", "FREQ=WEEKLY;EVERY=TU", ErrInvalidRRule},
+		{"Own Start", "DTSTART:20250101T000000Z\nRRULE:FREQ=WEEKLY", ErrInvalidRRule},
+		{"Empty", "", ErrInvalidRRule},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newRecurrence(tt.rule, utc(2025, 3, 5, 1, 0), time.UTC, nil)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_recurrence_Includes(t *testing.T) {
+	rec, err := newRecurrence("FREQ=WEEKLY", utc(2025, 3, 5, 1, 0), mustLoadLocation(t, "America/New_York"), nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.True(t, rec.Includes(utc(2025, 3, 12, 0, 0)))
+	assert.False(t, rec.Includes(utc(2025, 3, 12, 1, 0)), "wall clock shifted by DST is not an occurrence")
+	assert.False(t, rec.Includes(utc(2025, 2, 26, 1, 0)), "before the series starts")
+}
+
+func Test_seriesService_CreateSeries(t *testing.T) {
+	now := utc(2025, 3, 1, 12, 0)
+	dungeon := repo.Dungeon{ID: 3, Code: "ARAK", Active: true, ParSeconds: 1800,
+		Difficulties: []string{"Mythic", "Mythic+"}}
+	organizer := repo.GetUserByIDRow{ID: 7, Timezone: "America/New_York"}
+
+	tests := []struct {
+		name    string
+		input   *SeriesInput
+		want    repo.CreateSeriesParams
+		wantErr error
+	}{
+		{
+			"CreateSeries Strips Prefix",
+			&SeriesInput{RunInput: RunInput{DungeonCode: "ARAK", Difficulty: DifficultyMythic,
+				StartsAt: "2025-03-04T20:00", DurationMinutes: 60}, RRule: "RRULE:FREQ=WEEKLY;BYDAY=TU"},
+			repo.CreateSeriesParams{OrganizerID: 7, DungeonID: 3, Difficulty: "Mythic", Rrule: "FREQ=WEEKLY;BYDAY=TU",
+				Timezone: "America/New_York", StartsAt: pgTimestamptz(utc(2025, 3, 5, 1, 0)),
+				DurationMinutes: 60, TankSlots: 1, HealerSlots: 1, DpsSlots: 3},
+			nil,
+		},
+		{
+			"CreateSeries Invalid Rule",
+			&SeriesInput{RunInput: RunInput{DungeonCode: "ARAK", Difficulty: DifficultyMythic,
+				StartsAt: "2025-03-04T20:00", DurationMinutes: 60}, RRule: "FREQ=MINUTELY"},
+			repo.CreateSeriesParams{},
+			ErrInvalidRRule,
+		},
+		{
+			"CreateSeries In The Past",
+			&SeriesInput{RunInput: RunInput{DungeonCode: "ARAK", Difficulty: DifficultyMythic,
+				StartsAt: "2025-02-25T20:00", DurationMinutes: 60}, RRule: "FREQ=WEEKLY"},
+			repo.CreateSeriesParams{},
+			ErrRunInPast,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			mockq.EXPECT().GetUserByID(ctx, organizer.ID).Return(organizer, nil)
+			mockq.EXPECT().GetDungeonByCode(ctx, "ARAK").Return(dungeon, nil)
+			if tt.wantErr == nil {
+				mockq.EXPECT().CreateSeries(ctx, tt.want).Return(repo.RunSeries{
+					ID: 1, OrganizerID: 7, DungeonID: 3, Difficulty: tt.want.Difficulty, Rrule: tt.want.Rrule,
+					Timezone: tt.want.Timezone, StartsAt: tt.want.StartsAt, DurationMinutes: 60,
+					TankSlots: 1, HealerSlots: 1, DpsSlots: 3,
+				}, nil)
+			}
+			clock := func() time.Time { return now }
+			s := &seriesService{seriesRepo: mockq, runs: &runService{runRepo: mockq, now: clock}, now: clock}
+
+			series, err := s.CreateSeries(ctx, organizer.ID, tt.input)
+			if !assert.ErrorIs(t, err, tt.wantErr) || tt.wantErr != nil {
+				return
+			}
+			assert.Equal(t, "FREQ=WEEKLY;BYDAY=TU", series.RRule)
+			assert.Equal(t, "ARAK", series.Dungeon.Code)
+		})
+	}
+}
+
+func Test_splitSeries(t *testing.T) {
+	ctx := context.Background()
+	dungeon := repo.Dungeon{ID: 3, Code: "ARAK", Name: "Ara-Kara, City of Echoes"}
+	occurrence := utc(2025, 3, 12, 1, 0)
+	f := &runFields{dungeon: dungeon, difficulty: DifficultyMythic, startsAt: occurrence, timezone: "UTC",
+		durationMinutes: 60, notes: "Bring potions", composition: DefaultComposition}
+	seriesRun := func(id int32, at time.Time, status RunStatus) repo.Run {
+		return repo.Run{ID: id, OrganizerID: 7, DungeonID: 3, Difficulty: "Mythic", Status: string(status),
+			StartsAt: pgTimestamptz(at), Timezone: "UTC", DurationMinutes: 60, TankSlots: 1, HealerSlots: 1,
+			DpsSlots: 3, SeriesID: pgInt4(int32Ptr(1)), OccurrenceAt: pgTimestamptz(at)}
+	}
+	// The split crosses a plain occurrence, a skip, an override and a cancelled run.
+	plain := seriesRun(10, occurrence, RunStatusScheduled)
+	skipped := utc(2025, 3, 19, 1, 0)
+	overridden := seriesRun(11, utc(2025, 3, 26, 1, 0), RunStatusForming)
+	cancelled := seriesRun(12, utc(2025, 4, 2, 1, 0), RunStatusCancelled)
+
+	mockq := repo.NewMockQuerier(t)
+	mockq.EXPECT().EndSeries(ctx, repo.EndSeriesParams{ID: 1, UntilAt: pgTimestamptz(occurrence)}).Return(nil)
+	mockq.EXPECT().CreateSeries(ctx, createSeriesParams(7, f, "FREQ=WEEKLY")).Return(repo.RunSeries{
+		ID: 2, OrganizerID: 7, DungeonID: 3, Difficulty: "Mythic", Rrule: "FREQ=WEEKLY", Timezone: "UTC",
+		StartsAt: pgTimestamptz(occurrence), DurationMinutes: 60, Notes: "Bring potions",
+		TankSlots: 1, HealerSlots: 1, DpsSlots: 3,
+	}, nil)
+	mockq.EXPECT().GetSeriesSkipsFrom(ctx, repo.GetSeriesSkipsFromParams{SeriesID: 1,
+		OccurrenceAt: pgTimestamptz(occurrence)}).Return([]pgtype.Timestamptz{pgTimestamptz(skipped)}, nil)
+	mockq.EXPECT().GetSeriesRunsFrom(ctx, repo.GetSeriesRunsFromParams{SeriesID: pgInt4(int32Ptr(1)),
+		OccurrenceAt: pgTimestamptz(occurrence)}).Return([]repo.GetSeriesRunsFromRow{
+		{Run: plain, Dungeon: dungeon},
+		{Run: overridden, Dungeon: dungeon, Overridden: true},
+		{Run: cancelled, Dungeon: dungeon},
+	}, nil)
+	for _, skip := range []time.Time{skipped, cancelled.OccurrenceAt.Time} {
+		mockq.EXPECT().UpsertSeriesException(ctx, repo.UpsertSeriesExceptionParams{SeriesID: 2,
+			OccurrenceAt: pgTimestamptz(skip), Kind: "skip"}).Return(nil)
+	}
+
+	// The plain occurrence takes on the new details and keeps its signups.
+	mockq.EXPECT().MoveSeriesRun(ctx, repo.MoveSeriesRunParams{ID: 10, ToSeriesID: pgInt4(int32Ptr(2)),
+		OccurrenceAt: pgTimestamptz(occurrence)}).Return(nil)
+	mockq.EXPECT().LockRun(ctx, int32(10)).Return(plain, nil)
+	mockq.EXPECT().CountConfirmedSignups(ctx, mock.MatchedBy(func(arg repo.CountConfirmedSignupsParams) bool {
+		return arg.RunID == 10
+	})).Return(1, nil)
+	moved := plain
+	moved.SeriesID, moved.Notes = pgInt4(int32Ptr(2)), "Bring potions"
+	mockq.EXPECT().UpdateRun(ctx, mock.MatchedBy(func(arg repo.UpdateRunParams) bool {
+		return arg.ID == 10 && arg.Notes == "Bring potions" && arg.StartsAt == pgTimestamptz(occurrence)
+	})).Return(moved, nil)
+	mockq.EXPECT().CreateRunEvent(ctx, mock.MatchedBy(func(arg repo.CreateRunEventParams) bool {
+		return arg.RunID == 10 && arg.Type == "run.updated"
+	})).Return(repo.RunEvent{}, nil)
+	mockq.EXPECT().GetSubscribedWebhookEndpoints(ctx, repo.GetSubscribedWebhookEndpointsParams{
+		Event: "run.updated"}).Return(nil, nil)
+
+	// The override keeps its own details.
+	mockq.EXPECT().MoveSeriesRun(ctx, repo.MoveSeriesRunParams{ID: 11, ToSeriesID: pgInt4(int32Ptr(2)),
+		OccurrenceAt: overridden.OccurrenceAt}).Return(nil)
+	mockq.EXPECT().UpsertSeriesException(ctx, repo.UpsertSeriesExceptionParams{SeriesID: 2,
+		OccurrenceAt: overridden.OccurrenceAt, Kind: "override"}).Return(nil)
+
+	series, err := splitSeries(ctx, mockq, 7, 1, occurrence, f, "FREQ=WEEKLY")
+	if assert.NoError(t, err) {
+		assert.Equal(t, int32(2), series.ID)
+	}
+}
+
+func Test_splitSeries_timeOfDay(t *testing.T) {
+	ctx := context.Background()
+	dungeon := repo.Dungeon{ID: 3, Code: "ARAK", Name: "Ara-Kara, City of Echoes"}
+	// A Tuesday and Thursday 20:00 series in New York moves to 21:00 from the Tuesday on.
+	tuesday, thursday := utc(2025, 3, 12, 0, 0), utc(2025, 3, 14, 0, 0)
+	skipped := utc(2025, 3, 19, 0, 0)
+	f := &runFields{dungeon: dungeon, difficulty: DifficultyMythic, startsAt: tuesday.Add(time.Hour),
+		timezone: "America/New_York", durationMinutes: 60, composition: DefaultComposition}
+	seriesRun := func(id int32, at time.Time) repo.Run {
+		return repo.Run{ID: id, OrganizerID: 7, DungeonID: 3, Difficulty: "Mythic", Status: "scheduled",
+			StartsAt: pgTimestamptz(at), Timezone: "America/New_York", DurationMinutes: 60, TankSlots: 1,
+			HealerSlots: 1, DpsSlots: 3, SeriesID: pgInt4(int32Ptr(1)), OccurrenceAt: pgTimestamptz(at)}
+	}
+
+	mockq := repo.NewMockQuerier(t)
+	mockq.EXPECT().EndSeries(ctx, repo.EndSeriesParams{ID: 1, UntilAt: pgTimestamptz(tuesday)}).Return(nil)
+	mockq.EXPECT().CreateSeries(ctx, createSeriesParams(7, f, "FREQ=WEEKLY;BYDAY=TU,TH")).Return(repo.RunSeries{
+		ID: 2, OrganizerID: 7, DungeonID: 3, Difficulty: "Mythic", Rrule: "FREQ=WEEKLY;BYDAY=TU,TH",
+		Timezone: "America/New_York", StartsAt: pgTimestamptz(f.startsAt), DurationMinutes: 60,
+		TankSlots: 1, HealerSlots: 1, DpsSlots: 3,
+	}, nil)
+	mockq.EXPECT().GetSeriesSkipsFrom(ctx, repo.GetSeriesSkipsFromParams{SeriesID: 1,
+		OccurrenceAt: pgTimestamptz(tuesday)}).Return([]pgtype.Timestamptz{pgTimestamptz(skipped)}, nil)
+	mockq.EXPECT().GetSeriesRunsFrom(ctx, repo.GetSeriesRunsFromParams{SeriesID: pgInt4(int32Ptr(1)),
+		OccurrenceAt: pgTimestamptz(tuesday)}).Return([]repo.GetSeriesRunsFromRow{
+		{Run: seriesRun(10, tuesday), Dungeon: dungeon},
+		{Run: seriesRun(11, thursday), Dungeon: dungeon},
+	}, nil)
+
+	// The skip moves to the new time of its day.
+	mockq.EXPECT().UpsertSeriesException(ctx, repo.UpsertSeriesExceptionParams{SeriesID: 2,
+		OccurrenceAt: pgTimestamptz(skipped.Add(time.Hour)), Kind: "skip"}).Return(nil)
+
+	// Both runs keep their confirmed players and start an hour later.
+	for _, r := range []repo.Run{seriesRun(10, tuesday), seriesRun(11, thursday)} {
+		later := r.StartsAt.Time.Add(time.Hour)
+		mockq.EXPECT().MoveSeriesRun(ctx, repo.MoveSeriesRunParams{ID: r.ID, ToSeriesID: pgInt4(int32Ptr(2)),
+			OccurrenceAt: pgTimestamptz(later)}).Return(nil)
+		mockq.EXPECT().LockRun(ctx, r.ID).Return(r, nil)
+		mockq.EXPECT().CountConfirmedSignups(ctx, mock.MatchedBy(func(arg repo.CountConfirmedSignupsParams) bool {
+			return arg.RunID == r.ID
+		})).Return(1, nil)
+		moved := r
+		moved.SeriesID, moved.StartsAt, moved.OccurrenceAt = pgInt4(int32Ptr(2)), pgTimestamptz(later),
+			pgTimestamptz(later)
+		mockq.EXPECT().UpdateRun(ctx, mock.MatchedBy(func(arg repo.UpdateRunParams) bool {
+			return arg.ID == r.ID && arg.StartsAt == pgTimestamptz(later)
+		})).Return(moved, nil)
+		mockq.EXPECT().DeleteRunReminders(ctx, r.ID).Return(nil)
+		mockq.EXPECT().CreateRunEvent(ctx, mock.MatchedBy(func(arg repo.CreateRunEventParams) bool {
+			return arg.RunID == r.ID && arg.Type == "run.updated"
+		})).Return(repo.RunEvent{}, nil)
+	}
+	mockq.EXPECT().GetSubscribedWebhookEndpoints(ctx, repo.GetSubscribedWebhookEndpointsParams{
+		Event: "run.updated"}).Return(nil, nil)
+
+	_, err := splitSeries(ctx, mockq, 7, 1, tuesday, f, "FREQ=WEEKLY;BYDAY=TU,TH")
+	assert.NoError(t, err, "no run is cancelled")
+}
+
+func Test_materializeSeriesRun(t *testing.T) {
+	occurrence := utc(2025, 3, 12, 1, 0)
+	params := repo.MaterializeSeriesRunParams{SeriesID: 1, OccurrenceAt: pgTimestamptz(occurrence)}
+	run := repo.Run{ID: 10, OrganizerID: 7, DungeonID: 3, Difficulty: "Mythic", Status: "scheduled",
+		StartsAt: pgTimestamptz(occurrence), SeriesID: pgInt4(int32Ptr(1)), OccurrenceAt: pgTimestamptz(occurrence),
+		GuildID: pgInt4(int32Ptr(4))}
+
+	tests := []struct {
+		name    string
+		err     error
+		created bool
+	}{
+		{"Created Run Is Announced", nil, true},
+		{"Existing Or Skipped Occurrence", pgx.ErrNoRows, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			mockq.EXPECT().MaterializeSeriesRun(ctx, params).Return(run, tt.err)
+			if tt.created {
+				mockq.EXPECT().GetRunByID(ctx, int32(10)).Return(repo.GetRunByIDRow{Run: run,
+					Dungeon: repo.Dungeon{ID: 3}}, nil)
+				mockq.EXPECT().CreateGuildAnnouncement(ctx, repo.CreateGuildAnnouncementParams{
+					RunID: 10, Kind: string(AnnouncementRunCreated), GuildID: 4}).Return(nil)
+				mockq.EXPECT().GetSubscribedWebhookEndpoints(ctx, repo.GetSubscribedWebhookEndpointsParams{
+					Event: "run.created", GuildID: pgInt4(int32Ptr(4))}).Return(nil, nil)
+			}
+
+			assert.NoError(t, materializeSeriesRun(ctx, mockq, 1, occurrence))
+		})
+	}
+}
+
+func Test_cancelSeriesRun(t *testing.T) {
+	occurrence := utc(2025, 3, 12, 1, 0)
+	params := repo.LockSeriesRunParams{SeriesID: pgInt4(int32Ptr(1)), OccurrenceAt: pgTimestamptz(occurrence)}
+	run := repo.Run{ID: 10, OrganizerID: 7, DungeonID: 3, Difficulty: "Mythic", Status: "scheduled",
+		StartsAt: pgTimestamptz(occurrence), SeriesID: pgInt4(int32Ptr(1)), OccurrenceAt: pgTimestamptz(occurrence),
+		GuildID: pgInt4(int32Ptr(4))}
+
+	tests := []struct {
+		name      string
+		status    string
+		err       error
+		cancelled bool
+	}{
+		{"Scheduled Run Is Cancelled", "scheduled", nil, true},
+		{"Run Already Completed", "completed", nil, false},
+		{"Run Not Created Yet", "", pgx.ErrNoRows, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			locked := run
+			locked.Status = tt.status
+			mockq := repo.NewMockQuerier(t)
+			mockq.EXPECT().LockSeriesRun(ctx, params).Return(locked, tt.err)
+			if tt.cancelled {
+				row := repo.GetRunByIDRow{Run: run, Dungeon: repo.Dungeon{ID: 3, Name: "Ara-Kara, City of Echoes"}}
+				mockq.EXPECT().GetRunByID(ctx, int32(10)).Return(row, nil).Times(2)
+				mockq.EXPECT().SetRunStatus(ctx, repo.SetRunStatusParams{ID: 10, Status: "cancelled"}).
+					Return(repo.Run{ID: 10, Status: "cancelled", Sequence: 2}, nil)
+				mockq.EXPECT().CreateRunEvent(ctx, mock.MatchedBy(func(arg repo.CreateRunEventParams) bool {
+					return arg.RunID == 10 && arg.Type == "run.cancelled"
+				})).Return(repo.RunEvent{ID: 4, RunID: 10, Type: "run.cancelled"}, nil)
+				mockq.EXPECT().GetRunSignups(ctx, int32(10)).Return([]repo.GetRunSignupsRow{
+					{RunID: 10, UserID: 8, Role: "tank", Status: "confirmed"}}, nil)
+				mockq.EXPECT().CreateNotification(ctx, mock.MatchedBy(func(arg repo.CreateNotificationParams) bool {
+					return arg.UserID == 8 && arg.Type == "run.cancelled"
+				})).Return(nil)
+				mockq.EXPECT().CreateGuildAnnouncement(ctx, repo.CreateGuildAnnouncementParams{
+					RunID: 10, Kind: string(AnnouncementRunCancelled), GuildID: 4}).Return(nil)
+				mockq.EXPECT().GetSubscribedWebhookEndpoints(ctx, repo.GetSubscribedWebhookEndpointsParams{
+					Event: "run.cancelled", GuildID: pgInt4(int32Ptr(4))}).Return([]repo.WebhookEndpoint{{ID: 2}}, nil)
+				mockq.EXPECT().CreateWebhookDelivery(ctx, mock.MatchedBy(func(arg repo.CreateWebhookDeliveryParams) bool {
+					return arg.EndpointID == 2 && arg.Event == "run.cancelled"
+				})).Return(repo.WebhookDelivery{ID: 20}, nil)
+				mockq.EXPECT().CreateJob(ctx, repo.CreateJobParams{
+					Queue:       WebhookQueue,
+					Kind:        "webhook.deliver",
+					Args:        []byte(`{"delivery_id":20}`),
+					MaxAttempts: webhookMaxAttempts,
+				}).Return(1, nil)
+			}
+
+			assert.NoError(t, cancelSeriesRun(ctx, mockq, 1, occurrence))
+		})
+	}
+}
+
+func Test_seriesService_organizedOccurrence(t *testing.T) {
+	now := utc(2025, 3, 1, 12, 0)
+	row := repo.GetSeriesByIDRow{RunSeries: repo.RunSeries{ID: 1, OrganizerID: 7, Rrule: "FREQ=WEEKLY",
+		Timezone: "America/New_York", StartsAt: pgTimestamptz(utc(2025, 2, 26, 1, 0))}}
+
+	tests := []struct {
+		name       string
+		actorID    int32
+		occurrence time.Time
+		rowErr     error
+		wantErr    error
+	}{
+		{"Upcoming Occurrence", 7, utc(2025, 3, 12, 0, 0), nil, nil},
+		{"Not Organizer", 8, utc(2025, 3, 12, 0, 0), nil, ErrForbidden},
+		{"Not An Occurrence", 7, utc(2025, 3, 12, 1, 0), nil, ErrOccurrenceNotFound},
+		{"Past Occurrence", 7, utc(2025, 2, 26, 1, 0), nil, ErrRunInPast},
+		{"Series Not Found", 7, utc(2025, 3, 12, 0, 0), pgx.ErrNoRows, ErrSeriesNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			mockq.EXPECT().GetSeriesByID(ctx, int32(1)).Return(row, tt.rowErr)
+			s := &seriesService{seriesRepo: mockq, now: func() time.Time { return now }}
+
+			_, err := s.organizedOccurrence(ctx, tt.actorID, 1, tt.occurrence)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,441 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// SignupStatus represents the state of a user's signup for a run
+type SignupStatus string
+
+const (
+	SignupStatusConfirmed  = SignupStatus("confirmed")
+	SignupStatusWaitlisted = SignupStatus("waitlisted")
+	SignupStatusWithdrawn  = SignupStatus("withdrawn")
+)
+
+// Signup is a user's claim on a role slot in a run, as one of their characters
+// if they picked one. WaitlistPosition is only set while the signup is waitlisted.
+type Signup struct {
+	ID               int32        `json:"id"`
+	RunID            int32        `json:"run_id"`
+	UserID           int32        `json:"user_id"`
+	Username         string       `json:"username"`
+	CharacterID      *int32       `json:"character_id,omitempty"`
+	CharacterName    string       `json:"character_name,omitempty"`
+	Role             UserRole     `json:"role"`
+	Status           SignupStatus `json:"status"`
+	WaitlistPosition *int32       `json:"waitlist_position,omitempty"`
+	CreatedAt        time.Time    `json:"created_at"`
+}
+
+// RoleSlots describes how many slots a run has for a role, who fills them
+// and who is waiting for one to open up, in promotion order.
+type RoleSlots struct {
+	Role     UserRole  `json:"role"`
+	Total    int32     `json:"total"`
+	Filled   int32     `json:"filled"`
+	Open     int32     `json:"open"`
+	Signups  []*Signup `json:"signups"`
+	Waitlist []*Signup `json:"waitlist"`
+}
+
+// Roster is the current state of every role slot in a run.
+type Roster struct {
+	RunID       int32       `json:"run_id"`
+	Composition Composition `json:"composition"`
+	Full        bool        `json:"full"`
+	Slots       []RoleSlots `json:"slots"`
+}
+
+// SignupService is the interface for signing up for runs.
+type SignupService interface {
+	SignUp(context.Context, int32, int32, UserRole, *int32) (*Roster, error)
+	Withdraw(context.Context, int32, int32) (*Roster, error)
+	GetRoster(context.Context, int32) (*Roster, error)
+	ReorderWaitlist(context.Context, int32, int32, UserRole, []int32) (*Roster, error)
+}
+
+// signupService is the implementation of SignupService.
+type signupService struct {
+	dbPool     *pgxpool.Pool
+	signupRepo repo.Querier
+	now        func() time.Time
+}
+
+// NewSignupService creates a new signupService with the provided database connection pool.
+// It returns a pointer to the signupService.
+func NewSignupService(dbPool *pgxpool.Pool) *signupService {
+	return &signupService{
+		dbPool:     dbPool,
+		signupRepo: repo.New(dbPool),
+		now:        time.Now,
+	}
+}
+
+// SignUp claims a slot for role in the run for the user, as the character with
+// characterID if it is not nil. The character, or the user without one, must list
+// the role in their roles. The run row is locked for the duration of the claim so two users
+// can never take the last slot of a role at the same time.
+// If every slot for the role is taken the user joins the end of the role's waitlist,
+// as do users the guild's signup policy holds back, see SignupPolicy.
+func (s *signupService) SignUp(ctx context.Context, runID, userID int32, role UserRole,
+	characterID *int32) (*Roster, error) {
+	if !isCombatRole(role) {
+		return nil, ErrInvalidRole
+	}
+
+	roles, err := playableRoles(ctx, s.signupRepo, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(roles, role) {
+		return nil, ErrRoleNotPlayable
+	}
+
+	var roster *Roster
+	err = inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		run, err := lockOpenRun(ctx, q, runID, s.now())
+		if err != nil {
+			return err
+		}
+
+		_, err = q.GetActiveSignup(ctx, repo.GetActiveSignupParams{RunID: runID, UserID: userID})
+		if err == nil {
+			return ErrAlreadySignedUp
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+
+		confirmed, err := q.CountConfirmedSignups(ctx, repo.CountConfirmedSignupsParams{
+			RunID: runID,
+			Role:  string(role),
+		})
+		if err != nil {
+			return err
+		}
+		params := repo.CreateSignupParams{
+			RunID:       runID,
+			UserID:      userID,
+			Role:        string(role),
+			Status:      string(SignupStatusConfirmed),
+			CharacterID: pgInt4(characterID),
+		}
+		event := EventSignupConfirmed
+		waitlist := confirmed >= int64(runComposition(run).Slots(role))
+		if !waitlist {
+			waitlist, err = heldBackByPolicy(ctx, q, run, userID, s.now())
+			if err != nil {
+				return err
+			}
+		}
+		if waitlist {
+			position, err := q.NextWaitlistPosition(ctx, repo.NextWaitlistPositionParams{
+				RunID: runID,
+				Role:  string(role),
+			})
+			if err != nil {
+				return err
+			}
+			params.Status = string(SignupStatusWaitlisted)
+			params.WaitlistPosition = pgInt4(&position)
+			event = EventSignupWaitlisted
+		}
+
+		signup, err := q.CreateSignup(ctx, params)
+		if err != nil {
+			return err
+		}
+		if err := recordSignupEvent(ctx, q, event, signup); err != nil {
+			return err
+		}
+
+		roster, err = loadRoster(ctx, q, run)
+		if err != nil {
+			return err
+		}
+		// Only the signup that takes the last slot announces the run as full.
+		if event == EventSignupConfirmed && roster.Full {
+			return announceRun(ctx, q, run.ID, int4Ptr(run.GuildID), AnnouncementRunFilled)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return roster, nil
+}
+
+// Withdraw removes the user's signup from the run. If the user held a slot,
+// the first waitlisted user for the role that can still play it is promoted in
+// the same transaction. Returns ErrSignupNotFound if the user is not signed up.
+func (s *signupService) Withdraw(ctx context.Context, runID, userID int32) (*Roster, error) {
+	var roster *Roster
+	err := inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		run, err := lockOpenRun(ctx, q, runID, s.now())
+		if err != nil {
+			return err
+		}
+
+		signup, err := q.GetActiveSignup(ctx, repo.GetActiveSignupParams{RunID: runID, UserID: userID})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrSignupNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		withdrawn, err := q.WithdrawSignup(ctx, signup.ID)
+		if err != nil {
+			return err
+		}
+		if err := recordSignupEvent(ctx, q, EventSignupWithdrawn, withdrawn); err != nil {
+			return err
+		}
+
+		if SignupStatus(signup.Status) == SignupStatusConfirmed {
+			if err := promoteNext(ctx, q, runID, UserRole(signup.Role)); err != nil {
+				return err
+			}
+		}
+
+		roster, err = loadRoster(ctx, q, run)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return roster, nil
+}
+
+// GetRoster returns the filled and open slots of a run.
+func (s *signupService) GetRoster(ctx context.Context, runID int32) (*Roster, error) {
+	row, err := s.signupRepo.GetRunByID(ctx, runID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrRunNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return loadRoster(ctx, s.signupRepo, row.Run)
+}
+
+// ReorderWaitlist sets the order of the waitlist for role. userIDs must list every
+// user currently waitlisted for the role exactly once, first to be promoted first.
+// Only the run's organizer can reorder the waitlist.
+func (s *signupService) ReorderWaitlist(ctx context.Context, actorID, runID int32, role UserRole,
+	userIDs []int32) (*Roster, error) {
+	if !isCombatRole(role) {
+		return nil, ErrInvalidRole
+	}
+
+	var roster *Roster
+	err := inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		run, err := lockOpenRun(ctx, q, runID, s.now())
+		if err != nil {
+			return err
+		}
+		if run.OrganizerID != actorID {
+			return ErrForbidden
+		}
+
+		waitlist, err := q.GetWaitlist(ctx, repo.GetWaitlistParams{RunID: runID, Role: string(role)})
+		if err != nil {
+			return err
+		}
+
+		signupIDs, err := waitlistOrder(waitlist, userIDs)
+		if err != nil {
+			return err
+		}
+		for i, id := range signupIDs {
+			err := q.SetWaitlistPosition(ctx, repo.SetWaitlistPositionParams{
+				ID:               id,
+				WaitlistPosition: pgtype.Int4{Int32: int32(i + 1), Valid: true},
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = recordRunEvent(ctx, q, runID, EventWaitlistReordered, &actorID, map[string]any{
+			"role":     role,
+			"user_ids": userIDs,
+		})
+		if err != nil {
+			return err
+		}
+
+		roster, err = loadRoster(ctx, q, run)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return roster, nil
+}
+
+// promoteNext moves the first eligible waitlisted user for role into the slot
+// that just opened up, records a signup.promoted event and lets the user know.
+// It does nothing if nobody on the waitlist can take the slot.
+func promoteNext(ctx context.Context, q repo.Querier, runID int32, role UserRole) error {
+	waitlist, err := q.GetWaitlist(ctx, repo.GetWaitlistParams{RunID: runID, Role: string(role)})
+	if err != nil {
+		return err
+	}
+
+	next, ok := nextPromotion(waitlist, role)
+	if !ok {
+		return nil
+	}
+
+	promoted, err := q.PromoteSignup(ctx, next.ID)
+	if err != nil {
+		return err
+	}
+	if err := recordSignupEvent(ctx, q, EventSignupPromoted, promoted); err != nil {
+		return err
+	}
+
+	row, err := q.GetRunByID(ctx, runID)
+	if err != nil {
+		return err
+	}
+	return enqueueRunNotification(ctx, q, promoted.UserID, NotificationSignupPromoted,
+		mapRun(row.Run, row.Dungeon), role)
+}
+
+// nextPromotion returns the first waitlist entry whose user, or character, can
+// still play role. Roles can be dropped after joining a waitlist, those entries
+// are passed over.
+func nextPromotion(waitlist []repo.GetWaitlistRow, role UserRole) (repo.GetWaitlistRow, bool) {
+	for _, entry := range waitlist {
+		if slices.Contains(entry.UserRoles, string(role)) {
+			return entry, true
+		}
+	}
+	return repo.GetWaitlistRow{}, false
+}
+
+// waitlistOrder maps the requested order of users to waitlisted signup ids.
+// Returns ErrInvalidWaitlistOrder unless userIDs is a permutation of the waitlist.
+func waitlistOrder(waitlist []repo.GetWaitlistRow, userIDs []int32) ([]int32, error) {
+	if len(waitlist) != len(userIDs) {
+		return nil, ErrInvalidWaitlistOrder
+	}
+
+	signupByUser := make(map[int32]int32, len(waitlist))
+	for _, entry := range waitlist {
+		signupByUser[entry.UserID] = entry.ID
+	}
+
+	signupIDs := make([]int32, 0, len(userIDs))
+	for _, userID := range userIDs {
+		id, ok := signupByUser[userID]
+		if !ok {
+			return nil, ErrInvalidWaitlistOrder
+		}
+		delete(signupByUser, userID)
+		signupIDs = append(signupIDs, id)
+	}
+	return signupIDs, nil
+}
+
+// lockOpenRun locks a run that can still be signed up for or withdrawn from.
+func lockOpenRun(ctx context.Context, q repo.Querier, runID int32, now time.Time) (repo.Run, error) {
+	run, err := q.LockRun(ctx, runID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return repo.Run{}, ErrRunNotFound
+	}
+	if err != nil {
+		return repo.Run{}, err
+	}
+
+	if RunStatus(run.Status) == RunStatusCancelled {
+		return repo.Run{}, ErrRunCancelled
+	}
+	if !RunStatus(run.Status).Open() {
+		return repo.Run{}, ErrRunStarted
+	}
+	if !run.StartsAt.Time.After(now) {
+		return repo.Run{}, ErrRunInPast
+	}
+	return run, nil
+}
+
+func loadRoster(ctx context.Context, q repo.Querier, run repo.Run) (*Roster, error) {
+	rows, err := q.GetRunSignups(ctx, run.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var signups []*Signup
+	for _, row := range rows {
+		signups = append(signups, &Signup{
+			ID:               row.ID,
+			RunID:            row.RunID,
+			UserID:           row.UserID,
+			Username:         row.Username,
+			CharacterID:      int4Ptr(row.CharacterID),
+			CharacterName:    row.CharacterName.String,
+			Role:             UserRole(row.Role),
+			Status:           SignupStatus(row.Status),
+			WaitlistPosition: int4Ptr(row.WaitlistPosition),
+			CreatedAt:        row.CreatedAt.Time,
+		})
+	}
+	return buildRoster(run.ID, runComposition(run), signups), nil
+}
+
+// buildRoster groups signups into the slots and waitlist of each combat role.
+// signups must already be in waitlist order.
+func buildRoster(runID int32, composition Composition, signups []*Signup) *Roster {
+	roster := &Roster{
+		RunID:       runID,
+		Composition: composition,
+		Full:        true,
+	}
+
+	for _, role := range combatRoles {
+		slots := RoleSlots{
+			Role:     role,
+			Total:    composition.Slots(role),
+			Signups:  []*Signup{},
+			Waitlist: []*Signup{},
+		}
+		for _, signup := range signups {
+			if signup.Role != role {
+				continue
+			}
+			switch signup.Status {
+			case SignupStatusConfirmed:
+				slots.Signups = append(slots.Signups, signup)
+			case SignupStatusWaitlisted:
+				slots.Waitlist = append(slots.Waitlist, signup)
+			}
+		}
+		slots.Filled = int32(len(slots.Signups))
+		slots.Open = max(slots.Total-slots.Filled, 0)
+		if slots.Open > 0 {
+			roster.Full = false
+		}
+		roster.Slots = append(roster.Slots, slots)
+	}
+	return roster
+}
+
+func runComposition(r repo.Run) Composition {
+	return Composition{
+		Tanks:   r.TankSlots,
+		Healers: r.HealerSlots,
+		DPS:     r.DpsSlots,
+	}
+}
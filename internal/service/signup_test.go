@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+func Test_buildRoster(t *testing.T) {
+	tank := &Signup{ID: 1, UserID: 1, Role: RoleTank, Status: SignupStatusConfirmed}
+	healer := &Signup{ID: 2, UserID: 2, Role: RoleHealer, Status: SignupStatusConfirmed}
+	dps := []*Signup{
+		{ID: 3, UserID: 3, Role: RoleDPS, Status: SignupStatusConfirmed},
+		{ID: 4, UserID: 4, Role: RoleDPS, Status: SignupStatusConfirmed},
+		{ID: 5, UserID: 5, Role: RoleDPS, Status: SignupStatusConfirmed},
+	}
+
+	tests := []struct {
+		name       string
+		signups    []*Signup
+		wantFull   bool
+		wantFilled []int32
+		wantOpen   []int32
+	}{
+		{"Empty Run", nil, false, []int32{0, 0, 0}, []int32{1, 1, 3}},
+		{"Tank And Healer", []*Signup{tank, healer}, false, []int32{1, 1, 0}, []int32{0, 0, 3}},
+		{"Full Run", append([]*Signup{tank, healer}, dps...), true, []int32{1, 1, 3}, []int32{0, 0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildRoster(1, DefaultComposition, tt.signups)
+
+			assert.Equal(t, tt.wantFull, got.Full)
+			for i, slots := range got.Slots {
+				assert.Equal(t, combatRoles[i], slots.Role)
+				assert.Equal(t, tt.wantFilled[i], slots.Filled, slots.Role)
+				assert.Equal(t, tt.wantOpen[i], slots.Open, slots.Role)
+			}
+		})
+	}
+}
+
+func Test_signupService_SignUp_validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		role    UserRole
+		user    repo.GetUserByIDRow
+		userErr error
+		wantErr error
+	}{
+		{"Non Combat Role", RoleLeader, repo.GetUserByIDRow{}, nil, ErrInvalidRole},
+		{"Unknown User", RoleTank, repo.GetUserByIDRow{}, pgx.ErrNoRows, ErrUserNotFound},
+		{"Role Not Listed", RoleHealer, repo.GetUserByIDRow{ID: 1, Roles: []string{"Tank", "DPS"}}, nil, ErrRoleNotPlayable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			if tt.role != RoleLeader {
+				mockq.EXPECT().GetUserByID(ctx, int32(1)).Return(tt.user, tt.userErr)
+			}
+			s := &signupService{signupRepo: mockq, now: time.Now}
+
+			_, err := s.SignUp(ctx, 1, 1, tt.role, nil)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_signupService_SignUp_character(t *testing.T) {
+	tests := []struct {
+		name      string
+		role      UserRole
+		character repo.Character
+		charErr   error
+		wantErr   error
+	}{
+		{"Unknown Character", RoleTank, repo.Character{}, pgx.ErrNoRows, ErrCharacterNotFound},
+		{"Someone Elses Character", RoleTank, repo.Character{ID: 4, UserID: 2, Roles: []string{"Tank"}}, nil,
+			ErrCharacterNotFound},
+		{"Role Not Listed", RoleHealer, repo.Character{ID: 4, UserID: 1, Roles: []string{"Tank"}}, nil,
+			ErrRoleNotPlayable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			mockq.EXPECT().GetCharacterByID(ctx, int32(4)).
+				Return(repo.GetCharacterByIDRow{Character: tt.character}, tt.charErr)
+			s := &signupService{signupRepo: mockq, now: time.Now}
+
+			_, err := s.SignUp(ctx, 1, 1, tt.role, int32Ptr(4))
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_buildRoster_waitlist(t *testing.T) {
+	signups := []*Signup{
+		{ID: 1, UserID: 1, Role: RoleHealer, Status: SignupStatusConfirmed},
+		{ID: 3, UserID: 3, Role: RoleHealer, Status: SignupStatusWaitlisted, WaitlistPosition: int32Ptr(1)},
+		{ID: 2, UserID: 2, Role: RoleHealer, Status: SignupStatusWaitlisted, WaitlistPosition: int32Ptr(2)},
+	}
+
+	got := buildRoster(1, DefaultComposition, signups)
+	healers := got.Slots[1]
+	assert.Equal(t, int32(1), healers.Filled)
+	assert.Equal(t, int32(0), healers.Open)
+	if assert.Len(t, healers.Waitlist, 2) {
+		assert.Equal(t, int32(3), healers.Waitlist[0].UserID)
+		assert.Equal(t, int32(2), healers.Waitlist[1].UserID)
+	}
+}
+
+func Test_nextPromotion(t *testing.T) {
+	tests := []struct {
+		name     string
+		waitlist []repo.GetWaitlistRow
+		wantID   int32
+		wantOK   bool
+	}{
+		{"Empty Waitlist", nil, 0, false},
+		{"First In Line", []repo.GetWaitlistRow{
+			{ID: 4, UserRoles: []string{"Healer"}},
+			{ID: 5, UserRoles: []string{"Healer"}},
+		}, 4, true},
+		{"Skips Users That Dropped The Role", []repo.GetWaitlistRow{
+			{ID: 4, UserRoles: []string{"DPS"}},
+			{ID: 5, UserRoles: []string{"Tank", "Healer"}},
+		}, 5, true},
+		{"Nobody Eligible", []repo.GetWaitlistRow{
+			{ID: 4, UserRoles: []string{"DPS"}},
+		}, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := nextPromotion(tt.waitlist, RoleHealer)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantID, got.ID)
+		})
+	}
+}
+
+func Test_waitlistOrder(t *testing.T) {
+	waitlist := []repo.GetWaitlistRow{
+		{ID: 10, UserID: 1},
+		{ID: 11, UserID: 2},
+		{ID: 12, UserID: 3},
+	}
+
+	tests := []struct {
+		name    string
+		userIDs []int32
+		want    []int32
+		wantErr error
+	}{
+		{"Reversed", []int32{3, 2, 1}, []int32{12, 11, 10}, nil},
+		{"Unchanged", []int32{1, 2, 3}, []int32{10, 11, 12}, nil},
+		{"Missing User", []int32{3, 2}, nil, ErrInvalidWaitlistOrder},
+		{"Duplicate User", []int32{3, 3, 1}, nil, ErrInvalidWaitlistOrder},
+		{"Unknown User", []int32{3, 2, 9}, nil, ErrInvalidWaitlistOrder},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := waitlistOrder(waitlist, tt.userIDs)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tmaffia/dungeon-time-api/internal/jobs"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// lateWithdrawalWindow is how close to the start of a run withdrawing from it
+// counts as a late withdrawal.
+const lateWithdrawalWindow = 24 * time.Hour
+
+// RefreshStatsArgs are the arguments of the job that refreshes the stats summary.
+type RefreshStatsArgs struct{}
+
+func (RefreshStatsArgs) Kind() string {
+	return "stats.refresh"
+}
+
+// UserStats is the run history of a user. Completed counts the runs they
+// finished, in time or not, leaving out runs they were a no-show for, and Timed
+// the Mythic+ runs finished within par. AverageParRatio is the time spent in
+// finished runs over their par time, so below 1 is faster than par. Organizers take part in their own runs without
+// playing a role, so their runs are missing from Roles. Stats filtered by an
+// Affix only count the runs started while it was active.
+type UserStats struct {
+	UserID          int32           `json:"user_id"`
+	Affix           string          `json:"affix,omitempty"`
+	Completed       int32           `json:"completed"`
+	Timed           int32           `json:"timed"`
+	AverageParRatio *float64        `json:"average_par_ratio,omitempty"`
+	NoShows         int32           `json:"no_shows"`
+	LateWithdrawals int32           `json:"late_withdrawals"`
+	Dungeons        []*DungeonStats `json:"dungeons"`
+	Roles           []*RoleStats    `json:"roles"`
+}
+
+// DungeonStats is the run history of a user in one dungeon.
+// BestTimedLevel is the highest key they timed there, if any.
+type DungeonStats struct {
+	Dungeon         *Dungeon `json:"dungeon"`
+	Completed       int32    `json:"completed"`
+	Timed           int32    `json:"timed"`
+	BestTimedLevel  *int32   `json:"best_timed_level,omitempty"`
+	AverageSeconds  int32    `json:"average_seconds"`
+	AverageParRatio float64  `json:"average_par_ratio"`
+
+	elapsedSeconds int64
+	parSeconds     int64
+}
+
+// RoleStats is the run history of a user playing one role.
+type RoleStats struct {
+	Role      UserRole `json:"role"`
+	Completed int32    `json:"completed"`
+	Timed     int32    `json:"timed"`
+}
+
+// StatsService is the interface for player statistics.
+type StatsService interface {
+	GetUserStats(ctx context.Context, userID int32, affix string) (*UserStats, error)
+}
+
+// statsService is the implementation of StatsService.
+// With summary set, run stats are read from the materialized summary instead
+// of being aggregated for every request.
+type statsService struct {
+	dbPool    *pgxpool.Pool
+	statsRepo repo.Querier
+	summary   bool
+}
+
+// NewStatsService creates a new statsService with the provided database connection pool.
+// With summary, stats are read from the summary kept by the stats.refresh job
+// and are as old as its last refresh.
+// It returns a pointer to the statsService.
+func NewStatsService(dbPool *pgxpool.Pool, summary bool) *statsService {
+	return &statsService{
+		dbPool:    dbPool,
+		statsRepo: repo.New(dbPool),
+		summary:   summary,
+	}
+}
+
+// GetUserStats returns the run history of a user, of the runs started with
+// the affix with code affix active if it is not empty. Returns ErrUserNotFound
+// if the user does not exist and ErrAffixNotFound for an unknown affix.
+func (s *statsService) GetUserStats(ctx context.Context, userID int32, affix string) (*UserStats, error) {
+	if _, err := s.statsRepo.GetUserByID(ctx, userID); errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrUserNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	var rows []repo.GetUserRunStatsRow
+	var err error
+	if affix == "" {
+		rows, err = s.runStats(ctx, userID)
+	} else {
+		rows, err = s.affixRunStats(ctx, userID, affix)
+	}
+	if err != nil {
+		return nil, err
+	}
+	stats := summarizeRunStats(userID, rows)
+	if affix != "" {
+		stats.Affix = strings.ToUpper(affix)
+	}
+
+	signups, err := s.statsRepo.GetUserSignupStats(ctx, repo.GetUserSignupStatsParams{
+		UserID:                userID,
+		LateWithdrawalSeconds: int32(lateWithdrawalWindow / time.Second),
+	})
+	if err != nil {
+		return nil, err
+	}
+	stats.NoShows = signups.NoShows
+	stats.LateWithdrawals = signups.LateWithdrawals
+	return stats, nil
+}
+
+// runStats returns the run stats of a user from the summary or from the runs.
+func (s *statsService) runStats(ctx context.Context, userID int32) ([]repo.GetUserRunStatsRow, error) {
+	if !s.summary {
+		return s.statsRepo.GetUserRunStats(ctx, userID)
+	}
+
+	summary, err := s.statsRepo.GetUserRunStatsSummary(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]repo.GetUserRunStatsRow, 0, len(summary))
+	for _, row := range summary {
+		rows = append(rows, repo.GetUserRunStatsRow{
+			UserRunStat: repo.UserRunStat(row.UserRunStatsSummary),
+			Dungeon:     row.Dungeon,
+		})
+	}
+	return rows, nil
+}
+
+// affixRunStats returns the run stats of a user over the runs started with the
+// affix with code active. The summary has no affixes, so they are always
+// aggregated from the runs.
+func (s *statsService) affixRunStats(ctx context.Context, userID int32, code string) ([]repo.GetUserRunStatsRow,
+	error) {
+	affix, err := loadAffix(ctx, s.statsRepo, code)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.statsRepo.GetUserAffixRunStats(ctx, repo.GetUserAffixRunStatsParams{
+		UserID: userID,
+		Affix:  affix.Code,
+	})
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]repo.GetUserRunStatsRow, 0, len(stats))
+	for _, row := range stats {
+		rows = append(rows, repo.GetUserRunStatsRow{
+			UserRunStat: repo.UserRunStat{
+				UserID:         row.UserID,
+				DungeonID:      row.DungeonID,
+				Role:           row.Role,
+				Completed:      row.Completed,
+				Timed:          row.Timed,
+				BestTimedLevel: row.BestTimedLevel,
+				ElapsedSeconds: row.ElapsedSeconds,
+				ParSeconds:     row.ParSeconds,
+			},
+			Dungeon: row.Dungeon,
+		})
+	}
+	return rows, nil
+}
+
+// RefreshStats is the job handler that refreshes the stats summary.
+func (s *statsService) RefreshStats(ctx context.Context, _ *jobs.Job[RefreshStatsArgs]) error {
+	return s.statsRepo.RefreshUserRunStatsSummary(ctx)
+}
+
+// Work queues a refresh of the stats summary every interval until ctx is done.
+// The job is unique, so workers sharing the database refresh it once between them.
+func (s *statsService) Work(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		_, err := jobs.Enqueue(ctx, s.statsRepo, RefreshStatsArgs{}, &jobs.EnqueueOptions{UniqueKey: "summary"})
+		if err != nil && ctx.Err() == nil {
+			log.Printf("queueing stats refresh: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// summarizeRunStats adds up the run stats rows of a user, one per dungeon and
+// role, into totals per dungeon and per role. Dungeons and roles keep the
+// order of the rows.
+func summarizeRunStats(userID int32, rows []repo.GetUserRunStatsRow) *UserStats {
+	stats := &UserStats{UserID: userID, Dungeons: []*DungeonStats{}, Roles: []*RoleStats{}}
+	dungeons := make(map[int32]*DungeonStats)
+	roles := make(map[UserRole]*RoleStats)
+	var elapsed, par int64
+
+	for _, row := range rows {
+		r := row.UserRunStat
+		stats.Completed += r.Completed
+		stats.Timed += r.Timed
+		elapsed += r.ElapsedSeconds
+		par += r.ParSeconds
+
+		d, ok := dungeons[r.DungeonID]
+		if !ok {
+			d = &DungeonStats{Dungeon: mapDungeon(row.Dungeon)}
+			dungeons[r.DungeonID] = d
+			stats.Dungeons = append(stats.Dungeons, d)
+		}
+		d.Completed += r.Completed
+		d.Timed += r.Timed
+		if r.BestTimedLevel > 0 && (d.BestTimedLevel == nil || r.BestTimedLevel > *d.BestTimedLevel) {
+			best := r.BestTimedLevel
+			d.BestTimedLevel = &best
+		}
+		d.elapsedSeconds += r.ElapsedSeconds
+		d.parSeconds += r.ParSeconds
+
+		if r.Role == "" {
+			continue
+		}
+		role, ok := roles[UserRole(r.Role)]
+		if !ok {
+			role = &RoleStats{Role: UserRole(r.Role)}
+			roles[role.Role] = role
+			stats.Roles = append(stats.Roles, role)
+		}
+		role.Completed += r.Completed
+		role.Timed += r.Timed
+	}
+
+	for _, d := range stats.Dungeons {
+		d.AverageSeconds = int32(d.elapsedSeconds / int64(d.Completed))
+		if d.parSeconds > 0 {
+			d.AverageParRatio = float64(d.elapsedSeconds) / float64(d.parSeconds)
+		}
+	}
+	if par > 0 {
+		ratio := float64(elapsed) / float64(par)
+		stats.AverageParRatio = &ratio
+	}
+	return stats
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+func Test_summarizeRunStats(t *testing.T) {
+	arak := repo.Dungeon{ID: 3, Code: "ARAK", Name: "Ara-Kara, City of Echoes", ParSeconds: 1800}
+	stone := repo.Dungeon{ID: 4, Code: "SV", Name: "The Stonevault", ParSeconds: 2000}
+
+	tests := []struct {
+		name string
+		rows []repo.GetUserRunStatsRow
+		want *UserStats
+	}{
+		{"No Runs", nil, &UserStats{UserID: 5, Dungeons: []*DungeonStats{}, Roles: []*RoleStats{}}},
+		{
+			"Dungeons And Roles",
+			[]repo.GetUserRunStatsRow{
+				{UserRunStat: repo.UserRunStat{UserID: 5, DungeonID: 3, Role: "", Completed: 1, Timed: 1,
+					BestTimedLevel: 12, ElapsedSeconds: 1500, ParSeconds: 1800}, Dungeon: arak},
+				{UserRunStat: repo.UserRunStat{UserID: 5, DungeonID: 3, Role: "Tank", Completed: 2, Timed: 1,
+					BestTimedLevel: 10, ElapsedSeconds: 3900, ParSeconds: 3600}, Dungeon: arak},
+				{UserRunStat: repo.UserRunStat{UserID: 5, DungeonID: 4, Role: "Tank", Completed: 1,
+					ElapsedSeconds: 2500, ParSeconds: 2000}, Dungeon: stone},
+			},
+			&UserStats{
+				UserID: 5, Completed: 4, Timed: 2, AverageParRatio: floatPtr(7900.0 / 7400.0),
+				Dungeons: []*DungeonStats{
+					{Dungeon: mapDungeon(arak), Completed: 3, Timed: 2, BestTimedLevel: int32Ptr(12),
+						AverageSeconds: 1800, AverageParRatio: 1, elapsedSeconds: 5400, parSeconds: 5400},
+					{Dungeon: mapDungeon(stone), Completed: 1, AverageSeconds: 2500, AverageParRatio: 1.25,
+						elapsedSeconds: 2500, parSeconds: 2000},
+				},
+				Roles: []*RoleStats{{Role: RoleTank, Completed: 3, Timed: 1}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, summarizeRunStats(5, tt.rows))
+		})
+	}
+}
+
+func Test_statsService_GetUserStats(t *testing.T) {
+	ctx := context.Background()
+	row := repo.UserRunStat{UserID: 5, DungeonID: 3, Role: "Healer", Completed: 1, ElapsedSeconds: 1900,
+		ParSeconds: 1800}
+	dungeon := repo.Dungeon{ID: 3, Code: "ARAK", ParSeconds: 1800}
+
+	tests := []struct {
+		name    string
+		summary bool
+		affix   string
+	}{
+		{"Aggregated", false, ""},
+		{"From Summary", true, ""},
+		{"Affix Skips Summary", true, "tyrannical"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockq := repo.NewMockQuerier(t)
+			mockq.EXPECT().GetUserByID(ctx, int32(5)).Return(repo.GetUserByIDRow{ID: 5}, nil)
+			switch {
+			case tt.affix != "":
+				mockq.EXPECT().GetAffixByCode(ctx, "TYRANNICAL").Return(repo.Affix{ID: 2, Code: "TYRANNICAL"}, nil)
+				mockq.EXPECT().GetUserAffixRunStats(ctx, repo.GetUserAffixRunStatsParams{UserID: 5,
+					Affix: "TYRANNICAL"}).Return([]repo.GetUserAffixRunStatsRow{{UserID: 5, DungeonID: 3, Role: "Healer",
+					Completed: 1, ElapsedSeconds: 1900, ParSeconds: 1800, Dungeon: dungeon}}, nil)
+			case tt.summary:
+				mockq.EXPECT().GetUserRunStatsSummary(ctx, int32(5)).Return([]repo.GetUserRunStatsSummaryRow{
+					{UserRunStatsSummary: repo.UserRunStatsSummary(row), Dungeon: dungeon}}, nil)
+			default:
+				mockq.EXPECT().GetUserRunStats(ctx, int32(5)).Return([]repo.GetUserRunStatsRow{
+					{UserRunStat: row, Dungeon: dungeon}}, nil)
+			}
+			mockq.EXPECT().GetUserSignupStats(ctx, repo.GetUserSignupStatsParams{UserID: 5,
+				LateWithdrawalSeconds: 86400}).Return(repo.GetUserSignupStatsRow{NoShows: 1, LateWithdrawals: 2}, nil)
+
+			s := &statsService{statsRepo: mockq, summary: tt.summary}
+			stats, err := s.GetUserStats(ctx, 5, tt.affix)
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, int32(1), stats.Completed)
+			assert.Equal(t, "ARAK", stats.Dungeons[0].Dungeon.Code)
+			assert.Equal(t, int32(1), stats.NoShows)
+			assert.Equal(t, int32(2), stats.LateWithdrawals)
+		})
+	}
+}
+
+func Test_statsService_GetUserStats_noShow(t *testing.T) {
+	// The only run the player signed up for finished, but they were marked as
+	// a no-show, so it is not among their run stats.
+	ctx := context.Background()
+	mockq := repo.NewMockQuerier(t)
+	mockq.EXPECT().GetUserByID(ctx, int32(5)).Return(repo.GetUserByIDRow{ID: 5}, nil)
+	mockq.EXPECT().GetUserRunStats(ctx, int32(5)).Return(nil, nil)
+	mockq.EXPECT().GetUserSignupStats(ctx, repo.GetUserSignupStatsParams{UserID: 5,
+		LateWithdrawalSeconds: 86400}).Return(repo.GetUserSignupStatsRow{NoShows: 1}, nil)
+
+	s := &statsService{statsRepo: mockq}
+	stats, err := s.GetUserStats(ctx, 5, "")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Zero(t, stats.Completed)
+	assert.Zero(t, stats.Timed)
+	assert.Empty(t, stats.Dungeons)
+	assert.Equal(t, int32(1), stats.NoShows)
+}
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// runEventChannel is the channel run events are published on when they are
+// recorded, see the notify_run_event trigger.
+const runEventChannel = "run_events"
+
+const (
+	// maxReplayEvents is the most missed events replayed to a stream that
+	// resumes. A stream that missed more has to reload the run instead.
+	maxReplayEvents = 100
+	// subscriptionBuffer is how many events a stream can fall behind by before
+	// it is dropped.
+	subscriptionBuffer = 32
+	// streamReconnectDelay is how long to wait before listening again after
+	// the listening connection failed.
+	streamReconnectDelay = 5 * time.Second
+)
+
+// RunStreamService is the interface for following the events of a run as
+// they happen.
+type RunStreamService interface {
+	Subscribe(ctx context.Context, runID int32, lastEventID int64) (*RunSubscription, error)
+}
+
+// RunSubscription is a live feed of the events of a run.
+type RunSubscription struct {
+	// Replay are the events recorded after the last event id the stream
+	// resumed from, oldest first. Events may also be sent on Events, skip
+	// those that were replayed.
+	Replay []*RunEvent
+	// Reset is set when more events were missed than can be replayed, the
+	// client has to reload the run.
+	Reset bool
+	// Events receives events as they are recorded. It is closed when the
+	// subscriber falls too far behind or the service stops listening.
+	Events <-chan *RunEvent
+
+	close func()
+}
+
+// Close stops the subscription.
+func (s *RunSubscription) Close() {
+	if s.close != nil {
+		s.close()
+	}
+}
+
+// runSubscriber is the sending side of a RunSubscription.
+type runSubscriber struct {
+	events chan *RunEvent
+	closed bool
+}
+
+// runStreamService is the implementation of RunStreamService. Every process
+// serving streams listens for run events on a connection of its own and fans
+// them out to its subscribers, so streams see changes made by any process.
+type runStreamService struct {
+	dbPool     *pgxpool.Pool
+	streamRepo repo.Querier
+
+	mu          sync.Mutex
+	subscribers map[int32]map[*runSubscriber]struct{}
+	stopped     bool
+}
+
+// NewRunStreamService creates a new runStreamService with the provided
+// database connection pool. Events are only delivered while Listen runs.
+// It returns a pointer to the runStreamService.
+func NewRunStreamService(dbPool *pgxpool.Pool) *runStreamService {
+	return &runStreamService{
+		dbPool:      dbPool,
+		streamRepo:  repo.New(dbPool),
+		subscribers: make(map[int32]map[*runSubscriber]struct{}),
+	}
+}
+
+// Subscribe follows the events of a run. A lastEventID above 0 resumes a
+// stream, replaying the events recorded after it.
+func (s *runStreamService) Subscribe(ctx context.Context, runID int32, lastEventID int64) (*RunSubscription, error) {
+	if _, err := s.streamRepo.GetRunByID(ctx, runID); errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrRunNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Subscribe before loading the replay so no event falls in between.
+	sub := s.subscribe(runID)
+	subscription := &RunSubscription{
+		Events: sub.events,
+		close:  func() { s.unsubscribe(runID, sub) },
+	}
+	if lastEventID <= 0 {
+		return subscription, nil
+	}
+
+	rows, err := s.streamRepo.GetRunEventsAfter(ctx, repo.GetRunEventsAfterParams{
+		RunID:     runID,
+		AfterID:   lastEventID,
+		MaxEvents: maxReplayEvents + 1,
+	})
+	if err != nil {
+		subscription.Close()
+		return nil, err
+	}
+	if len(rows) > maxReplayEvents {
+		subscription.Reset = true
+		return subscription, nil
+	}
+	for _, row := range rows {
+		subscription.Replay = append(subscription.Replay, mapRunEvent(row))
+	}
+	return subscription, nil
+}
+
+// Listen delivers run events to subscribers until ctx is done, then closes
+// every subscription.
+func (s *runStreamService) Listen(ctx context.Context) {
+	defer s.closeAll()
+	for {
+		err := s.listenOnce(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("listening for run events: %v", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(streamReconnectDelay):
+		}
+	}
+}
+
+// listenOnce listens for run events on a connection of its own until it fails
+// or ctx is done. The connection is closed rather than returned to the pool.
+// Notifications only carry the ids of an event, it is loaded if anyone is
+// streaming its run.
+func (s *runStreamService) listenOnce(ctx context.Context) error {
+	pooled, err := s.dbPool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+runEventChannel); err != nil {
+		return err
+	}
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		var ref struct {
+			ID    int64 `json:"id"`
+			RunID int32 `json:"run_id"`
+		}
+		if err := json.Unmarshal([]byte(notification.Payload), &ref); err != nil {
+			log.Printf("decoding run event: %v", err)
+			continue
+		}
+		if !s.streaming(ref.RunID) {
+			continue
+		}
+		event, err := s.streamRepo.GetRunEvent(ctx, ref.ID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.Printf("loading run event %d: %v", ref.ID, err)
+			continue
+		}
+		s.publish(mapRunEvent(event))
+	}
+}
+
+// streaming reports whether anyone subscribed to the events of a run.
+func (s *runStreamService) streaming(runID int32) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.subscribers[runID]) > 0
+}
+
+// subscribe adds a subscriber to the events of a run.
+func (s *runStreamService) subscribe(runID int32) *runSubscriber {
+	sub := &runSubscriber{events: make(chan *RunEvent, subscriptionBuffer)}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stopped {
+		sub.closed = true
+		close(sub.events)
+		return sub
+	}
+	if s.subscribers[runID] == nil {
+		s.subscribers[runID] = make(map[*runSubscriber]struct{})
+	}
+	s.subscribers[runID][sub] = struct{}{}
+	return sub
+}
+
+// unsubscribe closes sub and forgets it.
+func (s *runStreamService) unsubscribe(runID int32, sub *runSubscriber) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.remove(runID, sub)
+}
+
+// publish sends event to the subscribers of its run. Subscribers that are too
+// far behind to take it are dropped, they resume from their last event.
+func (s *runStreamService) publish(event *RunEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for sub := range s.subscribers[event.RunID] {
+		select {
+		case sub.events <- event:
+		default:
+			s.remove(event.RunID, sub)
+		}
+	}
+}
+
+// remove closes sub and forgets it. s.mu must be held.
+func (s *runStreamService) remove(runID int32, sub *runSubscriber) {
+	if !sub.closed {
+		sub.closed = true
+		close(sub.events)
+	}
+	delete(s.subscribers[runID], sub)
+	if len(s.subscribers[runID]) == 0 {
+		delete(s.subscribers, runID)
+	}
+}
+
+// closeAll closes every subscription, and any made later.
+func (s *runStreamService) closeAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stopped = true
+	for runID, subs := range s.subscribers {
+		for sub := range subs {
+			s.remove(runID, sub)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+func Test_runStreamService_Subscribe(t *testing.T) {
+	events := func(n int) []repo.RunEvent {
+		rows := make([]repo.RunEvent, n)
+		for i := range rows {
+			rows[i] = repo.RunEvent{ID: int64(11 + i), RunID: 1, Type: "signup.confirmed"}
+		}
+		return rows
+	}
+
+	tests := []struct {
+		name        string
+		lastEventID int64
+		rowErr      error
+		missed      []repo.RunEvent
+		wantReplay  int
+		wantReset   bool
+		wantErr     error
+	}{
+		{"New Stream", 0, nil, nil, 0, false, nil},
+		{"Resume", 10, nil, events(3), 3, false, nil},
+		{"Resume Up To Date", 10, nil, nil, 0, false, nil},
+		{"Missed Too Many", 10, nil, events(maxReplayEvents + 1), 0, true, nil},
+		{"Run Not Found", 0, pgx.ErrNoRows, nil, 0, false, ErrRunNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockq := repo.NewMockQuerier(t)
+			mockq.EXPECT().GetRunByID(ctx, int32(1)).Return(repo.GetRunByIDRow{}, tt.rowErr)
+			if tt.lastEventID > 0 {
+				mockq.EXPECT().GetRunEventsAfter(ctx, repo.GetRunEventsAfterParams{
+					RunID: 1, AfterID: tt.lastEventID, MaxEvents: maxReplayEvents + 1,
+				}).Return(tt.missed, nil)
+			}
+			s := &runStreamService{streamRepo: mockq, subscribers: make(map[int32]map[*runSubscriber]struct{})}
+
+			sub, err := s.Subscribe(ctx, 1, tt.lastEventID)
+			if !assert.ErrorIs(t, err, tt.wantErr) || err != nil {
+				return
+			}
+			defer sub.Close()
+			assert.Len(t, sub.Replay, tt.wantReplay)
+			assert.Equal(t, tt.wantReset, sub.Reset)
+			if tt.wantReplay > 0 {
+				assert.Equal(t, int64(11), sub.Replay[0].ID)
+			}
+		})
+	}
+}
+
+func Test_runStreamService_publish(t *testing.T) {
+	s := &runStreamService{subscribers: make(map[int32]map[*runSubscriber]struct{})}
+	run1 := s.subscribe(1)
+	run2 := s.subscribe(2)
+	slow := s.subscribe(1)
+	for range subscriptionBuffer {
+		slow.events <- &RunEvent{}
+	}
+
+	s.publish(&RunEvent{ID: 5, RunID: 1})
+
+	assert.Equal(t, int64(5), (<-run1.events).ID)
+	assert.Empty(t, run2.events, "events only go to subscribers of their run")
+	for range subscriptionBuffer {
+		<-slow.events
+	}
+	_, open := <-slow.events
+	assert.False(t, open, "subscribers that fall behind are dropped")
+
+	s.unsubscribe(1, run1)
+	_, open = <-run1.events
+	assert.False(t, open)
+
+	s.closeAll()
+	_, open = <-run2.events
+	assert.False(t, open)
+	late := s.subscribe(1)
+	_, open = <-late.events
+	assert.False(t, open, "subscriptions after the service stopped are closed")
+	assert.Empty(t, s.subscribers)
+}
@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// inTx runs fn inside a database transaction on the provided pool.
+// The transaction is committed if fn returns nil and rolled back otherwise.
+// Use the Querier passed to fn for every query that should be part of the transaction.
+func inTx(ctx context.Context, dbPool *pgxpool.Pool, fn func(repo.Querier) error) error {
+	tx, err := dbPool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := fn(repo.New(tx)); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
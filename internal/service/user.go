@@ -2,10 +2,12 @@ package service
 
 import (
 	"context"
+	"errors"
 	"regexp"
 	"slices"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/tmaffia/dungeon-time-api/internal/repo"
 	"golang.org/x/crypto/bcrypt"
@@ -23,6 +25,7 @@ type User struct {
 	passwordHash string
 	Roles        []UserRole    `json:"roles"`
 	Timezone     time.Location `json:"timezone"`
+	Reliability  *Reliability  `json:"reliability,omitempty"`
 	CreatedAt    time.Time     `json:"created_at"`
 	UpdatedAt    time.Time     `json:"updated_at"`
 }
@@ -110,14 +113,17 @@ type UserService interface {
 	GetUserByID(context.Context, int32) (*User, error)
 	GetUserByEmail(context.Context, string) (*User, error)
 	GetUserByUsername(context.Context, string) (*User, error)
+	GetReliability(context.Context, int32) (*Reliability, error)
 }
 
 // userService is the implementation of UserService. It uses a database connection
 // pool and a repository to interact with the database. It is responsible for
-// user-related operations.
+// user-related operations. Writes that belong together go through inTx.
 type userService struct {
 	dbPool   *pgxpool.Pool
 	userRepo repo.Querier
+	inTx     func(context.Context, func(repo.Querier) error) error
+	now      func() time.Time
 }
 
 // NewUserService creates a new userService with the provided database connection pool.
@@ -126,6 +132,10 @@ func NewUserService(dbPool *pgxpool.Pool) *userService {
 	return &userService{
 		dbPool:   dbPool,
 		userRepo: repo.New(dbPool),
+		inTx: func(ctx context.Context, fn func(repo.Querier) error) error {
+			return inTx(ctx, dbPool, fn)
+		},
+		now: time.Now,
 	}
 }
 
@@ -139,7 +149,19 @@ func (s *userService) RegisterUser(ctx context.Context, user *User) (*User, erro
 		return nil, err
 	}
 
-	u, err := s.userRepo.CreateUser(ctx, repo.CreateUserParams{
+	err = s.inTx(ctx, func(q repo.Querier) error {
+		return createUser(ctx, q, user)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// createUser inserts user with q, filling in its ID and timestamps, and
+// emits the user.registered webhook event.
+func createUser(ctx context.Context, q repo.Querier, user *User) error {
+	u, err := q.CreateUser(ctx, repo.CreateUserParams{
 		Username:     user.Username,
 		Email:        user.Email,
 		PasswordHash: user.passwordHash,
@@ -148,14 +170,18 @@ func (s *userService) RegisterUser(ctx context.Context, user *User) (*User, erro
 	})
 
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	user.ID = u.ID
 	user.CreatedAt = u.CreatedAt.Time
 	user.UpdatedAt = u.UpdatedAt.Time
 
-	return user, nil
+	return emitWebhookEvent(ctx, q, WebhookUserRegistered, nil, userWebhookData{
+		ID:       u.ID,
+		Username: u.Username,
+		Timezone: u.Timezone,
+	})
 }
 
 // GetUsers returns all registered users. Only the ID, Username, Email, Roles, and Timezone
@@ -191,8 +217,12 @@ func (s *userService) GetUsers(ctx context.Context) ([]*User, error) {
 
 // GetUserByID returns a user by ID. Only the ID, Username, Email, Roles, and Timezone
 // fields are returned for the user.
+// Returns ErrUserNotFound if no user has the ID.
 func (s *userService) GetUserByID(ctx context.Context, id int32) (*User, error) {
 	u, err := s.userRepo.GetUserByID(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrUserNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -218,8 +248,12 @@ func (s *userService) GetUserByID(ctx context.Context, id int32) (*User, error)
 
 // GetUserByEmail returns a user by email. Only the ID, Username, Email, Roles, and Timezone
 // fields are returned for the user.
+// Returns ErrUserNotFound if no user has the email.
 func (s *userService) GetUserByEmail(ctx context.Context, email string) (*User, error) {
 	u, err := s.userRepo.GetUserByEmail(ctx, email)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrUserNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -245,8 +279,12 @@ func (s *userService) GetUserByEmail(ctx context.Context, email string) (*User,
 
 // GetUserByUsername returns a user by username. Only the ID, Username, Email, Roles, and Timezone
 // fields are returned for the user.
+// Returns ErrUserNotFound if no user has the username.
 func (s *userService) GetUserByUsername(ctx context.Context, username string) (*User, error) {
 	u, err := s.userRepo.GetUserByUsername(ctx, username)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrUserNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -144,7 +144,13 @@ func Test_userService_RegisterUser(t *testing.T) {
 				Roles:    []string{},
 				Timezone: "UTC",
 			}, nil)
-			tt.s = &userService{userRepo: mockq}
+			mockq.EXPECT().GetSubscribedWebhookEndpoints(tt.args.ctx, repo.GetSubscribedWebhookEndpointsParams{
+				Event: "user.registered",
+			}).Return(nil, nil)
+
+			tt.s = &userService{userRepo: mockq, inTx: func(_ context.Context, fn func(repo.Querier) error) error {
+				return fn(mockq)
+			}}
 
 			u, err := tt.s.RegisterUser(tt.args.ctx, tt.args.user)
 			if !assert.ErrorIs(t, err, tt.wantErr) {
@@ -155,6 +161,12 @@ func Test_userService_RegisterUser(t *testing.T) {
 	}
 }
 
+func Test_userService_RegisterUser_invalid(t *testing.T) {
+	s := &userService{userRepo: repo.NewMockQuerier(t)}
+	_, err := s.RegisterUser(context.Background(), &User{Username: "x", Email: "example@example.com"})
+	assert.ErrorIs(t, err, ErrInvalidUsername)
+}
+
 func Test_userService_GetUsers(t *testing.T) {
 	type args struct {
 		ctx context.Context
@@ -0,0 +1,390 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+// vaultThresholds are how many Mythic+ runs a player has to time in a week to
+// unlock each slot of the Great Vault.
+var vaultThresholds = []int32{1, 4, 8}
+
+// vaultPushNotes are the notes of the runs created for a vault push.
+const vaultPushNotes = "Vault push for members short of their weekly runs."
+
+// VaultSlot is a slot of the Great Vault, unlocked by timing Threshold runs in
+// the week. KeyLevel is the level of the run that unlocked it, the
+// Threshold-th highest key timed, which sets the reward of the slot.
+type VaultSlot struct {
+	Threshold int32  `json:"threshold"`
+	Unlocked  bool   `json:"unlocked"`
+	KeyLevel  *int32 `json:"key_level,omitempty"`
+}
+
+// WeeklyProgress is how many Mythic+ runs a user timed this week and the vault
+// slots they unlocked with them. RunsNeeded is how many more timed runs unlock
+// the next slot, 0 once every slot is unlocked.
+type WeeklyProgress struct {
+	UserID     int32       `json:"user_id"`
+	Username   string      `json:"username"`
+	TimedRuns  int32       `json:"timed_runs"`
+	RunsNeeded int32       `json:"runs_needed"`
+	Slots      []VaultSlot `json:"slots"`
+}
+
+// VaultProgress is the progress of a user in the week from WeekStart until
+// ResetsAt.
+type VaultProgress struct {
+	WeekStart time.Time `json:"week_start"`
+	ResetsAt  time.Time `json:"resets_at"`
+	WeeklyProgress
+}
+
+// VaultQuery picks the runs that count towards the vault, timed at MinLevel or
+// above, and for guilds how many Slots members should unlock. Zero values count
+// every key and ask for every slot. With Push set, a vault push is planned for
+// the members that are short.
+type VaultQuery struct {
+	MinLevel int32
+	Slots    int32
+	Push     *KeyPlanQuery
+}
+
+// target returns how many timed runs unlock the slots asked for.
+func (q *VaultQuery) target() int32 {
+	if q.Slots == 0 {
+		return vaultThresholds[len(vaultThresholds)-1]
+	}
+	return vaultThresholds[q.Slots-1]
+}
+
+// GuildVault lists the members of a guild that are short of the runs to unlock
+// the vault slots asked for this week, the furthest behind first. Push is a
+// key that members who are short can run together before the reset, if a vault
+// push was asked for and a group could be found.
+type GuildVault struct {
+	GuildID   int32             `json:"guild_id"`
+	WeekStart time.Time         `json:"week_start"`
+	ResetsAt  time.Time         `json:"resets_at"`
+	Target    int32             `json:"target"`
+	Short     []*WeeklyProgress `json:"short"`
+	Push      *PlannedKey       `json:"push,omitempty"`
+}
+
+// VaultService is the interface for weekly Great Vault progress.
+type VaultService interface {
+	GetVaultProgress(ctx context.Context, userID int32, query *VaultQuery) (*VaultProgress, error)
+	GetGuildVault(ctx context.Context, guildID int32, query *VaultQuery) (*GuildVault, error)
+	CreateVaultPush(ctx context.Context, actorID, guildID int32, query *VaultQuery) (*Run, error)
+}
+
+// vaultService is the implementation of VaultService.
+type vaultService struct {
+	dbPool    *pgxpool.Pool
+	vaultRepo repo.Querier
+	reset     WeeklyReset
+	now       func() time.Time
+}
+
+// NewVaultService creates a new vaultService with the provided database connection pool.
+// Runs count towards the vault of the week they were finished in, by reset.
+// It returns a pointer to the vaultService.
+func NewVaultService(dbPool *pgxpool.Pool, reset WeeklyReset) *vaultService {
+	return &vaultService{
+		dbPool:    dbPool,
+		vaultRepo: repo.New(dbPool),
+		reset:     reset,
+		now:       time.Now,
+	}
+}
+
+// GetVaultProgress returns the progress of the user with userID this week.
+// Returns ErrUserNotFound if the user does not exist.
+func (s *vaultService) GetVaultProgress(ctx context.Context, userID int32, query *VaultQuery) (*VaultProgress,
+	error) {
+	if err := isValidVaultQuery(query); err != nil {
+		return nil, err
+	}
+
+	user, err := s.vaultRepo.GetUserByID(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	week := s.reset.CurrentWeek(s.now())
+	levels, err := weeklyTimedRuns(ctx, s.vaultRepo, []int32{userID}, week, query.MinLevel)
+	if err != nil {
+		return nil, err
+	}
+	return &VaultProgress{
+		WeekStart:      week.StartsAt,
+		ResetsAt:       week.EndsAt,
+		WeeklyProgress: *newWeeklyProgress(user.ID, user.Username, levels[userID]),
+	}, nil
+}
+
+// GetGuildVault returns the members of the guild with guildID that are short
+// of their vault slots this week, see GuildVault.
+func (s *vaultService) GetGuildVault(ctx context.Context, guildID int32, query *VaultQuery) (*GuildVault, error) {
+	if err := isValidVaultQuery(query); err != nil {
+		return nil, err
+	}
+
+	guild, err := loadGuild(ctx, s.vaultRepo, guildID)
+	if err != nil {
+		return nil, err
+	}
+	return guildVault(ctx, s.vaultRepo, guild, s.reset.CurrentWeek(s.now()), query, s.now())
+}
+
+// CreateVaultPush schedules the vault push of the guild with guildID, led by
+// the holder of its key, with the group confirmed in the roles they were
+// planned for. Only guild admins can create it. Returns ErrNoVaultPush if no
+// group of members that are short can run a key before the reset.
+func (s *vaultService) CreateVaultPush(ctx context.Context, actorID, guildID int32, query *VaultQuery) (*Run,
+	error) {
+	if err := isValidVaultQuery(query); err != nil {
+		return nil, err
+	}
+	if query.Push == nil {
+		return nil, ErrInvalidVaultQuery
+	}
+
+	var run *Run
+	err := inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		guild, err := loadGuild(ctx, q, guildID)
+		if err != nil {
+			return err
+		}
+		if !guild.IsAdmin(actorID) {
+			return ErrForbidden
+		}
+
+		now := s.now()
+		vault, err := guildVault(ctx, q, guild, s.reset.CurrentWeek(now), query, now)
+		if err != nil {
+			return err
+		}
+		if vault.Push == nil {
+			return ErrNoVaultPush
+		}
+		run, err = createVaultPushRun(ctx, q, guildID, vault.Push, query.Push)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// guildVault lists the members of guild that are short of the vault slots
+// asked for in week, and plans their push from now if the query asks for one.
+func guildVault(ctx context.Context, q repo.Querier, guild *Guild, week Week, query *VaultQuery,
+	now time.Time) (*GuildVault, error) {
+	ids := make([]int32, 0, len(guild.Members))
+	for _, m := range guild.Members {
+		ids = append(ids, m.UserID)
+	}
+	levels, err := weeklyTimedRuns(ctx, q, ids, week, query.MinLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	vault := &GuildVault{
+		GuildID:   guild.ID,
+		WeekStart: week.StartsAt,
+		ResetsAt:  week.EndsAt,
+		Target:    query.target(),
+		Short:     []*WeeklyProgress{},
+	}
+	for _, m := range guild.Members {
+		progress := newWeeklyProgress(m.UserID, m.Username, levels[m.UserID])
+		if progress.TimedRuns < vault.Target {
+			vault.Short = append(vault.Short, progress)
+		}
+	}
+	slices.SortStableFunc(vault.Short, func(a, b *WeeklyProgress) int {
+		return int(a.TimedRuns - b.TimedRuns)
+	})
+
+	if query.Push != nil && len(vault.Short) > 0 {
+		vault.Push, err = planVaultPush(ctx, q, guild.ID, vault.Short, week, query.Push, now)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return vault, nil
+}
+
+// planVaultPush plans the highest key held by a member who is short that a
+// group of members who are short can run between now and the reset, or nil if
+// there is none.
+func planVaultPush(ctx context.Context, q repo.Querier, guildID int32, short []*WeeklyProgress, week Week,
+	query *KeyPlanQuery, now time.Time) (*PlannedKey, error) {
+	isShort := make(map[int32]bool, len(short))
+	for _, p := range short {
+		isShort[p.UserID] = true
+	}
+
+	members, err := loadPlayers(ctx, q, &BestTimeQuery{GuildID: &guildID})
+	if err != nil {
+		return nil, err
+	}
+	var players []*player
+	for _, p := range members {
+		if isShort[p.userID] {
+			players = append(players, p)
+		}
+	}
+	if err := loadFreeTime(ctx, q, players, now, week.EndsAt); err != nil {
+		return nil, err
+	}
+
+	rows, err := q.GetGuildKeystones(ctx, repo.GetGuildKeystonesParams{
+		GuildID:       guildID,
+		RecordedAfter: pgTimestamptz(week.StartsAt),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var keys []heldKey
+	for _, row := range rows {
+		if isShort[row.Keystone.UserID] {
+			keys = append(keys, heldKey{
+				keystone: mapHeldKeystone(row.Keystone, row.Dungeon, row.Username, row.CharacterName),
+				roles:    playerRoles(row.HolderRoles),
+			})
+		}
+	}
+
+	duration := time.Duration(query.DurationMinutes) * time.Minute
+	planned, _ := planKeys(keys, players, query.Composition, now, week.EndsAt, duration)
+	if len(planned) == 0 {
+		return nil, nil
+	}
+	return planned[0], nil
+}
+
+// createVaultPushRun creates the run of a planned vault push in the guild with
+// guildID, like any new run, and books the group. Each member is told they
+// were booked.
+func createVaultPushRun(ctx context.Context, q repo.Querier, guildID int32, push *PlannedKey,
+	query *KeyPlanQuery) (*Run, error) {
+	dungeon, err := q.GetDungeonByCode(ctx, push.Keystone.Dungeon)
+	if err != nil {
+		return nil, err
+	}
+	holder, err := q.GetUserByID(ctx, push.Keystone.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	level := push.Keystone.Level
+	run, err := createRun(ctx, q, holder.ID, &runFields{
+		dungeon:         dungeon,
+		difficulty:      DifficultyMythicPlus,
+		keyLevel:        &level,
+		startsAt:        push.StartsAt,
+		timezone:        holder.Timezone,
+		durationMinutes: query.DurationMinutes,
+		notes:           vaultPushNotes,
+		composition:     query.Composition,
+		guildID:         &guildID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, member := range push.Group {
+		params := repo.CreateSignupParams{
+			RunID:  run.ID,
+			UserID: member.UserID,
+			Role:   string(member.Role),
+			Status: string(SignupStatusConfirmed),
+		}
+		if member.UserID == holder.ID {
+			params.CharacterID = pgInt4(push.Keystone.CharacterID)
+		}
+		signup, err := q.CreateSignup(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		if err := recordSignupEvent(ctx, q, EventSignupConfirmed, signup); err != nil {
+			return nil, err
+		}
+		if err := enqueueRunNotification(ctx, q, member.UserID, NotificationSignupBooked, run,
+			member.Role); err != nil {
+			return nil, err
+		}
+	}
+	return run, nil
+}
+
+// weeklyTimedRuns returns the levels of the keys each of the users with ids
+// timed in week at minLevel or above, highest first.
+func weeklyTimedRuns(ctx context.Context, q repo.Querier, ids []int32, week Week,
+	minLevel int32) (map[int32][]int32, error) {
+	rows, err := q.GetWeeklyTimedRuns(ctx, repo.GetWeeklyTimedRunsParams{
+		UserIds:  ids,
+		StartsAt: pgTimestamptz(week.StartsAt),
+		EndsAt:   pgTimestamptz(week.EndsAt),
+		MinLevel: minLevel,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	levels := make(map[int32][]int32)
+	for _, row := range rows {
+		levels[row.UserID] = append(levels[row.UserID], row.KeyLevel)
+	}
+	return levels, nil
+}
+
+// newWeeklyProgress returns the progress of a user who timed keys of levels
+// this week, highest first.
+func newWeeklyProgress(userID int32, username string, levels []int32) *WeeklyProgress {
+	progress := &WeeklyProgress{
+		UserID:    userID,
+		Username:  username,
+		TimedRuns: int32(len(levels)),
+		Slots:     make([]VaultSlot, 0, len(vaultThresholds)),
+	}
+	for _, threshold := range vaultThresholds {
+		slot := VaultSlot{Threshold: threshold}
+		if threshold <= progress.TimedRuns {
+			slot.Unlocked = true
+			slot.KeyLevel = &levels[threshold-1]
+		} else if progress.RunsNeeded == 0 {
+			progress.RunsNeeded = threshold - progress.TimedRuns
+		}
+		progress.Slots = append(progress.Slots, slot)
+	}
+	return progress
+}
+
+func isValidVaultQuery(query *VaultQuery) error {
+	if (query.MinLevel != 0 && (query.MinLevel < minKeyLevel || query.MinLevel > maxKeyLevel)) ||
+		query.Slots < 0 || int(query.Slots) > len(vaultThresholds) {
+		return ErrInvalidVaultQuery
+	}
+	if query.Push == nil {
+		return nil
+	}
+	if !isValidRunDuration(query.Push.DurationMinutes) {
+		return ErrInvalidDuration
+	}
+	if !isValidComposition(query.Push.Composition) {
+		return ErrInvalidComposition
+	}
+	return nil
+}
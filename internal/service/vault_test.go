@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+)
+
+func Test_newWeeklyProgress(t *testing.T) {
+	tests := []struct {
+		name       string
+		levels     []int32
+		runsNeeded int32
+		unlocked   []*int32
+	}{
+		{"No Runs", nil, 1, []*int32{nil, nil, nil}},
+		{"First Slot", []int32{12, 10}, 2, []*int32{int32Ptr(12), nil, nil}},
+		{"Second Slot", []int32{14, 12, 11, 10, 7}, 3, []*int32{int32Ptr(14), int32Ptr(10), nil}},
+		{"Every Slot", []int32{15, 14, 14, 13, 12, 12, 11, 10, 8}, 0,
+			[]*int32{int32Ptr(15), int32Ptr(13), int32Ptr(10)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newWeeklyProgress(7, "thrall", tt.levels)
+			assert.Equal(t, int32(len(tt.levels)), got.TimedRuns)
+			assert.Equal(t, tt.runsNeeded, got.RunsNeeded)
+			if assert.Len(t, got.Slots, len(vaultThresholds)) {
+				for i, slot := range got.Slots {
+					assert.Equal(t, vaultThresholds[i], slot.Threshold)
+					assert.Equal(t, tt.unlocked[i] != nil, slot.Unlocked)
+					assert.Equal(t, tt.unlocked[i], slot.KeyLevel)
+				}
+			}
+		})
+	}
+}
+
+func Test_guildVault(t *testing.T) {
+	ctx := context.Background()
+	week := regionResets[RegionUS].CurrentWeek(utc(2026, 10, 15, 12, 0))
+	guild := &Guild{ID: 4, Members: []*GuildMember{
+		{UserID: 1, Username: "jaina"},
+		{UserID: 2, Username: "thrall"},
+		{UserID: 3, Username: "anduin"},
+	}}
+	rows := []repo.GetWeeklyTimedRunsRow{
+		{UserID: 1, KeyLevel: 12}, {UserID: 1, KeyLevel: 11}, {UserID: 1, KeyLevel: 10}, {UserID: 1, KeyLevel: 10},
+		{UserID: 3, KeyLevel: 9},
+	}
+
+	tests := []struct {
+		name  string
+		query *VaultQuery
+		want  []int32
+	}{
+		{"Every Slot", &VaultQuery{}, []int32{2, 3, 1}},
+		{"Two Slots", &VaultQuery{Slots: 2}, []int32{2, 3}},
+		{"One Slot", &VaultQuery{Slots: 1}, []int32{2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockq := repo.NewMockQuerier(t)
+			mockq.EXPECT().GetWeeklyTimedRuns(ctx, repo.GetWeeklyTimedRunsParams{
+				UserIds:  []int32{1, 2, 3},
+				StartsAt: pgTimestamptz(week.StartsAt),
+				EndsAt:   pgTimestamptz(week.EndsAt),
+			}).Return(rows, nil)
+
+			got, err := guildVault(ctx, mockq, guild, week, tt.query, week.StartsAt)
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, tt.query.target(), got.Target)
+			assert.Nil(t, got.Push)
+			ids := make([]int32, 0, len(got.Short))
+			for _, p := range got.Short {
+				ids = append(ids, p.UserID)
+			}
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+}
+
+func Test_createVaultPushRun(t *testing.T) {
+	ctx := context.Background()
+	startsAt := utc(2026, 10, 16, 19, 0)
+	push := &PlannedKey{
+		Keystone: &HeldKeystone{UserID: 1, CharacterID: int32Ptr(11), Dungeon: "NW", Level: 12},
+		StartsAt: startsAt,
+		Group:    []RoleAssignment{{UserID: 1, Role: RoleTank}, {UserID: 2, Role: RoleHealer}},
+	}
+	query := &KeyPlanQuery{Composition: Composition{Tanks: 1, Healers: 1}, DurationMinutes: 45}
+
+	mockq := repo.NewMockQuerier(t)
+	mockq.EXPECT().GetDungeonByCode(ctx, "NW").Return(repo.Dungeon{ID: 3, Code: "NW"}, nil)
+	mockq.EXPECT().GetUserByID(ctx, int32(1)).Return(repo.GetUserByIDRow{ID: 1, Timezone: "UTC"}, nil)
+	mockq.EXPECT().CreateRun(ctx, mock.MatchedBy(func(arg repo.CreateRunParams) bool {
+		return arg.OrganizerID == 1 && arg.GuildID.Int32 == 4
+	})).Return(repo.Run{ID: 9, OrganizerID: 1, DungeonID: 3, GuildID: pgtype.Int4{Int32: 4, Valid: true},
+		StartsAt: pgTimestamptz(startsAt), Status: string(RunStatusScheduled)}, nil)
+	mockq.EXPECT().CreateGuildAnnouncement(ctx, repo.CreateGuildAnnouncementParams{
+		RunID: 9, Kind: string(AnnouncementRunCreated), GuildID: 4,
+	}).Return(nil)
+	mockq.EXPECT().GetSubscribedWebhookEndpoints(ctx, repo.GetSubscribedWebhookEndpointsParams{
+		Event: string(WebhookRunCreated), GuildID: pgtype.Int4{Int32: 4, Valid: true},
+	}).Return(nil, nil)
+	for i, member := range push.Group {
+		mockq.EXPECT().CreateSignup(ctx, mock.MatchedBy(func(arg repo.CreateSignupParams) bool {
+			return arg.RunID == 9 && arg.UserID == member.UserID && arg.Status == string(SignupStatusConfirmed)
+		})).Return(repo.RunSignup{ID: int32(i + 1), RunID: 9, UserID: member.UserID, Role: string(member.Role),
+			Status: string(SignupStatusConfirmed)}, nil)
+		mockq.EXPECT().CreateNotification(ctx, mock.MatchedBy(func(arg repo.CreateNotificationParams) bool {
+			return arg.UserID == member.UserID && arg.Type == string(NotificationSignupBooked)
+		})).Return(nil)
+	}
+	mockq.EXPECT().CreateRunEvent(ctx, mock.MatchedBy(func(arg repo.CreateRunEventParams) bool {
+		return arg.Type == string(EventSignupConfirmed)
+	})).Return(repo.RunEvent{}, nil).Times(2)
+
+	run, err := createVaultPushRun(ctx, mockq, 4, push, query)
+	if assert.NoError(t, err) {
+		assert.Equal(t, int32(9), run.ID)
+	}
+}
+
+func Test_isValidVaultQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   *VaultQuery
+		wantErr error
+	}{
+		{"Defaults", &VaultQuery{}, nil},
+		{"Valid", &VaultQuery{MinLevel: 10, Slots: 2}, nil},
+		{"Min Level Too Low", &VaultQuery{MinLevel: 1}, ErrInvalidVaultQuery},
+		{"Min Level Too High", &VaultQuery{MinLevel: maxKeyLevel + 1}, ErrInvalidVaultQuery},
+		{"Too Many Slots", &VaultQuery{Slots: 4}, ErrInvalidVaultQuery},
+		{"Negative Slots", &VaultQuery{Slots: -1}, ErrInvalidVaultQuery},
+		{"Valid Push", &VaultQuery{Push: &KeyPlanQuery{Composition: DefaultComposition, DurationMinutes: 45}},
+			nil},
+		{"Push Duration", &VaultQuery{Push: &KeyPlanQuery{Composition: DefaultComposition}}, ErrInvalidDuration},
+		{"Push Composition", &VaultQuery{Push: &KeyPlanQuery{DurationMinutes: 45}}, ErrInvalidComposition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, isValidVaultQuery(tt.query), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,584 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tmaffia/dungeon-time-api/internal/jobs"
+	"github.com/tmaffia/dungeon-time-api/internal/repo"
+	"github.com/tmaffia/dungeon-time-api/internal/webhook"
+)
+
+// WebhookEvent is an event third-party endpoints can subscribe to.
+type WebhookEvent string
+
+const (
+	WebhookUserRegistered     = WebhookEvent("user.registered")
+	WebhookGuildMemberAdded   = WebhookEvent("guild.member_added")
+	WebhookGuildMemberRemoved = WebhookEvent("guild.member_removed")
+	WebhookRunCreated         = WebhookEvent("run.created")
+	WebhookRunUpdated         = WebhookEvent("run.updated")
+	WebhookRunCancelled       = WebhookEvent("run.cancelled")
+	WebhookRunStatusChanged   = WebhookEvent("run.status_changed")
+)
+
+// webhookEvents lists every event. Guild endpoints can subscribe to all but
+// user.registered, which is only for server endpoints.
+var webhookEvents = []WebhookEvent{WebhookUserRegistered, WebhookGuildMemberAdded, WebhookGuildMemberRemoved,
+	WebhookRunCreated, WebhookRunUpdated, WebhookRunCancelled, WebhookRunStatusChanged}
+
+// Webhook delivery statuses.
+const (
+	webhookPending   = "pending"
+	webhookDelivered = "delivered"
+	webhookFailed    = "failed"
+)
+
+// Webhook limits. An endpoint is disabled once maxWebhookFailures deliveries in
+// a row have failed every attempt.
+const (
+	webhookPayloadVersion      = 1
+	webhookMaxAttempts         = 8
+	maxWebhookFailures         = 5
+	maxWebhookDeliveriesListed = 50
+	webhookSecretBytes         = 32
+)
+
+// WebhookQueue is the job queue webhooks are delivered on, so slow endpoints
+// do not hold up other jobs.
+const WebhookQueue = "webhooks"
+
+// WebhookOwner is who a webhook endpoint belongs to. Guild endpoints are
+// managed by the guild's admins, ActorID must be one of them. Endpoints
+// without a guild belong to the server and are managed with the admin token,
+// so the caller has already checked the actor.
+type WebhookOwner struct {
+	GuildID *int32
+	ActorID int32
+}
+
+// WebhookEndpoint is a URL that events are POSTed to. The secret is only shown
+// when the endpoint is created.
+type WebhookEndpoint struct {
+	ID                  int32          `json:"id"`
+	GuildID             *int32         `json:"guild_id"`
+	URL                 string         `json:"url"`
+	Secret              string         `json:"secret,omitempty"`
+	Events              []WebhookEvent `json:"events"`
+	Active              bool           `json:"active"`
+	ConsecutiveFailures int32          `json:"consecutive_failures"`
+	DisabledAt          *time.Time     `json:"disabled_at"`
+	CreatedAt           time.Time      `json:"created_at"`
+	UpdatedAt           time.Time      `json:"updated_at"`
+}
+
+// WebhookInput creates or replaces a webhook endpoint. Active is only used on
+// update, where nil leaves it as it is and true enables a disabled endpoint.
+type WebhookInput struct {
+	URL    string         `json:"url"`
+	Events []WebhookEvent `json:"events"`
+	Active *bool          `json:"active"`
+}
+
+// WebhookDelivery is an entry in the delivery log of an endpoint.
+type WebhookDelivery struct {
+	ID             int64           `json:"id"`
+	EndpointID     int32           `json:"endpoint_id"`
+	EventID        string          `json:"event_id"`
+	Event          WebhookEvent    `json:"event"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int32           `json:"attempts"`
+	ResponseStatus *int32          `json:"response_status"`
+	LastError      string          `json:"last_error"`
+	DeliveredAt    *time.Time      `json:"delivered_at"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+// webhookPayload is the body of every webhook request. Version changes when
+// the shape of the payload or of Data changes incompatibly.
+type webhookPayload struct {
+	ID        string       `json:"id"`
+	Version   int          `json:"version"`
+	Type      WebhookEvent `json:"type"`
+	CreatedAt time.Time    `json:"created_at"`
+	Data      any          `json:"data"`
+}
+
+// userWebhookData is the data of user events. Email is left out on purpose.
+type userWebhookData struct {
+	ID       int32  `json:"id"`
+	Username string `json:"username"`
+	Timezone string `json:"timezone"`
+}
+
+// guildMemberWebhookData is the data of guild membership events.
+type guildMemberWebhookData struct {
+	GuildID int32     `json:"guild_id"`
+	UserID  int32     `json:"user_id"`
+	Role    GuildRole `json:"role"`
+}
+
+// DeliverWebhookArgs are the arguments of the job that delivers a webhook.
+type DeliverWebhookArgs struct {
+	DeliveryID int64 `json:"delivery_id"`
+}
+
+func (DeliverWebhookArgs) Kind() string {
+	return "webhook.deliver"
+}
+
+// WebhookService is the interface for managing outgoing webhooks.
+type WebhookService interface {
+	CreateWebhook(context.Context, WebhookOwner, *WebhookInput) (*WebhookEndpoint, error)
+	GetWebhooks(context.Context, WebhookOwner) ([]*WebhookEndpoint, error)
+	UpdateWebhook(context.Context, WebhookOwner, int32, *WebhookInput) (*WebhookEndpoint, error)
+	DeleteWebhook(context.Context, WebhookOwner, int32) error
+	GetDeliveries(context.Context, WebhookOwner, int32) ([]*WebhookDelivery, error)
+	Redeliver(context.Context, WebhookOwner, int32, int64) (*WebhookDelivery, error)
+}
+
+// webhookService is the implementation of WebhookService.
+type webhookService struct {
+	dbPool      *pgxpool.Pool
+	webhookRepo repo.Querier
+	client      *webhook.Client
+	now         func() time.Time
+}
+
+// NewWebhookService creates a new webhookService with the provided database
+// connection pool, sending webhooks with client.
+// It returns a pointer to the webhookService.
+func NewWebhookService(dbPool *pgxpool.Pool, client *webhook.Client) *webhookService {
+	return &webhookService{
+		dbPool:      dbPool,
+		webhookRepo: repo.New(dbPool),
+		client:      client,
+		now:         time.Now,
+	}
+}
+
+// CreateWebhook registers an endpoint for owner with a new signing secret.
+func (s *webhookService) CreateWebhook(ctx context.Context, owner WebhookOwner,
+	input *WebhookInput) (*WebhookEndpoint, error) {
+	events, err := validateWebhookInput(owner, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorize(ctx, owner); err != nil {
+		return nil, err
+	}
+
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+	e, err := s.webhookRepo.CreateWebhookEndpoint(ctx, repo.CreateWebhookEndpointParams{
+		GuildID: pgInt4(owner.GuildID),
+		Url:     input.URL,
+		Secret:  secret,
+		Events:  events,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := mapWebhookEndpoint(e)
+	endpoint.Secret = e.Secret
+	return endpoint, nil
+}
+
+// GetWebhooks returns the endpoints of owner.
+func (s *webhookService) GetWebhooks(ctx context.Context, owner WebhookOwner) ([]*WebhookEndpoint, error) {
+	if err := s.authorize(ctx, owner); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.webhookRepo.GetWebhookEndpoints(ctx, pgInt4(owner.GuildID))
+	if err != nil {
+		return nil, err
+	}
+	endpoints := make([]*WebhookEndpoint, 0, len(rows))
+	for _, row := range rows {
+		endpoints = append(endpoints, mapWebhookEndpoint(row))
+	}
+	return endpoints, nil
+}
+
+// UpdateWebhook replaces the URL and events of an endpoint. Enabling an
+// endpoint resets its failures, disabling it stops deliveries.
+func (s *webhookService) UpdateWebhook(ctx context.Context, owner WebhookOwner, id int32,
+	input *WebhookInput) (*WebhookEndpoint, error) {
+	events, err := validateWebhookInput(owner, input)
+	if err != nil {
+		return nil, err
+	}
+	e, err := s.ownedEndpoint(ctx, owner, id)
+	if err != nil {
+		return nil, err
+	}
+
+	params := repo.UpdateWebhookEndpointParams{
+		ID:                  id,
+		Url:                 input.URL,
+		Events:              events,
+		Active:              e.Active,
+		ConsecutiveFailures: e.ConsecutiveFailures,
+		DisabledAt:          e.DisabledAt,
+	}
+	if input.Active != nil && *input.Active != e.Active {
+		params.Active = *input.Active
+		if params.Active {
+			params.ConsecutiveFailures = 0
+			params.DisabledAt = pgtype.Timestamptz{}
+		} else {
+			params.DisabledAt = pgTimestamptz(s.now())
+		}
+	}
+
+	updated, err := s.webhookRepo.UpdateWebhookEndpoint(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return mapWebhookEndpoint(updated), nil
+}
+
+// DeleteWebhook removes an endpoint and its delivery log.
+func (s *webhookService) DeleteWebhook(ctx context.Context, owner WebhookOwner, id int32) error {
+	if _, err := s.ownedEndpoint(ctx, owner, id); err != nil {
+		return err
+	}
+	return s.webhookRepo.DeleteWebhookEndpoint(ctx, id)
+}
+
+// GetDeliveries returns the most recent deliveries to an endpoint.
+func (s *webhookService) GetDeliveries(ctx context.Context, owner WebhookOwner, id int32) ([]*WebhookDelivery, error) {
+	if _, err := s.ownedEndpoint(ctx, owner, id); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.webhookRepo.GetWebhookDeliveries(ctx, repo.GetWebhookDeliveriesParams{
+		EndpointID:    id,
+		MaxDeliveries: maxWebhookDeliveriesListed,
+	})
+	if err != nil {
+		return nil, err
+	}
+	deliveries := make([]*WebhookDelivery, 0, len(rows))
+	for _, row := range rows {
+		deliveries = append(deliveries, mapWebhookDelivery(row))
+	}
+	return deliveries, nil
+}
+
+// Redeliver sends the event of a past delivery to its endpoint again, as a new
+// delivery with the same event id so receivers can tell it is a repeat.
+func (s *webhookService) Redeliver(ctx context.Context, owner WebhookOwner, id int32,
+	deliveryID int64) (*WebhookDelivery, error) {
+	e, err := s.ownedEndpoint(ctx, owner, id)
+	if err != nil {
+		return nil, err
+	}
+	if !e.Active {
+		return nil, ErrWebhookDisabled
+	}
+
+	d, err := s.webhookRepo.GetWebhookDelivery(ctx, deliveryID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && d.EndpointID != id) {
+		return nil, ErrWebhookDeliveryNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var redelivery repo.WebhookDelivery
+	err = inTx(ctx, s.dbPool, func(q repo.Querier) error {
+		redelivery, err = queueWebhookDelivery(ctx, q, e.ID, d.EventID, WebhookEvent(d.Event), d.Payload)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mapWebhookDelivery(redelivery), nil
+}
+
+// DeliverWebhook is the job handler that sends a delivery. Failed attempts
+// are retried by the job queue; once the last attempt fails the delivery is
+// marked failed and counts towards disabling the endpoint.
+func (s *webhookService) DeliverWebhook(ctx context.Context, job *jobs.Job[DeliverWebhookArgs]) error {
+	d, err := s.webhookRepo.GetWebhookDelivery(ctx, job.Args.DeliveryID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// The endpoint was deleted along with its deliveries.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if d.Status != webhookPending {
+		return nil
+	}
+
+	e, err := s.webhookRepo.GetWebhookEndpoint(ctx, d.EndpointID)
+	if err != nil {
+		return err
+	}
+
+	params := repo.UpdateWebhookDeliveryParams{
+		ID:       d.ID,
+		Status:   webhookDelivered,
+		Attempts: d.Attempts + 1,
+	}
+	if !e.Active {
+		params.Status = webhookFailed
+		params.Attempts = d.Attempts
+		params.LastError = "endpoint is disabled"
+		return s.webhookRepo.UpdateWebhookDelivery(ctx, params)
+	}
+
+	resp, sendErr := s.client.Send(ctx, &webhook.Request{
+		URL:        e.Url,
+		Secret:     e.Secret,
+		Event:      d.Event,
+		DeliveryID: d.EventID,
+		Body:       d.Payload,
+	})
+	if ctx.Err() != nil {
+		// Shutting down, the job runs again later and this attempt does not count.
+		return ctx.Err()
+	}
+	if resp != nil {
+		params.ResponseStatus = pgtype.Int4{Int32: int32(resp.StatusCode), Valid: true}
+	}
+
+	if sendErr == nil && resp.OK() {
+		params.DeliveredAt = pgTimestamptz(s.now())
+		if err := s.webhookRepo.UpdateWebhookDelivery(ctx, params); err != nil {
+			return err
+		}
+		return s.webhookRepo.ResetWebhookFailures(ctx, e.ID)
+	}
+
+	failure := sendErr
+	if failure == nil {
+		failure = fmt.Errorf("endpoint responded %d", resp.StatusCode)
+	}
+	params.LastError = failure.Error()
+	params.Status = webhookPending
+	if job.Attempt < webhookMaxAttempts {
+		if err := s.webhookRepo.UpdateWebhookDelivery(ctx, params); err != nil {
+			return err
+		}
+		return failure
+	}
+
+	params.Status = webhookFailed
+	if err := s.webhookRepo.UpdateWebhookDelivery(ctx, params); err != nil {
+		return err
+	}
+	disabled, err := s.webhookRepo.RecordWebhookFailure(ctx, repo.RecordWebhookFailureParams{
+		ID:          e.ID,
+		MaxFailures: maxWebhookFailures,
+	})
+	if err != nil {
+		return err
+	}
+	if !disabled.Active && e.Active {
+		log.Printf("webhook endpoint %d disabled after %d failed deliveries", e.ID, disabled.ConsecutiveFailures)
+	}
+	return nil
+}
+
+// authorize checks that owner's actor may manage its endpoints.
+func (s *webhookService) authorize(ctx context.Context, owner WebhookOwner) error {
+	if owner.GuildID == nil {
+		return nil
+	}
+	guild, err := loadGuild(ctx, s.webhookRepo, *owner.GuildID)
+	if err != nil {
+		return err
+	}
+	if !guild.IsAdmin(owner.ActorID) {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// ownedEndpoint loads an endpoint of owner that its actor may manage.
+func (s *webhookService) ownedEndpoint(ctx context.Context, owner WebhookOwner, id int32) (repo.WebhookEndpoint, error) {
+	if err := s.authorize(ctx, owner); err != nil {
+		return repo.WebhookEndpoint{}, err
+	}
+
+	e, err := s.webhookRepo.GetWebhookEndpoint(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && e.GuildID != pgInt4(owner.GuildID)) {
+		return repo.WebhookEndpoint{}, ErrWebhookNotFound
+	}
+	return e, err
+}
+
+// emitWebhookEvent queues a delivery of event to every active endpoint
+// subscribed to it: the endpoints of the guild with guildID, if any, and the
+// server's. Call it with the Querier of the transaction making the change.
+func emitWebhookEvent(ctx context.Context, q repo.Querier, event WebhookEvent, guildID *int32, data any) error {
+	endpoints, err := q.GetSubscribedWebhookEndpoints(ctx, repo.GetSubscribedWebhookEndpointsParams{
+		Event:   string(event),
+		GuildID: pgInt4(guildID),
+	})
+	if err != nil || len(endpoints) == 0 {
+		return err
+	}
+
+	eventID, err := newWebhookEventID()
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal(webhookPayload{
+		ID:        eventID,
+		Version:   webhookPayloadVersion,
+		Type:      event,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, e := range endpoints {
+		if _, err := queueWebhookDelivery(ctx, q, e.ID, eventID, event, payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// queueWebhookDelivery logs a delivery to the endpoint with endpointID and
+// queues the job that sends it.
+func queueWebhookDelivery(ctx context.Context, q repo.Querier, endpointID int32, eventID string,
+	event WebhookEvent, payload []byte) (repo.WebhookDelivery, error) {
+	d, err := q.CreateWebhookDelivery(ctx, repo.CreateWebhookDeliveryParams{
+		EndpointID: endpointID,
+		EventID:    eventID,
+		Event:      string(event),
+		Payload:    payload,
+	})
+	if err != nil {
+		return repo.WebhookDelivery{}, err
+	}
+
+	_, err = jobs.Enqueue(ctx, q, DeliverWebhookArgs{DeliveryID: d.ID}, &jobs.EnqueueOptions{
+		Queue:       WebhookQueue,
+		MaxAttempts: webhookMaxAttempts,
+	})
+	return d, err
+}
+
+// validateWebhookInput checks input for an endpoint of owner and returns its
+// events without duplicates.
+func validateWebhookInput(owner WebhookOwner, input *WebhookInput) ([]string, error) {
+	if !isValidWebhookURL(input.URL) {
+		return nil, fmt.Errorf("%w: url must be https and public", ErrInvalidWebhook)
+	}
+	if len(input.Events) == 0 {
+		return nil, fmt.Errorf("%w: no events", ErrInvalidWebhook)
+	}
+
+	events := make([]string, 0, len(input.Events))
+	for _, event := range input.Events {
+		if !slices.Contains(webhookEvents, event) {
+			return nil, fmt.Errorf("%w: unknown event %q", ErrInvalidWebhook, event)
+		}
+		if owner.GuildID != nil && event == WebhookUserRegistered {
+			return nil, fmt.Errorf("%w: guild webhooks can not subscribe to %s", ErrInvalidWebhook, event)
+		}
+		if !slices.Contains(events, string(event)) {
+			events = append(events, string(event))
+		}
+	}
+	return events, nil
+}
+
+// isValidWebhookURL reports whether rawURL is an https URL that does not
+// obviously point into our own network. Hostnames are not resolved here, this
+// keeps out the easy mistakes. The client from webhook.NewHTTPClient checks
+// the address every request actually connects to.
+func isValidWebhookURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != "https" || u.User != nil || u.Hostname() == "" {
+		return false
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") || strings.HasSuffix(host, ".internal") {
+		return false
+	}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return webhook.IsPublicAddr(addr)
+	}
+	return true
+}
+
+// newWebhookSecret returns a random signing secret.
+func newWebhookSecret() (string, error) {
+	b := make([]byte, webhookSecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// newWebhookEventID returns a random id for an event.
+func newWebhookEventID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "evt_" + hex.EncodeToString(b), nil
+}
+
+func mapWebhookEndpoint(e repo.WebhookEndpoint) *WebhookEndpoint {
+	events := make([]WebhookEvent, 0, len(e.Events))
+	for _, event := range e.Events {
+		events = append(events, WebhookEvent(event))
+	}
+	return &WebhookEndpoint{
+		ID:                  e.ID,
+		GuildID:             int4Ptr(e.GuildID),
+		URL:                 e.Url,
+		Events:              events,
+		Active:              e.Active,
+		ConsecutiveFailures: e.ConsecutiveFailures,
+		DisabledAt:          timestamptzPtr(e.DisabledAt),
+		CreatedAt:           e.CreatedAt.Time,
+		UpdatedAt:           e.UpdatedAt.Time,
+	}
+}
+
+func mapWebhookDelivery(d repo.WebhookDelivery) *WebhookDelivery {
+	return &WebhookDelivery{
+		ID:             d.ID,
+		EndpointID:     d.EndpointID,
+		EventID:        d.EventID,
+		Event:          WebhookEvent(d.Event),
+		Payload:        d.Payload,
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		ResponseStatus: int4Ptr(d.ResponseStatus),
+		LastError:      d.LastError,
+		DeliveredAt:    timestamptzPtr(d.DeliveredAt),
+		CreatedAt:      d.CreatedAt.Time,
+	}
+}
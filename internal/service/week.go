@@ -0,0 +1,85 @@
+package service
+
+import (
+	"strings"
+	"time"
+	// Region resets are placed by the wall clock of the region, which needs
+	// its timezone even where the system has no timezone database.
+	_ "time/tzdata"
+)
+
+// Region is a game region, each with its own weekly reset.
+type Region string
+
+const (
+	RegionUS = Region("us")
+	RegionEU = Region("eu")
+)
+
+// regionResets are the weekly resets of the regions, by the local hour they
+// happen at.
+var regionResets = map[Region]WeeklyReset{
+	RegionUS: {Weekday: time.Tuesday, Hour: 8, Location: loadRegionLocation("America/Los_Angeles")},
+	RegionEU: {Weekday: time.Wednesday, Hour: 5, Location: loadRegionLocation("Europe/Paris")},
+}
+
+// WeeklyReset is when the game week rolls over, every Weekday at Hour in
+// Location. Held keystones only count for the week they were recorded in.
+// The reset is placed by the wall clock of Location, so it keeps its local
+// hour across DST changes.
+type WeeklyReset struct {
+	Weekday  time.Weekday
+	Hour     int
+	Location *time.Location
+}
+
+// DefaultWeeklyReset is the reset of the US region.
+var DefaultWeeklyReset = regionResets[RegionUS]
+
+// RegionReset returns the weekly reset of region, case insensitively, and
+// whether the region is known.
+func RegionReset(region Region) (WeeklyReset, bool) {
+	reset, ok := regionResets[Region(strings.ToLower(string(region)))]
+	return reset, ok
+}
+
+// Week is a game week, from the reset at StartsAt until the next at EndsAt.
+type Week struct {
+	StartsAt time.Time `json:"starts_at"`
+	EndsAt   time.Time `json:"ends_at"`
+}
+
+// Contains reports whether t is in the week.
+func (w Week) Contains(t time.Time) bool {
+	return !t.Before(w.StartsAt) && t.Before(w.EndsAt)
+}
+
+// CurrentWeek returns the week now is in, in UTC.
+func (r WeeklyReset) CurrentWeek(now time.Time) Week {
+	start := r.WeekStart(now)
+	return Week{StartsAt: start.UTC(), EndsAt: r.at(start, 7).UTC()}
+}
+
+// WeekStart returns the last reset at or before t.
+func (r WeeklyReset) WeekStart(t time.Time) time.Time {
+	local := t.In(r.Location)
+	days := (int(local.Weekday()) - int(r.Weekday) + 7) % 7
+	start := r.at(local, -days)
+	if start.After(t) {
+		start = r.at(local, -days-7)
+	}
+	return start
+}
+
+// at returns the reset hour of the day days after the day of local.
+func (r WeeklyReset) at(local time.Time, days int) time.Time {
+	return time.Date(local.Year(), local.Month(), local.Day()+days, r.Hour, 0, 0, 0, r.Location)
+}
+
+func loadRegionLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWeeklyReset_CurrentWeek(t *testing.T) {
+	us, eu := regionResets[RegionUS], regionResets[RegionEU]
+
+	tests := []struct {
+		name          string
+		reset         WeeklyReset
+		t             time.Time
+		wantWeekStart time.Time
+		wantNext      time.Time
+	}{
+		{"Mid Week", us, utc(2026, 10, 19, 12, 0), utc(2026, 10, 13, 15, 0), utc(2026, 10, 20, 15, 0)},
+		{"Reset Day Before Reset", us, utc(2026, 10, 20, 14, 59), utc(2026, 10, 13, 15, 0), utc(2026, 10, 20, 15, 0)},
+		{"At Reset", us, utc(2026, 10, 20, 15, 0), utc(2026, 10, 20, 15, 0), utc(2026, 10, 27, 15, 0)},
+		{"US Across DST End", us, utc(2026, 11, 2, 12, 0), utc(2026, 10, 27, 15, 0), utc(2026, 11, 3, 16, 0)},
+		{"Local Day Differs", eu, utc(2026, 10, 21, 2, 30), utc(2026, 10, 14, 3, 0), utc(2026, 10, 21, 3, 0)},
+		{"EU Across DST End", eu, utc(2026, 10, 22, 12, 0), utc(2026, 10, 21, 3, 0), utc(2026, 10, 28, 4, 0)},
+		{"Custom", WeeklyReset{Weekday: time.Monday, Hour: 0, Location: time.UTC}, utc(2026, 10, 19, 0, 0),
+			utc(2026, 10, 19, 0, 0), utc(2026, 10, 26, 0, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			week := tt.reset.CurrentWeek(tt.t)
+			assert.True(t, tt.wantWeekStart.Equal(week.StartsAt), week.StartsAt)
+			assert.True(t, tt.wantNext.Equal(week.EndsAt), week.EndsAt)
+			assert.True(t, week.Contains(tt.t))
+		})
+	}
+}
+
+func TestRegionReset(t *testing.T) {
+	reset, ok := RegionReset("EU")
+	assert.True(t, ok)
+	assert.Equal(t, time.Wednesday, reset.Weekday)
+
+	_, ok = RegionReset("oceania")
+	assert.False(t, ok)
+}
@@ -0,0 +1,183 @@
+// Package webhook sends signed webhook requests to third-party endpoints.
+//
+// Every request carries a signature header like
+//
+//	Dungeon-Time-Signature: t=1741136400,v1=5257a869e7ecebeda32affa62cdca3fa51cad7e77a0e56ff536d0ce8e108d8bd
+//
+// where v1 is the hex HMAC-SHA256 of the timestamp, a dot and the request body,
+// keyed with the endpoint's secret. Receivers should recompute it, compare in
+// constant time and reject old timestamps to stop replays. Verify does this.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// Request headers.
+const (
+	SignatureHeader = "Dungeon-Time-Signature"
+	EventHeader     = "Dungeon-Time-Event"
+	DeliveryHeader  = "Dungeon-Time-Delivery"
+)
+
+var (
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrExpiredSignature = errors.New("webhook signature timestamp is too old")
+	ErrForbiddenAddress = errors.New("webhook address is not public")
+)
+
+// sharedAddressSpace is the carrier-grade NAT range of RFC 6598, not public
+// but not covered by netip.Addr.IsPrivate either.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// IsPublicAddr reports whether addr may be sent webhook requests: it is not a
+// loopback, private, link-local, unspecified, multicast or shared address.
+func IsPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return !(addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() || addr.IsUnspecified() || addr.IsMulticast() ||
+		sharedAddressSpace.Contains(addr))
+}
+
+// NewHTTPClient returns an http.Client for sending requests to URLs users
+// choose. It only connects to public addresses, checked after the hostname is
+// resolved, and does not follow redirects, so endpoints can not steer requests
+// into the server's own network. Requests time out after timeout.
+func NewHTTPClient(timeout time.Duration) *http.Client {
+	return newHTTPClient(timeout, IsPublicAddr)
+}
+
+// newHTTPClient is NewHTTPClient connecting to the addresses allow accepts.
+func newHTTPClient(timeout time.Duration, allow func(netip.Addr) bool) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil || !allow(addrPort.Addr()) {
+				return fmt.Errorf("%w: %s", ErrForbiddenAddress, address)
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Transport: transport,
+		Timeout:   timeout,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// Sign returns the signature header value for body sent at t.
+func Sign(secret string, t time.Time, body []byte) string {
+	ts := strconv.FormatInt(t.Unix(), 10)
+	return fmt.Sprintf("t=%s,v1=%s", ts, signature(secret, ts, body))
+}
+
+// Verify checks a signature header value for body. Signatures made more than
+// tolerance before or after now are rejected.
+func Verify(secret, header string, body []byte, now time.Time, tolerance time.Duration) error {
+	var ts string
+	var sigs []string
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch key {
+		case "t":
+			ts = value
+		case "v1":
+			sigs = append(sigs, value)
+		}
+	}
+
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil || len(sigs) == 0 {
+		return ErrInvalidSignature
+	}
+	if d := now.Sub(time.Unix(unix, 0)); d > tolerance || d < -tolerance {
+		return ErrExpiredSignature
+	}
+
+	want := signature(secret, ts, body)
+	for _, sig := range sigs {
+		if hmac.Equal([]byte(sig), []byte(want)) {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+func signature(secret, ts string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Request is a webhook request to send.
+type Request struct {
+	URL        string
+	Secret     string
+	Event      string
+	DeliveryID string
+	Body       []byte
+}
+
+// Response is what an endpoint answered. The body is not kept.
+type Response struct {
+	StatusCode int
+}
+
+// OK reports whether the endpoint accepted the request with a 2xx status.
+func (r *Response) OK() bool {
+	return r.StatusCode >= 200 && r.StatusCode <= 299
+}
+
+// Client sends signed webhook requests.
+type Client struct {
+	http *http.Client
+	now  func() time.Time
+}
+
+// NewClient creates a Client that sends requests with httpClient. Endpoints are
+// not trusted, give it a client from NewHTTPClient.
+func NewClient(httpClient *http.Client) *Client {
+	return &Client{http: httpClient, now: time.Now}
+}
+
+// Send POSTs a signed request. It returns an error only when there was no
+// response, a response with any status is returned as is.
+func (c *Client) Send(ctx context.Context, r *Request) (*Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.URL, bytes.NewReader(r.Body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Dungeon-Time-Webhooks/1")
+	req.Header.Set(EventHeader, r.Event)
+	req.Header.Set(DeliveryHeader, r.DeliveryID)
+	req.Header.Set(SignatureHeader, Sign(r.Secret, c.now(), r.Body))
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	return &Response{StatusCode: resp.StatusCode}, nil
+}
@@ -0,0 +1,128 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerify(t *testing.T) {
+	sent := time.Unix(1741136400, 0)
+	body := []byte(`{"type":"run.created"}`)
+	header := Sign("whsec_test", sent, body)
+	assert.True(t, strings.HasPrefix(header, "t=1741136400,v1="))
+
+	tests := []struct {
+		name    string
+		secret  string
+		header  string
+		body    []byte
+		now     time.Time
+		wantErr error
+	}{
+		{"Valid", "whsec_test", header, body, sent.Add(time.Minute), nil},
+		{"Rotated Secret Among Several", "whsec_test", "t=1741136400,v1=deadbeef," + header[len("t=1741136400,"):],
+			body, sent, nil},
+		{"Wrong Secret", "whsec_other", header, body, sent, ErrInvalidSignature},
+		{"Changed Body", "whsec_test", header, []byte(`{"type":"run.cancelled"}`), sent, ErrInvalidSignature},
+		{"Too Old", "whsec_test", header, body, sent.Add(10 * time.Minute), ErrExpiredSignature},
+		{"Malformed", "whsec_test", "v1=abc", body, sent, ErrInvalidSignature},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Verify(tt.secret, tt.header, tt.body, tt.now, 5*time.Minute)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestClient_Send(t *testing.T) {
+	sent := time.Unix(1741136400, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "run.created", r.Header.Get(EventHeader))
+		assert.Equal(t, "evt_1", r.Header.Get(DeliveryHeader))
+		assert.NoError(t, Verify("whsec_test", r.Header.Get(SignatureHeader), body, sent, time.Minute))
+		w.WriteHeader(http.StatusGone)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.Client())
+	c.now = func() time.Time { return sent }
+	resp, err := c.Send(context.Background(), &Request{URL: server.URL, Secret: "whsec_test", Event: "run.created",
+		DeliveryID: "evt_1", Body: []byte(`{}`)})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, http.StatusGone, resp.StatusCode)
+	assert.False(t, resp.OK())
+}
+
+func TestNewHTTPClient(t *testing.T) {
+	hit := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hit = true
+	}))
+	defer server.Close()
+
+	// localhost is a public looking hostname that resolves to a loopback address.
+	_, port, _ := net.SplitHostPort(server.Listener.Addr().String())
+	resp, err := NewHTTPClient(time.Second).Post("http://localhost:"+port, "application/json", nil)
+	if err == nil {
+		resp.Body.Close()
+	}
+	assert.ErrorIs(t, err, ErrForbiddenAddress)
+	assert.False(t, hit, "the request never reaches the server")
+}
+
+func TestNewHTTPClient_redirect(t *testing.T) {
+	hit := false
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hit = true
+	}))
+	defer target.Close()
+	redirect := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusTemporaryRedirect))
+	defer redirect.Close()
+
+	// Test servers listen on loopback, so every address is allowed here.
+	c := NewClient(newHTTPClient(time.Second, func(netip.Addr) bool { return true }))
+	resp, err := c.Send(context.Background(), &Request{URL: redirect.URL, Secret: "whsec_test",
+		Event: "run.created", DeliveryID: "evt_1", Body: []byte(`{}`)})
+	if assert.NoError(t, err) {
+		assert.Equal(t, http.StatusTemporaryRedirect, resp.StatusCode)
+		assert.False(t, resp.OK())
+	}
+	assert.False(t, hit, "the redirect is not followed")
+}
+
+func TestIsPublicAddr(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:4700::1111", true},
+		{"127.0.0.1", false},
+		{"10.1.2.3", false},
+		{"192.168.0.10", false},
+		{"169.254.169.254", false},
+		{"100.64.0.1", false},
+		{"0.0.0.0", false},
+		{"::1", false},
+		{"fd00::1", false},
+		{"::ffff:10.0.0.1", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsPublicAddr(netip.MustParseAddr(tt.addr)))
+		})
+	}
+}